@@ -33,6 +33,8 @@ func main() {
 	}
 
 	services := app.NewServices(sqlDB)
+	go services.OutboxService.Run(ctx)
+	go services.WebhookService.Run(ctx)
 	router := app.NewRouter(services)
 
 	c := cors.New(cors.Options{
@@ -0,0 +1,142 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/audit"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/job"
+)
+
+// auditedService records the admins that are registered, changed or deleted and every moderation
+// done from the admin console in the audit log
+type auditedService struct {
+	AdminService
+	auditService audit.Service
+	jobService   job.Service
+}
+
+func NewAuditedService(adminService AdminService, auditService audit.Service, jobService job.Service) AdminService {
+	return &auditedService{
+		AdminService: adminService,
+		auditService: auditService,
+		jobService:   jobService,
+	}
+}
+
+// accountState is the part of a worker or employer that suspending and reactivating changes
+type accountState struct {
+	Status     AccountStatus `json:"status"`
+	Suspension *Suspension   `json:"suspension,omitempty"`
+}
+
+func (auditedS *auditedService) RegisterAdmin(ctx context.Context, adminData Admin) (Admin, error) {
+	createdAdmin, err := auditedS.AdminService.RegisterAdmin(ctx, adminData)
+	if err != nil {
+		return Admin{}, err
+	}
+
+	auditedS.auditService.Record(ctx, audit.Create, audit.Admin, createdAdmin.ID, nil, createdAdmin)
+	return createdAdmin, nil
+}
+
+func (auditedS *auditedService) DeleteAdmin(ctx context.Context, adminId int) error {
+	before := auditedS.findAdmin(ctx, adminId)
+
+	err := auditedS.AdminService.DeleteAdmin(ctx, adminId)
+	if err != nil {
+		return err
+	}
+
+	auditedS.auditService.Record(ctx, audit.Delete, audit.Admin, adminId, before, nil)
+	return nil
+}
+
+func (auditedS *auditedService) UpdateAdminRole(ctx context.Context, adminId int, roleUpdate RoleUpdate) (Admin, error) {
+	before := auditedS.findAdmin(ctx, adminId)
+
+	updatedAdmin, err := auditedS.AdminService.UpdateAdminRole(ctx, adminId, roleUpdate)
+	if err != nil {
+		return Admin{}, err
+	}
+
+	auditedS.auditService.Record(ctx, audit.Update, audit.Admin, adminId, before, updatedAdmin)
+	return updatedAdmin, nil
+}
+
+func (auditedS *auditedService) SuspendUser(ctx context.Context, userType UserType, userId int, adminId int, suspension Suspension) (UserSummary, error) {
+	user, err := auditedS.AdminService.SuspendUser(ctx, userType, userId, adminId, suspension)
+	if err != nil {
+		return UserSummary{}, err
+	}
+
+	auditedS.auditService.Record(ctx, audit.Suspend, audit.EntityType(userType), userId, accountState{Status: Active}, accountState{Status: user.Status, Suspension: user.Suspension})
+	return user, nil
+}
+
+func (auditedS *auditedService) ReactivateUser(ctx context.Context, userType UserType, userId int) (UserSummary, error) {
+	user, err := auditedS.AdminService.ReactivateUser(ctx, userType, userId)
+	if err != nil {
+		return UserSummary{}, err
+	}
+
+	auditedS.auditService.Record(ctx, audit.Reactivate, audit.EntityType(userType), userId, accountState{Status: Suspended}, accountState{Status: user.Status})
+	return user, nil
+}
+
+func (auditedS *auditedService) HideJob(ctx context.Context, jobId int, adminId int, moderation Moderation) (HiddenJob, error) {
+	hiddenJob, err := auditedS.AdminService.HideJob(ctx, jobId, adminId, moderation)
+	if err != nil {
+		return HiddenJob{}, err
+	}
+
+	auditedS.auditService.Record(ctx, audit.Hide, audit.Job, jobId, nil, hiddenJob)
+	return hiddenJob, nil
+}
+
+func (auditedS *auditedService) UnhideJob(ctx context.Context, jobId int) error {
+	var before interface{}
+	if hiddenJobs, err := auditedS.AdminService.FetchHiddenJobs(ctx); err == nil {
+		for _, hiddenJob := range hiddenJobs {
+			if hiddenJob.JobID == jobId {
+				before = hiddenJob
+			}
+		}
+	}
+
+	err := auditedS.AdminService.UnhideJob(ctx, jobId)
+	if err != nil {
+		return err
+	}
+
+	auditedS.auditService.Record(ctx, audit.Unhide, audit.Job, jobId, before, nil)
+	return nil
+}
+
+func (auditedS *auditedService) RemoveJob(ctx context.Context, jobId int) (int, error) {
+	var before interface{}
+	if fetchedJob, err := auditedS.jobService.FetchJobByID(ctx, jobId); err == nil {
+		before = fetchedJob
+	}
+
+	removedId, err := auditedS.AdminService.RemoveJob(ctx, jobId)
+	if err != nil {
+		return removedId, err
+	}
+
+	auditedS.auditService.Record(ctx, audit.Delete, audit.Job, jobId, before, nil)
+	return removedId, nil
+}
+
+// findAdmin returns the admin to record as the state before a change, nil when it cannot be found
+func (auditedS *auditedService) findAdmin(ctx context.Context, adminId int) interface{} {
+	admins, err := auditedS.AdminService.FetchAdmins(ctx)
+	if err != nil {
+		return nil
+	}
+	for _, admin := range admins {
+		if admin.ID == adminId {
+			return admin
+		}
+	}
+	return nil
+}
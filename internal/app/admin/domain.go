@@ -1,13 +1,103 @@
 package admin
 
-import "time"
+import (
+	"time"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/datetime"
+)
 
 type Admin struct {
+	ID        int       `json:"id"`
 	Name      string    `json:"name"`
 	ContactNo string    `json:"contact_no"`
 	Email     string    `json:"email"`
-	Password  string    `json:"password"`
+	Password  string    `json:"password,omitempty"`
 	Role      string    `json:"role"`
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
 }
+
+type RoleUpdate struct {
+	Role string `json:"role"`
+}
+
+type UserType string
+
+const (
+	Worker   UserType = "worker"
+	Employer UserType = "employer"
+)
+
+type AccountStatus string
+
+const (
+	Active    AccountStatus = "active"
+	Suspended AccountStatus = "suspended"
+)
+
+// UserSearch filters the workers or employers listed in the admin console, Query matches the
+// name, email or contact number
+type UserSearch struct {
+	Query  string
+	City   string
+	Status AccountStatus
+	Limit  int
+	Offset int
+}
+
+type UserSummary struct {
+	ID            int           `json:"id"`
+	Type          UserType      `json:"type"`
+	Name          string        `json:"name"`
+	ContactNumber string        `json:"contact_number"`
+	Email         string        `json:"email"`
+	City          string        `json:"city"`
+	State         string        `json:"state"`
+	Status        AccountStatus `json:"status"`
+	Suspension    *Suspension   `json:"suspension,omitempty"`
+	CreatedAt     time.Time     `json:"created_at"`
+}
+
+type Suspension struct {
+	Reason      string     `json:"reason"`
+	SuspendedBy int        `json:"suspended_by,omitempty"`
+	SuspendedAt *time.Time `json:"suspended_at,omitempty"`
+}
+
+// Moderation is the reason an admin gives for hiding a job
+type Moderation struct {
+	Reason string `json:"reason"`
+}
+
+type HiddenJob struct {
+	JobID      int       `json:"job_id"`
+	Title      string    `json:"title"`
+	EmployerID int       `json:"employer_id"`
+	Reason     string    `json:"reason"`
+	HiddenBy   int       `json:"hidden_by"`
+	HiddenAt   time.Time `json:"hidden_at"`
+}
+
+// Dashboard are the platform counts between two dates, both included
+type Dashboard struct {
+	From                 datetime.Date     `json:"from"`
+	To                   datetime.Date     `json:"to"`
+	Registrations        RegistrationStats `json:"registrations"`
+	Jobs                 JobStats          `json:"jobs"`
+	ApplicationsByStatus map[string]int    `json:"applications_by_status"`
+}
+
+type RegistrationStats struct {
+	Workers   int `json:"workers"`
+	Employers int `json:"employers"`
+}
+
+// JobStats count the jobs posted in the range and how many of their vacancies were filled, open
+// jobs are the jobs that can still be applied to today
+type JobStats struct {
+	Posted          int     `json:"posted"`
+	Open            int     `json:"open"`
+	Vacancies       int     `json:"vacancies"`
+	FilledVacancies int     `json:"filled_vacancies"`
+	FillRate        float64 `json:"fill_rate"`
+}
@@ -1,6 +1,7 @@
 package admin
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"net/http"
@@ -26,7 +27,7 @@ func RegisterAdmin(adminS AdminService) func(w http.ResponseWriter, r *http.Requ
 
 		admin, err := adminS.RegisterAdmin(ctx, req)
 		if err != nil {
-			if errors.Is(err, apperrors.ErrInvalidUserDetails) {
+			if errors.Is(err, apperrors.ErrInvalidUserDetails) || errors.Is(err, apperrors.ErrInvalidAdminRole) {
 				logger.Errorw(ctx, apperrors.ErrCreateAdmin.Error(), zap.Error(err))
 				middleware.HandleErrorResponse(ctx, w, apperrors.ErrCreateAdmin.Error()+": "+err.Error(), http.StatusBadRequest)
 				return
@@ -68,11 +69,287 @@ func DeleteAdmin(adminS AdminService) func(w http.ResponseWriter, r *http.Reques
 				return
 			}
 
+			if errors.Is(err, apperrors.ErrLastSuperAdmin) {
+				logger.Errorw(ctx, apperrors.ErrDeleteAdmin.Error(), zap.Error(err), zap.String("ID", id))
+				middleware.HandleErrorResponse(ctx, w, apperrors.ErrDeleteAdmin.Error()+": "+err.Error(), http.StatusConflict)
+				return
+			}
+
 			logger.Errorw(ctx, apperrors.ErrDeleteAdmin.Error(), zap.Error(err), zap.String("ID", id))
 			middleware.HandleErrorResponse(ctx, w, apperrors.ErrDeleteAdmin.Error()+", "+err.Error(), http.StatusInternalServerError)
 			return
 		}
 
-		w.WriteHeader(http.StatusNoContent)
+		middleware.HandleSuccessResponse(ctx, w, "admin deleted successfully", http.StatusOK, adminId)
+	}
+}
+
+func FetchAdmins(adminS AdminService) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		admins, err := adminS.FetchAdmins(ctx)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrFetchAdmins.Error(), zap.Error(err))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrFetchAdmins.Error()+", "+err.Error(), adminErrorStatusCode(err))
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, "admins retrieved successfully", http.StatusOK, admins)
+	}
+}
+
+func UpdateAdminRole(adminS AdminService) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		adminId, id := isPathIdValid(ctx, w, r, "admin_id", apperrors.MsgInvalidAdminId, apperrors.ErrUpdateAdmin)
+		if adminId == -1 {
+			return
+		}
+
+		var roleUpdate RoleUpdate
+		err := json.NewDecoder(r.Body).Decode(&roleUpdate)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrInvalidRequestBody.Error(), zap.Error(err))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrInvalidRequestBody.Error()+": "+err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		admin, err := adminS.UpdateAdminRole(ctx, adminId, roleUpdate)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrUpdateAdmin.Error(), zap.Error(err), zap.String("ID", id))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrUpdateAdmin.Error()+": "+err.Error(), adminErrorStatusCode(err))
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, "admin role updated successfully", http.StatusOK, admin)
+	}
+}
+
+func SearchUsers(adminS AdminService, userType UserType) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		query := r.URL.Query()
+		search := UserSearch{
+			Query:  query.Get("q"),
+			City:   query.Get("city"),
+			Status: AccountStatus(query.Get("status")),
+		}
+
+		var err error
+		if limit := query.Get("limit"); limit != "" {
+			search.Limit, err = strconv.Atoi(limit)
+		}
+		if offset := query.Get("offset"); offset != "" && err == nil {
+			search.Offset, err = strconv.Atoi(offset)
+		}
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrInvalidUserSearch.Error(), zap.Error(err))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrFetchUsers.Error()+": "+apperrors.ErrInvalidUserSearch.Error(), http.StatusBadRequest)
+			return
+		}
+
+		users, err := adminS.SearchUsers(ctx, userType, search)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrFetchUsers.Error(), zap.Error(err), zap.String("type", string(userType)))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrFetchUsers.Error()+", "+err.Error(), adminErrorStatusCode(err))
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, string(userType)+"s retrieved successfully", http.StatusOK, users)
+	}
+}
+
+func SuspendUser(adminS AdminService, userType UserType) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		userId, id := isPathIdValid(ctx, w, r, userIdKey(userType), userIdMsg(userType), apperrors.ErrSuspendUser)
+		if userId == -1 {
+			return
+		}
+
+		var suspension Suspension
+		err := json.NewDecoder(r.Body).Decode(&suspension)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrInvalidRequestBody.Error(), zap.Error(err))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrInvalidRequestBody.Error()+": "+err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		user, err := adminS.SuspendUser(ctx, userType, userId, currentAdminId(ctx), suspension)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrSuspendUser.Error(), zap.Error(err), zap.String("ID", id))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrSuspendUser.Error()+": "+err.Error(), adminErrorStatusCode(err))
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, string(userType)+" suspended successfully", http.StatusOK, user)
+	}
+}
+
+func ReactivateUser(adminS AdminService, userType UserType) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		userId, id := isPathIdValid(ctx, w, r, userIdKey(userType), userIdMsg(userType), apperrors.ErrReactivateUser)
+		if userId == -1 {
+			return
+		}
+
+		user, err := adminS.ReactivateUser(ctx, userType, userId)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrReactivateUser.Error(), zap.Error(err), zap.String("ID", id))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrReactivateUser.Error()+": "+err.Error(), adminErrorStatusCode(err))
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, string(userType)+" reactivated successfully", http.StatusOK, user)
+	}
+}
+
+func HideJob(adminS AdminService) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		jobId, id := isPathIdValid(ctx, w, r, "job_id", apperrors.MsgInvalidJobId, apperrors.ErrModerateJob)
+		if jobId == -1 {
+			return
+		}
+
+		var moderation Moderation
+		err := json.NewDecoder(r.Body).Decode(&moderation)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrInvalidRequestBody.Error(), zap.Error(err))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrInvalidRequestBody.Error()+": "+err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		hiddenJob, err := adminS.HideJob(ctx, jobId, currentAdminId(ctx), moderation)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrModerateJob.Error(), zap.Error(err), zap.String("ID", id))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrModerateJob.Error()+": "+err.Error(), adminErrorStatusCode(err))
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, "job hidden from the job listings", http.StatusOK, hiddenJob)
+	}
+}
+
+func UnhideJob(adminS AdminService) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		jobId, id := isPathIdValid(ctx, w, r, "job_id", apperrors.MsgInvalidJobId, apperrors.ErrModerateJob)
+		if jobId == -1 {
+			return
+		}
+
+		err := adminS.UnhideJob(ctx, jobId)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrModerateJob.Error(), zap.Error(err), zap.String("ID", id))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrModerateJob.Error()+": "+err.Error(), adminErrorStatusCode(err))
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, "job restored to the job listings", http.StatusOK, jobId)
+	}
+}
+
+func FetchHiddenJobs(adminS AdminService) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		hiddenJobs, err := adminS.FetchHiddenJobs(ctx)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrFetchJob.Error(), zap.Error(err))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrFetchJob.Error()+", "+err.Error(), adminErrorStatusCode(err))
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, "hidden jobs retrieved successfully", http.StatusOK, hiddenJobs)
+	}
+}
+
+func RemoveJob(adminS AdminService) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		jobId, id := isPathIdValid(ctx, w, r, "job_id", apperrors.MsgInvalidJobId, apperrors.ErrDeleteJob)
+		if jobId == -1 {
+			return
+		}
+
+		removedId, err := adminS.RemoveJob(ctx, jobId)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrDeleteJob.Error(), zap.Error(err), zap.String("ID", id))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrDeleteJob.Error()+": "+err.Error(), adminErrorStatusCode(err))
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, "job removed successfully", http.StatusOK, removedId)
+	}
+}
+
+func FetchDashboard(adminS AdminService) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		from := r.URL.Query().Get("from")
+		to := r.URL.Query().Get("to")
+		dashboard, err := adminS.FetchDashboard(ctx, from, to)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrFetchDashboard.Error(), zap.Error(err), zap.String("from", from), zap.String("to", to))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrFetchDashboard.Error()+", "+err.Error(), adminErrorStatusCode(err))
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, "dashboard retrieved successfully", http.StatusOK, dashboard)
+	}
+}
+
+// currentAdminId is the admin making the request, taken from the JWT when the route is authenticated
+func currentAdminId(ctx context.Context) int {
+	userId, _ := ctx.Value("user_id").(int)
+	return userId
+}
+
+func userIdKey(userType UserType) string {
+	return string(userType) + "_id"
+}
+
+func userIdMsg(userType UserType) string {
+	if userType == Employer {
+		return apperrors.MsgInvalidEmployerId
+	}
+	return apperrors.MsgInvalidWorkerId
+}
+
+func isPathIdValid(ctx context.Context, w http.ResponseWriter, r *http.Request, key string, invalidMsg string, errType error) (int, string) {
+	vars := mux.Vars(r)
+	id := vars[key]
+	pathId, err := strconv.Atoi(id)
+	if err != nil {
+		logger.Errorw(ctx, invalidMsg, zap.Error(err), zap.String("ID", id))
+		httpResponseMsg := apperrors.HttpErrorResponseMessage(errType.Error(), invalidMsg, id)
+		middleware.HandleErrorResponse(ctx, w, httpResponseMsg, http.StatusBadRequest)
+		return -1, id
+	}
+	return pathId, id
+}
+
+func adminErrorStatusCode(err error) int {
+	switch {
+	case errors.Is(err, apperrors.ErrInvalidUserSearch), errors.Is(err, apperrors.ErrInvalidSuspension), errors.Is(err, apperrors.ErrInvalidModeration),
+		errors.Is(err, apperrors.ErrInvalidAdminRole), errors.Is(err, apperrors.ErrInvalidDashboardRange), errors.Is(err, apperrors.ErrInvalidDateTime):
+		return http.StatusBadRequest
+	case errors.Is(err, apperrors.ErrNoWorkerExists), errors.Is(err, apperrors.ErrNoEmployerExists), errors.Is(err, apperrors.ErrNoJobExists), errors.Is(err, apperrors.ErrNoAdminExists):
+		return http.StatusNotFound
+	case errors.Is(err, apperrors.ErrAccountAlreadySuspended), errors.Is(err, apperrors.ErrAccountNotSuspended), errors.Is(err, apperrors.ErrJobAlreadyHidden),
+		errors.Is(err, apperrors.ErrJobNotHidden), errors.Is(err, apperrors.ErrLastSuperAdmin):
+		return http.StatusConflict
 	}
+	return http.StatusInternalServerError
 }
@@ -1,7 +1,106 @@
 package admin
 
-import "github.com/harsh-jagtap-josh/RozgarLink/internal/repo"
+import (
+	"math"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo"
+)
+
+const (
+	defaultSearchLimit = 20
+	maxSearchLimit     = 100
+	// the dashboard covers the last 30 days unless a range is asked for, and at most a year
+	defaultDashboardDays = 30
+	maxDashboardDays     = 366
+	// admins are registered with the built-in admin role unless another one is given
+	defaultAdminRole = "admin"
+)
 
 func MapAdminServiceToRepo(adminData Admin) repo.Admin {
 	return repo.Admin(adminData)
 }
+
+// MapAdminRepoToService never hands out the password hash of an admin
+func MapAdminRepoToService(adminData repo.Admin) Admin {
+	admin := Admin(adminData)
+	admin.Password = ""
+	return admin
+}
+
+func MapUserSummaryRepoToService(userType UserType, user repo.UserSummary) UserSummary {
+	summary := UserSummary{
+		ID:            user.ID,
+		Type:          userType,
+		Name:          user.Name,
+		ContactNumber: user.ContactNumber,
+		Email:         user.Email,
+		City:          user.City,
+		State:         user.State,
+		Status:        Active,
+		CreatedAt:     user.CreatedAt,
+	}
+	if user.SuspendedAt != nil {
+		summary.Status = Suspended
+		summary.Suspension = &Suspension{
+			Reason:      user.SuspensionReason,
+			SuspendedBy: user.SuspendedBy,
+			SuspendedAt: user.SuspendedAt,
+		}
+	}
+	return summary
+}
+
+func MapHiddenJobRepoToService(hiddenJob repo.HiddenJob) HiddenJob {
+	return HiddenJob(hiddenJob)
+}
+
+func MapDashboardStatsRepoToService(stats repo.DashboardStats) Dashboard {
+	dashboard := Dashboard{
+		Registrations: RegistrationStats{
+			Workers:   stats.WorkerRegistrations,
+			Employers: stats.EmployerRegistrations,
+		},
+		Jobs: JobStats{
+			Posted:          stats.JobsPosted,
+			Open:            stats.OpenJobs,
+			Vacancies:       stats.Vacancies,
+			FilledVacancies: stats.FilledVacancies,
+			FillRate:        fillRate(stats.FilledVacancies, stats.Vacancies),
+		},
+		ApplicationsByStatus: map[string]int{
+			string(repo.Pending):     0,
+			string(repo.Shortlisted): 0,
+			string(repo.Confirmed):   0,
+		},
+	}
+	for _, count := range stats.ApplicationsByStatus {
+		dashboard.ApplicationsByStatus[count.Status] = count.Count
+	}
+	return dashboard
+}
+
+// fillRate is the share of vacancies filled, rounded to two decimals
+func fillRate(filled, vacancies int) float64 {
+	if vacancies == 0 {
+		return 0
+	}
+	return math.Round(float64(filled)/float64(vacancies)*100) / 100
+}
+
+func normalizeUserSearch(search UserSearch) (UserSearch, error) {
+	if search.Status != "" && search.Status != Active && search.Status != Suspended {
+		return UserSearch{}, apperrors.ErrInvalidUserSearch
+	}
+	if search.Limit < 0 || search.Offset < 0 {
+		return UserSearch{}, apperrors.ErrInvalidUserSearch
+	}
+
+	if search.Limit == 0 {
+		search.Limit = defaultSearchLimit
+	}
+	if search.Limit > maxSearchLimit {
+		search.Limit = maxSearchLimit
+	}
+	return search, nil
+}
@@ -2,25 +2,46 @@ package admin
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/datetime"
 	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/utils"
 	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo"
 )
 
 type service struct {
-	adminRepo repo.AdminStorer
+	adminRepo        repo.AdminStorer
+	adminConsoleRepo repo.AdminConsoleStorer
+	jobRepo          repo.JobStorer
+	roleRepo         repo.RoleStorer
+	today            func() datetime.Date
 }
 
 type AdminService interface {
 	RegisterAdmin(ctx context.Context, adminData Admin) (Admin, error)
 	DeleteAdmin(ctx context.Context, adminId int) error
+	FetchAdmins(ctx context.Context) ([]Admin, error)
+	UpdateAdminRole(ctx context.Context, adminId int, roleUpdate RoleUpdate) (Admin, error)
+	SearchUsers(ctx context.Context, userType UserType, search UserSearch) ([]UserSummary, error)
+	SuspendUser(ctx context.Context, userType UserType, userId int, adminId int, suspension Suspension) (UserSummary, error)
+	ReactivateUser(ctx context.Context, userType UserType, userId int) (UserSummary, error)
+	HideJob(ctx context.Context, jobId int, adminId int, moderation Moderation) (HiddenJob, error)
+	UnhideJob(ctx context.Context, jobId int) error
+	FetchHiddenJobs(ctx context.Context) ([]HiddenJob, error)
+	RemoveJob(ctx context.Context, jobId int) (int, error)
+	FetchDashboard(ctx context.Context, from, to string) (Dashboard, error)
 }
 
-func NewAdminService(adminRepo repo.AdminStorer) AdminService {
+func NewAdminService(adminRepo repo.AdminStorer, adminConsoleRepo repo.AdminConsoleStorer, jobRepo repo.JobStorer, roleRepo repo.RoleStorer) AdminService {
 	return &service{
-		adminRepo: adminRepo,
+		adminRepo:        adminRepo,
+		adminConsoleRepo: adminConsoleRepo,
+		jobRepo:          jobRepo,
+		roleRepo:         roleRepo,
+		today:            datetime.Today,
 	}
 }
 
@@ -36,6 +57,14 @@ func (adminS *service) RegisterAdmin(ctx context.Context, adminData Admin) (Admi
 		return Admin{}, apperrors.ErrAdminExists
 	}
 
+	if strings.TrimSpace(adminData.Role) == "" {
+		adminData.Role = defaultAdminRole
+	}
+	adminData.Role, err = adminS.assignableRole(ctx, adminData.Role)
+	if err != nil {
+		return Admin{}, err
+	}
+
 	hashed_password, err := utils.HashPassword(adminData.Password)
 	if err != nil {
 		return Admin{}, fmt.Errorf("%w: %w", apperrors.ErrEncrPassword, err)
@@ -47,7 +76,7 @@ func (adminS *service) RegisterAdmin(ctx context.Context, adminData Admin) (Admi
 		return Admin{}, err
 	}
 
-	return Admin(createdAdmin), nil
+	return MapAdminRepoToService(createdAdmin), nil
 }
 
 func (adminS *service) DeleteAdmin(ctx context.Context, adminId int) error {
@@ -61,3 +90,187 @@ func (adminS *service) DeleteAdmin(ctx context.Context, adminId int) error {
 	}
 	return nil
 }
+
+func (adminS *service) FetchAdmins(ctx context.Context) ([]Admin, error) {
+	admins, err := adminS.adminRepo.FetchAdmins(ctx)
+	if err != nil {
+		return []Admin{}, err
+	}
+
+	mappedAdmins := make([]Admin, 0, len(admins))
+	for _, admin := range admins {
+		mappedAdmins = append(mappedAdmins, MapAdminRepoToService(admin))
+	}
+	return mappedAdmins, nil
+}
+
+func (adminS *service) UpdateAdminRole(ctx context.Context, adminId int, roleUpdate RoleUpdate) (Admin, error) {
+	role, err := adminS.assignableRole(ctx, roleUpdate.Role)
+	if err != nil {
+		return Admin{}, err
+	}
+
+	admin, err := adminS.adminRepo.UpdateAdminRole(ctx, adminId, role)
+	if err != nil {
+		return Admin{}, err
+	}
+	return MapAdminRepoToService(admin), nil
+}
+
+// assignableRole normalizes the role given to an admin, admins can be given any role stored in the
+// roles table but the ones of workers and employers
+func (adminS *service) assignableRole(ctx context.Context, role string) (string, error) {
+	role = strings.ToLower(strings.TrimSpace(role))
+	if role == "worker" || role == "employer" {
+		return "", apperrors.ErrInvalidAdminRole
+	}
+
+	_, err := adminS.roleRepo.FetchRole(ctx, role)
+	if err != nil {
+		if errors.Is(err, apperrors.ErrNoRoleExists) {
+			return "", fmt.Errorf("%w: %w", apperrors.ErrInvalidAdminRole, err)
+		}
+		return "", err
+	}
+	return role, nil
+}
+
+func (adminS *service) SearchUsers(ctx context.Context, userType UserType, search UserSearch) ([]UserSummary, error) {
+	search, err := normalizeUserSearch(search)
+	if err != nil {
+		return []UserSummary{}, err
+	}
+
+	users, err := adminS.adminConsoleRepo.SearchUsers(ctx, repo.UserType(userType), repo.UserSearch{
+		Query:  strings.TrimSpace(search.Query),
+		City:   strings.TrimSpace(search.City),
+		Status: string(search.Status),
+		Limit:  search.Limit,
+		Offset: search.Offset,
+	})
+	if err != nil {
+		return []UserSummary{}, err
+	}
+
+	summaries := make([]UserSummary, 0, len(users))
+	for _, user := range users {
+		summaries = append(summaries, MapUserSummaryRepoToService(userType, user))
+	}
+	return summaries, nil
+}
+
+// Suspend a worker or employer, suspended accounts cannot log in and the jobs of a suspended
+// employer are left out of the job listings until the account is reactivated
+func (adminS *service) SuspendUser(ctx context.Context, userType UserType, userId int, adminId int, suspension Suspension) (UserSummary, error) {
+	reason := strings.TrimSpace(suspension.Reason)
+	if reason == "" {
+		return UserSummary{}, apperrors.ErrInvalidSuspension
+	}
+
+	user, err := adminS.adminConsoleRepo.SuspendUser(ctx, repo.UserType(userType), userId, reason, adminId)
+	if err != nil {
+		return UserSummary{}, err
+	}
+	return MapUserSummaryRepoToService(userType, user), nil
+}
+
+func (adminS *service) ReactivateUser(ctx context.Context, userType UserType, userId int) (UserSummary, error) {
+	user, err := adminS.adminConsoleRepo.ReactivateUser(ctx, repo.UserType(userType), userId)
+	if err != nil {
+		return UserSummary{}, err
+	}
+	return MapUserSummaryRepoToService(userType, user), nil
+}
+
+// Hide a job from the job listings, its employer and the workers who applied still see it
+func (adminS *service) HideJob(ctx context.Context, jobId int, adminId int, moderation Moderation) (HiddenJob, error) {
+	reason := strings.TrimSpace(moderation.Reason)
+	if reason == "" {
+		return HiddenJob{}, apperrors.ErrInvalidModeration
+	}
+
+	hiddenJob, err := adminS.adminConsoleRepo.HideJob(ctx, jobId, reason, adminId)
+	if err != nil {
+		return HiddenJob{}, err
+	}
+	return MapHiddenJobRepoToService(hiddenJob), nil
+}
+
+func (adminS *service) UnhideJob(ctx context.Context, jobId int) error {
+	return adminS.adminConsoleRepo.UnhideJob(ctx, jobId)
+}
+
+func (adminS *service) FetchHiddenJobs(ctx context.Context) ([]HiddenJob, error) {
+	hiddenJobs, err := adminS.adminConsoleRepo.FetchHiddenJobs(ctx)
+	if err != nil {
+		return []HiddenJob{}, err
+	}
+
+	mappedJobs := make([]HiddenJob, 0, len(hiddenJobs))
+	for _, hiddenJob := range hiddenJobs {
+		mappedJobs = append(mappedJobs, MapHiddenJobRepoToService(hiddenJob))
+	}
+	return mappedJobs, nil
+}
+
+// Remove a job posting for good, the job is deleted the same way its employer deletes it
+func (adminS *service) RemoveJob(ctx context.Context, jobId int) (int, error) {
+	exists := adminS.jobRepo.FindJobById(ctx, jobId)
+	if !exists {
+		return -1, apperrors.ErrNoJobExists
+	}
+
+	return adminS.jobRepo.DeleteJobById(ctx, jobId)
+}
+
+// Fetch the platform counts between from and to, both YYYY-MM-DD and included. Without a range
+// the last 30 days up to today are counted.
+func (adminS *service) FetchDashboard(ctx context.Context, from, to string) (Dashboard, error) {
+	today := adminS.today()
+
+	toDate := today
+	if to != "" {
+		parsed, err := datetime.ParseDate(to)
+		if err != nil {
+			return Dashboard{}, err
+		}
+		toDate = parsed
+	}
+
+	fromDate, err := toDate.AddDays(1 - defaultDashboardDays)
+	if err != nil {
+		return Dashboard{}, err
+	}
+	if from != "" {
+		fromDate, err = datetime.ParseDate(from)
+		if err != nil {
+			return Dashboard{}, err
+		}
+	}
+
+	fromTime, err := fromDate.Time()
+	if err != nil {
+		return Dashboard{}, err
+	}
+	// the range ends at the midnight after the last day
+	toTime, err := toDate.Time()
+	if err != nil {
+		return Dashboard{}, err
+	}
+	toTime = toTime.AddDate(0, 0, 1)
+
+	days := int(toTime.Sub(fromTime).Hours() / 24)
+	if days < 1 || days > maxDashboardDays {
+		return Dashboard{}, apperrors.ErrInvalidDashboardRange
+	}
+
+	stats, err := adminS.adminConsoleRepo.FetchDashboardStats(ctx, fromTime, toTime, today)
+	if err != nil {
+		return Dashboard{}, err
+	}
+
+	dashboard := MapDashboardStatsRepoToService(stats)
+	dashboard.From = fromDate
+	dashboard.To = toDate
+	return dashboard, nil
+}
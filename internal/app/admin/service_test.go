@@ -0,0 +1,430 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/datetime"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type AdminServiceTestSuite struct {
+	suite.Suite
+	service          AdminService
+	adminRepo        mocks.AdminStorer
+	adminConsoleRepo mocks.AdminConsoleStorer
+	jobRepo          mocks.JobStorer
+	roleRepo         mocks.RoleStorer
+}
+
+var suspendedAt = time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC)
+
+func (suite *AdminServiceTestSuite) SetupTest() {
+	suite.adminRepo = mocks.AdminStorer{}
+	suite.adminConsoleRepo = mocks.AdminConsoleStorer{}
+	suite.jobRepo = mocks.JobStorer{}
+	suite.roleRepo = mocks.RoleStorer{}
+	suite.service = &service{
+		adminRepo:        &suite.adminRepo,
+		adminConsoleRepo: &suite.adminConsoleRepo,
+		jobRepo:          &suite.jobRepo,
+		roleRepo:         &suite.roleRepo,
+		today:            func() datetime.Date { return "2025-03-31" },
+	}
+}
+
+func (suite *AdminServiceTestSuite) TearDownTest() {
+	suite.adminRepo.AssertExpectations(suite.T())
+	suite.adminConsoleRepo.AssertExpectations(suite.T())
+	suite.jobRepo.AssertExpectations(suite.T())
+	suite.roleRepo.AssertExpectations(suite.T())
+}
+
+func TestAdminServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(AdminServiceTestSuite))
+}
+
+func (suite *AdminServiceTestSuite) TestRegisterAdmin() {
+	type testCase struct {
+		name          string
+		input         Admin
+		setup         func()
+		expectedError error
+	}
+
+	newAdmin := func(role string) Admin {
+		return Admin{Name: "Asha Patil", ContactNo: "9876543210", Email: "asha@rozgarlink.in", Password: "Secret@123", Role: role}
+	}
+
+	testCases := []testCase{
+		{
+			name:  "role does not exist",
+			input: newAdmin("owner"),
+			setup: func() {
+				suite.adminRepo.On("FindAdminByEmail", mock.Anything, "asha@rozgarlink.in").Return(false)
+				suite.roleRepo.On("FetchRole", mock.Anything, "owner").Return(repo.Role{}, apperrors.ErrNoRoleExists)
+			},
+			expectedError: apperrors.ErrInvalidAdminRole,
+		},
+		{
+			name:  "role of employers",
+			input: newAdmin("employer"),
+			setup: func() {
+				suite.adminRepo.On("FindAdminByEmail", mock.Anything, "asha@rozgarlink.in").Return(false)
+			},
+			expectedError: apperrors.ErrInvalidAdminRole,
+		},
+	}
+
+	for _, test := range testCases {
+		suite.SetupTest()
+		suite.Run(test.name, func() {
+			test.setup()
+
+			_, err := suite.service.RegisterAdmin(context.Background(), test.input)
+
+			suite.ErrorIs(err, test.expectedError)
+		})
+		suite.TearDownTest()
+	}
+}
+
+func (suite *AdminServiceTestSuite) TestUpdateAdminRole() {
+	type testCase struct {
+		name          string
+		input         RoleUpdate
+		setup         func()
+		expectedError error
+	}
+
+	testCases := []testCase{
+		{
+			name:  "promoted to super admin",
+			input: RoleUpdate{Role: " super-admin "},
+			setup: func() {
+				suite.roleRepo.On("FetchRole", mock.Anything, "super-admin").Return(repo.Role{Name: "super-admin", IsSystem: true}, nil)
+				suite.adminRepo.On("UpdateAdminRole", mock.Anything, 3, "super-admin").Return(repo.Admin{ID: 3, Role: "super-admin", Password: "$2a$hash"}, nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:  "role does not exist",
+			input: RoleUpdate{Role: "owner"},
+			setup: func() {
+				suite.roleRepo.On("FetchRole", mock.Anything, "owner").Return(repo.Role{}, apperrors.ErrNoRoleExists)
+			},
+			expectedError: apperrors.ErrInvalidAdminRole,
+		},
+		{
+			name:          "role of workers",
+			input:         RoleUpdate{Role: "worker"},
+			setup:         func() {},
+			expectedError: apperrors.ErrInvalidAdminRole,
+		},
+		{
+			name:  "last super admin demoted",
+			input: RoleUpdate{Role: "admin"},
+			setup: func() {
+				suite.roleRepo.On("FetchRole", mock.Anything, "admin").Return(repo.Role{Name: "admin", IsSystem: true}, nil)
+				suite.adminRepo.On("UpdateAdminRole", mock.Anything, 3, "admin").Return(repo.Admin{}, apperrors.ErrLastSuperAdmin)
+			},
+			expectedError: apperrors.ErrLastSuperAdmin,
+		},
+	}
+
+	for _, test := range testCases {
+		suite.SetupTest()
+		suite.Run(test.name, func() {
+			test.setup()
+
+			admin, err := suite.service.UpdateAdminRole(context.Background(), 3, test.input)
+
+			suite.ErrorIs(err, test.expectedError)
+			if test.expectedError == nil {
+				suite.Equal("super-admin", admin.Role)
+				suite.Empty(admin.Password)
+			}
+		})
+		suite.TearDownTest()
+	}
+}
+
+func (suite *AdminServiceTestSuite) TestSearchUsers() {
+	type testCase struct {
+		name           string
+		search         UserSearch
+		setup          func()
+		expectedOutput []UserSummary
+		expectedError  error
+	}
+
+	testCases := []testCase{
+		{
+			name:   "default page",
+			search: UserSearch{Query: " patil ", Status: Suspended},
+			setup: func() {
+				suite.adminConsoleRepo.On("SearchUsers", mock.Anything, repo.WorkerUser, repo.UserSearch{Query: "patil", Status: "suspended", Limit: defaultSearchLimit}).Return([]repo.UserSummary{
+					{ID: 7, Name: "Ravi Patil", SuspensionReason: "fake profile", SuspendedBy: 1, SuspendedAt: &suspendedAt},
+				}, nil)
+			},
+			expectedOutput: []UserSummary{
+				{ID: 7, Type: Worker, Name: "Ravi Patil", Status: Suspended, Suspension: &Suspension{Reason: "fake profile", SuspendedBy: 1, SuspendedAt: &suspendedAt}},
+			},
+			expectedError: nil,
+		},
+		{
+			name:   "limit capped",
+			search: UserSearch{Limit: 500, Offset: 40},
+			setup: func() {
+				suite.adminConsoleRepo.On("SearchUsers", mock.Anything, repo.WorkerUser, repo.UserSearch{Limit: maxSearchLimit, Offset: 40}).Return([]repo.UserSummary{{ID: 2, Name: "Sunita"}}, nil)
+			},
+			expectedOutput: []UserSummary{{ID: 2, Type: Worker, Name: "Sunita", Status: Active}},
+			expectedError:  nil,
+		},
+		{
+			name:          "unknown status",
+			search:        UserSearch{Status: "banned"},
+			setup:         func() {},
+			expectedError: apperrors.ErrInvalidUserSearch,
+		},
+		{
+			name:          "negative offset",
+			search:        UserSearch{Offset: -1},
+			setup:         func() {},
+			expectedError: apperrors.ErrInvalidUserSearch,
+		},
+	}
+
+	for _, test := range testCases {
+		suite.SetupTest()
+		suite.Run(test.name, func() {
+			test.setup()
+
+			users, err := suite.service.SearchUsers(context.Background(), Worker, test.search)
+
+			suite.ErrorIs(err, test.expectedError)
+			if test.expectedError == nil {
+				suite.Equal(test.expectedOutput, users)
+			}
+		})
+		suite.TearDownTest()
+	}
+}
+
+func (suite *AdminServiceTestSuite) TestSuspendUser() {
+	type testCase struct {
+		name          string
+		suspension    Suspension
+		setup         func()
+		expectedError error
+	}
+
+	testCases := []testCase{
+		{
+			name:       "suspended",
+			suspension: Suspension{Reason: " posting fake jobs "},
+			setup: func() {
+				suite.adminConsoleRepo.On("SuspendUser", mock.Anything, repo.EmployerUser, 4, "posting fake jobs", 1).Return(repo.UserSummary{ID: 4, SuspensionReason: "posting fake jobs", SuspendedBy: 1, SuspendedAt: &suspendedAt}, nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:          "no reason",
+			suspension:    Suspension{Reason: " "},
+			setup:         func() {},
+			expectedError: apperrors.ErrInvalidSuspension,
+		},
+		{
+			name:       "already suspended",
+			suspension: Suspension{Reason: "spam"},
+			setup: func() {
+				suite.adminConsoleRepo.On("SuspendUser", mock.Anything, repo.EmployerUser, 4, "spam", 1).Return(repo.UserSummary{}, apperrors.ErrAccountAlreadySuspended)
+			},
+			expectedError: apperrors.ErrAccountAlreadySuspended,
+		},
+	}
+
+	for _, test := range testCases {
+		suite.SetupTest()
+		suite.Run(test.name, func() {
+			test.setup()
+
+			user, err := suite.service.SuspendUser(context.Background(), Employer, 4, 1, test.suspension)
+
+			suite.ErrorIs(err, test.expectedError)
+			if test.expectedError == nil {
+				suite.Equal(Suspended, user.Status)
+				suite.Equal(Employer, user.Type)
+				suite.Equal("posting fake jobs", user.Suspension.Reason)
+			}
+		})
+		suite.TearDownTest()
+	}
+}
+
+func (suite *AdminServiceTestSuite) TestHideJob() {
+	type testCase struct {
+		name          string
+		moderation    Moderation
+		setup         func()
+		expectedError error
+	}
+
+	testCases := []testCase{
+		{
+			name:       "hidden",
+			moderation: Moderation{Reason: "asks workers for a deposit"},
+			setup: func() {
+				suite.adminConsoleRepo.On("HideJob", mock.Anything, 12, "asks workers for a deposit", 1).Return(repo.HiddenJob{JobID: 12, Reason: "asks workers for a deposit", HiddenBy: 1}, nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:          "no reason",
+			moderation:    Moderation{},
+			setup:         func() {},
+			expectedError: apperrors.ErrInvalidModeration,
+		},
+		{
+			name:       "job does not exist",
+			moderation: Moderation{Reason: "spam"},
+			setup: func() {
+				suite.adminConsoleRepo.On("HideJob", mock.Anything, 12, "spam", 1).Return(repo.HiddenJob{}, apperrors.ErrNoJobExists)
+			},
+			expectedError: apperrors.ErrNoJobExists,
+		},
+	}
+
+	for _, test := range testCases {
+		suite.SetupTest()
+		suite.Run(test.name, func() {
+			test.setup()
+
+			hiddenJob, err := suite.service.HideJob(context.Background(), 12, 1, test.moderation)
+
+			suite.ErrorIs(err, test.expectedError)
+			if test.expectedError == nil {
+				suite.Equal(12, hiddenJob.JobID)
+			}
+		})
+		suite.TearDownTest()
+	}
+}
+
+func (suite *AdminServiceTestSuite) TestRemoveJob() {
+	suite.Run("removed", func() {
+		suite.SetupTest()
+		suite.jobRepo.On("FindJobById", mock.Anything, 12).Return(true)
+		suite.jobRepo.On("DeleteJobById", mock.Anything, 12).Return(12, nil)
+
+		jobId, err := suite.service.RemoveJob(context.Background(), 12)
+
+		suite.NoError(err)
+		suite.Equal(12, jobId)
+		suite.TearDownTest()
+	})
+
+	suite.Run("job does not exist", func() {
+		suite.SetupTest()
+		suite.jobRepo.On("FindJobById", mock.Anything, 12).Return(false)
+
+		_, err := suite.service.RemoveJob(context.Background(), 12)
+
+		suite.ErrorIs(err, apperrors.ErrNoJobExists)
+		suite.TearDownTest()
+	})
+}
+
+func (suite *AdminServiceTestSuite) TestFetchDashboard() {
+	type testCase struct {
+		name          string
+		from          string
+		to            string
+		setup         func()
+		expectedFrom  datetime.Date
+		expectedError error
+	}
+
+	day := func(value string) time.Time {
+		parsed, _ := datetime.Date(value).Time()
+		return parsed
+	}
+	stats := repo.DashboardStats{
+		WorkerRegistrations: 40, EmployerRegistrations: 6, JobsPosted: 9, OpenJobs: 5, Vacancies: 30, FilledVacancies: 20,
+		ApplicationsByStatus: []repo.ApplicationStatusCount{{Status: "confirmed", Count: 20}, {Status: "pending", Count: 14}},
+	}
+
+	testCases := []testCase{
+		{
+			name: "last 30 days by default",
+			setup: func() {
+				suite.adminConsoleRepo.On("FetchDashboardStats", mock.Anything, day("2025-03-02"), day("2025-04-01"), datetime.Date("2025-03-31")).Return(stats, nil)
+			},
+			expectedFrom:  "2025-03-02",
+			expectedError: nil,
+		},
+		{
+			name: "single day",
+			from: "2025-03-15",
+			to:   "2025-03-15",
+			setup: func() {
+				suite.adminConsoleRepo.On("FetchDashboardStats", mock.Anything, day("2025-03-15"), day("2025-03-16"), datetime.Date("2025-03-31")).Return(stats, nil)
+			},
+			expectedFrom:  "2025-03-15",
+			expectedError: nil,
+		},
+		{
+			name:          "range ends before it starts",
+			from:          "2025-03-15",
+			to:            "2025-03-01",
+			setup:         func() {},
+			expectedError: apperrors.ErrInvalidDashboardRange,
+		},
+		{
+			name:          "range longer than a year",
+			from:          "2024-01-01",
+			to:            "2025-03-01",
+			setup:         func() {},
+			expectedError: apperrors.ErrInvalidDashboardRange,
+		},
+		{
+			name:          "malformed date",
+			from:          "15-03-2025",
+			setup:         func() {},
+			expectedError: apperrors.ErrInvalidDateTime,
+		},
+		{
+			name: "database error",
+			setup: func() {
+				suite.adminConsoleRepo.On("FetchDashboardStats", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(repo.DashboardStats{}, errors.New("connection reset"))
+			},
+			expectedError: errors.New("connection reset"),
+		},
+	}
+
+	for _, test := range testCases {
+		suite.SetupTest()
+		suite.Run(test.name, func() {
+			test.setup()
+
+			dashboard, err := suite.service.FetchDashboard(context.Background(), test.from, test.to)
+
+			if test.expectedError != nil {
+				suite.ErrorContains(err, test.expectedError.Error())
+				return
+			}
+			suite.NoError(err)
+			suite.Equal(test.expectedFrom, dashboard.From)
+			suite.Equal(RegistrationStats{Workers: 40, Employers: 6}, dashboard.Registrations)
+			suite.Equal(JobStats{Posted: 9, Open: 5, Vacancies: 30, FilledVacancies: 20, FillRate: 0.67}, dashboard.Jobs)
+			suite.Equal(map[string]int{"pending": 14, "shortlisted": 0, "confirmed": 20}, dashboard.ApplicationsByStatus)
+		})
+		suite.TearDownTest()
+	}
+}
@@ -0,0 +1,102 @@
+package analytics
+
+import "github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/datetime"
+
+type Season string
+
+const (
+	// Summer is March to May, Monsoon June to September and Winter October to February
+	Summer  Season = "summer"
+	Monsoon Season = "monsoon"
+	Winter  Season = "winter"
+)
+
+type WageFilter struct {
+	Sector string `json:"sector,omitempty"`
+	Skill  string `json:"skill,omitempty"`
+	City   string `json:"city,omitempty"`
+	Season Season `json:"season,omitempty"`
+}
+
+// WageDistribution is the spread of the wages of an 8 hour day, Value is the sector, skill, city or
+// season of a breakdown
+type WageDistribution struct {
+	Value   string  `json:"value,omitempty"`
+	Samples int     `json:"samples"`
+	P25     float64 `json:"p25"`
+	Median  float64 `json:"median"`
+	P75     float64 `json:"p75"`
+}
+
+// WageAnalytics breaks the wages of the jobs matching a filter down by each field that is not filtered
+type WageAnalytics struct {
+	Filter   WageFilter         `json:"filter"`
+	Since    datetime.Date      `json:"since"`
+	Overall  WageDistribution   `json:"overall"`
+	BySector []WageDistribution `json:"by_sector,omitempty"`
+	BySkill  []WageDistribution `json:"by_skill,omitempty"`
+	ByCity   []WageDistribution `json:"by_city,omitempty"`
+	BySeason []WageDistribution `json:"by_season,omitempty"`
+}
+
+// WageSuggestion is the wage of a shift of a drafted job, the median of similar jobs prorated to its
+// hours and never below its minimum wage
+type WageSuggestion struct {
+	Wage            int `json:"wage"`
+	Low             int `json:"low"`
+	High            int `json:"high"`
+	DurationInHours int `json:"duration_in_hours"`
+	// Basis is the filter of the similar jobs, fields are dropped until enough jobs match
+	Basis   WageFilter `json:"basis"`
+	Samples int        `json:"samples"`
+	// MinimumWage is the minimum wage of the shift, RaisedToMinimum is set when similar jobs pay less
+	MinimumWage     int  `json:"minimum_wage,omitempty"`
+	RaisedToMinimum bool `json:"raised_to_minimum,omitempty"`
+}
+
+type Interval string
+
+const (
+	Week  Interval = "week"
+	Month Interval = "month"
+)
+
+// EmployerAnalyticsFilter is the period of the jobs an employer posted, both dates included
+type EmployerAnalyticsFilter struct {
+	From     datetime.Date
+	To       datetime.Date
+	Interval Interval
+}
+
+// EmployerAnalytics is the hiring of an employer for the jobs they posted in a period, rates are
+// fractions between 0 and 1
+type EmployerAnalytics struct {
+	EmployerID           int                   `json:"employer_id"`
+	From                 datetime.Date         `json:"from"`
+	To                   datetime.Date         `json:"to"`
+	Jobs                 int                   `json:"jobs"`
+	Applications         int                   `json:"applications"`
+	ApplicationsPerJob   float64               `json:"applications_per_job"`
+	ApplicationsOverTime []ApplicationActivity `json:"applications_over_time"`
+	FilledJobs           int                   `json:"filled_jobs"`
+	// AverageHoursToFill is the time from posting a job to confirming the worker for its last vacancy
+	AverageHoursToFill float64 `json:"average_hours_to_fill"`
+	// ShortlistRate is the applications shortlisted or confirmed, ConfirmRate the ones confirmed
+	ShortlistRate float64 `json:"shortlist_rate"`
+	ConfirmRate   float64 `json:"confirm_rate"`
+	// NoShowRate is the days confirmed workers did not turn up out of the days attendance was taken
+	NoShowRate          float64 `json:"no_show_rate"`
+	WorkersHired        int     `json:"workers_hired"`
+	AverageWorkerRating float64 `json:"average_worker_rating"`
+	// RepeatHireRate is the workers hired that were hired for more than one job
+	RepeatHireRate float64 `json:"repeat_hire_rate"`
+	// Spend is the wages paid to confirmed workers, refunds taken off
+	Spend int `json:"spend"`
+}
+
+type ApplicationActivity struct {
+	Period             datetime.Date `json:"period"`
+	Jobs               int           `json:"jobs"`
+	Applications       int           `json:"applications"`
+	ApplicationsPerJob float64       `json:"applications_per_job"`
+}
@@ -0,0 +1,115 @@
+package analytics
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/job"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/datetime"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/logger"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/middleware"
+	"go.uber.org/zap"
+)
+
+func FetchWageAnalytics(analyticsService Service) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		query := r.URL.Query()
+		filter := WageFilter{
+			Sector: query.Get("sector"),
+			Skill:  query.Get("skill"),
+			City:   query.Get("city"),
+			Season: Season(query.Get("season")),
+		}
+
+		analytics, err := analyticsService.FetchWageAnalytics(ctx, filter)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrFetchWageAnalytics.Error(), zap.Error(err), zap.String("sector", filter.Sector), zap.String("city", filter.City))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrFetchWageAnalytics.Error()+", "+err.Error(), analyticsErrorStatusCode(err))
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, "wage analytics retrieved successfully", http.StatusOK, analytics)
+	}
+}
+
+// SuggestWage takes the job an employer is drafting, in the same format as creating a job
+func SuggestWage(analyticsService Service) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		var draft job.Job
+		err := json.NewDecoder(r.Body).Decode(&draft)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrInvalidRequestBody.Error(), zap.Error(err))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrInvalidRequestBody.Error()+": "+err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		suggestion, err := analyticsService.SuggestWage(ctx, draft)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrSuggestWage.Error(), zap.Error(err), zap.String("sectors", draft.Sectors), zap.String("city", draft.Location.City))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrSuggestWage.Error()+", "+err.Error(), analyticsErrorStatusCode(err))
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, "wage suggested successfully", http.StatusOK, suggestion)
+	}
+}
+
+func FetchEmployerAnalytics(analyticsService Service) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		employerId, id := isPathIdValid(ctx, w, r, "employer_id", apperrors.MsgInvalidEmployerId, apperrors.ErrFetchEmployerAnalytics)
+		if employerId == -1 {
+			return
+		}
+
+		query := r.URL.Query()
+		filter := EmployerAnalyticsFilter{
+			From:     datetime.Date(query.Get("from")),
+			To:       datetime.Date(query.Get("to")),
+			Interval: Interval(query.Get("interval")),
+		}
+
+		analytics, err := analyticsService.FetchEmployerAnalytics(ctx, employerId, filter)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrFetchEmployerAnalytics.Error(), zap.Error(err), zap.String("ID", id))
+			middleware.HandleErrorResponse(ctx, w, apperrors.HttpErrorResponseMessage(apperrors.ErrFetchEmployerAnalytics.Error(), err.Error(), id), analyticsErrorStatusCode(err))
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, "employer analytics retrieved successfully", http.StatusOK, analytics)
+	}
+}
+
+func isPathIdValid(ctx context.Context, w http.ResponseWriter, r *http.Request, key string, invalidMsg string, errType error) (int, string) {
+	vars := mux.Vars(r)
+	id := vars[key]
+	pathId, err := strconv.Atoi(id)
+	if err != nil {
+		logger.Errorw(ctx, invalidMsg, zap.Error(err), zap.String("ID", id))
+		httpResponseMsg := apperrors.HttpErrorResponseMessage(errType.Error(), invalidMsg, id)
+		middleware.HandleErrorResponse(ctx, w, httpResponseMsg, http.StatusBadRequest)
+		return -1, id
+	}
+	return pathId, id
+}
+
+func analyticsErrorStatusCode(err error) int {
+	switch {
+	case errors.Is(err, apperrors.ErrInvalidWageFilter), errors.Is(err, apperrors.ErrInvalidDateTime), errors.Is(err, apperrors.ErrInvalidSkillLevel),
+		errors.Is(err, apperrors.ErrInvalidAnalyticsFilter):
+		return http.StatusBadRequest
+	case errors.Is(err, apperrors.ErrNotEnoughWageData), errors.Is(err, apperrors.ErrNoEmployerExists):
+		return http.StatusNotFound
+	}
+	return http.StatusInternalServerError
+}
@@ -0,0 +1,189 @@
+package analytics
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/job"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/minwage"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/datetime"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo"
+)
+
+const (
+	// wageLookbackDays is how far back jobs are counted, two years so every season has been seen twice
+	wageLookbackDays = 730
+	// minSuggestionSamples is the fewest similar jobs and hires a wage is suggested from
+	minSuggestionSamples = 5
+	// defaultAnalyticsDays is the period of employer analytics when no dates are given
+	defaultAnalyticsDays = 365
+)
+
+var intervals = map[Interval]bool{
+	Week:  true,
+	Month: true,
+}
+
+var seasons = map[Season]bool{
+	Summer:  true,
+	Monsoon: true,
+	Winter:  true,
+}
+
+func MapWageDistributionRepoToService(distribution repo.WageDistribution) WageDistribution {
+	return WageDistribution{
+		Value:   distribution.Value,
+		Samples: distribution.Samples,
+		P25:     roundWage(distribution.P25),
+		Median:  roundWage(distribution.Median),
+		P75:     roundWage(distribution.P75),
+	}
+}
+
+func mapWageFilter(filter WageFilter) repo.WageFilter {
+	return repo.WageFilter{
+		Sector: filter.Sector,
+		Skill:  filter.Skill,
+		City:   filter.City,
+		Season: string(filter.Season),
+	}
+}
+
+func normalizeWageFilter(filter WageFilter) (WageFilter, error) {
+	filter.Sector = strings.ToLower(strings.TrimSpace(filter.Sector))
+	filter.Skill = strings.ToLower(strings.TrimSpace(filter.Skill))
+	filter.City = strings.ToLower(strings.TrimSpace(filter.City))
+	if filter.Season != "" && !seasons[filter.Season] {
+		return WageFilter{}, apperrors.ErrInvalidWageFilter
+	}
+	return filter, nil
+}
+
+func seasonOf(day time.Time) Season {
+	switch day.Month() {
+	case time.March, time.April, time.May:
+		return Summer
+	case time.June, time.July, time.August, time.September:
+		return Monsoon
+	}
+	return Winter
+}
+
+func firstListed(values string) string {
+	for _, value := range strings.Split(values, ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// suggestionFilters are the filters similar jobs are looked for with, from the closest to the
+// drafted job to every job, dropping the season, skill, city and sector in turn
+func suggestionFilters(draft WageFilter) []WageFilter {
+	filters := []WageFilter{draft}
+	for _, drop := range []func(*WageFilter){
+		func(filter *WageFilter) { filter.Season = "" },
+		func(filter *WageFilter) { filter.Skill = "" },
+		func(filter *WageFilter) { filter.City = "" },
+		func(filter *WageFilter) { filter.Sector = "" },
+	} {
+		broader := filters[len(filters)-1]
+		drop(&broader)
+		if broader != filters[len(filters)-1] {
+			filters = append(filters, broader)
+		}
+	}
+	return filters
+}
+
+func draftHours(draft job.Job) int {
+	if draft.DurationInHours <= 0 {
+		return minwage.StandardWorkingHours
+	}
+	return draft.DurationInHours
+}
+
+// prorate turns the wage of an 8 hour day into the wage of a shift, rounded to the nearest rupee
+func prorate(dailyWage float64, durationInHours int) int {
+	return int(math.Round(dailyWage * float64(durationInHours) / minwage.StandardWorkingHours))
+}
+
+func roundWage(wage float64) float64 {
+	return math.Round(wage*100) / 100
+}
+
+// normalizeEmployerAnalyticsFilter defaults to the jobs posted in the last year, counted by month,
+// and returns the period as the start of from until the start of the day after to
+func normalizeEmployerAnalyticsFilter(filter EmployerAnalyticsFilter) (EmployerAnalyticsFilter, time.Time, time.Time, error) {
+	var err error
+	if filter.To.IsZero() {
+		filter.To = datetime.Today()
+	}
+	if filter.From.IsZero() {
+		filter.From, err = filter.To.AddDays(-defaultAnalyticsDays)
+		if err != nil {
+			return EmployerAnalyticsFilter{}, time.Time{}, time.Time{}, fmt.Errorf("%w: %w", apperrors.ErrInvalidAnalyticsFilter, err)
+		}
+	}
+	if filter.Interval == "" {
+		filter.Interval = Month
+	}
+	if !intervals[filter.Interval] {
+		return EmployerAnalyticsFilter{}, time.Time{}, time.Time{}, apperrors.ErrInvalidAnalyticsFilter
+	}
+
+	from, err := filter.From.Time()
+	if err != nil {
+		return EmployerAnalyticsFilter{}, time.Time{}, time.Time{}, fmt.Errorf("%w: %w", apperrors.ErrInvalidAnalyticsFilter, err)
+	}
+	to, err := filter.To.Time()
+	if err != nil {
+		return EmployerAnalyticsFilter{}, time.Time{}, time.Time{}, fmt.Errorf("%w: %w", apperrors.ErrInvalidAnalyticsFilter, err)
+	}
+	if from.After(to) {
+		return EmployerAnalyticsFilter{}, time.Time{}, time.Time{}, apperrors.ErrInvalidAnalyticsFilter
+	}
+	return filter, from, to.AddDate(0, 0, 1), nil
+}
+
+func MapHiringSummaryRepoToService(employerId int, filter EmployerAnalyticsFilter, summary repo.HiringSummary) EmployerAnalytics {
+	return EmployerAnalytics{
+		EmployerID:           employerId,
+		From:                 filter.From,
+		To:                   filter.To,
+		Jobs:                 summary.Jobs,
+		Applications:         summary.Applications,
+		ApplicationsPerJob:   ratio(summary.Applications, summary.Jobs),
+		ApplicationsOverTime: []ApplicationActivity{},
+		FilledJobs:           summary.FilledJobs,
+		AverageHoursToFill:   roundWage(summary.AverageHoursToFill),
+		ShortlistRate:        ratio(summary.Shortlisted, summary.Applications),
+		ConfirmRate:          ratio(summary.Confirmed, summary.Applications),
+		NoShowRate:           ratio(summary.NoShows, summary.AttendanceRecords),
+		WorkersHired:         summary.WorkersHired,
+		AverageWorkerRating:  roundWage(summary.AverageWorkerRating),
+		RepeatHireRate:       ratio(summary.RepeatWorkers, summary.WorkersHired),
+		Spend:                summary.Spend,
+	}
+}
+
+func MapApplicationActivityRepoToService(activity repo.ApplicationActivity) ApplicationActivity {
+	return ApplicationActivity{
+		Period:             datetime.Date(activity.Period.In(datetime.Location).Format(datetime.DateLayout)),
+		Jobs:               activity.Jobs,
+		Applications:       activity.Applications,
+		ApplicationsPerJob: ratio(activity.Applications, activity.Jobs),
+	}
+}
+
+// ratio is part divided by whole rounded to 2 decimals, 0 when there is no whole
+func ratio(part int, whole int) float64 {
+	if whole == 0 {
+		return 0
+	}
+	return math.Round(float64(part)/float64(whole)*100) / 100
+}
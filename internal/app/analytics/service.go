@@ -0,0 +1,197 @@
+package analytics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/job"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/minwage"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/skill"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/datetime"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo"
+)
+
+type analyticsService struct {
+	wageAnalyticsRepo     repo.WageAnalyticsStorer
+	employerAnalyticsRepo repo.EmployerAnalyticsStorer
+	skillService          skill.Service
+	wageService           minwage.Service
+}
+
+type Service interface {
+	FetchWageAnalytics(ctx context.Context, filter WageFilter) (WageAnalytics, error)
+	SuggestWage(ctx context.Context, draft job.Job) (WageSuggestion, error)
+	FetchEmployerAnalytics(ctx context.Context, employerId int, filter EmployerAnalyticsFilter) (EmployerAnalytics, error)
+}
+
+func NewService(wageAnalyticsRepo repo.WageAnalyticsStorer, employerAnalyticsRepo repo.EmployerAnalyticsStorer, skillService skill.Service, wageService minwage.Service) Service {
+	return &analyticsService{
+		wageAnalyticsRepo:     wageAnalyticsRepo,
+		employerAnalyticsRepo: employerAnalyticsRepo,
+		skillService:          skillService,
+		wageService:           wageService,
+	}
+}
+
+// FetchWageAnalytics computes the wages of the jobs of the last two years matching the filter
+func (analyticsS *analyticsService) FetchWageAnalytics(ctx context.Context, filter WageFilter) (WageAnalytics, error) {
+	filter, err := normalizeWageFilter(filter)
+	if err != nil {
+		return WageAnalytics{}, err
+	}
+
+	if filter.Skill != "" {
+		filter.Skill, err = analyticsS.skillService.NormalizeSkills(ctx, filter.Skill)
+		if err != nil {
+			return WageAnalytics{}, fmt.Errorf("%w: %w", apperrors.ErrNormalizeSkills, err)
+		}
+	}
+
+	since, err := datetime.Today().AddDays(-wageLookbackDays)
+	if err != nil {
+		return WageAnalytics{}, err
+	}
+	repoFilter := mapWageFilter(filter)
+	repoFilter.Since = since
+
+	analytics := WageAnalytics{Filter: filter, Since: since}
+	overall, err := analyticsS.fetchDistributions(ctx, repoFilter, repo.WageGroupNone)
+	if err != nil {
+		return WageAnalytics{}, err
+	}
+	if len(overall) > 0 {
+		analytics.Overall = overall[0]
+	}
+
+	// a field that is filtered on has a single value, it is not broken down
+	breakdowns := []struct {
+		filtered bool
+		groupBy  string
+		into     *[]WageDistribution
+	}{
+		{filter.Sector != "", repo.WageGroupSector, &analytics.BySector},
+		{filter.Skill != "", repo.WageGroupSkill, &analytics.BySkill},
+		{filter.City != "", repo.WageGroupCity, &analytics.ByCity},
+		{filter.Season != "", repo.WageGroupSeason, &analytics.BySeason},
+	}
+	for _, breakdown := range breakdowns {
+		if breakdown.filtered {
+			continue
+		}
+		*breakdown.into, err = analyticsS.fetchDistributions(ctx, repoFilter, breakdown.groupBy)
+		if err != nil {
+			return WageAnalytics{}, err
+		}
+	}
+	return analytics, nil
+}
+
+// SuggestWage suggests the wage of a shift of a drafted job from the jobs closest to it that have
+// enough samples, raised to the minimum wage of the job when they pay less
+func (analyticsS *analyticsService) SuggestWage(ctx context.Context, draft job.Job) (WageSuggestion, error) {
+	skills, err := analyticsS.skillService.NormalizeSkills(ctx, draft.SkillsRequired)
+	if err != nil {
+		return WageSuggestion{}, fmt.Errorf("%w: %w", apperrors.ErrNormalizeSkills, err)
+	}
+
+	draftFilter := WageFilter{Sector: firstListed(draft.Sectors), Skill: firstListed(skills), City: draft.Location.City}
+	if !draft.Date.IsZero() {
+		day, err := draft.Date.Time()
+		if err != nil {
+			return WageSuggestion{}, err
+		}
+		draftFilter.Season = seasonOf(day)
+	}
+	draftFilter, err = normalizeWageFilter(draftFilter)
+	if err != nil {
+		return WageSuggestion{}, err
+	}
+
+	since, err := datetime.Today().AddDays(-wageLookbackDays)
+	if err != nil {
+		return WageSuggestion{}, err
+	}
+
+	hours := draftHours(draft)
+	suggestion := WageSuggestion{DurationInHours: hours}
+	for _, filter := range suggestionFilters(draftFilter) {
+		repoFilter := mapWageFilter(filter)
+		repoFilter.Since = since
+
+		distributions, err := analyticsS.fetchDistributions(ctx, repoFilter, repo.WageGroupNone)
+		if err != nil {
+			return WageSuggestion{}, err
+		}
+		if len(distributions) > 0 && distributions[0].Samples >= minSuggestionSamples {
+			suggestion.Basis = filter
+			suggestion.Samples = distributions[0].Samples
+			suggestion.Wage = prorate(distributions[0].Median, hours)
+			suggestion.Low = prorate(distributions[0].P25, hours)
+			suggestion.High = prorate(distributions[0].P75, hours)
+			break
+		}
+	}
+
+	compliance, err := analyticsS.wageService.CheckJobWage(ctx, minwage.JobWage{
+		State:           draft.Location.State,
+		Sectors:         draft.Sectors,
+		SkillLevel:      draft.SkillLevel,
+		Wage:            suggestion.Wage,
+		DurationInHours: hours,
+	})
+	if err != nil && !errors.Is(err, apperrors.ErrBelowMinimumWage) {
+		return WageSuggestion{}, err
+	}
+	if compliance.Status != minwage.NoMinimum {
+		suggestion.MinimumWage = compliance.RequiredWage
+	}
+	if compliance.Status == minwage.BelowMinimum {
+		suggestion.RaisedToMinimum = suggestion.Samples > 0
+		suggestion.Wage = compliance.RequiredWage
+		suggestion.Low = max(suggestion.Low, compliance.RequiredWage)
+		suggestion.High = max(suggestion.High, compliance.RequiredWage)
+	}
+
+	if suggestion.Wage == 0 {
+		return WageSuggestion{}, apperrors.ErrNotEnoughWageData
+	}
+	return suggestion, nil
+}
+
+// FetchEmployerAnalytics computes the hiring of an employer for the jobs they posted in the period
+func (analyticsS *analyticsService) FetchEmployerAnalytics(ctx context.Context, employerId int, filter EmployerAnalyticsFilter) (EmployerAnalytics, error) {
+	filter, from, to, err := normalizeEmployerAnalyticsFilter(filter)
+	if err != nil {
+		return EmployerAnalytics{}, err
+	}
+
+	summary, err := analyticsS.employerAnalyticsRepo.FetchHiringSummary(ctx, employerId, from, to)
+	if err != nil {
+		return EmployerAnalytics{}, err
+	}
+	analytics := MapHiringSummaryRepoToService(employerId, filter, summary)
+
+	activity, err := analyticsS.employerAnalyticsRepo.FetchApplicationActivity(ctx, employerId, from, to, string(filter.Interval))
+	if err != nil {
+		return EmployerAnalytics{}, err
+	}
+	for _, period := range activity {
+		analytics.ApplicationsOverTime = append(analytics.ApplicationsOverTime, MapApplicationActivityRepoToService(period))
+	}
+	return analytics, nil
+}
+
+func (analyticsS *analyticsService) fetchDistributions(ctx context.Context, filter repo.WageFilter, groupBy string) ([]WageDistribution, error) {
+	distributions, err := analyticsS.wageAnalyticsRepo.FetchWageDistributions(ctx, filter, groupBy)
+	if err != nil {
+		return []WageDistribution{}, err
+	}
+
+	mappedDistributions := make([]WageDistribution, 0, len(distributions))
+	for _, distribution := range distributions {
+		mappedDistributions = append(mappedDistributions, MapWageDistributionRepoToService(distribution))
+	}
+	return mappedDistributions, nil
+}
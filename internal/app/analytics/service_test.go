@@ -0,0 +1,287 @@
+package analytics
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/job"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/minwage"
+	wageMocks "github.com/harsh-jagtap-josh/RozgarLink/internal/app/minwage/mocks"
+	skillMocks "github.com/harsh-jagtap-josh/RozgarLink/internal/app/skill/mocks"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/worker"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/datetime"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type AnalyticsServiceTestSuite struct {
+	suite.Suite
+	service               Service
+	wageAnalyticsRepo     mocks.WageAnalyticsStorer
+	employerAnalyticsRepo mocks.EmployerAnalyticsStorer
+	skillService          skillMocks.Service
+	wageService           wageMocks.Service
+}
+
+func (suite *AnalyticsServiceTestSuite) SetupTest() {
+	suite.wageAnalyticsRepo = mocks.WageAnalyticsStorer{}
+	suite.employerAnalyticsRepo = mocks.EmployerAnalyticsStorer{}
+	suite.skillService = skillMocks.Service{}
+	suite.skillService.On("NormalizeSkills", mock.Anything, mock.Anything).Return(func(ctx context.Context, skills string) (string, error) {
+		return skills, nil
+	}).Maybe()
+	suite.wageService = wageMocks.Service{}
+	suite.service = NewService(&suite.wageAnalyticsRepo, &suite.employerAnalyticsRepo, &suite.skillService, &suite.wageService)
+}
+
+func (suite *AnalyticsServiceTestSuite) TearDownTest() {
+	suite.wageAnalyticsRepo.AssertExpectations(suite.T())
+	suite.employerAnalyticsRepo.AssertExpectations(suite.T())
+	suite.wageService.AssertExpectations(suite.T())
+}
+
+func TestAnalyticsServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(AnalyticsServiceTestSuite))
+}
+
+// filterOf matches the repo filter of a wage query whatever the lookback date is
+func filterOf(sector, skill, city, season string) interface{} {
+	return mock.MatchedBy(func(filter repo.WageFilter) bool {
+		return filter.Sector == sector && filter.Skill == skill && filter.City == city && filter.Season == season && !filter.Since.IsZero()
+	})
+}
+
+func (suite *AnalyticsServiceTestSuite) TestFetchWageAnalytics() {
+	type testCase struct {
+		name           string
+		input          WageFilter
+		setup          func()
+		expectedOutput WageAnalytics
+		expectedError  error
+	}
+
+	testCases := []testCase{
+		{
+			name:  "breakdown of the fields not filtered",
+			input: WageFilter{Sector: " Construction", City: "Pune"},
+			setup: func() {
+				filter := filterOf("construction", "", "pune", "")
+				suite.wageAnalyticsRepo.On("FetchWageDistributions", mock.Anything, filter, repo.WageGroupNone).Return([]repo.WageDistribution{{Samples: 42, P25: 450, Median: 533.333, P75: 600}}, nil)
+				suite.wageAnalyticsRepo.On("FetchWageDistributions", mock.Anything, filter, repo.WageGroupSkill).Return([]repo.WageDistribution{{Value: "masonry", Samples: 30, P25: 500, Median: 550, P75: 620}}, nil)
+				suite.wageAnalyticsRepo.On("FetchWageDistributions", mock.Anything, filter, repo.WageGroupSeason).Return([]repo.WageDistribution{{Value: "summer", Samples: 42, P25: 450, Median: 533.333, P75: 600}}, nil)
+			},
+			expectedOutput: WageAnalytics{
+				Filter:   WageFilter{Sector: "construction", City: "pune"},
+				Overall:  WageDistribution{Samples: 42, P25: 450, Median: 533.33, P75: 600},
+				BySkill:  []WageDistribution{{Value: "masonry", Samples: 30, P25: 500, Median: 550, P75: 620}},
+				BySeason: []WageDistribution{{Value: "summer", Samples: 42, P25: 450, Median: 533.33, P75: 600}},
+			},
+		},
+		{
+			name:          "unknown season",
+			input:         WageFilter{Season: "spring"},
+			setup:         func() {},
+			expectedError: apperrors.ErrInvalidWageFilter,
+		},
+	}
+
+	for _, test := range testCases {
+		suite.SetupTest()
+		suite.Run(test.name, func() {
+			test.setup()
+
+			analytics, err := suite.service.FetchWageAnalytics(context.Background(), test.input)
+
+			suite.ErrorIs(err, test.expectedError)
+			if test.expectedError == nil {
+				analytics.Since = ""
+				suite.Equal(test.expectedOutput, analytics)
+			}
+		})
+		suite.TearDownTest()
+	}
+}
+
+func (suite *AnalyticsServiceTestSuite) TestSuggestWage() {
+	type testCase struct {
+		name           string
+		input          job.Job
+		setup          func()
+		expectedOutput WageSuggestion
+		expectedError  error
+	}
+
+	draft := job.Job{
+		DurationInHours: 4,
+		SkillsRequired:  "Masonry, Plastering",
+		Sectors:         "Construction",
+		Location:        worker.Address{City: "Pune", State: "Maharashtra"},
+		Date:            "2026-07-14",
+	}
+
+	testCases := []testCase{
+		{
+			name:  "falls back to broader jobs",
+			input: draft,
+			setup: func() {
+				suite.wageAnalyticsRepo.On("FetchWageDistributions", mock.Anything, filterOf("construction", "masonry", "pune", "monsoon"), repo.WageGroupNone).Return([]repo.WageDistribution{{Samples: 2, Median: 700}}, nil)
+				suite.wageAnalyticsRepo.On("FetchWageDistributions", mock.Anything, filterOf("construction", "masonry", "pune", ""), repo.WageGroupNone).Return([]repo.WageDistribution{{Samples: 12, P25: 500, Median: 600, P75: 710}}, nil)
+				suite.wageService.On("CheckJobWage", mock.Anything, minwage.JobWage{State: "Maharashtra", Sectors: "Construction", Wage: 300, DurationInHours: 4}).Return(minwage.Compliance{Status: minwage.Compliant, RequiredWage: 260}, nil)
+			},
+			expectedOutput: WageSuggestion{
+				Wage:            300,
+				Low:             250,
+				High:            355,
+				DurationInHours: 4,
+				Basis:           WageFilter{Sector: "construction", Skill: "masonry", City: "pune"},
+				Samples:         12,
+				MinimumWage:     260,
+			},
+		},
+		{
+			name:  "raised to the minimum wage",
+			input: draft,
+			setup: func() {
+				suite.wageAnalyticsRepo.On("FetchWageDistributions", mock.Anything, filterOf("construction", "masonry", "pune", "monsoon"), repo.WageGroupNone).Return([]repo.WageDistribution{{Samples: 8, P25: 400, Median: 480, P75: 560}}, nil)
+				suite.wageService.On("CheckJobWage", mock.Anything, mock.Anything).Return(minwage.Compliance{Status: minwage.BelowMinimum, RequiredWage: 260, Shortfall: 20}, apperrors.ErrBelowMinimumWage)
+			},
+			expectedOutput: WageSuggestion{
+				Wage:            260,
+				Low:             260,
+				High:            280,
+				DurationInHours: 4,
+				Basis:           WageFilter{Sector: "construction", Skill: "masonry", City: "pune", Season: Monsoon},
+				Samples:         8,
+				MinimumWage:     260,
+				RaisedToMinimum: true,
+			},
+		},
+		{
+			name:  "no similar jobs and no minimum wage",
+			input: job.Job{Sectors: "Weaving", DurationInHours: 8},
+			setup: func() {
+				suite.wageAnalyticsRepo.On("FetchWageDistributions", mock.Anything, mock.Anything, repo.WageGroupNone).Return([]repo.WageDistribution{{Samples: 1, Median: 300}}, nil)
+				suite.wageService.On("CheckJobWage", mock.Anything, mock.Anything).Return(minwage.Compliance{Status: minwage.NoMinimum}, nil)
+			},
+			expectedError: apperrors.ErrNotEnoughWageData,
+		},
+		{
+			name:          "invalid date",
+			input:         job.Job{Sectors: "Construction", Date: "14-07-2026"},
+			setup:         func() {},
+			expectedError: apperrors.ErrInvalidDateTime,
+		},
+	}
+
+	for _, test := range testCases {
+		suite.SetupTest()
+		suite.Run(test.name, func() {
+			test.setup()
+
+			suggestion, err := suite.service.SuggestWage(context.Background(), test.input)
+
+			suite.ErrorIs(err, test.expectedError)
+			suite.Equal(test.expectedOutput, suggestion)
+		})
+		suite.TearDownTest()
+	}
+}
+
+func (suite *AnalyticsServiceTestSuite) TestFetchEmployerAnalytics() {
+	type testCase struct {
+		name           string
+		input          EmployerAnalyticsFilter
+		setup          func()
+		expectedOutput EmployerAnalytics
+		expectedError  error
+	}
+
+	from := time.Date(2026, time.January, 1, 0, 0, 0, 0, datetime.Location)
+	to := time.Date(2026, time.April, 1, 0, 0, 0, 0, datetime.Location)
+
+	testCases := []testCase{
+		{
+			name:  "success",
+			input: EmployerAnalyticsFilter{From: "2026-01-01", To: "2026-03-31"},
+			setup: func() {
+				suite.employerAnalyticsRepo.On("FetchHiringSummary", mock.Anything, 3, from, to).Return(repo.HiringSummary{
+					EmployerExists:      true,
+					Jobs:                4,
+					Applications:        30,
+					Shortlisted:         12,
+					Confirmed:           9,
+					FilledJobs:          3,
+					AverageHoursToFill:  40.5,
+					AttendanceRecords:   40,
+					NoShows:             3,
+					WorkersHired:        6,
+					RepeatWorkers:       2,
+					AverageWorkerRating: 4.256,
+					Spend:               54000,
+				}, nil)
+				suite.employerAnalyticsRepo.On("FetchApplicationActivity", mock.Anything, 3, from, to, "month").Return([]repo.ApplicationActivity{
+					{Period: from, Jobs: 3, Applications: 20},
+					{Period: time.Date(2026, time.February, 1, 0, 0, 0, 0, datetime.Location), Jobs: 1, Applications: 10},
+				}, nil)
+			},
+			expectedOutput: EmployerAnalytics{
+				EmployerID:         3,
+				From:               "2026-01-01",
+				To:                 "2026-03-31",
+				Jobs:               4,
+				Applications:       30,
+				ApplicationsPerJob: 7.5,
+				ApplicationsOverTime: []ApplicationActivity{
+					{Period: "2026-01-01", Jobs: 3, Applications: 20, ApplicationsPerJob: 6.67},
+					{Period: "2026-02-01", Jobs: 1, Applications: 10, ApplicationsPerJob: 10},
+				},
+				FilledJobs:          3,
+				AverageHoursToFill:  40.5,
+				ShortlistRate:       0.4,
+				ConfirmRate:         0.3,
+				NoShowRate:          0.08,
+				WorkersHired:        6,
+				AverageWorkerRating: 4.26,
+				RepeatHireRate:      0.33,
+				Spend:               54000,
+			},
+		},
+		{
+			name:  "employer does not exist",
+			input: EmployerAnalyticsFilter{From: "2026-01-01", To: "2026-03-31", Interval: Week},
+			setup: func() {
+				suite.employerAnalyticsRepo.On("FetchHiringSummary", mock.Anything, 3, from, to).Return(repo.HiringSummary{}, apperrors.ErrNoEmployerExists)
+			},
+			expectedError: apperrors.ErrNoEmployerExists,
+		},
+		{
+			name:          "from after to",
+			input:         EmployerAnalyticsFilter{From: "2026-04-01", To: "2026-03-31"},
+			setup:         func() {},
+			expectedError: apperrors.ErrInvalidAnalyticsFilter,
+		},
+		{
+			name:          "unknown interval",
+			input:         EmployerAnalyticsFilter{Interval: "year"},
+			setup:         func() {},
+			expectedError: apperrors.ErrInvalidAnalyticsFilter,
+		},
+	}
+
+	for _, test := range testCases {
+		suite.SetupTest()
+		suite.Run(test.name, func() {
+			test.setup()
+
+			analytics, err := suite.service.FetchEmployerAnalytics(context.Background(), 3, test.input)
+
+			suite.ErrorIs(err, test.expectedError)
+			suite.Equal(test.expectedOutput, analytics)
+		})
+		suite.TearDownTest()
+	}
+}
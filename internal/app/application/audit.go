@@ -0,0 +1,43 @@
+package application
+
+import (
+	"context"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/audit"
+)
+
+// auditedService records every application that is submitted, updated or deleted in the audit log
+type auditedService struct {
+	Service
+	recorder audit.Recorder[Application]
+}
+
+func NewAuditedService(applicationService Service, auditService audit.Service) Service {
+	return &auditedService{
+		Service: applicationService,
+		recorder: audit.Recorder[Application]{
+			Service: auditService,
+			Type:    audit.Application,
+			ID:      func(application Application) int { return application.ID },
+			Fetch:   applicationService.FetchApplicationById,
+		},
+	}
+}
+
+func (auditedS *auditedService) CreateNewApplication(ctx context.Context, applicationData Application) (Application, error) {
+	return auditedS.recorder.Create(ctx, func() (Application, error) {
+		return auditedS.Service.CreateNewApplication(ctx, applicationData)
+	})
+}
+
+func (auditedS *auditedService) UpdateApplicationById(ctx context.Context, applicationData Application) (Application, error) {
+	return auditedS.recorder.Update(ctx, applicationData.ID, func() (Application, error) {
+		return auditedS.Service.UpdateApplicationById(ctx, applicationData)
+	})
+}
+
+func (auditedS *auditedService) DeleteApplicationById(ctx context.Context, applicationId int) (int, error) {
+	return auditedS.recorder.Delete(ctx, applicationId, func() (int, error) {
+		return auditedS.Service.DeleteApplicationById(ctx, applicationId)
+	})
+}
@@ -2,6 +2,8 @@ package application
 
 import (
 	"time"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/datetime"
 )
 
 type Status string
@@ -35,6 +37,8 @@ type Application struct {
 	WorkerComment  string        `json:"worker_comments"`
 	AppliedAt      time.Time     `json:"applied_at"`
 	UpdatedAt      time.Time     `json:"updated_at"`
+	ShiftIDs       []int         `json:"shift_ids,omitempty"`
+	TotalWage      int           `json:"total_wage,omitempty"`
 }
 
 type ApplicationComplete struct {
@@ -59,7 +63,7 @@ type ApplicationComplete struct {
 	JobSectors     string        `json:"sectors"`
 	JobWage        int           `json:"wage"`
 	Vacancy        int           `json:"vacancy"`
-	JobDate        string        `json:"date"`
+	JobDate        datetime.Date `json:"date"`
 	EmployerName   string        `json:"name"`
 	ContactNumber  string        `json:"contact_number"`
 	EmployerEmail  string        `json:"email"`
@@ -88,7 +92,7 @@ type ApplicationCompleteEmp struct {
 	JobSectors     string        `json:"sectors"`
 	JobWage        int           `json:"wage"`
 	Vacancy        int           `json:"vacancy"`
-	JobDate        string        `json:"date"`
+	JobDate        datetime.Date `json:"date"`
 	WorkerName     string        `json:"name"`
 	ContactNumber  string        `json:"contact_number"`
 	WorkerEmail    string        `json:"email"`
@@ -8,6 +8,7 @@ import (
 	"strconv"
 
 	"github.com/gorilla/mux"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/schedule"
 	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
 	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/logger"
 	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/middleware"
@@ -30,7 +31,7 @@ func CreateNewApplication(appService Service) func(w http.ResponseWriter, r *htt
 		createdAppl, err := appService.CreateNewApplication(ctx, applicationData)
 		if err != nil {
 			logger.Errorw(ctx, apperrors.ErrCreateApplication.Error(), zap.Error(err))
-			middleware.HandleErrorResponse(ctx, w, apperrors.ErrCreateApplication.Error()+": "+err.Error(), http.StatusInternalServerError)
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrCreateApplication.Error()+": "+err.Error(), applicationErrorStatusCode(err))
 			return
 		}
 
@@ -54,10 +55,11 @@ func UpdateApplicationByID(appService Service) func(w http.ResponseWriter, r *ht
 			return
 		}
 
+		applicationData.ID = applicationId
 		updatedApplication, err := appService.UpdateApplicationById(ctx, applicationData)
 		if err != nil {
 			logger.Errorw(ctx, apperrors.ErrUpdateApplication.Error(), zap.Error(err))
-			middleware.HandleErrorResponse(ctx, w, apperrors.ErrUpdateApplication.Error()+", "+err.Error(), http.StatusInternalServerError)
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrUpdateApplication.Error()+", "+err.Error(), applicationErrorStatusCode(err))
 			return
 		}
 		middleware.HandleSuccessResponse(ctx, w, "successfully updated application details", http.StatusOK, updatedApplication)
@@ -140,3 +142,10 @@ func isApplicationIdValid(ctx context.Context, w http.ResponseWriter, r *http.Re
 	}
 	return applicationId, id
 }
+
+func applicationErrorStatusCode(err error) int {
+	if errors.Is(err, apperrors.ErrInvalidShiftSelection) {
+		return http.StatusBadRequest
+	}
+	return schedule.ScheduleErrorStatusCode(err)
+}
@@ -1,6 +1,10 @@
 package application
 
 import (
+	"fmt"
+	"strings"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
 	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo"
 )
 
@@ -71,7 +75,7 @@ func MapRepoApplCompToService(application repo.ApplicationComplete) ApplicationC
 		Vacancy:        application.Vacancy,
 		JobDate:        application.JobDate,
 		EmployerName:   application.EmployerName,
-		ContactNumber:  application.ContactNumber,
+		ContactNumber:  MaskContactNumber(application.ContactNumber, Status(application.Status)),
 		EmployerEmail:  application.EmployerEmail,
 		EmployerType:   application.EmployerType,
 	}
@@ -102,8 +106,53 @@ func MapRepoApplCompEmpToService(application repo.ApplicationCompleteEmp) Applic
 		Vacancy:        application.Vacancy,
 		JobDate:        application.JobDate,
 		WorkerName:     application.WorkerName,
-		ContactNumber:  application.ContactNumber,
+		ContactNumber:  MaskContactNumber(application.ContactNumber, Status(application.Status)),
 		WorkerEmail:    application.WorkerEmail,
 		WorkerGender:   application.WorkerGender,
 	}
 }
+
+// MaskContactNumber hides all but the last two digits of a phone number until the application is
+// confirmed, until then the employer and the worker talk through in-app messages
+func MaskContactNumber(contactNumber string, status Status) string {
+	if status == Confirmed || len(contactNumber) <= 2 {
+		return contactNumber
+	}
+	return strings.Repeat("X", len(contactNumber)-2) + contactNumber[len(contactNumber)-2:]
+}
+
+// selectShifts returns the job shifts targeted by an application, all of them when no shift is selected
+func selectShifts(jobShifts []repo.JobShift, shiftIds []int) ([]repo.JobShift, error) {
+	if len(shiftIds) == 0 {
+		return jobShifts, nil
+	}
+
+	selected := make(map[int]bool)
+	for _, shiftId := range shiftIds {
+		selected[shiftId] = true
+	}
+
+	shifts := make([]repo.JobShift, 0)
+	for _, shift := range jobShifts {
+		if selected[shift.ID] {
+			shifts = append(shifts, shift)
+			delete(selected, shift.ID)
+		}
+	}
+
+	if len(selected) > 0 {
+		return []repo.JobShift{}, fmt.Errorf("%w: %d of the selected shifts are not part of the job", apperrors.ErrInvalidShiftSelection, len(selected))
+	}
+	return shifts, nil
+}
+
+// shiftSummary returns the ids of the covered shifts along with the wage payable for all of them
+func shiftSummary(shifts []repo.JobShift) ([]int, int) {
+	var shiftIds []int
+	total := 0
+	for _, shift := range shifts {
+		shiftIds = append(shiftIds, shift.ID)
+		total += shift.Wage
+	}
+	return shiftIds, total
+}
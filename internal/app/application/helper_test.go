@@ -70,7 +70,7 @@ func TestMapRepoApplCompEmpToService(t *testing.T) {
 				Vacancy:        5,
 				JobDate:        "",
 				WorkerName:     "John Doe",
-				ContactNumber:  "9067691136",
+				ContactNumber:  "XXXXXXXX36",
 				WorkerEmail:    "jhon@gmail.com",
 				WorkerGender:   "Male",
 			},
@@ -83,3 +83,26 @@ func TestMapRepoApplCompEmpToService(t *testing.T) {
 		}
 	}
 }
+
+func TestMaskContactNumber(t *testing.T) {
+	type testCase struct {
+		name           string
+		contactNumber  string
+		status         application.Status
+		expectedOutput string
+	}
+
+	testCases := []testCase{
+		{name: "pending application", contactNumber: "9067691363", status: application.Pending, expectedOutput: "XXXXXXXX63"},
+		{name: "shortlisted application", contactNumber: "9067691363", status: application.Shortlisted, expectedOutput: "XXXXXXXX63"},
+		{name: "confirmed application", contactNumber: "9067691363", status: application.Confirmed, expectedOutput: "9067691363"},
+		{name: "empty number", contactNumber: "", status: application.Pending, expectedOutput: ""},
+	}
+
+	for _, test := range testCases {
+		output := application.MaskContactNumber(test.contactNumber, test.status)
+		if output != test.expectedOutput {
+			t.Errorf("%s: expected %q, got %q", test.name, test.expectedOutput, output)
+		}
+	}
+}
@@ -6,12 +6,14 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/schedule"
 	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
 	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo"
 )
 
 type applicationService struct {
 	applicationRepo repo.ApplicationStorer
+	shiftRepo       repo.ShiftStorer
 }
 
 type Service interface {
@@ -22,23 +24,43 @@ type Service interface {
 	FetchAllApplications(ctx context.Context) ([]ApplicationComplete, error)
 }
 
-func NewService(applicationRepo repo.ApplicationStorer) Service {
+func NewService(applicationRepo repo.ApplicationStorer, shiftRepo repo.ShiftStorer) Service {
 	return &applicationService{
 		applicationRepo: applicationRepo,
+		shiftRepo:       shiftRepo,
 	}
 }
 
 func (appS *applicationService) CreateNewApplication(ctx context.Context, applicationData Application) (Application, error) {
 	var createApplication Application
 
+	jobShifts, err := appS.shiftRepo.FetchShiftsByJobId(ctx, applicationData.JobID)
+	if err != nil {
+		return Application{}, err
+	}
+
+	shifts, err := selectShifts(jobShifts, applicationData.ShiftIDs)
+	if err != nil {
+		return Application{}, err
+	}
+
 	repoAppObj := MapServiceApplicationToRepo(applicationData)
 
-	application, err := appS.applicationRepo.CreateNewApplication(ctx, repoAppObj)
+	// applications without selected shifts are for the whole series and need no shift rows, they
+	// keep covering every shift of the job when its days change
+	var shiftIds []int
+	repoAppObj.WholeSeries = len(applicationData.ShiftIDs) == 0
+	if !repoAppObj.WholeSeries {
+		shiftIds, _ = shiftSummary(shifts)
+	}
+
+	application, err := appS.applicationRepo.CreateNewApplication(ctx, repoAppObj, shiftIds)
 	if err != nil {
 		return Application{}, err
 	}
 
 	createApplication = MapRepoApplicationToService(application)
+	createApplication.ShiftIDs, createApplication.TotalWage = shiftSummary(shifts)
 
 	return createApplication, nil
 }
@@ -46,7 +68,8 @@ func (appS *applicationService) CreateNewApplication(ctx context.Context, applic
 func (appS *applicationService) UpdateApplicationById(ctx context.Context, applicationData Application) (Application, error) {
 	applRepoObj := MapServiceApplicationToRepo(applicationData)
 
-	application, err := appS.applicationRepo.UpdateApplicationByID(ctx, applRepoObj)
+	// confirming an application books the worker, it is refused when the job clashes with their calendar
+	application, err := appS.applicationRepo.UpdateApplicationByID(ctx, applRepoObj, schedule.CheckConflict)
 	if err != nil {
 		return Application{}, err
 	}
@@ -66,6 +89,13 @@ func (appS *applicationService) FetchApplicationById(ctx context.Context, applic
 	}
 
 	fetchedApplication := MapRepoApplicationToService(application)
+
+	shifts, err := appS.shiftRepo.FetchShiftsByApplicationId(ctx, applicationId)
+	if err != nil {
+		return Application{}, err
+	}
+	fetchedApplication.ShiftIDs, fetchedApplication.TotalWage = shiftSummary(shifts)
+
 	return fetchedApplication, nil
 }
 
@@ -18,15 +18,18 @@ type ApplicationServiceTestSuite struct {
 	suite.Suite
 	service         Service
 	applicationRepo mocks.ApplicationStorer
+	shiftRepo       mocks.ShiftStorer
 }
 
 func (suite *ApplicationServiceTestSuite) SetupTest() {
 	suite.applicationRepo = mocks.ApplicationStorer{}
-	suite.service = NewService(&suite.applicationRepo)
+	suite.shiftRepo = mocks.ShiftStorer{}
+	suite.service = NewService(&suite.applicationRepo, &suite.shiftRepo)
 }
 
 func (suite *ApplicationServiceTestSuite) TearDownTest() {
 	suite.applicationRepo.AssertExpectations(suite.T())
+	suite.shiftRepo.AssertExpectations(suite.T())
 }
 
 func TestOrderServiceTestSuite(t *testing.T) {
@@ -64,6 +67,7 @@ func (suite *ApplicationServiceTestSuite) TestFetchApplicationById() {
 					State:          "location state",
 					Pincode:        411025,
 				}, nil)
+				suite.shiftRepo.On("FetchShiftsByApplicationId", mock.Anything, 1).Return([]repo.JobShift{}, nil)
 			},
 			expectedOutput: Application{
 				ID:            1,
@@ -222,7 +226,7 @@ func (suite *ApplicationServiceTestSuite) TestFetchAllApplications() {
 					Vacancy:        5,
 					JobDate:        "2025-02-12",
 					EmployerName:   "Employer XYZ",
-					ContactNumber:  "9067691363",
+					ContactNumber:  "XXXXXXXX63",
 					EmployerEmail:  "employer@gmail.com",
 					EmployerType:   "Organization",
 				},
@@ -250,7 +254,7 @@ func (suite *ApplicationServiceTestSuite) TestFetchAllApplications() {
 					Vacancy:        5,
 					JobDate:        "2025-02-12",
 					EmployerName:   "Employer XYZ",
-					ContactNumber:  "9067691363",
+					ContactNumber:  "XXXXXXXX63",
 					EmployerEmail:  "employer@gmail.com",
 					EmployerType:   "Organization",
 				},
@@ -311,6 +315,7 @@ func (suite *ApplicationServiceTestSuite) TestCreateNewApplication() {
 				UpdatedAt:     time.Time{},
 			},
 			setup: func() {
+				suite.shiftRepo.On("FetchShiftsByJobId", mock.Anything, 3).Return([]repo.JobShift{}, nil)
 				suite.applicationRepo.On("CreateNewApplication", mock.Anything, repo.Application{
 					ID:             1,
 					JobID:          3,
@@ -325,9 +330,10 @@ func (suite *ApplicationServiceTestSuite) TestCreateNewApplication() {
 					State:          "location state",
 					Pincode:        411025,
 					WorkerComment:  "some random comments by worker",
+					WholeSeries:    true,
 					AppliedAt:      time.Time{},
 					UpdatedAt:      time.Time{},
-				}).Return(repo.Application{
+				}, []int(nil)).Return(repo.Application{
 					ID:             1,
 					JobID:          3,
 					WorkerID:       12,
@@ -388,6 +394,7 @@ func (suite *ApplicationServiceTestSuite) TestCreateNewApplication() {
 				UpdatedAt:     time.Time{},
 			},
 			setup: func() {
+				suite.shiftRepo.On("FetchShiftsByJobId", mock.Anything, 3).Return([]repo.JobShift{}, nil)
 				suite.applicationRepo.On("CreateNewApplication", mock.Anything, repo.Application{
 					ID:             1,
 					JobID:          3,
@@ -402,9 +409,45 @@ func (suite *ApplicationServiceTestSuite) TestCreateNewApplication() {
 					State:          "location state",
 					Pincode:        411025,
 					WorkerComment:  "some random comments by worker",
+					WholeSeries:    true,
 					AppliedAt:      time.Time{},
 					UpdatedAt:      time.Time{},
-				}).Return(repo.Application{}, apperrors.ErrCreateApplication)
+				}, []int(nil)).Return(repo.Application{}, apperrors.ErrCreateApplication)
+			},
+			expectedOutput:  Application{},
+			isExpectedError: true,
+		},
+		{
+			name:  "create for selected shifts",
+			input: Application{JobID: 3, WorkerID: 12, Status: Pending, ShiftIDs: []int{8}},
+			setup: func() {
+				suite.shiftRepo.On("FetchShiftsByJobId", mock.Anything, 3).Return([]repo.JobShift{
+					{ID: 7, JobID: 3, Date: "2025-03-10", Wage: 800},
+					{ID: 8, JobID: 3, Date: "2025-03-11", Wage: 900},
+				}, nil)
+				suite.applicationRepo.On("CreateNewApplication", mock.Anything, repo.Application{JobID: 3, WorkerID: 12, Status: repo.Pending}, []int{8}).Return(repo.Application{ID: 1, JobID: 3, WorkerID: 12, Status: repo.Pending}, nil)
+			},
+			expectedOutput:  Application{ID: 1, JobID: 3, WorkerID: 12, Status: Pending, ShiftIDs: []int{8}, TotalWage: 900},
+			isExpectedError: false,
+		},
+		{
+			name:  "create for whole series",
+			input: Application{JobID: 3, WorkerID: 12, Status: Pending},
+			setup: func() {
+				suite.shiftRepo.On("FetchShiftsByJobId", mock.Anything, 3).Return([]repo.JobShift{
+					{ID: 7, JobID: 3, Date: "2025-03-10", Wage: 800},
+					{ID: 8, JobID: 3, Date: "2025-03-11", Wage: 900},
+				}, nil)
+				suite.applicationRepo.On("CreateNewApplication", mock.Anything, repo.Application{JobID: 3, WorkerID: 12, Status: repo.Pending, WholeSeries: true}, []int(nil)).Return(repo.Application{ID: 1, JobID: 3, WorkerID: 12, Status: repo.Pending, WholeSeries: true}, nil)
+			},
+			expectedOutput:  Application{ID: 1, JobID: 3, WorkerID: 12, Status: Pending, ShiftIDs: []int{7, 8}, TotalWage: 1700},
+			isExpectedError: false,
+		},
+		{
+			name:  "selected shift of another job",
+			input: Application{JobID: 3, WorkerID: 12, Status: Pending, ShiftIDs: []int{9}},
+			setup: func() {
+				suite.shiftRepo.On("FetchShiftsByJobId", mock.Anything, 3).Return([]repo.JobShift{{ID: 7, JobID: 3, Date: "2025-03-10", Wage: 800}}, nil)
 			},
 			expectedOutput:  Application{},
 			isExpectedError: true,
@@ -423,6 +466,14 @@ func (suite *ApplicationServiceTestSuite) TestCreateNewApplication() {
 	}
 }
 
+// refusesClashingEngagements matches the check of a confirmation that refuses a job overlapping another
+// confirmed job of the worker
+func refusesClashingEngagements(checkEngagements repo.EngagementCheck) bool {
+	candidates := []repo.Engagement{{ApplicationID: 1, JobID: 3, WorkerID: 12, Date: "2025-03-10", StartHour: "09:00", EndHour: "17:00", DurationInHours: 8}}
+	engagements := []repo.Engagement{{ApplicationID: 2, JobID: 4, WorkerID: 12, Date: "2025-03-10", StartHour: "16:00", EndHour: "20:00", DurationInHours: 4}}
+	return errors.Is(checkEngagements(candidates, engagements, []repo.BlackoutDate{}), apperrors.ErrScheduleConflict)
+}
+
 func (suite *ApplicationServiceTestSuite) TestUpdateApplicationById() {
 	type testCase struct {
 		name            string
@@ -471,7 +522,7 @@ func (suite *ApplicationServiceTestSuite) TestUpdateApplicationById() {
 					WorkerComment:  "some random comments by worker",
 					AppliedAt:      time.Time{},
 					UpdatedAt:      time.Time{},
-				}).Return(repo.Application{
+				}, mock.Anything).Return(repo.Application{
 					ID:             1,
 					JobID:          3,
 					WorkerID:       12,
@@ -548,7 +599,25 @@ func (suite *ApplicationServiceTestSuite) TestUpdateApplicationById() {
 					WorkerComment:  "some random comments by worker",
 					AppliedAt:      time.Time{},
 					UpdatedAt:      time.Time{},
-				}).Return(repo.Application{}, apperrors.ErrCreateApplication)
+				}, mock.Anything).Return(repo.Application{}, apperrors.ErrCreateApplication)
+			},
+			expectedOutput:  Application{},
+			isExpectedError: true,
+		},
+		{
+			name:  "confirm success",
+			input: Application{ID: 1, JobID: 3, WorkerID: 12, Status: Confirmed},
+			setup: func() {
+				suite.applicationRepo.On("UpdateApplicationByID", mock.Anything, repo.Application{ID: 1, JobID: 3, WorkerID: 12, Status: repo.Confirmed}, mock.MatchedBy(refusesClashingEngagements)).Return(repo.Application{ID: 1, JobID: 3, WorkerID: 12, Status: repo.Confirmed}, nil)
+			},
+			expectedOutput:  Application{ID: 1, JobID: 3, WorkerID: 12, Status: Confirmed, PickUpLocation: Address{}},
+			isExpectedError: false,
+		},
+		{
+			name:  "confirm refused on schedule conflict",
+			input: Application{ID: 1, JobID: 3, WorkerID: 12, Status: Confirmed},
+			setup: func() {
+				suite.applicationRepo.On("UpdateApplicationByID", mock.Anything, repo.Application{ID: 1, JobID: 3, WorkerID: 12, Status: repo.Confirmed}, mock.Anything).Return(repo.Application{}, apperrors.ErrScheduleConflict)
 			},
 			expectedOutput:  Application{},
 			isExpectedError: true,
@@ -0,0 +1,69 @@
+package attendance
+
+import (
+	"time"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/datetime"
+)
+
+type Status string
+
+const (
+	CheckedIn  Status = "checked_in"
+	CheckedOut Status = "checked_out"
+	NoShow     Status = "no_show"
+)
+
+// Punch is a check-in or check-out request, verified by a one-time code issued by the employer
+type Punch struct {
+	Code      string   `json:"code"`
+	Latitude  *float64 `json:"latitude,omitempty"`
+	Longitude *float64 `json:"longitude,omitempty"`
+}
+
+// Issuer is the employer issuing an attendance code or marking a no-show, taken from their JWT
+type Issuer struct {
+	Role string
+	ID   int
+}
+
+type Code struct {
+	ApplicationID int       `json:"application_id"`
+	Code          string    `json:"code"`
+	ExpiresAt     time.Time `json:"expires_at"`
+}
+
+type NoShowRequest struct {
+	Date datetime.Date `json:"date"`
+}
+
+type Record struct {
+	ID                int           `json:"id"`
+	ApplicationID     int           `json:"application_id"`
+	ShiftID           int           `json:"shift_id,omitempty"`
+	WorkerID          int           `json:"worker_id"`
+	Date              datetime.Date `json:"date"`
+	Status            Status        `json:"status"`
+	CheckInAt         *time.Time    `json:"check_in_at,omitempty"`
+	CheckOutAt        *time.Time    `json:"check_out_at,omitempty"`
+	CheckInLatitude   *float64      `json:"check_in_latitude,omitempty"`
+	CheckInLongitude  *float64      `json:"check_in_longitude,omitempty"`
+	CheckOutLatitude  *float64      `json:"check_out_latitude,omitempty"`
+	CheckOutLongitude *float64      `json:"check_out_longitude,omitempty"`
+	HoursWorked       float64       `json:"hours_worked"`
+	ExpectedHours     int           `json:"expected_hours"`
+}
+
+// Summary is the attendance of all confirmed workers of a job, unmarked counts past or current shifts
+// without any check-in or no-show
+type Summary struct {
+	JobID          int           `json:"job_id"`
+	Date           datetime.Date `json:"date,omitempty"`
+	ExpectedShifts int           `json:"expected_shifts"`
+	Present        int           `json:"present"`
+	NoShows        int           `json:"no_shows"`
+	Unmarked       int           `json:"unmarked"`
+	HoursWorked    float64       `json:"hours_worked"`
+	ExpectedHours  int           `json:"expected_hours"`
+	Records        []Record      `json:"records"`
+}
@@ -0,0 +1,208 @@
+package attendance
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/datetime"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/logger"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/middleware"
+	"go.uber.org/zap"
+)
+
+func IssueCode(attendanceService Service) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		applicationId, id := isPathIdValid(ctx, w, r, "application_id", apperrors.MsgInvalidApplicationId, apperrors.ErrIssueAttendanceCode)
+		if applicationId == -1 {
+			return
+		}
+
+		code, err := attendanceService.IssueCode(ctx, currentIssuer(ctx), applicationId)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrIssueAttendanceCode.Error(), zap.Error(err), zap.String("ID", id))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrIssueAttendanceCode.Error()+", "+err.Error(), attendanceErrorStatusCode(err))
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, "attendance code issued successfully", http.StatusCreated, code)
+	}
+}
+
+func CheckIn(attendanceService Service) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		applicationId, _ := isPathIdValid(ctx, w, r, "application_id", apperrors.MsgInvalidApplicationId, apperrors.ErrCheckIn)
+		if applicationId == -1 {
+			return
+		}
+
+		var punch Punch
+		err := json.NewDecoder(r.Body).Decode(&punch)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrInvalidRequestBody.Error(), zap.Error(err))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrInvalidRequestBody.Error()+": "+err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		record, err := attendanceService.CheckIn(ctx, applicationId, punch)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrCheckIn.Error(), zap.Error(err))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrCheckIn.Error()+": "+err.Error(), attendanceErrorStatusCode(err))
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, "checked in successfully", http.StatusCreated, record)
+	}
+}
+
+func CheckOut(attendanceService Service) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		applicationId, _ := isPathIdValid(ctx, w, r, "application_id", apperrors.MsgInvalidApplicationId, apperrors.ErrCheckOut)
+		if applicationId == -1 {
+			return
+		}
+
+		var punch Punch
+		err := json.NewDecoder(r.Body).Decode(&punch)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrInvalidRequestBody.Error(), zap.Error(err))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrInvalidRequestBody.Error()+": "+err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		record, err := attendanceService.CheckOut(ctx, applicationId, punch)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrCheckOut.Error(), zap.Error(err))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrCheckOut.Error()+": "+err.Error(), attendanceErrorStatusCode(err))
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, "checked out successfully", http.StatusOK, record)
+	}
+}
+
+func MarkNoShow(attendanceService Service) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		applicationId, _ := isPathIdValid(ctx, w, r, "application_id", apperrors.MsgInvalidApplicationId, apperrors.ErrMarkNoShow)
+		if applicationId == -1 {
+			return
+		}
+
+		var request NoShowRequest
+		err := json.NewDecoder(r.Body).Decode(&request)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrInvalidRequestBody.Error(), zap.Error(err))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrInvalidRequestBody.Error()+": "+err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		record, err := attendanceService.MarkNoShow(ctx, currentIssuer(ctx), applicationId, request.Date)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrMarkNoShow.Error(), zap.Error(err))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrMarkNoShow.Error()+": "+err.Error(), attendanceErrorStatusCode(err))
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, "no-show marked successfully", http.StatusCreated, record)
+	}
+}
+
+func FetchApplicationAttendance(attendanceService Service) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		applicationId, id := isPathIdValid(ctx, w, r, "application_id", apperrors.MsgInvalidApplicationId, apperrors.ErrFetchAttendance)
+		if applicationId == -1 {
+			return
+		}
+
+		records, err := attendanceService.FetchApplicationAttendance(ctx, applicationId)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrFetchAttendance.Error(), zap.Error(err), zap.String("ID", id))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrFetchAttendance.Error()+", "+err.Error(), attendanceErrorStatusCode(err))
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, "attendance retrieved successfully", http.StatusOK, records)
+	}
+}
+
+func FetchJobAttendance(attendanceService Service) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		jobId, id := isPathIdValid(ctx, w, r, "job_id", apperrors.MsgInvalidJobId, apperrors.ErrFetchAttendance)
+		if jobId == -1 {
+			return
+		}
+
+		var date datetime.Date
+		var err error
+		if value := r.URL.Query().Get("date"); value != "" {
+			date, err = datetime.ParseDate(value)
+			if err != nil {
+				logger.Errorw(ctx, apperrors.ErrFetchAttendance.Error(), zap.Error(err), zap.String("ID", id))
+				middleware.HandleErrorResponse(ctx, w, apperrors.ErrFetchAttendance.Error()+", "+err.Error(), http.StatusBadRequest)
+				return
+			}
+		}
+
+		summary, err := attendanceService.FetchJobAttendance(ctx, jobId, date)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrFetchAttendance.Error(), zap.Error(err), zap.String("ID", id))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrFetchAttendance.Error()+", "+err.Error(), attendanceErrorStatusCode(err))
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, "job attendance retrieved successfully", http.StatusOK, summary)
+	}
+}
+
+func isPathIdValid(ctx context.Context, w http.ResponseWriter, r *http.Request, key string, invalidMsg string, errType error) (int, string) {
+	vars := mux.Vars(r)
+	id := vars[key]
+	pathId, err := strconv.Atoi(id)
+	if err != nil {
+		logger.Errorw(ctx, invalidMsg, zap.Error(err), zap.String("ID", id))
+		httpResponseMsg := apperrors.HttpErrorResponseMessage(errType.Error(), invalidMsg, id)
+		middleware.HandleErrorResponse(ctx, w, httpResponseMsg, http.StatusBadRequest)
+		return -1, id
+	}
+	return pathId, id
+}
+
+// currentIssuer is the employer calling an attendance API, taken from the JWT
+func currentIssuer(ctx context.Context) Issuer {
+	userId, _ := ctx.Value("user_id").(int)
+	role, _ := ctx.Value("role").(string)
+	return Issuer{Role: role, ID: userId}
+}
+
+func attendanceErrorStatusCode(err error) int {
+	switch {
+	case errors.Is(err, apperrors.ErrInvalidAttendance), errors.Is(err, apperrors.ErrInvalidDateTime):
+		return http.StatusBadRequest
+	case errors.Is(err, apperrors.ErrInvalidAttendanceCode):
+		return http.StatusUnauthorized
+	case errors.Is(err, apperrors.ErrNotAttendanceEmployer):
+		return http.StatusForbidden
+	case errors.Is(err, apperrors.ErrNoApplicationExists), errors.Is(err, apperrors.ErrNoJobExists):
+		return http.StatusNotFound
+	case errors.Is(err, apperrors.ErrApplicationNotConfirmed), errors.Is(err, apperrors.ErrNoShiftToday),
+		errors.Is(err, apperrors.ErrAttendanceAlreadyMarked), errors.Is(err, apperrors.ErrNotCheckedIn):
+		return http.StatusConflict
+	}
+	return http.StatusInternalServerError
+}
@@ -0,0 +1,121 @@
+package attendance
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/datetime"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo"
+)
+
+func MapRecordRepoToService(record repo.AttendanceRecord, expectedHours int) Record {
+	return Record{
+		ID:                record.ID,
+		ApplicationID:     record.ApplicationID,
+		ShiftID:           record.ShiftID,
+		WorkerID:          record.WorkerID,
+		Date:              record.Date,
+		Status:            Status(record.Status),
+		CheckInAt:         record.CheckInAt,
+		CheckOutAt:        record.CheckOutAt,
+		CheckInLatitude:   record.CheckInLatitude,
+		CheckInLongitude:  record.CheckInLongitude,
+		CheckOutLatitude:  record.CheckOutLatitude,
+		CheckOutLongitude: record.CheckOutLongitude,
+		HoursWorked:       hours(record.MinutesWorked),
+		ExpectedHours:     expectedHours,
+	}
+}
+
+// generateCode returns a random six digit code
+func generateCode() (string, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(1000000))
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%06d", n.Int64()), nil
+}
+
+func hashCode(code string) string {
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
+
+func hours(minutes int) float64 {
+	return math.Round(float64(minutes)/60*100) / 100
+}
+
+func validatePunch(punch Punch) error {
+	if punch.Code == "" {
+		return fmt.Errorf("%w: code issued by the employer is required", apperrors.ErrInvalidAttendance)
+	}
+	if (punch.Latitude == nil) != (punch.Longitude == nil) {
+		return fmt.Errorf("%w: latitude and longitude must be given together", apperrors.ErrInvalidAttendance)
+	}
+	if punch.Latitude != nil && (*punch.Latitude < -90 || *punch.Latitude > 90 || *punch.Longitude < -180 || *punch.Longitude > 180) {
+		return fmt.Errorf("%w: coordinates are out of range", apperrors.ErrInvalidAttendance)
+	}
+	return nil
+}
+
+// engagementOn finds the job slot of an application on a day
+func engagementOn(engagements []repo.Engagement, date datetime.Date) (repo.Engagement, bool) {
+	for _, engagement := range engagements {
+		if engagement.Date == date {
+			return engagement, true
+		}
+	}
+	return repo.Engagement{}, false
+}
+
+// summarize matches the expected job slots of a job against the recorded attendance
+func summarize(jobId int, date datetime.Date, engagements []repo.Engagement, records []repo.AttendanceRecord) Summary {
+	summary := Summary{JobID: jobId, Date: date, Records: make([]Record, 0)}
+	today := datetime.Today()
+
+	type slot struct {
+		applicationId int
+		date          datetime.Date
+	}
+	expectedHours := map[slot]int{}
+	for _, engagement := range engagements {
+		if !date.IsZero() && engagement.Date != date {
+			continue
+		}
+		expectedHours[slot{engagement.ApplicationID, engagement.Date}] = engagement.DurationInHours
+		summary.ExpectedShifts++
+		summary.ExpectedHours += engagement.DurationInHours
+	}
+
+	marked := map[slot]bool{}
+	for _, record := range records {
+		key := slot{record.ApplicationID, record.Date}
+		if _, ok := expectedHours[key]; !ok {
+			continue
+		}
+		marked[key] = true
+
+		mapped := MapRecordRepoToService(record, expectedHours[key])
+		switch mapped.Status {
+		case CheckedIn, CheckedOut:
+			summary.Present++
+		case NoShow:
+			summary.NoShows++
+		}
+		summary.HoursWorked += mapped.HoursWorked
+		summary.Records = append(summary.Records, mapped)
+	}
+
+	for key := range expectedHours {
+		if !marked[key] && string(key.date) <= string(today) {
+			summary.Unmarked++
+		}
+	}
+	summary.HoursWorked = math.Round(summary.HoursWorked*100) / 100
+	return summary
+}
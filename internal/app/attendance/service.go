@@ -0,0 +1,263 @@
+package attendance
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/schedule"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/datetime"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo"
+)
+
+// codeValidity is how long an attendance code issued by the employer can be used
+const codeValidity = 15 * time.Minute
+
+// employerRole is the role of the JWT allowed to issue codes and mark no-shows
+const employerRole = "employer"
+
+type attendanceService struct {
+	attendanceRepo  repo.AttendanceStorer
+	applicationRepo repo.ApplicationStorer
+	scheduleRepo    repo.ScheduleStorer
+	jobRepo         repo.JobStorer
+}
+
+type Service interface {
+	IssueCode(ctx context.Context, issuer Issuer, applicationId int) (Code, error)
+	CheckIn(ctx context.Context, applicationId int, punch Punch) (Record, error)
+	CheckOut(ctx context.Context, applicationId int, punch Punch) (Record, error)
+	MarkNoShow(ctx context.Context, issuer Issuer, applicationId int, date datetime.Date) (Record, error)
+	FetchApplicationAttendance(ctx context.Context, applicationId int) ([]Record, error)
+	FetchJobAttendance(ctx context.Context, jobId int, date datetime.Date) (Summary, error)
+}
+
+func NewService(attendanceRepo repo.AttendanceStorer, applicationRepo repo.ApplicationStorer, scheduleRepo repo.ScheduleStorer, jobRepo repo.JobStorer) Service {
+	return &attendanceService{
+		attendanceRepo:  attendanceRepo,
+		applicationRepo: applicationRepo,
+		scheduleRepo:    scheduleRepo,
+		jobRepo:         jobRepo,
+	}
+}
+
+// IssueCode creates a one-time code the employer shares with the worker on site, the code itself
+// is only returned here and never stored
+func (attS *attendanceService) IssueCode(ctx context.Context, issuer Issuer, applicationId int) (Code, error) {
+	_, err := attS.employerApplication(ctx, issuer, applicationId)
+	if err != nil {
+		return Code{}, err
+	}
+
+	code, err := generateCode()
+	if err != nil {
+		return Code{}, err
+	}
+
+	issuedCode, err := attS.attendanceRepo.CreateAttendanceCode(ctx, repo.AttendanceCode{
+		ApplicationID: applicationId,
+		CodeHash:      hashCode(code),
+		ExpiresAt:     time.Now().Add(codeValidity),
+	})
+	if err != nil {
+		return Code{}, err
+	}
+
+	return Code{ApplicationID: applicationId, Code: code, ExpiresAt: issuedCode.ExpiresAt}, nil
+}
+
+func (attS *attendanceService) CheckIn(ctx context.Context, applicationId int, punch Punch) (Record, error) {
+	err := validatePunch(punch)
+	if err != nil {
+		return Record{}, err
+	}
+
+	application, err := attS.confirmedApplication(ctx, applicationId)
+	if err != nil {
+		return Record{}, err
+	}
+
+	engagements, err := attS.scheduleRepo.FetchEngagementsByApplicationId(ctx, applicationId)
+	if err != nil {
+		return Record{}, err
+	}
+
+	today := datetime.Today()
+	engagement, ok := engagementOn(engagements, today)
+	if !ok {
+		return Record{}, apperrors.ErrNoShiftToday
+	}
+
+	now := time.Now()
+	record, err := attS.attendanceRepo.CheckInAttendanceRecord(ctx, repo.AttendanceRecord{
+		ApplicationID:    applicationId,
+		ShiftID:          engagement.ShiftID,
+		WorkerID:         application.WorkerID,
+		Date:             today,
+		Status:           string(CheckedIn),
+		CheckInAt:        &now,
+		CheckInLatitude:  punch.Latitude,
+		CheckInLongitude: punch.Longitude,
+	}, hashCode(punch.Code))
+	if err != nil {
+		return Record{}, err
+	}
+
+	return MapRecordRepoToService(record, engagement.DurationInHours), nil
+}
+
+// CheckOut closes the open check-in of an application and records the time worked, shifts running
+// past midnight are closed on the next day
+func (attS *attendanceService) CheckOut(ctx context.Context, applicationId int, punch Punch) (Record, error) {
+	err := validatePunch(punch)
+	if err != nil {
+		return Record{}, err
+	}
+
+	_, err = attS.confirmedApplication(ctx, applicationId)
+	if err != nil {
+		return Record{}, err
+	}
+
+	record, err := attS.attendanceRepo.FetchOpenAttendanceRecord(ctx, applicationId)
+	if err != nil {
+		return Record{}, err
+	}
+
+	now := time.Now()
+	record.CheckOutAt = &now
+	record.CheckOutLatitude = punch.Latitude
+	record.CheckOutLongitude = punch.Longitude
+	record.MinutesWorked = int(now.Sub(*record.CheckInAt).Minutes())
+
+	updatedRecord, err := attS.attendanceRepo.CheckOutAttendanceRecord(ctx, record, hashCode(punch.Code))
+	if err != nil {
+		return Record{}, err
+	}
+
+	return MapRecordRepoToService(updatedRecord, attS.expectedHours(ctx, applicationId, updatedRecord.Date)), nil
+}
+
+// MarkNoShow records that a confirmed worker did not turn up for a shift that has already started
+func (attS *attendanceService) MarkNoShow(ctx context.Context, issuer Issuer, applicationId int, date datetime.Date) (Record, error) {
+	if date.IsZero() {
+		date = datetime.Today()
+	}
+	_, err := date.Time()
+	if err != nil {
+		return Record{}, err
+	}
+
+	application, err := attS.employerApplication(ctx, issuer, applicationId)
+	if err != nil {
+		return Record{}, err
+	}
+
+	engagements, err := attS.scheduleRepo.FetchEngagementsByApplicationId(ctx, applicationId)
+	if err != nil {
+		return Record{}, err
+	}
+
+	engagement, ok := engagementOn(engagements, date)
+	if !ok {
+		return Record{}, fmt.Errorf("%w: application has no shift on %s", apperrors.ErrInvalidAttendance, date)
+	}
+
+	start, _, err := schedule.EngagementInterval(engagement)
+	if err != nil {
+		return Record{}, err
+	}
+	if time.Now().Before(start) {
+		return Record{}, fmt.Errorf("%w: shift on %s has not started yet", apperrors.ErrInvalidAttendance, date)
+	}
+
+	record, err := attS.attendanceRepo.CreateAttendanceRecord(ctx, repo.AttendanceRecord{
+		ApplicationID: applicationId,
+		ShiftID:       engagement.ShiftID,
+		WorkerID:      application.WorkerID,
+		Date:          date,
+		Status:        string(NoShow),
+	})
+	if err != nil {
+		return Record{}, err
+	}
+
+	return MapRecordRepoToService(record, engagement.DurationInHours), nil
+}
+
+func (attS *attendanceService) FetchApplicationAttendance(ctx context.Context, applicationId int) ([]Record, error) {
+	engagements, err := attS.scheduleRepo.FetchEngagementsByApplicationId(ctx, applicationId)
+	if err != nil {
+		return []Record{}, err
+	}
+
+	records, err := attS.attendanceRepo.FetchAttendanceByApplicationId(ctx, applicationId)
+	if err != nil {
+		return []Record{}, err
+	}
+
+	mappedRecords := make([]Record, 0)
+	for _, record := range records {
+		engagement, _ := engagementOn(engagements, record.Date)
+		mappedRecords = append(mappedRecords, MapRecordRepoToService(record, engagement.DurationInHours))
+	}
+	return mappedRecords, nil
+}
+
+func (attS *attendanceService) FetchJobAttendance(ctx context.Context, jobId int, date datetime.Date) (Summary, error) {
+	exists := attS.jobRepo.FindJobById(ctx, jobId)
+	if !exists {
+		return Summary{}, apperrors.ErrNoJobExists
+	}
+
+	engagements, err := attS.attendanceRepo.FetchConfirmedEngagementsByJobId(ctx, jobId)
+	if err != nil {
+		return Summary{}, err
+	}
+
+	records, err := attS.attendanceRepo.FetchAttendanceByJobId(ctx, jobId)
+	if err != nil {
+		return Summary{}, err
+	}
+
+	return summarize(jobId, date, engagements, records), nil
+}
+
+func (attS *attendanceService) confirmedApplication(ctx context.Context, applicationId int) (repo.Application, error) {
+	application, err := attS.applicationRepo.FetchApplicationByID(ctx, applicationId)
+	if err != nil {
+		return repo.Application{}, err
+	}
+	if application.Status != repo.Confirmed {
+		return repo.Application{}, apperrors.ErrApplicationNotConfirmed
+	}
+	return application, nil
+}
+
+// employerApplication is the confirmed application of a job posted by the employer in the JWT
+func (attS *attendanceService) employerApplication(ctx context.Context, issuer Issuer, applicationId int) (repo.Application, error) {
+	application, err := attS.confirmedApplication(ctx, applicationId)
+	if err != nil {
+		return repo.Application{}, err
+	}
+
+	job, err := attS.jobRepo.FetchJobById(ctx, application.JobID)
+	if err != nil {
+		return repo.Application{}, err
+	}
+	if issuer.Role != employerRole || issuer.ID != job.EmployerID {
+		return repo.Application{}, apperrors.ErrNotAttendanceEmployer
+	}
+	return application, nil
+}
+
+// expectedHours is a best effort lookup of the duration of a shift, used only for reporting
+func (attS *attendanceService) expectedHours(ctx context.Context, applicationId int, date datetime.Date) int {
+	engagements, err := attS.scheduleRepo.FetchEngagementsByApplicationId(ctx, applicationId)
+	if err != nil {
+		return 0
+	}
+	engagement, _ := engagementOn(engagements, date)
+	return engagement.DurationInHours
+}
@@ -0,0 +1,389 @@
+package attendance
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/datetime"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type AttendanceServiceTestSuite struct {
+	suite.Suite
+	service         Service
+	attendanceRepo  mocks.AttendanceStorer
+	applicationRepo mocks.ApplicationStorer
+	scheduleRepo    mocks.ScheduleStorer
+	jobRepo         mocks.JobStorer
+}
+
+func (suite *AttendanceServiceTestSuite) SetupTest() {
+	suite.attendanceRepo = mocks.AttendanceStorer{}
+	suite.applicationRepo = mocks.ApplicationStorer{}
+	suite.scheduleRepo = mocks.ScheduleStorer{}
+	suite.jobRepo = mocks.JobStorer{}
+	suite.service = NewService(&suite.attendanceRepo, &suite.applicationRepo, &suite.scheduleRepo, &suite.jobRepo)
+}
+
+func (suite *AttendanceServiceTestSuite) TearDownTest() {
+	suite.attendanceRepo.AssertExpectations(suite.T())
+	suite.applicationRepo.AssertExpectations(suite.T())
+	suite.scheduleRepo.AssertExpectations(suite.T())
+	suite.jobRepo.AssertExpectations(suite.T())
+}
+
+func TestAttendanceServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(AttendanceServiceTestSuite))
+}
+
+func (suite *AttendanceServiceTestSuite) TestIssueCode() {
+	type testCase struct {
+		name          string
+		issuer        Issuer
+		setup         func()
+		expectedError error
+	}
+
+	testCases := []testCase{
+		{
+			name:   "success",
+			issuer: Issuer{Role: "employer", ID: 2},
+			setup: func() {
+				suite.applicationRepo.On("FetchApplicationByID", mock.Anything, 1).Return(repo.Application{ID: 1, WorkerID: 5, JobID: 4, Status: repo.Confirmed}, nil)
+				suite.jobRepo.On("FetchJobById", mock.Anything, 4).Return(repo.Job{ID: 4, EmployerID: 2}, nil)
+				suite.attendanceRepo.On("CreateAttendanceCode", mock.Anything, mock.MatchedBy(func(code repo.AttendanceCode) bool {
+					return code.ApplicationID == 1 && len(code.CodeHash) == 64 && code.ExpiresAt.After(time.Now())
+				})).Return(repo.AttendanceCode{ID: 1, ApplicationID: 1}, nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:   "application not confirmed",
+			issuer: Issuer{Role: "employer", ID: 2},
+			setup: func() {
+				suite.applicationRepo.On("FetchApplicationByID", mock.Anything, 1).Return(repo.Application{ID: 1, WorkerID: 5, Status: repo.Pending}, nil)
+			},
+			expectedError: apperrors.ErrApplicationNotConfirmed,
+		},
+		{
+			name:   "not the employer of the job",
+			issuer: Issuer{Role: "employer", ID: 3},
+			setup: func() {
+				suite.applicationRepo.On("FetchApplicationByID", mock.Anything, 1).Return(repo.Application{ID: 1, WorkerID: 5, JobID: 4, Status: repo.Confirmed}, nil)
+				suite.jobRepo.On("FetchJobById", mock.Anything, 4).Return(repo.Job{ID: 4, EmployerID: 2}, nil)
+			},
+			expectedError: apperrors.ErrNotAttendanceEmployer,
+		},
+		{
+			name:   "worker cannot issue codes",
+			issuer: Issuer{Role: "worker", ID: 2},
+			setup: func() {
+				suite.applicationRepo.On("FetchApplicationByID", mock.Anything, 1).Return(repo.Application{ID: 1, WorkerID: 5, JobID: 4, Status: repo.Confirmed}, nil)
+				suite.jobRepo.On("FetchJobById", mock.Anything, 4).Return(repo.Job{ID: 4, EmployerID: 2}, nil)
+			},
+			expectedError: apperrors.ErrNotAttendanceEmployer,
+		},
+	}
+
+	for _, test := range testCases {
+		suite.SetupTest()
+		suite.Run(test.name, func() {
+			test.setup()
+
+			code, err := suite.service.IssueCode(context.Background(), test.issuer, 1)
+			suite.ErrorIs(err, test.expectedError)
+			if test.expectedError == nil {
+				suite.Len(code.Code, 6)
+			}
+		})
+		suite.TearDownTest()
+	}
+}
+
+func (suite *AttendanceServiceTestSuite) TestCheckIn() {
+	type testCase struct {
+		name          string
+		input         Punch
+		setup         func()
+		expectedError error
+	}
+
+	today := datetime.Today()
+	latitude, longitude := 18.52, 73.85
+
+	testCases := []testCase{
+		{
+			name:  "success",
+			input: Punch{Code: "123456", Latitude: &latitude, Longitude: &longitude},
+			setup: func() {
+				suite.applicationRepo.On("FetchApplicationByID", mock.Anything, 1).Return(repo.Application{ID: 1, WorkerID: 5, Status: repo.Confirmed}, nil)
+				suite.scheduleRepo.On("FetchEngagementsByApplicationId", mock.Anything, 1).Return([]repo.Engagement{{ApplicationID: 1, ShiftID: 3, Date: today, StartHour: "00:00", DurationInHours: 8}}, nil)
+				suite.attendanceRepo.On("CheckInAttendanceRecord", mock.Anything, mock.MatchedBy(func(record repo.AttendanceRecord) bool {
+					return record.ApplicationID == 1 && record.ShiftID == 3 && record.WorkerID == 5 && record.Date == today && record.Status == "checked_in" && *record.CheckInLatitude == latitude
+				}), hashCode("123456")).Return(repo.AttendanceRecord{ID: 1, ApplicationID: 1, ShiftID: 3, WorkerID: 5, Date: today, Status: "checked_in"}, nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:          "missing code",
+			input:         Punch{},
+			setup:         func() {},
+			expectedError: apperrors.ErrInvalidAttendance,
+		},
+		{
+			name:          "latitude without longitude",
+			input:         Punch{Code: "123456", Latitude: &latitude},
+			setup:         func() {},
+			expectedError: apperrors.ErrInvalidAttendance,
+		},
+		{
+			name:  "no shift today",
+			input: Punch{Code: "123456"},
+			setup: func() {
+				suite.applicationRepo.On("FetchApplicationByID", mock.Anything, 1).Return(repo.Application{ID: 1, WorkerID: 5, Status: repo.Confirmed}, nil)
+				suite.scheduleRepo.On("FetchEngagementsByApplicationId", mock.Anything, 1).Return([]repo.Engagement{{ApplicationID: 1, Date: "2020-01-01", DurationInHours: 8}}, nil)
+			},
+			expectedError: apperrors.ErrNoShiftToday,
+		},
+		{
+			name:  "invalid code",
+			input: Punch{Code: "000000"},
+			setup: func() {
+				suite.applicationRepo.On("FetchApplicationByID", mock.Anything, 1).Return(repo.Application{ID: 1, WorkerID: 5, Status: repo.Confirmed}, nil)
+				suite.scheduleRepo.On("FetchEngagementsByApplicationId", mock.Anything, 1).Return([]repo.Engagement{{ApplicationID: 1, Date: today, DurationInHours: 8}}, nil)
+				suite.attendanceRepo.On("CheckInAttendanceRecord", mock.Anything, mock.Anything, hashCode("000000")).Return(repo.AttendanceRecord{}, apperrors.ErrInvalidAttendanceCode)
+			},
+			expectedError: apperrors.ErrInvalidAttendanceCode,
+		},
+		{
+			name:  "already checked in",
+			input: Punch{Code: "123456"},
+			setup: func() {
+				suite.applicationRepo.On("FetchApplicationByID", mock.Anything, 1).Return(repo.Application{ID: 1, WorkerID: 5, Status: repo.Confirmed}, nil)
+				suite.scheduleRepo.On("FetchEngagementsByApplicationId", mock.Anything, 1).Return([]repo.Engagement{{ApplicationID: 1, Date: today, DurationInHours: 8}}, nil)
+				suite.attendanceRepo.On("CheckInAttendanceRecord", mock.Anything, mock.Anything, hashCode("123456")).Return(repo.AttendanceRecord{}, apperrors.ErrAttendanceAlreadyMarked)
+			},
+			expectedError: apperrors.ErrAttendanceAlreadyMarked,
+		},
+	}
+
+	for _, test := range testCases {
+		suite.SetupTest()
+		suite.Run(test.name, func() {
+			test.setup()
+
+			_, err := suite.service.CheckIn(context.Background(), 1, test.input)
+			suite.ErrorIs(err, test.expectedError)
+		})
+		suite.TearDownTest()
+	}
+}
+
+func (suite *AttendanceServiceTestSuite) TestCheckOut() {
+	type testCase struct {
+		name           string
+		setup          func()
+		expectedOutput Record
+		expectedError  error
+	}
+
+	today := datetime.Today()
+	checkInAt := time.Now().Add(-8 * time.Hour)
+	checkOutAt := checkInAt.Add(7*time.Hour + 30*time.Minute)
+
+	testCases := []testCase{
+		{
+			name: "hours worked against shift duration",
+			setup: func() {
+				suite.applicationRepo.On("FetchApplicationByID", mock.Anything, 1).Return(repo.Application{ID: 1, WorkerID: 5, Status: repo.Confirmed}, nil)
+				suite.attendanceRepo.On("FetchOpenAttendanceRecord", mock.Anything, 1).Return(repo.AttendanceRecord{ID: 1, ApplicationID: 1, WorkerID: 5, Date: today, Status: "checked_in", CheckInAt: &checkInAt}, nil)
+				suite.attendanceRepo.On("CheckOutAttendanceRecord", mock.Anything, mock.MatchedBy(func(record repo.AttendanceRecord) bool {
+					return record.ID == 1 && record.MinutesWorked == 480 && record.CheckOutAt != nil
+				}), hashCode("123456")).Return(repo.AttendanceRecord{ID: 1, ApplicationID: 1, WorkerID: 5, Date: today, Status: "checked_out", CheckInAt: &checkInAt, CheckOutAt: &checkOutAt, MinutesWorked: 450}, nil)
+				suite.scheduleRepo.On("FetchEngagementsByApplicationId", mock.Anything, 1).Return([]repo.Engagement{{ApplicationID: 1, Date: today, DurationInHours: 8}}, nil)
+			},
+			expectedOutput: Record{ID: 1, ApplicationID: 1, WorkerID: 5, Date: today, Status: CheckedOut, CheckInAt: &checkInAt, CheckOutAt: &checkOutAt, HoursWorked: 7.5, ExpectedHours: 8},
+			expectedError:  nil,
+		},
+		{
+			name: "not checked in",
+			setup: func() {
+				suite.applicationRepo.On("FetchApplicationByID", mock.Anything, 1).Return(repo.Application{ID: 1, WorkerID: 5, Status: repo.Confirmed}, nil)
+				suite.attendanceRepo.On("FetchOpenAttendanceRecord", mock.Anything, 1).Return(repo.AttendanceRecord{}, apperrors.ErrNotCheckedIn)
+			},
+			expectedOutput: Record{},
+			expectedError:  apperrors.ErrNotCheckedIn,
+		},
+		{
+			name: "invalid code",
+			setup: func() {
+				suite.applicationRepo.On("FetchApplicationByID", mock.Anything, 1).Return(repo.Application{ID: 1, WorkerID: 5, Status: repo.Confirmed}, nil)
+				suite.attendanceRepo.On("FetchOpenAttendanceRecord", mock.Anything, 1).Return(repo.AttendanceRecord{ID: 1, ApplicationID: 1, WorkerID: 5, Date: today, Status: "checked_in", CheckInAt: &checkInAt}, nil)
+				suite.attendanceRepo.On("CheckOutAttendanceRecord", mock.Anything, mock.Anything, hashCode("123456")).Return(repo.AttendanceRecord{}, apperrors.ErrInvalidAttendanceCode)
+			},
+			expectedOutput: Record{},
+			expectedError:  apperrors.ErrInvalidAttendanceCode,
+		},
+	}
+
+	for _, test := range testCases {
+		suite.SetupTest()
+		suite.Run(test.name, func() {
+			test.setup()
+
+			record, err := suite.service.CheckOut(context.Background(), 1, Punch{Code: "123456"})
+			suite.Equal(test.expectedOutput, record)
+			suite.ErrorIs(err, test.expectedError)
+		})
+		suite.TearDownTest()
+	}
+}
+
+func (suite *AttendanceServiceTestSuite) TestMarkNoShow() {
+	type testCase struct {
+		name          string
+		issuer        Issuer
+		input         datetime.Date
+		setup         func()
+		expectedError error
+	}
+
+	today := datetime.Today()
+	tomorrow, _ := today.AddDays(1)
+
+	testCases := []testCase{
+		{
+			name:   "success",
+			issuer: Issuer{Role: "employer", ID: 2},
+			input:  today,
+			setup: func() {
+				suite.applicationRepo.On("FetchApplicationByID", mock.Anything, 1).Return(repo.Application{ID: 1, WorkerID: 5, JobID: 4, Status: repo.Confirmed}, nil)
+				suite.jobRepo.On("FetchJobById", mock.Anything, 4).Return(repo.Job{ID: 4, EmployerID: 2}, nil)
+				suite.scheduleRepo.On("FetchEngagementsByApplicationId", mock.Anything, 1).Return([]repo.Engagement{{ApplicationID: 1, ShiftID: 3, Date: today, StartHour: "00:00", DurationInHours: 8}}, nil)
+				suite.attendanceRepo.On("CreateAttendanceRecord", mock.Anything, repo.AttendanceRecord{ApplicationID: 1, ShiftID: 3, WorkerID: 5, Date: today, Status: "no_show"}).Return(repo.AttendanceRecord{ID: 2, ApplicationID: 1, ShiftID: 3, WorkerID: 5, Date: today, Status: "no_show"}, nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:   "shift not started",
+			issuer: Issuer{Role: "employer", ID: 2},
+			input:  tomorrow,
+			setup: func() {
+				suite.applicationRepo.On("FetchApplicationByID", mock.Anything, 1).Return(repo.Application{ID: 1, WorkerID: 5, JobID: 4, Status: repo.Confirmed}, nil)
+				suite.jobRepo.On("FetchJobById", mock.Anything, 4).Return(repo.Job{ID: 4, EmployerID: 2}, nil)
+				suite.scheduleRepo.On("FetchEngagementsByApplicationId", mock.Anything, 1).Return([]repo.Engagement{{ApplicationID: 1, ShiftID: 3, Date: tomorrow, StartHour: "00:00", DurationInHours: 8}}, nil)
+			},
+			expectedError: apperrors.ErrInvalidAttendance,
+		},
+		{
+			name:   "no shift on the day",
+			issuer: Issuer{Role: "employer", ID: 2},
+			input:  "2020-01-01",
+			setup: func() {
+				suite.applicationRepo.On("FetchApplicationByID", mock.Anything, 1).Return(repo.Application{ID: 1, WorkerID: 5, JobID: 4, Status: repo.Confirmed}, nil)
+				suite.jobRepo.On("FetchJobById", mock.Anything, 4).Return(repo.Job{ID: 4, EmployerID: 2}, nil)
+				suite.scheduleRepo.On("FetchEngagementsByApplicationId", mock.Anything, 1).Return([]repo.Engagement{{ApplicationID: 1, Date: today}}, nil)
+			},
+			expectedError: apperrors.ErrInvalidAttendance,
+		},
+		{
+			name:   "not the employer of the job",
+			issuer: Issuer{Role: "employer", ID: 3},
+			input:  today,
+			setup: func() {
+				suite.applicationRepo.On("FetchApplicationByID", mock.Anything, 1).Return(repo.Application{ID: 1, WorkerID: 5, JobID: 4, Status: repo.Confirmed}, nil)
+				suite.jobRepo.On("FetchJobById", mock.Anything, 4).Return(repo.Job{ID: 4, EmployerID: 2}, nil)
+			},
+			expectedError: apperrors.ErrNotAttendanceEmployer,
+		},
+	}
+
+	for _, test := range testCases {
+		suite.SetupTest()
+		suite.Run(test.name, func() {
+			test.setup()
+
+			_, err := suite.service.MarkNoShow(context.Background(), test.issuer, 1, test.input)
+			suite.ErrorIs(err, test.expectedError)
+		})
+		suite.TearDownTest()
+	}
+}
+
+func (suite *AttendanceServiceTestSuite) TestFetchJobAttendance() {
+	type testCase struct {
+		name           string
+		setup          func()
+		expectedOutput Summary
+		expectedError  bool
+	}
+
+	checkInAt := time.Date(2025, 3, 10, 9, 0, 0, 0, datetime.Location)
+
+	testCases := []testCase{
+		{
+			name: "success",
+			setup: func() {
+				suite.jobRepo.On("FindJobById", mock.Anything, 2).Return(true)
+				suite.attendanceRepo.On("FetchConfirmedEngagementsByJobId", mock.Anything, 2).Return([]repo.Engagement{
+					{ApplicationID: 1, WorkerID: 5, Date: "2025-03-10", DurationInHours: 8},
+					{ApplicationID: 2, WorkerID: 6, Date: "2025-03-10", DurationInHours: 8},
+					{ApplicationID: 3, WorkerID: 7, Date: "2025-03-10", DurationInHours: 8},
+				}, nil)
+				suite.attendanceRepo.On("FetchAttendanceByJobId", mock.Anything, 2).Return([]repo.AttendanceRecord{
+					{ID: 1, ApplicationID: 1, WorkerID: 5, Date: "2025-03-10", Status: "checked_out", CheckInAt: &checkInAt, MinutesWorked: 450},
+					{ID: 2, ApplicationID: 2, WorkerID: 6, Date: "2025-03-10", Status: "no_show"},
+				}, nil)
+			},
+			expectedOutput: Summary{
+				JobID:          2,
+				ExpectedShifts: 3,
+				Present:        1,
+				NoShows:        1,
+				Unmarked:       1,
+				HoursWorked:    7.5,
+				ExpectedHours:  24,
+				Records: []Record{
+					{ID: 1, ApplicationID: 1, WorkerID: 5, Date: "2025-03-10", Status: CheckedOut, CheckInAt: &checkInAt, HoursWorked: 7.5, ExpectedHours: 8},
+					{ID: 2, ApplicationID: 2, WorkerID: 6, Date: "2025-03-10", Status: NoShow, ExpectedHours: 8},
+				},
+			},
+			expectedError: false,
+		},
+		{
+			name: "job not found",
+			setup: func() {
+				suite.jobRepo.On("FindJobById", mock.Anything, 2).Return(false)
+			},
+			expectedOutput: Summary{},
+			expectedError:  true,
+		},
+		{
+			name: "error from db",
+			setup: func() {
+				suite.jobRepo.On("FindJobById", mock.Anything, 2).Return(true)
+				suite.attendanceRepo.On("FetchConfirmedEngagementsByJobId", mock.Anything, 2).Return([]repo.Engagement{}, errors.New("some db error"))
+			},
+			expectedOutput: Summary{},
+			expectedError:  true,
+		},
+	}
+
+	for _, test := range testCases {
+		suite.SetupTest()
+		suite.Run(test.name, func() {
+			test.setup()
+
+			summary, err := suite.service.FetchJobAttendance(context.Background(), 2, "")
+			suite.Equal(test.expectedOutput, summary)
+			suite.Equal(test.expectedError, err != nil)
+		})
+		suite.TearDownTest()
+	}
+}
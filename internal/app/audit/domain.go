@@ -0,0 +1,65 @@
+package audit
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type Action string
+
+const (
+	Create     Action = "create"
+	Update     Action = "update"
+	Delete     Action = "delete"
+	Merge      Action = "merge"
+	Suspend    Action = "suspend"
+	Reactivate Action = "reactivate"
+	Hide       Action = "hide"
+	Unhide     Action = "unhide"
+	Resolve    Action = "resolve"
+)
+
+type EntityType string
+
+const (
+	Worker      EntityType = "worker"
+	Employer    EntityType = "employer"
+	Job         EntityType = "job"
+	Application EntityType = "application"
+	Sector      EntityType = "sector"
+	Admin       EntityType = "admin"
+	Message     EntityType = "message"
+	MinimumWage EntityType = "minimum_wage"
+)
+
+// Change is the value of a field before and after an action, null when the field did not exist
+type Change struct {
+	Before json.RawMessage `json:"before"`
+	After  json.RawMessage `json:"after"`
+}
+
+type Entry struct {
+	ID         int               `json:"id"`
+	ActorID    int               `json:"actor_id"`
+	ActorRole  string            `json:"actor_role"`
+	Action     Action            `json:"action"`
+	EntityType EntityType        `json:"entity_type"`
+	EntityID   int               `json:"entity_id"`
+	Changes    map[string]Change `json:"changes"`
+	RequestID  string            `json:"request_id"`
+	IP         string            `json:"ip"`
+	CreatedAt  time.Time         `json:"created_at"`
+}
+
+// Filter selects audit log entries, From and To are dates in YYYY-MM-DD format and both days are
+// included
+type Filter struct {
+	EntityType EntityType
+	EntityID   int
+	ActorID    int
+	ActorRole  string
+	From       string
+	To         string
+	Limit      int
+	Offset     int
+}
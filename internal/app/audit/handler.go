@@ -0,0 +1,60 @@
+package audit
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/logger"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/middleware"
+	"go.uber.org/zap"
+)
+
+func FetchLogs(auditS Service) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		query := r.URL.Query()
+		filter := Filter{
+			EntityType: EntityType(query.Get("entity_type")),
+			ActorRole:  query.Get("actor_role"),
+			From:       query.Get("from"),
+			To:         query.Get("to"),
+		}
+
+		var err error
+		for key, value := range map[string]*int{
+			"entity_id": &filter.EntityID,
+			"actor_id":  &filter.ActorID,
+			"limit":     &filter.Limit,
+			"offset":    &filter.Offset,
+		} {
+			if query.Get(key) == "" || err != nil {
+				continue
+			}
+			*value, err = strconv.Atoi(query.Get(key))
+		}
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrInvalidAuditFilter.Error(), zap.Error(err))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrFetchAuditLogs.Error()+": "+apperrors.ErrInvalidAuditFilter.Error(), http.StatusBadRequest)
+			return
+		}
+
+		entries, err := auditS.FetchLogs(ctx, filter)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrFetchAuditLogs.Error(), zap.Error(err))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrFetchAuditLogs.Error()+", "+err.Error(), auditErrorStatusCode(err))
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, "audit logs retrieved successfully", http.StatusOK, entries)
+	}
+}
+
+func auditErrorStatusCode(err error) int {
+	if errors.Is(err, apperrors.ErrInvalidAuditFilter) {
+		return http.StatusBadRequest
+	}
+	return http.StatusInternalServerError
+}
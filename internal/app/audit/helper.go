@@ -0,0 +1,171 @@
+package audit
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/datetime"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo"
+)
+
+const (
+	defaultLogLimit = 50
+	maxLogLimit     = 200
+)
+
+// fields that are never written to the audit log, a change to them is recorded as redacted
+var redactedFields = map[string]bool{
+	"password": true,
+}
+
+var redacted = json.RawMessage(`"[redacted]"`)
+
+var entityTypes = map[EntityType]bool{
+	Worker:      true,
+	Employer:    true,
+	Job:         true,
+	Application: true,
+	Sector:      true,
+	Admin:       true,
+	Message:     true,
+}
+
+// diff compares the JSON form of an entity before and after an action and returns the top level
+// fields whose value changed, a nil before or after is an entity that did not exist
+func diff(before, after interface{}) (map[string]Change, error) {
+	beforeFields, err := jsonFields(before)
+	if err != nil {
+		return nil, err
+	}
+	afterFields, err := jsonFields(after)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make(map[string]Change)
+	for field, beforeValue := range beforeFields {
+		afterValue := afterFields[field]
+		if !bytes.Equal(beforeValue, afterValue) {
+			changes[field] = Change{Before: beforeValue, After: afterValue}
+		}
+	}
+	for field, afterValue := range afterFields {
+		if _, ok := beforeFields[field]; !ok {
+			changes[field] = Change{After: afterValue}
+		}
+	}
+
+	for field, change := range changes {
+		if redactedFields[field] {
+			changes[field] = Change{Before: redactValue(change.Before), After: redactValue(change.After)}
+		}
+	}
+	return changes, nil
+}
+
+// jsonFields splits the JSON object of an entity into its fields, a value that is not an object is
+// kept under the field "value"
+func jsonFields(entity interface{}) (map[string]json.RawMessage, error) {
+	fields := make(map[string]json.RawMessage)
+	if entity == nil {
+		return fields, nil
+	}
+
+	data, err := json.Marshal(entity)
+	if err != nil {
+		return nil, err
+	}
+	if bytes.Equal(data, []byte("null")) {
+		return fields, nil
+	}
+
+	err = json.Unmarshal(data, &fields)
+	if err != nil {
+		return map[string]json.RawMessage{"value": data}, nil
+	}
+	return fields, nil
+}
+
+func redactValue(value json.RawMessage) json.RawMessage {
+	if value == nil {
+		return nil
+	}
+	return redacted
+}
+
+func normalizeFilter(filter Filter) (repo.AuditLogFilter, error) {
+	if filter.EntityType != "" && !entityTypes[filter.EntityType] {
+		return repo.AuditLogFilter{}, apperrors.ErrInvalidAuditFilter
+	}
+	if filter.EntityID < 0 || filter.ActorID < 0 || filter.Limit < 0 || filter.Offset < 0 {
+		return repo.AuditLogFilter{}, apperrors.ErrInvalidAuditFilter
+	}
+
+	repoFilter := repo.AuditLogFilter{
+		EntityType: string(filter.EntityType),
+		EntityID:   filter.EntityID,
+		ActorID:    filter.ActorID,
+		ActorRole:  filter.ActorRole,
+		Limit:      filter.Limit,
+		Offset:     filter.Offset,
+	}
+	if repoFilter.Limit == 0 {
+		repoFilter.Limit = defaultLogLimit
+	}
+	if repoFilter.Limit > maxLogLimit {
+		repoFilter.Limit = maxLogLimit
+	}
+
+	if filter.From != "" {
+		from, err := parseFilterDate(filter.From, 0)
+		if err != nil {
+			return repo.AuditLogFilter{}, err
+		}
+		repoFilter.From = from
+	}
+	if filter.To != "" {
+		// the range ends at the midnight after the last day
+		to, err := parseFilterDate(filter.To, 1)
+		if err != nil {
+			return repo.AuditLogFilter{}, err
+		}
+		repoFilter.To = to
+	}
+	if !repoFilter.From.IsZero() && !repoFilter.To.IsZero() && !repoFilter.From.Before(repoFilter.To) {
+		return repo.AuditLogFilter{}, apperrors.ErrInvalidAuditFilter
+	}
+	return repoFilter, nil
+}
+
+func parseFilterDate(value string, days int) (time.Time, error) {
+	date, err := datetime.ParseDate(value)
+	if err != nil {
+		return time.Time{}, apperrors.ErrInvalidAuditFilter
+	}
+	date, err = date.AddDays(days)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return date.Time()
+}
+
+func MapAuditLogRepoToService(auditLog repo.AuditLog) Entry {
+	changes := make(map[string]Change)
+	// entries are written by Record, a malformed one is shown without its changes
+	json.Unmarshal(auditLog.Changes, &changes)
+
+	return Entry{
+		ID:         auditLog.ID,
+		ActorID:    auditLog.ActorID,
+		ActorRole:  auditLog.ActorRole,
+		Action:     Action(auditLog.Action),
+		EntityType: EntityType(auditLog.EntityType),
+		EntityID:   auditLog.EntityID,
+		Changes:    changes,
+		RequestID:  auditLog.RequestID,
+		IP:         auditLog.IP,
+		CreatedAt:  auditLog.CreatedAt,
+	}
+}
@@ -0,0 +1,55 @@
+package audit
+
+import "context"
+
+// Recorder records the creates, updates and deletes of one type of entity done through a service,
+// the entity is fetched before an update or a delete to record its previous state
+type Recorder[T any] struct {
+	Service Service
+	Type    EntityType
+	ID      func(entity T) int
+	Fetch   func(ctx context.Context, entityId int) (T, error)
+}
+
+func (recorder Recorder[T]) Create(ctx context.Context, create func() (T, error)) (T, error) {
+	created, err := create()
+	if err != nil {
+		return created, err
+	}
+
+	recorder.Service.Record(ctx, Create, recorder.Type, recorder.ID(created), nil, created)
+	return created, nil
+}
+
+func (recorder Recorder[T]) Update(ctx context.Context, entityId int, update func() (T, error)) (T, error) {
+	before := recorder.previousState(ctx, entityId)
+
+	updated, err := update()
+	if err != nil {
+		return updated, err
+	}
+
+	recorder.Service.Record(ctx, Update, recorder.Type, recorder.ID(updated), before, updated)
+	return updated, nil
+}
+
+func (recorder Recorder[T]) Delete(ctx context.Context, entityId int, remove func() (int, error)) (int, error) {
+	before := recorder.previousState(ctx, entityId)
+
+	deletedId, err := remove()
+	if err != nil {
+		return deletedId, err
+	}
+
+	recorder.Service.Record(ctx, Delete, recorder.Type, entityId, before, nil)
+	return deletedId, nil
+}
+
+// previousState is nil for an entity that cannot be fetched, it is recorded without its previous state
+func (recorder Recorder[T]) previousState(ctx context.Context, entityId int) interface{} {
+	entity, err := recorder.Fetch(ctx, entityId)
+	if err != nil {
+		return nil
+	}
+	return entity
+}
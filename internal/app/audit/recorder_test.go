@@ -0,0 +1,129 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo"
+	"github.com/stretchr/testify/mock"
+)
+
+func (suite *AuditServiceTestSuite) workerRecorder(fetch func(ctx context.Context, workerId int) (auditedWorker, error)) Recorder[auditedWorker] {
+	return Recorder[auditedWorker]{
+		Service: suite.service,
+		Type:    Worker,
+		ID:      func(worker auditedWorker) int { return worker.ID },
+		Fetch:   fetch,
+	}
+}
+
+// expectChanges expects a single audit log of the action on the worker 3 with the given changes
+func (suite *AuditServiceTestSuite) expectChanges(action Action, expectedChanges map[string]Change) {
+	suite.auditRepo.On("CreateAuditLog", mock.Anything, mock.MatchedBy(func(auditLog repo.AuditLog) bool {
+		var changes map[string]Change
+		json.Unmarshal(auditLog.Changes, &changes)
+		return auditLog.ActorID == 7 && auditLog.Action == string(action) && auditLog.EntityType == "worker" && auditLog.EntityID == 3 &&
+			suite.Equal(expectedChanges, changes)
+	})).Return(nil).Once()
+}
+
+func (suite *AuditServiceTestSuite) TestRecorder() {
+	null := json.RawMessage(`null`)
+	stored := auditedWorker{ID: 3, Name: "Ravi", City: "Pune"}
+	fetchStored := func(ctx context.Context, workerId int) (auditedWorker, error) {
+		suite.Equal(3, workerId)
+		return stored, nil
+	}
+	notFound := func(ctx context.Context, workerId int) (auditedWorker, error) {
+		return auditedWorker{}, errors.New("worker not found")
+	}
+
+	suite.Run("create records the created entity", func() {
+		suite.SetupTest()
+		suite.expectChanges(Create, map[string]Change{
+			"id":   {Before: null, After: json.RawMessage(`3`)},
+			"name": {Before: null, After: json.RawMessage(`"Ravi"`)},
+			"city": {Before: null, After: json.RawMessage(`"Pune"`)},
+		})
+
+		created, err := suite.workerRecorder(notFound).Create(requestContext(), func() (auditedWorker, error) {
+			return stored, nil
+		})
+
+		suite.NoError(err)
+		suite.Equal(stored, created)
+		suite.TearDownTest()
+	})
+
+	suite.Run("update records the state fetched before it", func() {
+		suite.SetupTest()
+		suite.expectChanges(Update, map[string]Change{
+			"city": {Before: json.RawMessage(`"Pune"`), After: json.RawMessage(`"Nashik"`)},
+		})
+
+		updated, err := suite.workerRecorder(fetchStored).Update(requestContext(), 3, func() (auditedWorker, error) {
+			return auditedWorker{ID: 3, Name: "Ravi", City: "Nashik"}, nil
+		})
+
+		suite.NoError(err)
+		suite.Equal("Nashik", updated.City)
+		suite.TearDownTest()
+	})
+
+	suite.Run("update of an entity that cannot be fetched has no previous state", func() {
+		suite.SetupTest()
+		suite.expectChanges(Update, map[string]Change{
+			"id":   {Before: null, After: json.RawMessage(`3`)},
+			"name": {Before: null, After: json.RawMessage(`"Ravi"`)},
+			"city": {Before: null, After: json.RawMessage(`"Nashik"`)},
+		})
+
+		_, err := suite.workerRecorder(notFound).Update(requestContext(), 3, func() (auditedWorker, error) {
+			return auditedWorker{ID: 3, Name: "Ravi", City: "Nashik"}, nil
+		})
+
+		suite.NoError(err)
+		suite.TearDownTest()
+	})
+
+	suite.Run("delete records the state fetched before it", func() {
+		suite.SetupTest()
+		suite.expectChanges(Delete, map[string]Change{
+			"id":   {Before: json.RawMessage(`3`), After: null},
+			"name": {Before: json.RawMessage(`"Ravi"`), After: null},
+			"city": {Before: json.RawMessage(`"Pune"`), After: null},
+		})
+
+		deletedId, err := suite.workerRecorder(fetchStored).Delete(requestContext(), 3, func() (int, error) {
+			return 3, nil
+		})
+
+		suite.NoError(err)
+		suite.Equal(3, deletedId)
+		suite.TearDownTest()
+	})
+
+	suite.Run("failed changes are not recorded", func() {
+		suite.SetupTest()
+		recorder := suite.workerRecorder(fetchStored)
+
+		_, err := recorder.Create(requestContext(), func() (auditedWorker, error) {
+			return auditedWorker{}, errors.New("db error")
+		})
+		suite.Error(err)
+
+		_, err = recorder.Update(requestContext(), 3, func() (auditedWorker, error) {
+			return auditedWorker{}, errors.New("db error")
+		})
+		suite.Error(err)
+
+		_, err = recorder.Delete(requestContext(), 3, func() (int, error) {
+			return 0, errors.New("db error")
+		})
+		suite.Error(err)
+
+		suite.auditRepo.AssertNotCalled(suite.T(), "CreateAuditLog", mock.Anything, mock.Anything)
+		suite.TearDownTest()
+	})
+}
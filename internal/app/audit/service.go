@@ -0,0 +1,81 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/logger"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/middleware"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo"
+	"go.uber.org/zap"
+)
+
+type service struct {
+	auditRepo repo.AuditStorer
+}
+
+type Service interface {
+	Record(ctx context.Context, action Action, entityType EntityType, entityId int, before, after interface{})
+	FetchLogs(ctx context.Context, filter Filter) ([]Entry, error)
+}
+
+func NewService(auditRepo repo.AuditStorer) Service {
+	return &service{
+		auditRepo: auditRepo,
+	}
+}
+
+// Record writes who did an action on an entity and the fields it changed. The action has already
+// happened when it is recorded, so a failure is logged and not returned to the caller.
+func (auditS *service) Record(ctx context.Context, action Action, entityType EntityType, entityId int, before, after interface{}) {
+	changes, err := diff(before, after)
+	if err != nil {
+		logger.Errorw(ctx, "failed to diff audited entity", zap.Error(err), zap.String("entity_type", string(entityType)), zap.Int("entity_id", entityId))
+		return
+	}
+	// an update that changed nothing did not change any state
+	if action == Update && len(changes) == 0 {
+		return
+	}
+
+	changesData, err := json.Marshal(changes)
+	if err != nil {
+		logger.Errorw(ctx, "failed to marshal audit changes", zap.Error(err), zap.String("entity_type", string(entityType)), zap.Int("entity_id", entityId))
+		return
+	}
+
+	actorId, _ := ctx.Value("user_id").(int)
+	actorRole, _ := ctx.Value("role").(string)
+
+	err = auditS.auditRepo.CreateAuditLog(ctx, repo.AuditLog{
+		ActorID:    actorId,
+		ActorRole:  actorRole,
+		Action:     string(action),
+		EntityType: string(entityType),
+		EntityID:   entityId,
+		Changes:    changesData,
+		RequestID:  middleware.RequestID(ctx),
+		IP:         middleware.ClientIP(ctx),
+	})
+	if err != nil {
+		logger.Errorw(ctx, "failed to write audit log", zap.Error(err), zap.String("action", string(action)), zap.String("entity_type", string(entityType)), zap.Int("entity_id", entityId))
+	}
+}
+
+func (auditS *service) FetchLogs(ctx context.Context, filter Filter) ([]Entry, error) {
+	repoFilter, err := normalizeFilter(filter)
+	if err != nil {
+		return []Entry{}, err
+	}
+
+	auditLogs, err := auditS.auditRepo.FetchAuditLogs(ctx, repoFilter)
+	if err != nil {
+		return []Entry{}, err
+	}
+
+	entries := make([]Entry, 0, len(auditLogs))
+	for _, auditLog := range auditLogs {
+		entries = append(entries, MapAuditLogRepoToService(auditLog))
+	}
+	return entries, nil
+}
@@ -0,0 +1,206 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/middleware"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type AuditServiceTestSuite struct {
+	suite.Suite
+	service   Service
+	auditRepo mocks.AuditStorer
+}
+
+func (suite *AuditServiceTestSuite) SetupTest() {
+	suite.auditRepo = mocks.AuditStorer{}
+	suite.service = NewService(&suite.auditRepo)
+}
+
+func (suite *AuditServiceTestSuite) TearDownTest() {
+	suite.auditRepo.AssertExpectations(suite.T())
+}
+
+func TestAuditServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(AuditServiceTestSuite))
+}
+
+type auditedWorker struct {
+	ID       int    `json:"id"`
+	Name     string `json:"name"`
+	City     string `json:"city"`
+	Password string `json:"password,omitempty"`
+}
+
+// requestContext is the context of a request made by the employer 7 through RequestMetadata
+func requestContext() context.Context {
+	var ctx context.Context
+	handler := middleware.RequestMetadata(false)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx = r.Context()
+	}))
+
+	request := httptest.NewRequest(http.MethodPut, "/worker/3", nil)
+	request.RemoteAddr = "10.0.0.7:51234"
+	request.Header.Set(middleware.RequestIDHeader, "req-42")
+	handler.ServeHTTP(httptest.NewRecorder(), request)
+
+	ctx = context.WithValue(ctx, "user_id", 7)
+	return context.WithValue(ctx, "role", "employer")
+}
+
+func (suite *AuditServiceTestSuite) TestRecord() {
+	type testCase struct {
+		name            string
+		action          Action
+		before          interface{}
+		after           interface{}
+		expectedChanges map[string]Change
+	}
+
+	// a field missing before a create or after a delete is stored as null
+	null := json.RawMessage(`null`)
+
+	testCases := []testCase{
+		{
+			name:   "update records changed fields",
+			action: Update,
+			before: auditedWorker{ID: 3, Name: "Ravi", City: "Pune"},
+			after:  auditedWorker{ID: 3, Name: "Ravi", City: "Nashik"},
+			expectedChanges: map[string]Change{
+				"city": {Before: json.RawMessage(`"Pune"`), After: json.RawMessage(`"Nashik"`)},
+			},
+		},
+		{
+			name:   "create records every field and redacts the password",
+			action: Create,
+			before: nil,
+			after:  auditedWorker{ID: 3, Name: "Ravi", Password: "secret"},
+			expectedChanges: map[string]Change{
+				"id":       {Before: null, After: json.RawMessage(`3`)},
+				"name":     {Before: null, After: json.RawMessage(`"Ravi"`)},
+				"city":     {Before: null, After: json.RawMessage(`""`)},
+				"password": {Before: null, After: json.RawMessage(`"[redacted]"`)},
+			},
+		},
+		{
+			name:   "delete records the previous state",
+			action: Delete,
+			before: auditedWorker{ID: 3, Name: "Ravi"},
+			after:  nil,
+			expectedChanges: map[string]Change{
+				"id":   {Before: json.RawMessage(`3`), After: null},
+				"name": {Before: json.RawMessage(`"Ravi"`), After: null},
+				"city": {Before: json.RawMessage(`""`), After: null},
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		suite.SetupTest()
+		suite.Run(test.name, func() {
+			suite.auditRepo.On("CreateAuditLog", mock.Anything, mock.MatchedBy(func(auditLog repo.AuditLog) bool {
+				var changes map[string]Change
+				json.Unmarshal(auditLog.Changes, &changes)
+				return auditLog.ActorID == 7 && auditLog.ActorRole == "employer" && auditLog.Action == string(test.action) &&
+					auditLog.EntityType == "worker" && auditLog.EntityID == 3 && auditLog.RequestID == "req-42" && auditLog.IP == "10.0.0.7" &&
+					suite.Equal(test.expectedChanges, changes)
+			})).Return(nil)
+
+			suite.service.Record(requestContext(), test.action, Worker, 3, test.before, test.after)
+		})
+		suite.TearDownTest()
+	}
+}
+
+func (suite *AuditServiceTestSuite) TestRecordUnchangedUpdate() {
+	worker := auditedWorker{ID: 3, Name: "Ravi"}
+
+	suite.service.Record(requestContext(), Update, Worker, 3, worker, worker)
+
+	suite.auditRepo.AssertNotCalled(suite.T(), "CreateAuditLog", mock.Anything, mock.Anything)
+}
+
+func (suite *AuditServiceTestSuite) TestRecordFailureIsNotReturned() {
+	suite.auditRepo.On("CreateAuditLog", mock.Anything, mock.Anything).Return(errors.New("connection reset"))
+
+	suite.NotPanics(func() {
+		suite.service.Record(context.Background(), Delete, Job, 12, auditedWorker{ID: 12}, nil)
+	})
+}
+
+func (suite *AuditServiceTestSuite) TestFetchLogs() {
+	type testCase struct {
+		name          string
+		input         Filter
+		setup         func()
+		expectedError error
+	}
+
+	from := time.Date(2025, 3, 1, 0, 0, 0, 0, time.FixedZone("IST", 19800))
+	to := time.Date(2025, 3, 11, 0, 0, 0, 0, time.FixedZone("IST", 19800))
+
+	testCases := []testCase{
+		{
+			name:  "entity and actor in a date range",
+			input: Filter{EntityType: Job, EntityID: 12, ActorID: 7, From: "2025-03-01", To: "2025-03-10"},
+			setup: func() {
+				suite.auditRepo.On("FetchAuditLogs", mock.Anything, mock.MatchedBy(func(filter repo.AuditLogFilter) bool {
+					return filter.EntityType == "job" && filter.EntityID == 12 && filter.ActorID == 7 &&
+						filter.From.Equal(from) && filter.To.Equal(to) && filter.Limit == defaultLogLimit
+				})).Return([]repo.AuditLog{{ID: 1, Action: "update", EntityType: "job", EntityID: 12, Changes: []byte(`{"title":{"before":"Cook","after":"Chef"}}`)}}, nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:          "unknown entity type",
+			input:         Filter{EntityType: "invoice"},
+			setup:         func() {},
+			expectedError: apperrors.ErrInvalidAuditFilter,
+		},
+		{
+			name:          "invalid date",
+			input:         Filter{From: "01-03-2025"},
+			setup:         func() {},
+			expectedError: apperrors.ErrInvalidAuditFilter,
+		},
+		{
+			name:          "from after to",
+			input:         Filter{From: "2025-03-10", To: "2025-03-01"},
+			setup:         func() {},
+			expectedError: apperrors.ErrInvalidAuditFilter,
+		},
+		{
+			name:          "negative offset",
+			input:         Filter{Offset: -1},
+			setup:         func() {},
+			expectedError: apperrors.ErrInvalidAuditFilter,
+		},
+	}
+
+	for _, test := range testCases {
+		suite.SetupTest()
+		suite.Run(test.name, func() {
+			test.setup()
+
+			entries, err := suite.service.FetchLogs(context.Background(), test.input)
+
+			suite.ErrorIs(err, test.expectedError)
+			if test.expectedError == nil {
+				suite.Len(entries, 1)
+				suite.Equal(json.RawMessage(`"Chef"`), entries[0].Changes["title"].After)
+			}
+		})
+		suite.TearDownTest()
+	}
+}
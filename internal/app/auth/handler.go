@@ -66,6 +66,12 @@ func HandleLogin(authService Service) func(w http.ResponseWriter, r *http.Reques
 				return
 			}
 
+			if errors.Is(err, apperrors.ErrAccountSuspended) {
+				logger.Errorw(ctx, apperrors.ErrFailedLogin.Error(), zap.Error(err))
+				middleware.HandleErrorResponse(ctx, w, err.Error(), http.StatusForbidden)
+				return
+			}
+
 			logger.Errorw(ctx, apperrors.ErrFailedLogin.Error(), zap.Error(err))
 			middleware.HandleErrorResponse(ctx, w, err.Error(), http.StatusBadRequest)
 			return
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/notification"
 	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
 	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/middleware"
 	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/utils"
@@ -11,16 +12,18 @@ import (
 )
 
 type service struct {
-	authRepo repo.AuthStorer
+	authRepo            repo.AuthStorer
+	notificationService notification.Service
 }
 
 type Service interface {
 	Login(ctx context.Context, loginData LoginRequest) (LoginResponse, error)
 }
 
-func NewService(authRepo repo.AuthStorer) Service {
+func NewService(authRepo repo.AuthStorer, notificationService notification.Service) Service {
 	return &service{
-		authRepo: authRepo,
+		authRepo:            authRepo,
+		notificationService: notificationService,
 	}
 }
 
@@ -45,12 +48,25 @@ func (authS *service) Login(ctx context.Context, loginData LoginRequest) (LoginR
 		return LoginResponse{}, fmt.Errorf("%w: %w", apperrors.ErrFailedLogin, apperrors.ErrIncorrectLoginData)
 	}
 
+	// suspended accounts are only told so once they have proven they own the account
+	if user.IsSuspended {
+		return LoginResponse{}, fmt.Errorf("%w: %w", apperrors.ErrFailedLogin, apperrors.ErrAccountSuspended)
+	}
+
 	// create jwt
 	token, err := middleware.GenerateToken(user.ID, user.Role)
 	if err != nil {
 		return LoginResponse{}, fmt.Errorf("%w: %w", apperrors.ErrCreateToken, err)
 	}
 
+	// admins have no inbox, workers and employers are told about every login to their account
+	switch user.Role {
+	case string(notification.WorkerRecipient):
+		authS.notificationService.Notify(ctx, notification.Event{Type: notification.NewLogin, WorkerID: user.ID})
+	case string(notification.EmployerRecipient):
+		authS.notificationService.Notify(ctx, notification.Event{Type: notification.NewLogin, EmployerID: user.ID})
+	}
+
 	resp.Token = token
 	return resp, nil
 }
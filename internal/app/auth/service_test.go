@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	notificationMocks "github.com/harsh-jagtap-josh/RozgarLink/internal/app/notification/mocks"
 	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo/mocks"
 	"github.com/stretchr/testify/suite"
 )
@@ -15,7 +16,7 @@ type AuthServiceTestSuite struct {
 }
 
 func (suite *AuthServiceTestSuite) SetupTest() {
-	suite.authSerivce = NewService(&mocks.AuthStorer{})
+	suite.authSerivce = NewService(&mocks.AuthStorer{}, &notificationMocks.Service{})
 	suite.authRepo = mocks.AuthStorer{}
 }
 
@@ -1,25 +1,75 @@
 package app
 
 import (
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
 	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/admin"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/analytics"
 	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/application"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/attendance"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/audit"
 	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/auth"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/earnings"
 	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/employer"
 	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/job"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/kyc"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/media"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/message"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/minwage"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/notification"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/outbox"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/payment"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/pickup"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/report"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/role"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/schedule"
 	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/sector"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/skill"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/stream"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/verification"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/webhook"
 	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/worker"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/blobstore"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/notifychannel"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/paymentgateway"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/webhookclient"
 	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo"
 	"github.com/jmoiron/sqlx"
 )
 
 type Dependencies struct {
-	WorkerService      worker.Service
-	AuthService        auth.Service
-	EmployerService    employer.Service
-	JobService         job.Service
-	ApplicationService application.Service
-	SectorService      sector.Service
-	AdminService       admin.AdminService
+	WorkerService       worker.Service
+	AuthService         auth.Service
+	EmployerService     employer.Service
+	JobService          job.Service
+	ApplicationService  application.Service
+	SectorService       sector.Service
+	AdminService        admin.AdminService
+	SkillService        skill.Service
+	ScheduleService     schedule.Service
+	PaymentService      payment.Service
+	AttendanceService   attendance.Service
+	PickupService       pickup.Service
+	MessageService      message.Service
+	NotificationService notification.Service
+	OutboxService       outbox.Service
+	WebhookService      webhook.Service
+	StreamService       stream.Service
+	VerificationService verification.Service
+	KYCService          kyc.Service
+	MediaService        media.Service
+	RoleService         role.Service
+	AuditService        audit.Service
+	ReportService       report.Service
+	MinimumWageService  minwage.Service
+	AnalyticsService    analytics.Service
+	EarningsService     earnings.Service
 }
 
 func NewServices(db *sqlx.DB) Dependencies {
@@ -30,22 +80,168 @@ func NewServices(db *sqlx.DB) Dependencies {
 	ApplicationRepo := repo.NewApplicationRepo(db)
 	SectorRepo := repo.NewSectorRepo(db)
 	AdminRepo := repo.NewAdminRepo(db)
+	SkillRepo := repo.NewSkillRepo(db)
+	ScheduleRepo := repo.NewScheduleRepo(db)
+	ShiftRepo := repo.NewShiftRepo(db)
+	PaymentRepo := repo.NewPaymentRepo(db)
+	AttendanceRepo := repo.NewAttendanceRepo(db)
+	PickupRepo := repo.NewPickupRepo(db)
+	MessageRepo := repo.NewMessageRepo(db)
+	NotificationRepo := repo.NewNotificationRepo(db)
+	OutboxRepo := repo.NewOutboxRepo(db)
+	WebhookRepo := repo.NewWebhookRepo(db)
+	StreamRepo := repo.NewStreamRepo(db)
+	VerificationRepo := repo.NewVerificationRepo(db)
+	WorkerDocumentRepo := repo.NewWorkerDocumentRepo(db)
+	MediaRepo := repo.NewMediaRepo(db)
+	AdminConsoleRepo := repo.NewAdminConsoleRepo(db)
+	RoleRepo := repo.NewRoleRepo(db)
+	AuditRepo := repo.NewAuditRepo(db)
+	ReportRepo := repo.NewReportRepo(db)
+	MinimumWageRepo := repo.NewMinimumWageRepo(db)
+	WageAnalyticsRepo := repo.NewWageAnalyticsRepo(db)
+	EmployerAnalyticsRepo := repo.NewEmployerAnalyticsRepo(db)
+	WorkHistoryRepo := repo.NewWorkHistoryRepo(db)
 
-	workerService := worker.NewService(WorkerRepo)
-	authService := auth.NewService(AuthRepo)
-	employerService := employer.NewService(EmployerRepo)
-	jobService := job.NewService(JobRepo)
-	applicationService := application.NewService(ApplicationRepo)
-	sectorService := sector.NewService(SectorRepo)
-	adminService := admin.NewAdminService(AdminRepo)
+	// no SMS, push or email provider is integrated yet, those notifications are written to a local
+	// log file (or the application log when NOTIFICATION_LOG_FILE is not set)
+	devSender := notifychannel.NewDevSender(os.Getenv("NOTIFICATION_LOG_FILE"))
+	notificationService := notification.NewService(NotificationRepo, JobRepo, map[notifychannel.Channel]notifychannel.Sender{
+		notifychannel.SMS:   devSender,
+		notifychannel.Push:  devSender,
+		notifychannel.Email: devSender,
+	})
+	skillService := skill.NewService(SkillRepo)
+	// every create, update and delete done through these services is written to the audit log
+	auditService := audit.NewService(AuditRepo)
+	workerService := worker.NewAuditedService(worker.NewService(WorkerRepo, skillService), auditService)
+	authService := auth.NewService(AuthRepo, notificationService)
+	employerService := employer.NewAuditedService(employer.NewService(EmployerRepo), auditService)
+	minimumWageService := minwage.NewAuditedService(minwage.NewService(MinimumWageRepo, minimumWageEnforcement()), auditService)
+	jobService := job.NewAuditedService(job.NewService(JobRepo, ShiftRepo, skillService, minimumWageService), auditService)
+	analyticsService := analytics.NewService(WageAnalyticsRepo, EmployerAnalyticsRepo, skillService, minimumWageService)
+	earningsService := earnings.NewService(WorkHistoryRepo, WorkerRepo)
+	scheduleService := schedule.NewService(ScheduleRepo, WorkerRepo)
+	applicationService := application.NewAuditedService(application.NewService(ApplicationRepo, ShiftRepo), auditService)
+	sectorService := sector.NewAuditedService(sector.NewService(SectorRepo), auditService)
+	adminService := admin.NewAuditedService(admin.NewAdminService(AdminRepo, AdminConsoleRepo, JobRepo, RoleRepo), auditService, jobService)
+	roleService := role.NewService(RoleRepo)
+	// no real gateway is integrated yet, payments are collected through the local fake provider
+	paymentProvider := paymentgateway.NewFakeProvider(os.Getenv("PAYMENT_WEBHOOK_SECRET"))
+	paymentService := payment.NewService(PaymentRepo, WorkerRepo, EmployerRepo, paymentProvider)
+	attendanceService := attendance.NewService(AttendanceRepo, ApplicationRepo, ScheduleRepo, JobRepo)
+	pickupService := pickup.NewService(PickupRepo, JobRepo, ApplicationRepo)
+	messageService := message.NewService(MessageRepo, WorkerRepo, EmployerRepo)
+	webhookService := webhook.NewService(WebhookRepo, EmployerRepo, JobRepo, webhookclient.NewHTTPClient(10*time.Second))
+	streamService := stream.NewService(StreamRepo, JobRepo)
+	verificationService := verification.NewService(VerificationRepo, EmployerRepo)
+	blobStore := newBlobStore()
+	kycService := kyc.NewService(WorkerDocumentRepo, WorkerRepo, blobStore)
+	reportService := report.NewAuditedService(report.NewService(ReportRepo, reportHideThreshold()), auditService)
+	mediaService := media.NewService(MediaRepo, WorkerRepo, EmployerRepo, JobRepo, blobStore, newMediaURLSigner())
+
+	// side effects of state changes subscribe to the events written to the outbox, they run in
+	// the dispatcher started by OutboxService.Run
+	outboxService := outbox.NewService(OutboxRepo)
+	notificationHandler := notification.OutboxHandler(notificationService)
+	outboxService.Subscribe(outbox.JobPosted, "notifications", notificationHandler)
+	outboxService.Subscribe(outbox.JobUpdated, "notifications", notificationHandler)
+	outboxService.Subscribe(outbox.ApplicationSubmitted, "notifications", notificationHandler)
+	outboxService.Subscribe(outbox.ApplicationStatusChanged, "notifications", notificationHandler)
+	outboxService.Subscribe(outbox.ReportResolved, "notifications", notificationHandler)
+	for _, eventType := range webhook.Events {
+		outboxService.Subscribe(eventType, "webhooks", webhookService.Enqueue)
+	}
+	mediaHandler := media.OutboxHandler(mediaService)
+	outboxService.Subscribe(outbox.WorkerDeleted, "media", mediaHandler)
+	outboxService.Subscribe(outbox.EmployerDeleted, "media", mediaHandler)
+	outboxService.Subscribe(outbox.JobDeleted, "media", mediaHandler)
+	for _, eventType := range stream.Events {
+		outboxService.Subscribe(eventType, "streams", streamService.Publish)
+	}
 
 	return Dependencies{
-		WorkerService:      workerService,
-		AuthService:        authService,
-		EmployerService:    employerService,
-		JobService:         jobService,
-		ApplicationService: applicationService,
-		SectorService:      sectorService,
-		AdminService:       adminService,
+		WorkerService:       workerService,
+		AuthService:         authService,
+		EmployerService:     employerService,
+		JobService:          jobService,
+		ApplicationService:  applicationService,
+		SectorService:       sectorService,
+		AdminService:        adminService,
+		SkillService:        skillService,
+		ScheduleService:     scheduleService,
+		PaymentService:      paymentService,
+		AttendanceService:   attendanceService,
+		PickupService:       pickupService,
+		MessageService:      messageService,
+		NotificationService: notificationService,
+		OutboxService:       outboxService,
+		WebhookService:      webhookService,
+		StreamService:       streamService,
+		VerificationService: verificationService,
+		KYCService:          kycService,
+		MediaService:        mediaService,
+		RoleService:         roleService,
+		AuditService:        auditService,
+		ReportService:       reportService,
+		MinimumWageService:  minimumWageService,
+		AnalyticsService:    analyticsService,
+		EarningsService:     earningsService,
+	}
+}
+
+// newBlobStore keeps uploaded files in an S3 compatible bucket when BLOB_STORAGE is s3, and on the
+// local disk under BLOB_STORAGE_DIR (data/blobs by default) otherwise
+func newBlobStore() blobstore.BlobStore {
+	if os.Getenv("BLOB_STORAGE") == "s3" {
+		return blobstore.NewS3Store(blobstore.S3Config{
+			Endpoint:  os.Getenv("S3_ENDPOINT"),
+			Region:    os.Getenv("S3_REGION"),
+			Bucket:    os.Getenv("S3_BUCKET"),
+			AccessKey: os.Getenv("S3_ACCESS_KEY"),
+			SecretKey: os.Getenv("S3_SECRET_KEY"),
+		}, &http.Client{Timeout: 30 * time.Second})
+	}
+
+	blobStorageDir := os.Getenv("BLOB_STORAGE_DIR")
+	if blobStorageDir == "" {
+		blobStorageDir = "data/blobs"
+	}
+	return blobstore.NewLocalStore(blobStorageDir)
+}
+
+// newMediaURLSigner signs the image links served under PUBLIC_URL. Without MEDIA_URL_SECRET a
+// random secret is used, so links stop working when the server restarts.
+func newMediaURLSigner() blobstore.URLSigner {
+	publicURL := os.Getenv("PUBLIC_URL")
+	if publicURL == "" {
+		publicURL = "http://localhost:8080"
+	}
+
+	secret := os.Getenv("MEDIA_URL_SECRET")
+	if secret == "" {
+		random := make([]byte, 32)
+		rand.Read(random)
+		secret = hex.EncodeToString(random)
+	}
+	return blobstore.NewURLSigner(strings.TrimRight(publicURL, "/")+"/media/files", secret)
+}
+
+// reportHideThreshold is the number of workers and employers that have to report content before it
+// is hidden until an admin reviews it, REPORT_HIDE_THRESHOLD or 3 when it is not set
+func reportHideThreshold() int {
+	threshold, err := strconv.Atoi(os.Getenv("REPORT_HIDE_THRESHOLD"))
+	if err != nil || threshold < 1 {
+		return 3
+	}
+	return threshold
+}
+
+// minimumWageEnforcement rejects jobs paying below the minimum wage, unless MIN_WAGE_ENFORCEMENT is
+// "flag" to save them and only list them in the compliance report
+func minimumWageEnforcement() minwage.Enforcement {
+	if os.Getenv("MIN_WAGE_ENFORCEMENT") == string(minwage.Flag) {
+		return minwage.Flag
 	}
+	return minwage.Reject
 }
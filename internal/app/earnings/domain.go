@@ -0,0 +1,68 @@
+package earnings
+
+import "github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/datetime"
+
+type Format string
+
+const (
+	PDF Format = "pdf"
+	CSV Format = "csv"
+)
+
+// HistoryFilter is the period of the work history, both dates included
+type HistoryFilter struct {
+	From datetime.Date
+	To   datetime.Date
+}
+
+// Engagement is the work a worker completed for a confirmed application in a period, the amounts are
+// for the days worked and the payments recorded in the period and Pending is what is left of them
+type Engagement struct {
+	ApplicationID int           `json:"application_id"`
+	JobID         int           `json:"job_id"`
+	JobTitle      string        `json:"job_title"`
+	EmployerID    int           `json:"employer_id"`
+	EmployerName  string        `json:"employer_name"`
+	FirstDay      datetime.Date `json:"first_day"`
+	LastDay       datetime.Date `json:"last_day"`
+	DaysWorked    int           `json:"days_worked"`
+	HoursWorked   float64       `json:"hours_worked"`
+	Wage          int           `json:"wage"`
+	Deducted      int           `json:"deducted"`
+	Paid          int           `json:"paid"`
+	Pending       int           `json:"pending"`
+}
+
+type EmployerSummary struct {
+	EmployerID  int     `json:"employer_id"`
+	Name        string  `json:"name"`
+	Rating      float64 `json:"rating"`
+	Engagements int     `json:"engagements"`
+	DaysWorked  int     `json:"days_worked"`
+	HoursWorked float64 `json:"hours_worked"`
+	Paid        int     `json:"paid"`
+}
+
+type WorkHistory struct {
+	WorkerID     int               `json:"worker_id"`
+	WorkerName   string            `json:"worker_name"`
+	WorkerRating float64           `json:"worker_rating"`
+	From         datetime.Date     `json:"from"`
+	To           datetime.Date     `json:"to"`
+	Engagements  int               `json:"engagements"`
+	DaysWorked   int               `json:"days_worked"`
+	HoursWorked  float64           `json:"hours_worked"`
+	Wage         int               `json:"wage"`
+	Deducted     int               `json:"deducted"`
+	Paid         int               `json:"paid"`
+	Pending      int               `json:"pending"`
+	Employers    []EmployerSummary `json:"employers"`
+	History      []Engagement      `json:"history"`
+}
+
+// Statement is a work history rendered as a file to download
+type Statement struct {
+	Name        string
+	ContentType string
+	Content     []byte
+}
@@ -0,0 +1,96 @@
+package earnings
+
+import (
+	"context"
+	"errors"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/datetime"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/logger"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/middleware"
+	"go.uber.org/zap"
+)
+
+func FetchWorkHistory(earningsService Service) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		workerId, id := isPathIdValid(ctx, w, r, "worker_id", apperrors.MsgInvalidWorkerId, apperrors.ErrFetchWorkHistory)
+		if workerId == -1 {
+			return
+		}
+
+		history, err := earningsService.FetchWorkHistory(ctx, workerId, historyFilter(r))
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrFetchWorkHistory.Error(), zap.Error(err), zap.String("ID", id))
+			middleware.HandleErrorResponse(ctx, w, apperrors.HttpErrorResponseMessage(apperrors.ErrFetchWorkHistory.Error(), err.Error(), id), earningsErrorStatusCode(err))
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, "work history retrieved successfully", http.StatusOK, history)
+	}
+}
+
+// DownloadStatement sends the statement as a file, a PDF unless the format query is csv
+func DownloadStatement(earningsService Service) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		workerId, id := isPathIdValid(ctx, w, r, "worker_id", apperrors.MsgInvalidWorkerId, apperrors.ErrGenerateStatement)
+		if workerId == -1 {
+			return
+		}
+
+		format := Format(r.URL.Query().Get("format"))
+		statement, err := earningsService.GenerateStatement(ctx, workerId, historyFilter(r), format)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrGenerateStatement.Error(), zap.Error(err), zap.String("ID", id), zap.String("format", string(format)))
+			middleware.HandleErrorResponse(ctx, w, apperrors.HttpErrorResponseMessage(apperrors.ErrGenerateStatement.Error(), err.Error(), id), earningsErrorStatusCode(err))
+			return
+		}
+
+		w.Header().Set("Content-Type", statement.ContentType)
+		w.Header().Set("Content-Length", strconv.Itoa(len(statement.Content)))
+		w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": statement.Name}))
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+		w.Header().Set("Cache-Control", "no-store")
+		w.WriteHeader(http.StatusOK)
+
+		_, err = w.Write(statement.Content)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrGenerateStatement.Error(), zap.Error(err), zap.String("ID", id))
+		}
+	}
+}
+
+func historyFilter(r *http.Request) HistoryFilter {
+	query := r.URL.Query()
+	return HistoryFilter{From: datetime.Date(query.Get("from")), To: datetime.Date(query.Get("to"))}
+}
+
+func isPathIdValid(ctx context.Context, w http.ResponseWriter, r *http.Request, key string, invalidMsg string, errType error) (int, string) {
+	vars := mux.Vars(r)
+	id := vars[key]
+	pathId, err := strconv.Atoi(id)
+	if err != nil {
+		logger.Errorw(ctx, invalidMsg, zap.Error(err), zap.String("ID", id))
+		httpResponseMsg := apperrors.HttpErrorResponseMessage(errType.Error(), invalidMsg, id)
+		middleware.HandleErrorResponse(ctx, w, httpResponseMsg, http.StatusBadRequest)
+		return -1, id
+	}
+	return pathId, id
+}
+
+func earningsErrorStatusCode(err error) int {
+	switch {
+	case errors.Is(err, apperrors.ErrInvalidHistoryFilter), errors.Is(err, apperrors.ErrInvalidStatementFormat):
+		return http.StatusBadRequest
+	case errors.Is(err, apperrors.ErrNoWorkerExists):
+		return http.StatusNotFound
+	}
+	return http.StatusInternalServerError
+}
@@ -0,0 +1,102 @@
+package earnings
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/datetime"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo"
+)
+
+// defaultHistoryDays is the period of the work history when no dates are given
+const defaultHistoryDays = 365
+
+// normalizeHistoryFilter defaults to the work of the last year
+func normalizeHistoryFilter(filter HistoryFilter) (HistoryFilter, error) {
+	var err error
+	if filter.To.IsZero() {
+		filter.To = datetime.Today()
+	}
+	if filter.From.IsZero() {
+		filter.From, err = filter.To.AddDays(-defaultHistoryDays)
+		if err != nil {
+			return HistoryFilter{}, fmt.Errorf("%w: %w", apperrors.ErrInvalidHistoryFilter, err)
+		}
+	}
+
+	from, err := filter.From.Time()
+	if err != nil {
+		return HistoryFilter{}, fmt.Errorf("%w: %w", apperrors.ErrInvalidHistoryFilter, err)
+	}
+	to, err := filter.To.Time()
+	if err != nil {
+		return HistoryFilter{}, fmt.Errorf("%w: %w", apperrors.ErrInvalidHistoryFilter, err)
+	}
+	if from.After(to) {
+		return HistoryFilter{}, apperrors.ErrInvalidHistoryFilter
+	}
+	return filter, nil
+}
+
+func MapWorkHistoryEntryRepoToService(entry repo.WorkHistoryEntry) Engagement {
+	return Engagement{
+		ApplicationID: entry.ApplicationID,
+		JobID:         entry.JobID,
+		JobTitle:      entry.JobTitle,
+		EmployerID:    entry.EmployerID,
+		EmployerName:  entry.EmployerName,
+		FirstDay:      entry.FirstDay,
+		LastDay:       entry.LastDay,
+		DaysWorked:    entry.DaysWorked,
+		HoursWorked:   hours(entry.MinutesWorked),
+		Wage:          entry.Owed,
+		Deducted:      entry.Deducted,
+		Paid:          entry.Paid,
+		Pending:       max(entry.Owed-entry.Deducted-entry.Paid, 0),
+	}
+}
+
+// summarize totals the engagements of a worker and groups them by employer, in the order the
+// employers were first worked for
+func summarize(history WorkHistory, entries []repo.WorkHistoryEntry) WorkHistory {
+	history.Employers = []EmployerSummary{}
+	history.History = make([]Engagement, 0, len(entries))
+	employerIndex := map[int]int{}
+	minutes := 0
+
+	for _, entry := range entries {
+		engagement := MapWorkHistoryEntryRepoToService(entry)
+		history.History = append(history.History, engagement)
+
+		history.Engagements++
+		history.DaysWorked += engagement.DaysWorked
+		minutes += entry.MinutesWorked
+		history.Wage += engagement.Wage
+		history.Deducted += engagement.Deducted
+		history.Paid += engagement.Paid
+		history.Pending += engagement.Pending
+
+		index, ok := employerIndex[entry.EmployerID]
+		if !ok {
+			index = len(history.Employers)
+			employerIndex[entry.EmployerID] = index
+			history.Employers = append(history.Employers, EmployerSummary{EmployerID: entry.EmployerID, Name: entry.EmployerName, Rating: entry.EmployerRating})
+		}
+		history.Employers[index].Engagements++
+		history.Employers[index].DaysWorked += engagement.DaysWorked
+		history.Employers[index].HoursWorked = roundHours(history.Employers[index].HoursWorked + engagement.HoursWorked)
+		history.Employers[index].Paid += engagement.Paid
+	}
+
+	history.HoursWorked = hours(minutes)
+	return history
+}
+
+func hours(minutes int) float64 {
+	return roundHours(float64(minutes) / 60)
+}
+
+func roundHours(hours float64) float64 {
+	return math.Round(hours*100) / 100
+}
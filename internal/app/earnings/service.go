@@ -0,0 +1,82 @@
+package earnings
+
+import (
+	"context"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/datetime"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo"
+)
+
+type earningsService struct {
+	workHistoryRepo repo.WorkHistoryStorer
+	workerRepo      repo.WorkerStorer
+}
+
+type Service interface {
+	FetchWorkHistory(ctx context.Context, workerId int, filter HistoryFilter) (WorkHistory, error)
+	GenerateStatement(ctx context.Context, workerId int, filter HistoryFilter, format Format) (Statement, error)
+}
+
+func NewService(workHistoryRepo repo.WorkHistoryStorer, workerRepo repo.WorkerStorer) Service {
+	return &earningsService{
+		workHistoryRepo: workHistoryRepo,
+		workerRepo:      workerRepo,
+	}
+}
+
+// FetchWorkHistory summarizes the work a worker completed in the period, the hours, the wages paid
+// and pending and the employers worked for
+func (earningsS *earningsService) FetchWorkHistory(ctx context.Context, workerId int, filter HistoryFilter) (WorkHistory, error) {
+	filter, err := normalizeHistoryFilter(filter)
+	if err != nil {
+		return WorkHistory{}, err
+	}
+
+	worker, err := earningsS.workerRepo.FetchWorkerByID(ctx, workerId)
+	if err != nil {
+		return WorkHistory{}, err
+	}
+
+	entries, err := earningsS.workHistoryRepo.FetchWorkHistory(ctx, workerId, filter.From, filter.To)
+	if err != nil {
+		return WorkHistory{}, err
+	}
+
+	return summarize(WorkHistory{
+		WorkerID:     worker.ID,
+		WorkerName:   worker.Name,
+		WorkerRating: worker.Rating,
+		From:         filter.From,
+		To:           filter.To,
+	}, entries), nil
+}
+
+// GenerateStatement renders the work history of the period as a PDF or CSV file
+func (earningsS *earningsService) GenerateStatement(ctx context.Context, workerId int, filter HistoryFilter, format Format) (Statement, error) {
+	if format == "" {
+		format = PDF
+	}
+	if format != PDF && format != CSV {
+		return Statement{}, apperrors.ErrInvalidStatementFormat
+	}
+
+	history, err := earningsS.FetchWorkHistory(ctx, workerId, filter)
+	if err != nil {
+		return Statement{}, err
+	}
+
+	statement := Statement{Name: statementName(history, format)}
+	if format == CSV {
+		statement.ContentType = "text/csv; charset=utf-8"
+		statement.Content, err = renderCSV(history)
+		if err != nil {
+			return Statement{}, err
+		}
+		return statement, nil
+	}
+
+	statement.ContentType = "application/pdf"
+	statement.Content = renderPDF(history, datetime.Today())
+	return statement, nil
+}
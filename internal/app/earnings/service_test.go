@@ -0,0 +1,244 @@
+package earnings
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/datetime"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type EarningsServiceTestSuite struct {
+	suite.Suite
+	service         Service
+	workHistoryRepo mocks.WorkHistoryStorer
+	workerRepo      mocks.WorkerStorer
+}
+
+func (suite *EarningsServiceTestSuite) SetupTest() {
+	suite.workHistoryRepo = mocks.WorkHistoryStorer{}
+	suite.workerRepo = mocks.WorkerStorer{}
+	suite.service = NewService(&suite.workHistoryRepo, &suite.workerRepo)
+}
+
+func (suite *EarningsServiceTestSuite) TearDownTest() {
+	suite.workHistoryRepo.AssertExpectations(suite.T())
+	suite.workerRepo.AssertExpectations(suite.T())
+}
+
+func TestEarningsServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(EarningsServiceTestSuite))
+}
+
+var historyEntries = []repo.WorkHistoryEntry{
+	{
+		Dues:           repo.Dues{ApplicationID: 11, JobID: 4, JobTitle: "Mason", WorkerID: 5, EmployerID: 9, Status: "confirmed", Owed: 3000, Paid: 2000, Deducted: 200},
+		EmployerName:   "Patil Builders",
+		EmployerRating: 4.5,
+		FirstDay:       "2026-02-02",
+		LastDay:        "2026-02-06",
+		DaysWorked:     5,
+		MinutesWorked:  2400,
+	},
+	{
+		Dues:           repo.Dues{ApplicationID: 14, JobID: 7, JobTitle: "Helper", WorkerID: 5, EmployerID: 12, Status: "confirmed", Owed: 500, Paid: 500},
+		EmployerName:   "Shinde Farms",
+		EmployerRating: 3.9,
+		FirstDay:       "2026-03-10",
+		LastDay:        "2026-03-10",
+		DaysWorked:     1,
+		MinutesWorked:  250,
+	},
+	{
+		Dues:           repo.Dues{ApplicationID: 19, JobID: 8, JobTitle: "Plasterer", WorkerID: 5, EmployerID: 9, Status: "confirmed", Owed: 1200, Paid: 0},
+		EmployerName:   "Patil Builders",
+		EmployerRating: 4.5,
+		FirstDay:       "2026-03-20",
+		LastDay:        "2026-03-21",
+		DaysWorked:     2,
+		MinutesWorked:  960,
+	},
+}
+
+func (suite *EarningsServiceTestSuite) TestFetchWorkHistory() {
+	type testCase struct {
+		name           string
+		input          HistoryFilter
+		setup          func()
+		expectedOutput WorkHistory
+		expectedError  error
+	}
+
+	testCases := []testCase{
+		{
+			name:  "success",
+			input: HistoryFilter{From: "2026-01-01", To: "2026-03-31"},
+			setup: func() {
+				suite.workerRepo.On("FetchWorkerByID", mock.Anything, 5).Return(repo.Worker{ID: 5, Name: "Ramesh Pawar", Rating: 4.2}, nil)
+				suite.workHistoryRepo.On("FetchWorkHistory", mock.Anything, 5, datetime.Date("2026-01-01"), datetime.Date("2026-03-31")).Return(historyEntries, nil)
+			},
+			expectedOutput: WorkHistory{
+				WorkerID:     5,
+				WorkerName:   "Ramesh Pawar",
+				WorkerRating: 4.2,
+				From:         "2026-01-01",
+				To:           "2026-03-31",
+				Engagements:  3,
+				DaysWorked:   8,
+				HoursWorked:  60.17,
+				Wage:         4700,
+				Deducted:     200,
+				Paid:         2500,
+				Pending:      2000,
+				Employers: []EmployerSummary{
+					{EmployerID: 9, Name: "Patil Builders", Rating: 4.5, Engagements: 2, DaysWorked: 7, HoursWorked: 56, Paid: 2000},
+					{EmployerID: 12, Name: "Shinde Farms", Rating: 3.9, Engagements: 1, DaysWorked: 1, HoursWorked: 4.17, Paid: 500},
+				},
+				History: []Engagement{
+					{ApplicationID: 11, JobID: 4, JobTitle: "Mason", EmployerID: 9, EmployerName: "Patil Builders", FirstDay: "2026-02-02", LastDay: "2026-02-06", DaysWorked: 5, HoursWorked: 40, Wage: 3000, Deducted: 200, Paid: 2000, Pending: 800},
+					{ApplicationID: 14, JobID: 7, JobTitle: "Helper", EmployerID: 12, EmployerName: "Shinde Farms", FirstDay: "2026-03-10", LastDay: "2026-03-10", DaysWorked: 1, HoursWorked: 4.17, Wage: 500, Paid: 500},
+					{ApplicationID: 19, JobID: 8, JobTitle: "Plasterer", EmployerID: 9, EmployerName: "Patil Builders", FirstDay: "2026-03-20", LastDay: "2026-03-21", DaysWorked: 2, HoursWorked: 16, Wage: 1200, Pending: 1200},
+				},
+			},
+		},
+		{
+			name:  "engagement running past the end of the period",
+			input: HistoryFilter{From: "2026-03-01", To: "2026-03-31"},
+			setup: func() {
+				suite.workerRepo.On("FetchWorkerByID", mock.Anything, 5).Return(repo.Worker{ID: 5, Name: "Ramesh Pawar", Rating: 4.2}, nil)
+				// the job runs from 2026-03-30 to 2026-04-03, only the two days and the advance of March are returned
+				suite.workHistoryRepo.On("FetchWorkHistory", mock.Anything, 5, datetime.Date("2026-03-01"), datetime.Date("2026-03-31")).Return([]repo.WorkHistoryEntry{
+					{
+						Dues:           repo.Dues{ApplicationID: 21, JobID: 9, JobTitle: "Painter", WorkerID: 5, EmployerID: 9, Status: "confirmed", Owed: 1400, Paid: 500},
+						EmployerName:   "Patil Builders",
+						EmployerRating: 4.5,
+						FirstDay:       "2026-03-30",
+						LastDay:        "2026-03-31",
+						DaysWorked:     2,
+						MinutesWorked:  960,
+					},
+				}, nil)
+			},
+			expectedOutput: WorkHistory{
+				WorkerID:     5,
+				WorkerName:   "Ramesh Pawar",
+				WorkerRating: 4.2,
+				From:         "2026-03-01",
+				To:           "2026-03-31",
+				Engagements:  1,
+				DaysWorked:   2,
+				HoursWorked:  16,
+				Wage:         1400,
+				Paid:         500,
+				Pending:      900,
+				Employers: []EmployerSummary{
+					{EmployerID: 9, Name: "Patil Builders", Rating: 4.5, Engagements: 1, DaysWorked: 2, HoursWorked: 16, Paid: 500},
+				},
+				History: []Engagement{
+					{ApplicationID: 21, JobID: 9, JobTitle: "Painter", EmployerID: 9, EmployerName: "Patil Builders", FirstDay: "2026-03-30", LastDay: "2026-03-31", DaysWorked: 2, HoursWorked: 16, Wage: 1400, Paid: 500, Pending: 900},
+				},
+			},
+		},
+		{
+			name:  "worker does not exist",
+			input: HistoryFilter{From: "2026-01-01", To: "2026-03-31"},
+			setup: func() {
+				suite.workerRepo.On("FetchWorkerByID", mock.Anything, 5).Return(repo.Worker{}, apperrors.ErrNoWorkerExists)
+			},
+			expectedError: apperrors.ErrNoWorkerExists,
+		},
+		{
+			name:          "from after to",
+			input:         HistoryFilter{From: "2026-04-01", To: "2026-03-31"},
+			setup:         func() {},
+			expectedError: apperrors.ErrInvalidHistoryFilter,
+		},
+		{
+			name:          "invalid date",
+			input:         HistoryFilter{From: "01-01-2026"},
+			setup:         func() {},
+			expectedError: apperrors.ErrInvalidHistoryFilter,
+		},
+	}
+
+	for _, test := range testCases {
+		suite.SetupTest()
+		suite.Run(test.name, func() {
+			test.setup()
+
+			history, err := suite.service.FetchWorkHistory(context.Background(), 5, test.input)
+
+			suite.ErrorIs(err, test.expectedError)
+			suite.Equal(test.expectedOutput, history)
+		})
+		suite.TearDownTest()
+	}
+}
+
+func (suite *EarningsServiceTestSuite) TestGenerateStatement() {
+	type testCase struct {
+		name                string
+		format              Format
+		setup               func()
+		expectedName        string
+		expectedContentType string
+		expectedContent     []string
+		expectedError       error
+	}
+
+	fetchHistory := func() {
+		suite.workerRepo.On("FetchWorkerByID", mock.Anything, 5).Return(repo.Worker{ID: 5, Name: "Ramesh Pawar", Rating: 4.2}, nil)
+		suite.workHistoryRepo.On("FetchWorkHistory", mock.Anything, 5, datetime.Date("2026-01-01"), datetime.Date("2026-03-31")).Return(historyEntries, nil)
+	}
+
+	testCases := []testCase{
+		{
+			name:                "pdf by default",
+			setup:               fetchHistory,
+			expectedName:        "earnings-statement-5-2026-01-01-to-2026-03-31.pdf",
+			expectedContentType: "application/pdf",
+			expectedContent:     []string{"%PDF-1.4", "(Worker       : Ramesh Pawar \\(ID 5\\)) Tj", "(Pending \\(Rs.\\)         : 2000) Tj", "Patil Builders"},
+		},
+		{
+			name:                "csv",
+			format:              CSV,
+			setup:               fetchHistory,
+			expectedName:        "earnings-statement-5-2026-01-01-to-2026-03-31.csv",
+			expectedContentType: "text/csv; charset=utf-8",
+			expectedContent: []string{
+				"first_day,last_day,application_id,job_id,job_title,employer_id,employer_name,days_worked,hours_worked,wage,deducted,paid,pending\n",
+				"2026-02-02,2026-02-06,11,4,Mason,9,Patil Builders,5,40.00,3000,200,2000,800\n",
+				"2026-01-01,2026-03-31,,,total,,,8,60.17,4700,200,2500,2000\n",
+			},
+		},
+		{
+			name:          "unknown format",
+			format:        "xlsx",
+			setup:         func() {},
+			expectedError: apperrors.ErrInvalidStatementFormat,
+		},
+	}
+
+	for _, test := range testCases {
+		suite.SetupTest()
+		suite.Run(test.name, func() {
+			test.setup()
+
+			statement, err := suite.service.GenerateStatement(context.Background(), 5, HistoryFilter{From: "2026-01-01", To: "2026-03-31"}, test.format)
+
+			suite.ErrorIs(err, test.expectedError)
+			suite.Equal(test.expectedName, statement.Name)
+			suite.Equal(test.expectedContentType, statement.ContentType)
+			for _, content := range test.expectedContent {
+				suite.True(bytes.Contains(statement.Content, []byte(content)), "expected %q in %s", content, strings.SplitN(string(statement.Content), "\n", 2)[0])
+			}
+		})
+		suite.TearDownTest()
+	}
+}
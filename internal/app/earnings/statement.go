@@ -0,0 +1,119 @@
+package earnings
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/datetime"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/pdf"
+)
+
+var csvHeader = []string{"first_day", "last_day", "application_id", "job_id", "job_title", "employer_id", "employer_name", "days_worked", "hours_worked", "wage", "deducted", "paid", "pending"}
+
+func statementName(history WorkHistory, format Format) string {
+	return fmt.Sprintf("earnings-statement-%d-%s-to-%s.%s", history.WorkerID, history.From, history.To, format)
+}
+
+// renderCSV writes one row for each engagement, then a total row
+func renderCSV(history WorkHistory) ([]byte, error) {
+	var out bytes.Buffer
+	writer := csv.NewWriter(&out)
+
+	rows := [][]string{csvHeader}
+	for _, engagement := range history.History {
+		rows = append(rows, []string{
+			string(engagement.FirstDay),
+			string(engagement.LastDay),
+			strconv.Itoa(engagement.ApplicationID),
+			strconv.Itoa(engagement.JobID),
+			csvText(engagement.JobTitle),
+			strconv.Itoa(engagement.EmployerID),
+			csvText(engagement.EmployerName),
+			strconv.Itoa(engagement.DaysWorked),
+			strconv.FormatFloat(engagement.HoursWorked, 'f', 2, 64),
+			strconv.Itoa(engagement.Wage),
+			strconv.Itoa(engagement.Deducted),
+			strconv.Itoa(engagement.Paid),
+			strconv.Itoa(engagement.Pending),
+		})
+	}
+	rows = append(rows, []string{
+		string(history.From), string(history.To), "", "", "total", "", "",
+		strconv.Itoa(history.DaysWorked),
+		strconv.FormatFloat(history.HoursWorked, 'f', 2, 64),
+		strconv.Itoa(history.Wage),
+		strconv.Itoa(history.Deducted),
+		strconv.Itoa(history.Paid),
+		strconv.Itoa(history.Pending),
+	})
+
+	err := writer.WriteAll(rows)
+	if err != nil {
+		return nil, err
+	}
+	return out.Bytes(), nil
+}
+
+// csvText keeps text typed by employers from being read as a formula by spreadsheets, by starting
+// cells that begin with a formula character with a quote
+func csvText(text string) string {
+	if text != "" && strings.ContainsRune("=+-@\t\r", rune(text[0])) {
+		return "'" + text
+	}
+	return text
+}
+
+// renderPDF lays the statement out as a summary, the employers worked for and every engagement
+func renderPDF(history WorkHistory, generatedOn datetime.Date) []byte {
+	doc := pdf.New()
+
+	doc.Bold("RozgarLink - Earnings and Work History Statement")
+	doc.Blank()
+	doc.Line(fmt.Sprintf("Worker       : %s (ID %d)", history.WorkerName, history.WorkerID))
+	doc.Line(fmt.Sprintf("Rating       : %.1f", history.WorkerRating))
+	doc.Line(fmt.Sprintf("Period       : %s to %s", history.From, history.To))
+	doc.Line(fmt.Sprintf("Generated on : %s", generatedOn))
+	doc.Blank()
+
+	doc.Bold("Summary")
+	doc.Line(fmt.Sprintf("Engagements completed : %d", history.Engagements))
+	doc.Line(fmt.Sprintf("Days worked           : %d", history.DaysWorked))
+	doc.Line(fmt.Sprintf("Hours worked          : %.2f", history.HoursWorked))
+	doc.Line(fmt.Sprintf("Wages earned (Rs.)    : %d", history.Wage))
+	doc.Line(fmt.Sprintf("Deductions (Rs.)      : %d", history.Deducted))
+	doc.Line(fmt.Sprintf("Paid (Rs.)            : %d", history.Paid))
+	doc.Line(fmt.Sprintf("Pending (Rs.)         : %d", history.Pending))
+	doc.Blank()
+
+	doc.Bold("Employers")
+	doc.Bold(fmt.Sprintf("%-36s %6s %11s %5s %8s %10s", "Employer", "Rating", "Engagements", "Days", "Hours", "Paid"))
+	for _, employer := range history.Employers {
+		doc.Line(fmt.Sprintf("%-36s %6.1f %11d %5d %8.2f %10d", cut(employer.Name, 36), employer.Rating, employer.Engagements, employer.DaysWorked, employer.HoursWorked, employer.Paid))
+	}
+	doc.Blank()
+
+	doc.Bold("Engagements")
+	doc.Bold(fmt.Sprintf("%-10s %-10s %-20s %-16s %4s %7s %7s %7s", "From", "To", "Job", "Employer", "Days", "Wage", "Paid", "Pending"))
+	for _, engagement := range history.History {
+		doc.Line(fmt.Sprintf("%-10s %-10s %-20s %-16s %4d %7d %7d %7d", engagement.FirstDay, engagement.LastDay, cut(engagement.JobTitle, 20), cut(engagement.EmployerName, 16), engagement.DaysWorked, engagement.Wage, engagement.Paid, engagement.Pending))
+	}
+	if len(history.History) == 0 {
+		doc.Line("No work was completed in this period.")
+	}
+	doc.Blank()
+	doc.Line("Amounts are for the whole engagement. Days are counted when the worker checked out.")
+
+	return doc.Bytes()
+}
+
+// cut shortens text to fit a column of the statement
+func cut(text string, width int) string {
+	runes := []rune(text)
+	if len(runes) <= width {
+		return text
+	}
+	return string(runes[:width-1]) + "~"
+}
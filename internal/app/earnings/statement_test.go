@@ -0,0 +1,29 @@
+package earnings
+
+import "testing"
+
+func TestCSVText(t *testing.T) {
+	type testCase struct {
+		name           string
+		input          string
+		expectedOutput string
+	}
+
+	testCases := []testCase{
+		{name: "plain text", input: "Patil Builders", expectedOutput: "Patil Builders"},
+		{name: "empty", input: "", expectedOutput: ""},
+		{name: "formula", input: "=HYPERLINK(\"http://example.com\")", expectedOutput: "'=HYPERLINK(\"http://example.com\")"},
+		{name: "plus", input: "+91 Traders", expectedOutput: "'+91 Traders"},
+		{name: "minus", input: "-2+3", expectedOutput: "'-2+3"},
+		{name: "at", input: "@SUM(A1)", expectedOutput: "'@SUM(A1)"},
+		{name: "tab", input: "\t=1", expectedOutput: "'\t=1"},
+		{name: "formula character later in the text", input: "Mason = Helper", expectedOutput: "Mason = Helper"},
+	}
+
+	for _, test := range testCases {
+		output := csvText(test.input)
+		if output != test.expectedOutput {
+			t.Errorf("%s: expected %q, got %q", test.name, test.expectedOutput, output)
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package employer
+
+import (
+	"context"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/audit"
+)
+
+// auditedService records every employer that is registered, updated or deleted in the audit log
+type auditedService struct {
+	Service
+	recorder audit.Recorder[Employer]
+}
+
+func NewAuditedService(employerService Service, auditService audit.Service) Service {
+	return &auditedService{
+		Service: employerService,
+		recorder: audit.Recorder[Employer]{
+			Service: auditService,
+			Type:    audit.Employer,
+			ID:      func(employer Employer) int { return employer.ID },
+			Fetch:   employerService.FetchEmployerByID,
+		},
+	}
+}
+
+func (auditedS *auditedService) RegisterEmployer(ctx context.Context, employerData Employer) (Employer, error) {
+	return auditedS.recorder.Create(ctx, func() (Employer, error) {
+		return auditedS.Service.RegisterEmployer(ctx, employerData)
+	})
+}
+
+func (auditedS *auditedService) UpdateEmployerById(ctx context.Context, employerData Employer) (Employer, error) {
+	return auditedS.recorder.Update(ctx, employerData.ID, func() (Employer, error) {
+		return auditedS.Service.UpdateEmployerById(ctx, employerData)
+	})
+}
+
+func (auditedS *auditedService) DeleteEmployerById(ctx context.Context, employerId int) (int, error) {
+	return auditedS.recorder.Delete(ctx, employerId, func() (int, error) {
+		return auditedS.Service.DeleteEmployerById(ctx, employerId)
+	})
+}
@@ -212,6 +212,44 @@ func (suite *EmployerServiceTestSuite) TestUpdateEmployerById() {
 			},
 			expectedError: nil,
 		},
+		{
+			name: "verified badge is kept from the stored employer",
+			employerData: Employer{
+				ID:         1,
+				Name:       "John Doe",
+				ContactNo:  "9067691363",
+				Email:      "employer@gmail.com",
+				Type:       "Employer",
+				Sectors:    "IT",
+				Location:   Address{ID: 1},
+				IsVerified: false,
+			},
+			setup: func() {
+				suite.employerRepo.On("UpdateEmployerById", mock.Anything, mock.MatchedBy(func(employer repo.Employer) bool {
+					return employer.ID == 1 && !employer.IsVerified
+				})).Return(repo.Employer{
+					ID:         1,
+					Name:       "John Doe",
+					ContactNo:  "9067691363",
+					Email:      "employer@gmail.com",
+					Type:       "Employer",
+					Sectors:    "IT",
+					Location:   1,
+					IsVerified: true,
+				}, nil)
+			},
+			expectedOutput: Employer{
+				ID:         1,
+				Name:       "John Doe",
+				ContactNo:  "9067691363",
+				Email:      "employer@gmail.com",
+				Type:       "Employer",
+				Sectors:    "IT",
+				Location:   Address{ID: 1},
+				IsVerified: true,
+			},
+			expectedError: nil,
+		},
 		{
 			name: "db error",
 			employerData: Employer{
@@ -0,0 +1,43 @@
+package job
+
+import (
+	"context"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/audit"
+)
+
+// auditedService records every job that is created, updated or deleted in the audit log
+type auditedService struct {
+	Service
+	recorder audit.Recorder[Job]
+}
+
+func NewAuditedService(jobService Service, auditService audit.Service) Service {
+	return &auditedService{
+		Service: jobService,
+		recorder: audit.Recorder[Job]{
+			Service: auditService,
+			Type:    audit.Job,
+			ID:      func(job Job) int { return job.ID },
+			Fetch:   jobService.FetchJobByID,
+		},
+	}
+}
+
+func (auditedS *auditedService) CreateJob(ctx context.Context, jobData Job) (Job, error) {
+	return auditedS.recorder.Create(ctx, func() (Job, error) {
+		return auditedS.Service.CreateJob(ctx, jobData)
+	})
+}
+
+func (auditedS *auditedService) UpdateJobByID(ctx context.Context, jobData Job) (Job, error) {
+	return auditedS.recorder.Update(ctx, jobData.ID, func() (Job, error) {
+		return auditedS.Service.UpdateJobByID(ctx, jobData)
+	})
+}
+
+func (auditedS *auditedService) DeleteJobByID(ctx context.Context, jobId int) (int, error) {
+	return auditedS.recorder.Delete(ctx, jobId, func() (int, error) {
+		return auditedS.Service.DeleteJobByID(ctx, jobId)
+	})
+}
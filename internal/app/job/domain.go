@@ -3,9 +3,29 @@ package job
 import (
 	"time"
 
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/minwage"
 	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/worker"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/datetime"
 )
 
+type Recurrence string
+
+const (
+	Daily    Recurrence = "daily"
+	Weekdays Recurrence = "weekdays"
+	Weekly   Recurrence = "weekly"
+)
+
+// Shift is one day of work of a job, a single day job has exactly one shift
+type Shift struct {
+	ID        int            `json:"id"`
+	JobID     int            `json:"job_id"`
+	Date      datetime.Date  `json:"date"`
+	StartHour datetime.Clock `json:"start_hour"`
+	EndHour   datetime.Clock `json:"end_hour"`
+	Wage      int            `json:"wage"`
+}
+
 type Job struct {
 	ID              int            `json:"id"`
 	EmployerID      int            `json:"employer_id"`
@@ -18,11 +38,22 @@ type Job struct {
 	Wage            int            `json:"wage"`
 	Vacancy         int            `json:"vacancy"`
 	Location        worker.Address `json:"location,omitempty"`
-	Date            string         `json:"date"`
-	StartHour       string         `json:"start_hour"`
-	EndHour         string         `json:"end_hour"`
+	Date            datetime.Date  `json:"date"`
+	EndDate         datetime.Date  `json:"end_date,omitempty"`
+	Recurrence      Recurrence     `json:"recurrence,omitempty"`
+	RecurrenceDays  string         `json:"recurrence_days,omitempty"`
+	StartHour       datetime.Clock `json:"start_hour"`
+	EndHour         datetime.Clock `json:"end_hour"`
 	CreatedAt       time.Time      `json:"created_at"`
 	UpdatedAt       time.Time      `json:"updated_at"`
+	Shifts          []Shift        `json:"shifts,omitempty"`
+	TotalWage       int            `json:"total_wage,omitempty"`
+	// EmployerVerified is the verified badge of the employer, set when listing or fetching jobs
+	EmployerVerified bool `json:"employer_verified"`
+	// SkillLevel decides the minimum wage the job is checked against, unskilled when not given
+	SkillLevel minwage.SkillLevel `json:"skill_level,omitempty"`
+	// WageCompliance is set on a created or updated job that has a minimum wage to meet
+	WageCompliance *minwage.Compliance `json:"wage_compliance,omitempty"`
 }
 
 type JobFilters struct {
@@ -30,8 +61,10 @@ type JobFilters struct {
 	Sector    string
 	WageMin   int
 	WageMax   int
-	StartDate time.Time
-	EndDate   time.Time
+	StartDate datetime.Date
+	EndDate   datetime.Date
 	City      string
 	Gender    string
+	// VerifiedOnly keeps the jobs of verified employers
+	VerifiedOnly bool
 }
@@ -30,7 +30,7 @@ func CreateJob(js Service) func(w http.ResponseWriter, r *http.Request) {
 		createdJob, err := js.CreateJob(ctx, jobData)
 		if err != nil {
 			logger.Errorw(ctx, apperrors.ErrCreateJob.Error(), zap.Error(err))
-			middleware.HandleErrorResponse(ctx, w, apperrors.ErrCreateJob.Error()+": "+err.Error(), http.StatusInternalServerError)
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrCreateJob.Error()+": "+err.Error(), jobErrorStatusCode(err))
 			return
 		}
 
@@ -55,10 +55,11 @@ func UpdateJobById(js Service) func(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
+		jobData.ID = jobId
 		updatedJob, err := js.UpdateJobByID(ctx, jobData)
 		if err != nil {
 			logger.Errorw(ctx, apperrors.ErrUpdateJob.Error(), zap.Error(err))
-			middleware.HandleErrorResponse(ctx, w, apperrors.ErrUpdateJob.Error()+": "+err.Error(), http.StatusInternalServerError)
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrUpdateJob.Error()+": "+err.Error(), jobErrorStatusCode(err))
 			return
 		}
 
@@ -152,7 +153,12 @@ func FetchAllJobs(jobService Service) func(w http.ResponseWriter, r *http.Reques
 		ctx := r.Context()
 		queryParams := r.URL.Query()
 
-		jobFilters := retrieveQueryParams(queryParams)
+		jobFilters, err := retrieveQueryParams(queryParams)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrFetchJobs.Error(), zap.Error(err))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrFetchJobs.Error()+", "+err.Error(), http.StatusBadRequest)
+			return
+		}
 
 		jobs, err := jobService.FetchAllJobs(ctx, jobFilters)
 		if err != nil {
@@ -178,3 +184,14 @@ func isJobIdValid(ctx context.Context, w http.ResponseWriter, r *http.Request, e
 	return jobId, id
 
 }
+
+func jobErrorStatusCode(err error) int {
+	switch {
+	case errors.Is(err, apperrors.ErrInvalidRecurrence), errors.Is(err, apperrors.ErrInvalidJobTimings), errors.Is(err, apperrors.ErrInvalidDateTime),
+		errors.Is(err, apperrors.ErrInvalidSkillLevel), errors.Is(err, apperrors.ErrBelowMinimumWage):
+		return http.StatusBadRequest
+	case errors.Is(err, apperrors.ErrNoJobExists):
+		return http.StatusNotFound
+	}
+	return http.StatusInternalServerError
+}
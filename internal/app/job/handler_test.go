@@ -304,19 +304,18 @@ func (suite *JobHandlerTestSuite) TestFetchAllJobs() {
 		},
 		{
 			name:      "filters",
-			urlParams: "?title=Construction&sector=IT&wage_min=1200&wage_max=1500&start_date=2024-10-09&end_date=2024-10-09&city=Pune&required_gender=Male",
+			urlParams: "?title=Construction&sector=IT&wage_min=1200&wage_max=1500&start_date=2024-10-09&end_date=2024-10-09&city=Pune&required_gender=Male&verified_only=true",
 			setup: func() {
-				startParsed, _ := time.Parse("2006-01-02", "2024-10-09")
-				endParsed, _ := time.Parse("2006-01-02", "2024-10-09")
 				suite.jobService.On("FetchAllJobs", mock.Anything, job.JobFilters{
-					Title:     "Construction",
-					Sector:    "IT",
-					WageMin:   1200,
-					WageMax:   1500,
-					StartDate: startParsed,
-					EndDate:   endParsed,
-					City:      "Pune",
-					Gender:    "Male",
+					Title:        "Construction",
+					Sector:       "IT",
+					WageMin:      1200,
+					WageMax:      1500,
+					StartDate:    "2024-10-09",
+					EndDate:      "2024-10-09",
+					City:         "Pune",
+					Gender:       "Male",
+					VerifiedOnly: true,
 				}).Return([]job.Job{
 					{
 						ID:              1,
@@ -543,7 +542,7 @@ func (suite *JobHandlerTestSuite) TestUpdateJob() {
 			jobData: job.Job{},
 			jobId:   1,
 			setup: func() {
-				suite.jobService.On("UpdateJobByID", mock.Anything, job.Job{}).Return(job.Job{}, errors.New("error faced while update job by id"))
+				suite.jobService.On("UpdateJobByID", mock.Anything, job.Job{ID: 1}).Return(job.Job{}, errors.New("error faced while update job by id"))
 			},
 			expectedStatusCode: http.StatusInternalServerError,
 		},
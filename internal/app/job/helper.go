@@ -1,14 +1,22 @@
 package job
 
 import (
+	"fmt"
 	"net/url"
 	"strconv"
+	"strings"
 	"time"
 
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/minwage"
 	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/worker"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/datetime"
 	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo"
 )
 
+// maxShiftsPerJob caps the number of shifts a single job posting can expand to
+const maxShiftsPerJob = 366
+
 func MapJobRepoStructToService(job repo.Job) Job {
 	return Job{
 		ID:              job.ID,
@@ -19,6 +27,7 @@ func MapJobRepoStructToService(job repo.Job) Job {
 		DurationInHours: job.DurationInHours,
 		SkillsRequired:  job.SkillsRequired,
 		Sectors:         job.Sectors,
+		SkillLevel:      minwage.SkillLevel(job.SkillLevel),
 		Wage:            job.Wage,
 		Vacancy:         job.Vacancy,
 		Location: worker.Address{
@@ -29,11 +38,15 @@ func MapJobRepoStructToService(job repo.Job) Job {
 			State:   job.State,
 			Pincode: job.Pincode,
 		},
-		Date:      job.Date,
-		StartHour: job.StartHour,
-		EndHour:   job.EndHour,
-		CreatedAt: job.CreatedAt,
-		UpdatedAt: job.UpdatedAt,
+		Date:             job.Date,
+		EndDate:          job.EndDate,
+		Recurrence:       Recurrence(job.Recurrence),
+		RecurrenceDays:   job.RecurrenceDays,
+		StartHour:        job.StartHour,
+		EndHour:          job.EndHour,
+		CreatedAt:        job.CreatedAt,
+		UpdatedAt:        job.UpdatedAt,
+		EmployerVerified: job.EmployerVerified,
 	}
 }
 
@@ -47,10 +60,14 @@ func MapJobServiceStructToRepo(job Job) repo.Job {
 		DurationInHours: job.DurationInHours,
 		SkillsRequired:  job.SkillsRequired,
 		Sectors:         job.Sectors,
+		SkillLevel:      string(job.SkillLevel),
 		Wage:            job.Wage,
 		Vacancy:         job.Vacancy,
 		Location:        job.Location.ID,
 		Date:            job.Date,
+		EndDate:         job.EndDate,
+		Recurrence:      string(job.Recurrence),
+		RecurrenceDays:  job.RecurrenceDays,
 		StartHour:       job.StartHour,
 		EndHour:         job.EndHour,
 		CreatedAt:       job.CreatedAt,
@@ -63,7 +80,7 @@ func MapJobServiceStructToRepo(job Job) repo.Job {
 	}
 }
 
-func retrieveQueryParams(queryParams url.Values) JobFilters {
+func retrieveQueryParams(queryParams url.Values) (JobFilters, error) {
 	jobFilters := JobFilters{}
 
 	title := queryParams.Get("title")
@@ -74,6 +91,7 @@ func retrieveQueryParams(queryParams url.Values) JobFilters {
 	endDate := queryParams.Get("end_date")
 	city := queryParams.Get("city")
 	gender := queryParams.Get("required_gender")
+	verifiedOnly := queryParams.Get("verified_only")
 
 	if title != "" {
 		jobFilters.Title = title
@@ -95,15 +113,18 @@ func retrieveQueryParams(queryParams url.Values) JobFilters {
 		}
 	}
 
+	var err error
 	if startDate != "" {
-		if parsed, err := time.Parse("2006-01-02", startDate); err == nil {
-			jobFilters.StartDate = parsed
+		jobFilters.StartDate, err = datetime.ParseDate(startDate)
+		if err != nil {
+			return JobFilters{}, err
 		}
 	}
 
 	if endDate != "" {
-		if parsed, err := time.Parse("2006-01-02", endDate); err == nil {
-			jobFilters.EndDate = parsed
+		jobFilters.EndDate, err = datetime.ParseDate(endDate)
+		if err != nil {
+			return JobFilters{}, err
 		}
 	}
 
@@ -113,6 +134,198 @@ func retrieveQueryParams(queryParams url.Values) JobFilters {
 	if gender != "" {
 		jobFilters.Gender = gender
 	}
+	if verifiedOnly != "" {
+		if verified, err := strconv.ParseBool(verifiedOnly); err == nil {
+			jobFilters.VerifiedOnly = verified
+		}
+	}
+
+	return jobFilters, nil
+}
+
+func MapShiftRepoToService(shift repo.JobShift) Shift {
+	return Shift{
+		ID:        shift.ID,
+		JobID:     shift.JobID,
+		Date:      shift.Date,
+		StartHour: shift.StartHour,
+		EndHour:   shift.EndHour,
+		Wage:      shift.Wage,
+	}
+}
+
+func MapShiftServiceToRepo(shift Shift) repo.JobShift {
+	return repo.JobShift{
+		ID:        shift.ID,
+		JobID:     shift.JobID,
+		Date:      shift.Date,
+		StartHour: shift.StartHour,
+		EndHour:   shift.EndHour,
+		Wage:      shift.Wage,
+	}
+}
+
+func mapShiftsServiceToRepo(shifts []Shift) []repo.JobShift {
+	repoShifts := make([]repo.JobShift, 0)
+	for _, shift := range shifts {
+		repoShifts = append(repoShifts, MapShiftServiceToRepo(shift))
+	}
+	return repoShifts
+}
+
+func mapShiftsRepoToService(repoShifts []repo.JobShift) []Shift {
+	shifts := make([]Shift, 0)
+	for _, shift := range repoShifts {
+		shifts = append(shifts, MapShiftRepoToService(shift))
+	}
+	return shifts
+}
+
+// GenerateShifts expands the date range and recurrence of a job into one shift per working day,
+// a range without recurrence is worked every day and every shift is paid the job wage
+func GenerateShifts(job Job) ([]Shift, error) {
+	if job.Date.IsZero() {
+		return []Shift{}, fmt.Errorf("%w: date is required", apperrors.ErrInvalidJobTimings)
+	}
 
-	return jobFilters
+	start, err := job.Date.Time()
+	if err != nil {
+		return []Shift{}, err
+	}
+
+	end := start
+	if !job.EndDate.IsZero() {
+		end, err = job.EndDate.Time()
+		if err != nil {
+			return []Shift{}, err
+		}
+	}
+	if end.Before(start) {
+		return []Shift{}, fmt.Errorf("%w: end date must not be before date", apperrors.ErrInvalidRecurrence)
+	}
+
+	isWorkingDay, err := recurrenceRule(job.Recurrence, job.RecurrenceDays, start)
+	if err != nil {
+		return []Shift{}, err
+	}
+
+	shifts := make([]Shift, 0)
+	for day := start; !day.After(end); day = day.AddDate(0, 0, 1) {
+		if !isWorkingDay(day) {
+			continue
+		}
+		if len(shifts) == maxShiftsPerJob {
+			return []Shift{}, fmt.Errorf("%w: a job cannot have more than %d shifts", apperrors.ErrInvalidRecurrence, maxShiftsPerJob)
+		}
+
+		shifts = append(shifts, Shift{
+			JobID:     job.ID,
+			Date:      datetime.Date(day.Format(datetime.DateLayout)),
+			StartHour: job.StartHour,
+			EndHour:   job.EndHour,
+			Wage:      job.Wage,
+		})
+	}
+
+	if len(shifts) == 0 {
+		return []Shift{}, fmt.Errorf("%w: no working day falls between date and end date", apperrors.ErrInvalidRecurrence)
+	}
+	return shifts, nil
+}
+
+// ValidateJobTimings makes sure a job with working hours ends after it starts and that the hours
+// match its duration, jobs without hours only need a duration
+func ValidateJobTimings(job Job) error {
+	if job.StartHour.IsZero() && job.EndHour.IsZero() {
+		return nil
+	}
+	if job.StartHour.IsZero() || job.EndHour.IsZero() {
+		return fmt.Errorf("%w: start hour and end hour must be given together", apperrors.ErrInvalidJobTimings)
+	}
+
+	start, err := job.StartHour.Duration()
+	if err != nil {
+		return err
+	}
+	end, err := job.EndHour.Duration()
+	if err != nil {
+		return err
+	}
+
+	if end <= start {
+		return fmt.Errorf("%w: end hour %s must be after start hour %s", apperrors.ErrInvalidJobTimings, job.EndHour, job.StartHour)
+	}
+	if end-start != time.Duration(job.DurationInHours)*time.Hour {
+		return fmt.Errorf("%w: %s to %s does not match duration of %d hours", apperrors.ErrInvalidJobTimings, job.StartHour, job.EndHour, job.DurationInHours)
+	}
+	return nil
+}
+
+func recurrenceRule(recurrence Recurrence, recurrenceDays string, start time.Time) (func(day time.Time) bool, error) {
+	switch recurrence {
+	case "", Daily:
+		return func(day time.Time) bool { return true }, nil
+	case Weekdays:
+		return func(day time.Time) bool {
+			return day.Weekday() != time.Saturday && day.Weekday() != time.Sunday
+		}, nil
+	case Weekly:
+		days := map[time.Weekday]bool{}
+		for _, name := range strings.Split(recurrenceDays, ",") {
+			name = strings.ToLower(strings.TrimSpace(name))
+			if name == "" {
+				continue
+			}
+
+			weekday, ok := parseWeekday(name)
+			if !ok {
+				return nil, fmt.Errorf("%w: unknown recurrence day %q", apperrors.ErrInvalidRecurrence, name)
+			}
+			days[weekday] = true
+		}
+
+		// weekly jobs without explicit days repeat on the weekday of the first date
+		if len(days) == 0 {
+			days[start.Weekday()] = true
+		}
+		return func(day time.Time) bool { return days[day.Weekday()] }, nil
+	}
+	return nil, fmt.Errorf("%w: recurrence must be one of daily, weekdays or weekly", apperrors.ErrInvalidRecurrence)
+}
+
+// parseWeekday accepts full ("saturday") or short ("sat") english day names
+func parseWeekday(name string) (time.Weekday, bool) {
+	for weekday := time.Sunday; weekday <= time.Saturday; weekday++ {
+		fullName := strings.ToLower(weekday.String())
+		if name == fullName || name == fullName[:3] {
+			return weekday, true
+		}
+	}
+	return time.Sunday, false
+}
+
+func totalWage(shifts []Shift) int {
+	total := 0
+	for _, shift := range shifts {
+		total += shift.Wage
+	}
+	return total
+}
+
+func mapJobWage(job Job) minwage.JobWage {
+	return minwage.JobWage{
+		State:           job.Location.State,
+		Sectors:         job.Sectors,
+		SkillLevel:      job.SkillLevel,
+		Wage:            job.Wage,
+		DurationInHours: job.DurationInHours,
+	}
+}
+
+// complianceOrNil leaves out the compliance of a job that has no minimum wage to meet
+func complianceOrNil(compliance minwage.Compliance) *minwage.Compliance {
+	if compliance.Status == minwage.NoMinimum {
+		return nil
+	}
+	return &compliance
 }
@@ -0,0 +1,137 @@
+package job_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/job"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+)
+
+func TestGenerateShifts(t *testing.T) {
+	type testCase struct {
+		name          string
+		input         job.Job
+		expectedDates []string
+		expectedError error
+	}
+
+	testCases := []testCase{
+		{
+			name:          "single day job",
+			input:         job.Job{Date: "2025-03-10", Wage: 800},
+			expectedDates: []string{"2025-03-10"},
+		},
+		{
+			name:          "date range worked every day",
+			input:         job.Job{Date: "2025-03-10", EndDate: "2025-03-12", Wage: 800},
+			expectedDates: []string{"2025-03-10", "2025-03-11", "2025-03-12"},
+		},
+		{
+			name:          "weekdays skip the weekend",
+			input:         job.Job{Date: "2025-03-07", EndDate: "2025-03-11", Recurrence: job.Weekdays, Wage: 800},
+			expectedDates: []string{"2025-03-07", "2025-03-10", "2025-03-11"},
+		},
+		{
+			name:          "weekly on saturdays",
+			input:         job.Job{Date: "2025-03-01", EndDate: "2025-03-22", Recurrence: job.Weekly, RecurrenceDays: "Sat", Wage: 800},
+			expectedDates: []string{"2025-03-01", "2025-03-08", "2025-03-15", "2025-03-22"},
+		},
+		{
+			name:          "weekly on given days",
+			input:         job.Job{Date: "2025-03-03", EndDate: "2025-03-09", Recurrence: job.Weekly, RecurrenceDays: "monday, thu", Wage: 800},
+			expectedDates: []string{"2025-03-03", "2025-03-06"},
+		},
+		{
+			name:          "end date before date",
+			input:         job.Job{Date: "2025-03-10", EndDate: "2025-03-01"},
+			expectedError: apperrors.ErrInvalidRecurrence,
+		},
+		{
+			name:          "unknown recurrence",
+			input:         job.Job{Date: "2025-03-10", Recurrence: "monthly"},
+			expectedError: apperrors.ErrInvalidRecurrence,
+		},
+		{
+			name:          "unknown recurrence day",
+			input:         job.Job{Date: "2025-03-10", Recurrence: job.Weekly, RecurrenceDays: "funday"},
+			expectedError: apperrors.ErrInvalidRecurrence,
+		},
+		{
+			name:          "no working day in range",
+			input:         job.Job{Date: "2025-03-08", EndDate: "2025-03-09", Recurrence: job.Weekdays},
+			expectedError: apperrors.ErrInvalidRecurrence,
+		},
+		{
+			name:          "invalid date",
+			input:         job.Job{Date: "10/03/2025"},
+			expectedError: apperrors.ErrInvalidDateTime,
+		},
+	}
+
+	for _, test := range testCases {
+		shifts, err := job.GenerateShifts(test.input)
+		if !errors.Is(err, test.expectedError) {
+			t.Errorf("%s: expected error %v, got %v", test.name, test.expectedError, err)
+			continue
+		}
+
+		dates := make([]string, 0)
+		for _, shift := range shifts {
+			dates = append(dates, string(shift.Date))
+			if shift.Wage != test.input.Wage {
+				t.Errorf("%s: expected shift wage %d, got %d", test.name, test.input.Wage, shift.Wage)
+			}
+		}
+		if test.expectedError == nil && !reflect.DeepEqual(dates, test.expectedDates) {
+			t.Errorf("%s: expected shifts on %v, got %v", test.name, test.expectedDates, dates)
+		}
+	}
+}
+
+func TestValidateJobTimings(t *testing.T) {
+	type testCase struct {
+		name          string
+		input         job.Job
+		expectedError error
+	}
+
+	testCases := []testCase{
+		{
+			name:  "hours match duration",
+			input: job.Job{StartHour: "09:00", EndHour: "17:00", DurationInHours: 8},
+		},
+		{
+			name:  "no working hours",
+			input: job.Job{DurationInHours: 8},
+		},
+		{
+			name:          "only start hour",
+			input:         job.Job{StartHour: "09:00", DurationInHours: 8},
+			expectedError: apperrors.ErrInvalidJobTimings,
+		},
+		{
+			name:          "end before start",
+			input:         job.Job{StartHour: "17:00", EndHour: "09:00", DurationInHours: 8},
+			expectedError: apperrors.ErrInvalidJobTimings,
+		},
+		{
+			name:          "duration mismatch",
+			input:         job.Job{StartHour: "09:00", EndHour: "13:00", DurationInHours: 8},
+			expectedError: apperrors.ErrInvalidJobTimings,
+		},
+		{
+			name:          "invalid hour",
+			input:         job.Job{StartHour: "9am", EndHour: "17:00", DurationInHours: 8},
+			expectedError: apperrors.ErrInvalidDateTime,
+		},
+	}
+
+	for _, test := range testCases {
+		err := job.ValidateJobTimings(test.input)
+		if !errors.Is(err, test.expectedError) {
+			t.Errorf("%s: expected error %v, got %v", test.name, test.expectedError, err)
+		}
+	}
+}
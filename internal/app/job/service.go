@@ -2,14 +2,20 @@ package job
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/application"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/minwage"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/skill"
 	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
 	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo"
 )
 
 type jobService struct {
-	jobRepo repo.JobStorer
+	jobRepo      repo.JobStorer
+	shiftRepo    repo.ShiftStorer
+	skillService skill.Service
+	wageService  minwage.Service
 }
 
 type Service interface {
@@ -21,32 +27,93 @@ type Service interface {
 	FetchAllJobs(ctx context.Context, filters JobFilters) ([]Job, error)
 }
 
-func NewService(jobRepo repo.JobStorer) Service {
+func NewService(jobRepo repo.JobStorer, shiftRepo repo.ShiftStorer, skillService skill.Service, wageService minwage.Service) Service {
 	return &jobService{
-		jobRepo: jobRepo,
+		jobRepo:      jobRepo,
+		shiftRepo:    shiftRepo,
+		skillService: skillService,
+		wageService:  wageService,
 	}
 }
 
 func (js *jobService) CreateJob(ctx context.Context, jobData Job) (Job, error) {
+	err := ValidateJobTimings(jobData)
+	if err != nil {
+		return Job{}, err
+	}
+
+	shifts, err := GenerateShifts(jobData)
+	if err != nil {
+		return Job{}, err
+	}
+
+	skills, err := js.skillService.NormalizeSkills(ctx, jobData.SkillsRequired)
+	if err != nil {
+		return Job{}, fmt.Errorf("%w: %w", apperrors.ErrNormalizeSkills, err)
+	}
+	jobData.SkillsRequired = skills
+
+	jobWage := mapJobWage(jobData)
+	compliance, err := js.wageService.CheckJobWage(ctx, jobWage)
+	if err != nil {
+		return Job{}, err
+	}
+
+	// the skill level is saved as checked, a job posted without one is unskilled work
+	jobData.SkillLevel = compliance.SkillLevel
 	jobRepoObj := MapJobServiceStructToRepo(jobData)
-	job, err := js.jobRepo.CreateJob(ctx, jobRepoObj)
+	job, jobShifts, err := js.jobRepo.CreateJob(ctx, jobRepoObj, mapShiftsServiceToRepo(shifts))
 	if err != nil {
 		return Job{}, err
 	}
 	createdJob := MapJobRepoStructToService(job)
+	createdJob.Shifts = mapShiftsRepoToService(jobShifts)
+	createdJob.TotalWage = totalWage(createdJob.Shifts)
+
+	js.wageService.RecordJobWageCheck(ctx, createdJob.ID, jobWage, compliance)
+	createdJob.WageCompliance = complianceOrNil(compliance)
+
 	return createdJob, nil
 }
 
 func (js *jobService) UpdateJobByID(ctx context.Context, jobData Job) (Job, error) {
+	err := ValidateJobTimings(jobData)
+	if err != nil {
+		return Job{}, err
+	}
+
+	shifts, err := GenerateShifts(jobData)
+	if err != nil {
+		return Job{}, err
+	}
+
+	skills, err := js.skillService.NormalizeSkills(ctx, jobData.SkillsRequired)
+	if err != nil {
+		return Job{}, fmt.Errorf("%w: %w", apperrors.ErrNormalizeSkills, err)
+	}
+	jobData.SkillsRequired = skills
+
+	jobWage := mapJobWage(jobData)
+	compliance, err := js.wageService.CheckJobWage(ctx, jobWage)
+	if err != nil {
+		return Job{}, err
+	}
 
+	jobData.SkillLevel = compliance.SkillLevel
 	jobRepoObj := MapJobServiceStructToRepo(jobData)
 
-	job, err := js.jobRepo.UpdateJobById(ctx, jobRepoObj)
+	// the shifts generated before are replaced in the same transaction as the job
+	job, jobShifts, err := js.jobRepo.UpdateJobById(ctx, jobRepoObj, mapShiftsServiceToRepo(shifts))
 	if err != nil {
 		return Job{}, err
 	}
 
 	updatedJob := MapJobRepoStructToService(job)
+	updatedJob.Shifts = mapShiftsRepoToService(jobShifts)
+	updatedJob.TotalWage = totalWage(updatedJob.Shifts)
+
+	js.wageService.RecordJobWageCheck(ctx, updatedJob.ID, jobWage, compliance)
+	updatedJob.WageCompliance = complianceOrNil(compliance)
 
 	return updatedJob, nil
 }
@@ -58,6 +125,16 @@ func (js *jobService) FetchJobByID(ctx context.Context, jobId int) (Job, error)
 	}
 
 	fetchedJob := MapJobRepoStructToService(job)
+
+	shifts, err := js.shiftRepo.FetchShiftsByJobId(ctx, jobId)
+	if err != nil {
+		return Job{}, fmt.Errorf("%w: %w", apperrors.ErrFetchShifts, err)
+	}
+	for _, shift := range shifts {
+		fetchedJob.Shifts = append(fetchedJob.Shifts, MapShiftRepoToService(shift))
+	}
+	fetchedJob.TotalWage = totalWage(fetchedJob.Shifts)
+
 	return fetchedJob, nil
 }
 
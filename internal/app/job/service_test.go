@@ -8,9 +8,12 @@ import (
 
 	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/application"
 	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/job"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/minwage"
+	wageMocks "github.com/harsh-jagtap-josh/RozgarLink/internal/app/minwage/mocks"
+	skillMocks "github.com/harsh-jagtap-josh/RozgarLink/internal/app/skill/mocks"
 	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/worker"
 
-	// "github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
 	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo"
 	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo/mocks"
 	"github.com/stretchr/testify/mock"
@@ -19,17 +22,30 @@ import (
 
 type JobServiceTestSuite struct {
 	suite.Suite
-	service job.Service
-	jobRepo mocks.JobStorer
+	service      job.Service
+	jobRepo      mocks.JobStorer
+	shiftRepo    mocks.ShiftStorer
+	skillService skillMocks.Service
+	wageService  wageMocks.Service
 }
 
 func (suite *JobServiceTestSuite) SetupTest() {
 	suite.jobRepo = mocks.JobStorer{}
-	suite.service = job.NewService(&suite.jobRepo)
+	suite.shiftRepo = mocks.ShiftStorer{}
+	suite.skillService = skillMocks.Service{}
+	suite.skillService.On("NormalizeSkills", mock.Anything, mock.Anything).Return(func(ctx context.Context, skills string) (string, error) {
+		return skills, nil
+	}).Maybe()
+	suite.wageService = wageMocks.Service{}
+	suite.wageService.On("CheckJobWage", mock.Anything, mock.Anything).Return(minwage.Compliance{Status: minwage.NoMinimum}, nil).Maybe()
+	suite.wageService.On("RecordJobWageCheck", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return().Maybe()
+	suite.service = job.NewService(&suite.jobRepo, &suite.shiftRepo, &suite.skillService, &suite.wageService)
 }
 
 func (suite *JobServiceTestSuite) TearDownTest() {
 	suite.jobRepo.AssertExpectations(suite.T())
+	suite.shiftRepo.AssertExpectations(suite.T())
+	suite.wageService.AssertExpectations(suite.T())
 }
 
 func (suite *JobServiceTestSuite) TestFetchAllJobs() {
@@ -195,6 +211,7 @@ func (suite *JobServiceTestSuite) TestFetchJobByID() {
 					DurationInHours: 12,
 					SkillsRequired:  "Frontend, Backend",
 					Sectors:         "IT, Technology, Computers",
+					SkillLevel:      "skilled",
 					Wage:            2500,
 					Vacancy:         3,
 					Location:        1,
@@ -209,6 +226,7 @@ func (suite *JobServiceTestSuite) TestFetchJobByID() {
 					State:           "Maharastra",
 					Pincode:         411057,
 				}, nil)
+				suite.shiftRepo.On("FetchShiftsByJobId", mock.Anything, 1).Return([]repo.JobShift{{ID: 1, JobID: 1, Date: "2025-12-12", Wage: 2500}}, nil)
 			},
 			expectedOutput: job.Job{
 				ID:              1,
@@ -219,6 +237,7 @@ func (suite *JobServiceTestSuite) TestFetchJobByID() {
 				DurationInHours: 12,
 				SkillsRequired:  "Frontend, Backend",
 				Sectors:         "IT, Technology, Computers",
+				SkillLevel:      minwage.Skilled,
 				Wage:            2500,
 				Vacancy:         3,
 				Location: worker.Address{
@@ -234,6 +253,8 @@ func (suite *JobServiceTestSuite) TestFetchJobByID() {
 				EndHour:   "",
 				CreatedAt: time.Time{},
 				UpdatedAt: time.Time{},
+				Shifts:    []job.Shift{{ID: 1, JobID: 1, Date: "2025-12-12", Wage: 2500}},
+				TotalWage: 2500,
 			},
 			expectedError: false,
 		},
@@ -296,7 +317,7 @@ func (suite *JobServiceTestSuite) TestCreateJob() {
 					City:            "Pune",
 					State:           "Maharastra",
 					Pincode:         411057,
-				}).Return(repo.Job{
+				}, []repo.JobShift{{Date: "2025-12-12", Wage: 2500}}).Return(repo.Job{
 					ID:              1,
 					EmployerID:      3,
 					Title:           "Software Developer",
@@ -318,7 +339,7 @@ func (suite *JobServiceTestSuite) TestCreateJob() {
 					City:            "Pune",
 					State:           "Maharastra",
 					Pincode:         411057,
-				}, nil)
+				}, []repo.JobShift{{ID: 1, JobID: 1, Date: "2025-12-12", Wage: 2500}}, nil)
 			},
 			input: job.Job{
 				EmployerID:      3,
@@ -368,6 +389,8 @@ func (suite *JobServiceTestSuite) TestCreateJob() {
 				EndHour:   "",
 				CreatedAt: time.Time{},
 				UpdatedAt: time.Time{},
+				Shifts:    []job.Shift{{ID: 1, JobID: 1, Date: "2025-12-12", Wage: 2500}},
+				TotalWage: 2500,
 			},
 			expectedError: false,
 		},
@@ -395,7 +418,7 @@ func (suite *JobServiceTestSuite) TestCreateJob() {
 					City:            "Pune",
 					State:           "Maharastra",
 					Pincode:         411057,
-				}).Return(repo.Job{}, errors.New("db error while create job"))
+				}, mock.Anything).Return(repo.Job{}, []repo.JobShift{}, errors.New("db error while create job"))
 			},
 			input: job.Job{
 				EmployerID:      3,
@@ -424,6 +447,62 @@ func (suite *JobServiceTestSuite) TestCreateJob() {
 			expectedOutput: job.Job{},
 			expectedError:  true,
 		},
+		{
+			name: "wage below minimum wage",
+			setup: func() {
+				suite.wageService.ExpectedCalls = nil
+				suite.wageService.On("CheckJobWage", mock.Anything, minwage.JobWage{State: "Maharastra", Sectors: "Construction", SkillLevel: minwage.Skilled, Wage: 300, DurationInHours: 8}).Return(minwage.Compliance{Status: minwage.BelowMinimum, RequiredWage: 650, Shortfall: 350}, apperrors.ErrBelowMinimumWage)
+			},
+			input: job.Job{
+				EmployerID:      3,
+				Title:           "Mason",
+				DurationInHours: 8,
+				Sectors:         "Construction",
+				SkillLevel:      minwage.Skilled,
+				Wage:            300,
+				Vacancy:         2,
+				Location:        worker.Address{ID: 1, City: "Pune", State: "Maharastra"},
+				Date:            "2025-12-12",
+			},
+			expectedOutput: job.Job{},
+			expectedError:  true,
+		},
+		{
+			name: "wage compliance of a job with a minimum wage",
+			setup: func() {
+				suite.wageService.ExpectedCalls = nil
+				compliance := minwage.Compliance{Status: minwage.Compliant, State: "maharastra", Sector: "construction", SkillLevel: minwage.Unskilled, MinimumDailyWage: 520, HourlyRate: 87.5, RequiredWage: 520}
+				suite.wageService.On("CheckJobWage", mock.Anything, minwage.JobWage{State: "Maharastra", Sectors: "Construction", Wage: 700, DurationInHours: 8}).Return(compliance, nil)
+				suite.wageService.On("RecordJobWageCheck", mock.Anything, 2, minwage.JobWage{State: "Maharastra", Sectors: "Construction", Wage: 700, DurationInHours: 8}, compliance).Return()
+				suite.jobRepo.On("CreateJob", mock.Anything, mock.MatchedBy(func(job repo.Job) bool { return job.SkillLevel == "unskilled" }), []repo.JobShift{{Date: "2025-12-12", Wage: 700}}).Return(repo.Job{ID: 2, EmployerID: 3, Title: "Mason", DurationInHours: 8, Sectors: "Construction", SkillLevel: "unskilled", Wage: 700, Vacancy: 2, Location: 1, Date: "2025-12-12", City: "Pune", State: "Maharastra"}, []repo.JobShift{{ID: 4, JobID: 2, Date: "2025-12-12", Wage: 700}}, nil)
+			},
+			input: job.Job{
+				EmployerID:      3,
+				Title:           "Mason",
+				DurationInHours: 8,
+				Sectors:         "Construction",
+				Wage:            700,
+				Vacancy:         2,
+				Location:        worker.Address{ID: 1, City: "Pune", State: "Maharastra"},
+				Date:            "2025-12-12",
+			},
+			expectedOutput: job.Job{
+				ID:              2,
+				EmployerID:      3,
+				Title:           "Mason",
+				DurationInHours: 8,
+				Sectors:         "Construction",
+				Wage:            700,
+				Vacancy:         2,
+				Location:        worker.Address{ID: 1, City: "Pune", State: "Maharastra"},
+				Date:            "2025-12-12",
+				Shifts:          []job.Shift{{ID: 4, JobID: 2, Date: "2025-12-12", Wage: 700}},
+				TotalWage:       700,
+				SkillLevel:      minwage.Unskilled,
+				WageCompliance:  &minwage.Compliance{Status: minwage.Compliant, State: "maharastra", Sector: "construction", SkillLevel: minwage.Unskilled, MinimumDailyWage: 520, HourlyRate: 87.5, RequiredWage: 520},
+			},
+			expectedError: false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -476,7 +555,7 @@ func (suite *JobServiceTestSuite) TestUpdateJob() {
 					City:            "Pune",
 					State:           "Maharastra",
 					Pincode:         411057,
-				}).Return(repo.Job{
+				}, []repo.JobShift{{JobID: 1, Date: "2025-12-12", Wage: 2500}}).Return(repo.Job{
 					ID:              1,
 					EmployerID:      3,
 					Title:           "Software Developer",
@@ -498,7 +577,7 @@ func (suite *JobServiceTestSuite) TestUpdateJob() {
 					City:            "Pune",
 					State:           "Maharastra",
 					Pincode:         411057,
-				}, nil)
+				}, []repo.JobShift{{ID: 1, JobID: 1, Date: "2025-12-12", Wage: 2500}}, nil)
 			},
 			input: job.Job{
 				ID:              1,
@@ -549,6 +628,8 @@ func (suite *JobServiceTestSuite) TestUpdateJob() {
 				EndHour:   "",
 				CreatedAt: time.Time{},
 				UpdatedAt: time.Time{},
+				Shifts:    []job.Shift{{ID: 1, JobID: 1, Date: "2025-12-12", Wage: 2500}},
+				TotalWage: 2500,
 			},
 			expectedError: false,
 		},
@@ -576,7 +657,7 @@ func (suite *JobServiceTestSuite) TestUpdateJob() {
 					City:            "Pune",
 					State:           "Maharastra",
 					Pincode:         411057,
-				}).Return(repo.Job{}, errors.New("db error while create job"))
+				}, mock.Anything).Return(repo.Job{}, []repo.JobShift{}, errors.New("db error while create job"))
 			},
 			input: job.Job{
 				EmployerID:      3,
@@ -746,7 +827,7 @@ func (suite *JobServiceTestSuite) TestFetchApplicationsByJobId() {
 					Vacancy:        5,
 					JobDate:        "2025-12-3",
 					WorkerName:     "Jogn Doe",
-					ContactNumber:  "9067691363",
+					ContactNumber:  "XXXXXXXX63",
 					WorkerEmail:    "harsh@gmail.com",
 					WorkerGender:   "Male",
 				},
@@ -0,0 +1,61 @@
+package kyc
+
+import (
+	"io"
+	"time"
+)
+
+type Status string
+
+const (
+	Pending  Status = "pending"
+	Approved Status = "approved"
+	Rejected Status = "rejected"
+)
+
+type DocumentType string
+
+const (
+	Aadhaar        DocumentType = "aadhaar"
+	EShram         DocumentType = "eshram"
+	ITICertificate DocumentType = "iti_certificate"
+)
+
+// Document is an identity or skill document of a worker, only the masked number is kept and the
+// file itself is only served to admins
+type Document struct {
+	ID           int          `json:"id"`
+	WorkerID     int          `json:"worker_id"`
+	WorkerName   string       `json:"worker_name,omitempty"`
+	Type         DocumentType `json:"document_type"`
+	MaskedNumber string       `json:"masked_number"`
+	FileName     string       `json:"file_name"`
+	ContentType  string       `json:"content_type"`
+	Size         int64        `json:"size"`
+	Status       Status       `json:"status"`
+	Reason       string       `json:"reason,omitempty"`
+	ReviewedBy   int          `json:"reviewed_by,omitempty"`
+	UploadedAt   time.Time    `json:"uploaded_at"`
+	ReviewedAt   *time.Time   `json:"reviewed_at,omitempty"`
+}
+
+// Upload is a document file sent by a worker, for Aadhaar only the last 4 digits are accepted
+type Upload struct {
+	Type     DocumentType
+	Number   string
+	FileName string
+	Content  io.Reader
+}
+
+// File is the stored file of a document, the caller has to close its content
+type File struct {
+	Name        string
+	ContentType string
+	Size        int64
+	Content     io.ReadCloser
+}
+
+// Review is the decision of an admin on a document, a reason is required to reject it
+type Review struct {
+	Reason string `json:"reason"`
+}
@@ -0,0 +1,262 @@
+package kyc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/logger"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/middleware"
+	"go.uber.org/zap"
+)
+
+// room for the form fields and multipart boundaries around the file
+const multipartOverhead = 1 << 20
+
+func UploadDocument(kycService Service) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		workerId, id := isPathIdValid(ctx, w, r, "worker_id", apperrors.MsgInvalidWorkerId, apperrors.ErrUploadWorkerDocument)
+		if workerId == -1 {
+			return
+		}
+
+		r.Body = http.MaxBytesReader(w, r.Body, maxDocumentSize+multipartOverhead)
+		reader, err := r.MultipartReader()
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrInvalidRequestBody.Error(), zap.Error(err))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrInvalidRequestBody.Error()+": "+err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		// the fields are read before the file, so the file is streamed to the store without
+		// being buffered
+		var upload Upload
+		for {
+			part, err := reader.NextPart()
+			if err != nil {
+				logger.Errorw(ctx, apperrors.ErrInvalidRequestBody.Error(), zap.Error(err))
+				middleware.HandleErrorResponse(ctx, w, apperrors.ErrInvalidRequestBody.Error()+": expected document_type, document_number and file fields", requestBodyErrorStatusCode(err))
+				return
+			}
+
+			if part.FormName() == "file" {
+				upload.FileName = part.FileName()
+				upload.Content = part
+				break
+			}
+
+			value, err := io.ReadAll(io.LimitReader(part, 256))
+			if err != nil {
+				logger.Errorw(ctx, apperrors.ErrInvalidRequestBody.Error(), zap.Error(err))
+				middleware.HandleErrorResponse(ctx, w, apperrors.ErrInvalidRequestBody.Error()+": "+err.Error(), requestBodyErrorStatusCode(err))
+				return
+			}
+			switch part.FormName() {
+			case "document_type":
+				upload.Type = DocumentType(value)
+			case "document_number":
+				upload.Number = string(value)
+			}
+		}
+
+		document, err := kycService.UploadDocument(ctx, workerId, upload)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrUploadWorkerDocument.Error(), zap.Error(err), zap.String("ID", id))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrUploadWorkerDocument.Error()+": "+err.Error(), kycErrorStatusCode(err))
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, "document uploaded for review", http.StatusCreated, document)
+	}
+}
+
+func FetchWorkerDocuments(kycService Service) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		workerId, id := isPathIdValid(ctx, w, r, "worker_id", apperrors.MsgInvalidWorkerId, apperrors.ErrFetchWorkerDocuments)
+		if workerId == -1 {
+			return
+		}
+
+		documents, err := kycService.FetchWorkerDocuments(ctx, workerId)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrFetchWorkerDocuments.Error(), zap.Error(err), zap.String("ID", id))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrFetchWorkerDocuments.Error()+", "+err.Error(), kycErrorStatusCode(err))
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, "worker documents retrieved successfully", http.StatusOK, documents)
+	}
+}
+
+func FetchDocuments(kycService Service) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		status := r.URL.Query().Get("status")
+		documents, err := kycService.FetchDocuments(ctx, status)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrFetchWorkerDocuments.Error(), zap.Error(err), zap.String("status", status))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrFetchWorkerDocuments.Error()+", "+err.Error(), kycErrorStatusCode(err))
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, "worker documents retrieved successfully", http.StatusOK, documents)
+	}
+}
+
+func FetchDocumentById(kycService Service) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		documentId, id := isPathIdValid(ctx, w, r, "document_id", apperrors.MsgInvalidDocumentId, apperrors.ErrFetchWorkerDocuments)
+		if documentId == -1 {
+			return
+		}
+
+		document, err := kycService.FetchDocumentById(ctx, documentId)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrFetchWorkerDocuments.Error(), zap.Error(err), zap.String("ID", id))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrFetchWorkerDocuments.Error()+", "+err.Error(), kycErrorStatusCode(err))
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, "worker document retrieved successfully", http.StatusOK, document)
+	}
+}
+
+func ApproveDocument(kycService Service) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		documentId, id := isPathIdValid(ctx, w, r, "document_id", apperrors.MsgInvalidDocumentId, apperrors.ErrReviewWorkerDocument)
+		if documentId == -1 {
+			return
+		}
+
+		document, err := kycService.ApproveDocument(ctx, documentId, reviewerId(ctx))
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrReviewWorkerDocument.Error(), zap.Error(err), zap.String("ID", id))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrReviewWorkerDocument.Error()+", "+err.Error(), kycErrorStatusCode(err))
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, "worker document approved", http.StatusOK, document)
+	}
+}
+
+func RejectDocument(kycService Service) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		documentId, id := isPathIdValid(ctx, w, r, "document_id", apperrors.MsgInvalidDocumentId, apperrors.ErrReviewWorkerDocument)
+		if documentId == -1 {
+			return
+		}
+
+		var review Review
+		err := json.NewDecoder(r.Body).Decode(&review)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrInvalidRequestBody.Error(), zap.Error(err))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrInvalidRequestBody.Error()+": "+err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		document, err := kycService.RejectDocument(ctx, documentId, reviewerId(ctx), review)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrReviewWorkerDocument.Error(), zap.Error(err), zap.String("ID", id))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrReviewWorkerDocument.Error()+", "+err.Error(), kycErrorStatusCode(err))
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, "worker document rejected", http.StatusOK, document)
+	}
+}
+
+// DownloadDocumentFile serves the raw file of a document, its route must only be reachable by
+// authenticated admins
+func DownloadDocumentFile(kycService Service) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		documentId, id := isPathIdValid(ctx, w, r, "document_id", apperrors.MsgInvalidDocumentId, apperrors.ErrFetchWorkerDocumentFile)
+		if documentId == -1 {
+			return
+		}
+
+		file, err := kycService.OpenDocumentFile(ctx, documentId)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrFetchWorkerDocumentFile.Error(), zap.Error(err), zap.String("ID", id))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrFetchWorkerDocumentFile.Error()+", "+err.Error(), kycErrorStatusCode(err))
+			return
+		}
+		defer file.Content.Close()
+
+		w.Header().Set("Content-Type", file.ContentType)
+		w.Header().Set("Content-Length", strconv.FormatInt(file.Size, 10))
+		w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": file.Name}))
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+		w.Header().Set("Cache-Control", "no-store")
+		w.WriteHeader(http.StatusOK)
+
+		_, err = io.Copy(w, file.Content)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrFetchWorkerDocumentFile.Error(), zap.Error(err), zap.String("ID", id))
+		}
+	}
+}
+
+// reviewerId is the admin reviewing a document, taken from the JWT when the route is authenticated
+func reviewerId(ctx context.Context) int {
+	userId, _ := ctx.Value("user_id").(int)
+	return userId
+}
+
+func isPathIdValid(ctx context.Context, w http.ResponseWriter, r *http.Request, key string, invalidMsg string, errType error) (int, string) {
+	vars := mux.Vars(r)
+	id := vars[key]
+	pathId, err := strconv.Atoi(id)
+	if err != nil {
+		logger.Errorw(ctx, invalidMsg, zap.Error(err), zap.String("ID", id))
+		httpResponseMsg := apperrors.HttpErrorResponseMessage(errType.Error(), invalidMsg, id)
+		middleware.HandleErrorResponse(ctx, w, httpResponseMsg, http.StatusBadRequest)
+		return -1, id
+	}
+	return pathId, id
+}
+
+// requestBodyErrorStatusCode maps the errors of reading the multipart body, which is cut off once
+// it grows past the size limit
+func requestBodyErrorStatusCode(err error) int {
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		return http.StatusRequestEntityTooLarge
+	}
+	return http.StatusBadRequest
+}
+
+func kycErrorStatusCode(err error) int {
+	var maxBytesErr *http.MaxBytesError
+	switch {
+	case errors.As(err, &maxBytesErr), errors.Is(err, apperrors.ErrDocumentTooLarge):
+		return http.StatusRequestEntityTooLarge
+	case errors.Is(err, apperrors.ErrInvalidWorkerDocument), errors.Is(err, apperrors.ErrInvalidVerificationStatus):
+		return http.StatusBadRequest
+	case errors.Is(err, apperrors.ErrUnsupportedDocumentType):
+		return http.StatusUnsupportedMediaType
+	case errors.Is(err, apperrors.ErrNoWorkerDocumentExists), errors.Is(err, apperrors.ErrNoWorkerExists), errors.Is(err, apperrors.ErrBlobNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, apperrors.ErrWorkerDocumentPending), errors.Is(err, apperrors.ErrWorkerDocumentVerified), errors.Is(err, apperrors.ErrDocumentAlreadyReviewed):
+		return http.StatusConflict
+	}
+	return http.StatusInternalServerError
+}
@@ -0,0 +1,150 @@
+package kyc
+
+import (
+	"bufio"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo"
+)
+
+const (
+	maxDocumentSize = 5 << 20
+
+	maxReasonLength   = 500
+	maxFileNameLength = 255
+	sniffLength       = 512
+)
+
+var (
+	aadhaarLastFourPattern = regexp.MustCompile(`^[0-9]{4}$`)
+	uanPattern             = regexp.MustCompile(`^[0-9]{12}$`)
+	certificatePattern     = regexp.MustCompile(`^[A-Z0-9][A-Z0-9/-]{3,29}$`)
+
+	// extensions of the sniffed content types, the name sent by the client is never trusted
+	documentExtensions = map[string]string{
+		"application/pdf": ".pdf",
+		"image/jpeg":      ".jpg",
+		"image/png":       ".png",
+	}
+)
+
+func MapDocumentRepoToService(document repo.WorkerDocument) Document {
+	return Document{
+		ID:           document.ID,
+		WorkerID:     document.WorkerID,
+		WorkerName:   document.WorkerName,
+		Type:         DocumentType(document.DocumentType),
+		MaskedNumber: document.MaskedNumber,
+		FileName:     document.FileName,
+		ContentType:  document.ContentType,
+		Size:         document.Size,
+		Status:       Status(document.Status),
+		Reason:       document.Reason,
+		ReviewedBy:   document.ReviewedBy,
+		UploadedAt:   document.UploadedAt,
+		ReviewedAt:   document.ReviewedAt,
+	}
+}
+
+// maskDocumentNumber validates the number of a document and returns the form it is stored in,
+// Aadhaar and e-Shram numbers are never stored in full
+func maskDocumentNumber(documentType DocumentType, number string) (string, error) {
+	number = strings.ToUpper(strings.Join(strings.Fields(number), ""))
+
+	switch documentType {
+	case Aadhaar:
+		if !aadhaarLastFourPattern.MatchString(number) {
+			return "", fmt.Errorf("%w: provide only the last 4 digits of the Aadhaar number", apperrors.ErrInvalidWorkerDocument)
+		}
+		return "XXXX-XXXX-" + number, nil
+	case EShram:
+		if !uanPattern.MatchString(number) {
+			return "", fmt.Errorf("%w: the e-Shram UAN must be 12 digits", apperrors.ErrInvalidWorkerDocument)
+		}
+		return "XXXXXXXX" + number[8:], nil
+	case ITICertificate:
+		if !certificatePattern.MatchString(number) {
+			return "", fmt.Errorf("%w: invalid ITI certificate number", apperrors.ErrInvalidWorkerDocument)
+		}
+		return number, nil
+	}
+	return "", fmt.Errorf("%w: document type must be aadhaar, eshram or iti_certificate", apperrors.ErrInvalidWorkerDocument)
+}
+
+// sniffContentType detects the type of a file from its first bytes, the returned reader still
+// yields the whole file
+func sniffContentType(content io.Reader) (string, io.Reader, error) {
+	reader := bufio.NewReaderSize(content, sniffLength)
+	head, err := reader.Peek(sniffLength)
+	if err != nil && err != io.EOF && err != bufio.ErrBufferFull {
+		return "", nil, err
+	}
+	if len(head) == 0 {
+		return "", nil, fmt.Errorf("%w: the file is empty", apperrors.ErrInvalidWorkerDocument)
+	}
+
+	contentType := http.DetectContentType(head)
+	if _, ok := documentExtensions[contentType]; !ok {
+		return "", nil, apperrors.ErrUnsupportedDocumentType
+	}
+	return contentType, reader, nil
+}
+
+// documentBlobKey is a random key for a file of a worker, so keys cannot be guessed from ids
+func documentBlobKey(workerId int, contentType string) (string, error) {
+	random := make([]byte, 16)
+	_, err := rand.Read(random)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("worker-documents/%d/%s%s", workerId, hex.EncodeToString(random), documentExtensions[contentType]), nil
+}
+
+// cleanFileName keeps the base name of an uploaded file for display only
+func cleanFileName(name string) string {
+	name = strings.TrimSpace(filepath.Base(strings.ReplaceAll(name, "\\", "/")))
+	if name == "." || name == "/" || name == "" {
+		return "document"
+	}
+	if len(name) > maxFileNameLength {
+		name = name[len(name)-maxFileNameLength:]
+	}
+	return name
+}
+
+// sizeLimitedReader fails with ErrDocumentTooLarge once more than limit bytes are read and counts
+// the bytes it read
+type sizeLimitedReader struct {
+	reader io.Reader
+	limit  int64
+	read   int64
+}
+
+func (slr *sizeLimitedReader) Read(p []byte) (int, error) {
+	n, err := slr.reader.Read(p)
+	slr.read += int64(n)
+	if slr.read > slr.limit {
+		return n, apperrors.ErrDocumentTooLarge
+	}
+	return n, err
+}
+
+func parseStatus(status string) (repo.VerificationStatus, error) {
+	switch Status(status) {
+	case "", Pending:
+		return repo.VerificationPending, nil
+	case Approved:
+		return repo.VerificationApproved, nil
+	case Rejected:
+		return repo.VerificationRejected, nil
+	}
+	return "", apperrors.ErrInvalidVerificationStatus
+}
@@ -0,0 +1,39 @@
+package kyc
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+)
+
+func TestMaskDocumentNumber(t *testing.T) {
+	type testCase struct {
+		name           string
+		documentType   DocumentType
+		number         string
+		expectedOutput string
+		expectedError  error
+	}
+
+	testCases := []testCase{
+		{name: "aadhaar last 4 digits", documentType: Aadhaar, number: " 1234", expectedOutput: "XXXX-XXXX-1234"},
+		{name: "full aadhaar number", documentType: Aadhaar, number: "2345 6789 1234", expectedError: apperrors.ErrInvalidWorkerDocument},
+		{name: "e-shram uan with spaces", documentType: EShram, number: "1002 3004 5006", expectedOutput: "XXXXXXXX5006"},
+		{name: "short e-shram uan", documentType: EShram, number: "10023004", expectedError: apperrors.ErrInvalidWorkerDocument},
+		{name: "iti certificate", documentType: ITICertificate, number: "iti/2019/4471", expectedOutput: "ITI/2019/4471"},
+		{name: "iti certificate with symbols", documentType: ITICertificate, number: "ITI#2019", expectedError: apperrors.ErrInvalidWorkerDocument},
+		{name: "unknown document type", documentType: "passport", number: "1234", expectedError: apperrors.ErrInvalidWorkerDocument},
+	}
+
+	for _, test := range testCases {
+		masked, err := maskDocumentNumber(test.documentType, test.number)
+		if !errors.Is(err, test.expectedError) {
+			t.Errorf("%s: expected error %v, got %v", test.name, test.expectedError, err)
+			continue
+		}
+		if masked != test.expectedOutput {
+			t.Errorf("%s: expected %q, got %q", test.name, test.expectedOutput, masked)
+		}
+	}
+}
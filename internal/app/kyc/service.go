@@ -0,0 +1,193 @@
+package kyc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/blobstore"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/logger"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo"
+	"go.uber.org/zap"
+)
+
+type kycService struct {
+	documentRepo repo.WorkerDocumentStorer
+	workerRepo   repo.WorkerStorer
+	blobStore    blobstore.BlobStore
+}
+
+type Service interface {
+	UploadDocument(ctx context.Context, workerId int, upload Upload) (Document, error)
+	FetchWorkerDocuments(ctx context.Context, workerId int) ([]Document, error)
+	FetchDocuments(ctx context.Context, status string) ([]Document, error)
+	FetchDocumentById(ctx context.Context, documentId int) (Document, error)
+	ApproveDocument(ctx context.Context, documentId int, reviewerId int) (Document, error)
+	RejectDocument(ctx context.Context, documentId int, reviewerId int, review Review) (Document, error)
+	OpenDocumentFile(ctx context.Context, documentId int) (File, error)
+}
+
+func NewService(documentRepo repo.WorkerDocumentStorer, workerRepo repo.WorkerStorer, blobStore blobstore.BlobStore) Service {
+	return &kycService{
+		documentRepo: documentRepo,
+		workerRepo:   workerRepo,
+		blobStore:    blobStore,
+	}
+}
+
+// UploadDocument stores the file of a document and queues it for review, a worker can only have
+// one document of a type waiting or verified at a time
+func (kycS *kycService) UploadDocument(ctx context.Context, workerId int, upload Upload) (Document, error) {
+	maskedNumber, err := maskDocumentNumber(upload.Type, upload.Number)
+	if err != nil {
+		return Document{}, err
+	}
+
+	if !kycS.workerRepo.FindWorkerById(ctx, workerId) {
+		return Document{}, apperrors.ErrNoWorkerExists
+	}
+
+	documents, err := kycS.documentRepo.FetchWorkerDocuments(ctx, workerId)
+	if err != nil {
+		return Document{}, err
+	}
+	for _, document := range documents {
+		if document.DocumentType != repo.WorkerDocumentType(upload.Type) {
+			continue
+		}
+		switch document.Status {
+		case repo.VerificationPending:
+			return Document{}, apperrors.ErrWorkerDocumentPending
+		case repo.VerificationApproved:
+			return Document{}, apperrors.ErrWorkerDocumentVerified
+		}
+	}
+
+	content := &sizeLimitedReader{reader: upload.Content, limit: maxDocumentSize}
+	contentType, reader, err := sniffContentType(content)
+	if err != nil {
+		return Document{}, err
+	}
+
+	blobKey, err := documentBlobKey(workerId, contentType)
+	if err != nil {
+		return Document{}, err
+	}
+
+	err = kycS.blobStore.Put(ctx, blobKey, contentType, reader)
+	if err != nil {
+		return Document{}, err
+	}
+
+	createdDocument, err := kycS.documentRepo.CreateWorkerDocument(ctx, repo.WorkerDocument{
+		WorkerID:     workerId,
+		DocumentType: repo.WorkerDocumentType(upload.Type),
+		MaskedNumber: maskedNumber,
+		BlobKey:      blobKey,
+		FileName:     cleanFileName(upload.FileName),
+		ContentType:  contentType,
+		Size:         content.read,
+	})
+	if err != nil {
+		// the file is useless without its row
+		deleteErr := kycS.blobStore.Delete(ctx, blobKey)
+		if deleteErr != nil {
+			logger.Errorw(ctx, "failed to delete orphaned document file", zap.Error(deleteErr), zap.String("blob_key", blobKey))
+		}
+		return Document{}, err
+	}
+
+	return MapDocumentRepoToService(createdDocument), nil
+}
+
+func (kycS *kycService) FetchWorkerDocuments(ctx context.Context, workerId int) ([]Document, error) {
+	if !kycS.workerRepo.FindWorkerById(ctx, workerId) {
+		return []Document{}, apperrors.ErrNoWorkerExists
+	}
+
+	documents, err := kycS.documentRepo.FetchWorkerDocuments(ctx, workerId)
+	if err != nil {
+		return []Document{}, err
+	}
+	return mapDocuments(documents), nil
+}
+
+// FetchDocuments lists the documents with a status, the pending ones by default which makes up
+// the review queue of the admins
+func (kycS *kycService) FetchDocuments(ctx context.Context, status string) ([]Document, error) {
+	documentStatus, err := parseStatus(status)
+	if err != nil {
+		return []Document{}, err
+	}
+
+	documents, err := kycS.documentRepo.FetchWorkerDocumentsByStatus(ctx, documentStatus)
+	if err != nil {
+		return []Document{}, err
+	}
+	return mapDocuments(documents), nil
+}
+
+func (kycS *kycService) FetchDocumentById(ctx context.Context, documentId int) (Document, error) {
+	document, err := kycS.documentRepo.FetchWorkerDocumentById(ctx, documentId)
+	if err != nil {
+		return Document{}, err
+	}
+	return MapDocumentRepoToService(document), nil
+}
+
+// ApproveDocument approves a pending document, which adds its badge to the worker profile
+func (kycS *kycService) ApproveDocument(ctx context.Context, documentId int, reviewerId int) (Document, error) {
+	document, err := kycS.documentRepo.ReviewWorkerDocument(ctx, documentId, repo.VerificationApproved, "", reviewerId)
+	if err != nil {
+		return Document{}, err
+	}
+	return MapDocumentRepoToService(document), nil
+}
+
+// RejectDocument rejects a pending document with the reason shown to the worker, who can then
+// upload the document again
+func (kycS *kycService) RejectDocument(ctx context.Context, documentId int, reviewerId int, review Review) (Document, error) {
+	reason := strings.TrimSpace(review.Reason)
+	if reason == "" || len(reason) > maxReasonLength {
+		return Document{}, fmt.Errorf("%w: a reason of at most %d characters is required to reject a document", apperrors.ErrInvalidWorkerDocument, maxReasonLength)
+	}
+
+	document, err := kycS.documentRepo.ReviewWorkerDocument(ctx, documentId, repo.VerificationRejected, reason, reviewerId)
+	if err != nil {
+		return Document{}, err
+	}
+	return MapDocumentRepoToService(document), nil
+}
+
+// OpenDocumentFile opens the stored file of a document, it is only meant for admins
+func (kycS *kycService) OpenDocumentFile(ctx context.Context, documentId int) (File, error) {
+	document, err := kycS.documentRepo.FetchWorkerDocumentById(ctx, documentId)
+	if err != nil {
+		return File{}, err
+	}
+
+	content, err := kycS.blobStore.Get(ctx, document.BlobKey)
+	if err != nil {
+		if errors.Is(err, apperrors.ErrBlobNotFound) {
+			logger.Errorw(ctx, "document file is missing", zap.Int("document_id", documentId), zap.String("blob_key", document.BlobKey))
+		}
+		return File{}, err
+	}
+
+	return File{
+		Name:        document.FileName,
+		ContentType: document.ContentType,
+		Size:        document.Size,
+		Content:     content,
+	}, nil
+}
+
+func mapDocuments(documents []repo.WorkerDocument) []Document {
+	mappedDocuments := make([]Document, 0)
+	for _, document := range documents {
+		mappedDocuments = append(mappedDocuments, MapDocumentRepoToService(document))
+	}
+	return mappedDocuments
+}
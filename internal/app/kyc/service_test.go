@@ -0,0 +1,256 @@
+package kyc
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/blobstore"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type KYCServiceTestSuite struct {
+	suite.Suite
+	service      Service
+	documentRepo mocks.WorkerDocumentStorer
+	workerRepo   mocks.WorkerStorer
+	blobStore    blobstore.BlobStore
+}
+
+var uploadedAt = time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC)
+
+func (suite *KYCServiceTestSuite) SetupTest() {
+	suite.documentRepo = mocks.WorkerDocumentStorer{}
+	suite.workerRepo = mocks.WorkerStorer{}
+	suite.blobStore = blobstore.NewLocalStore(suite.T().TempDir())
+	suite.service = NewService(&suite.documentRepo, &suite.workerRepo, suite.blobStore)
+}
+
+func (suite *KYCServiceTestSuite) TearDownTest() {
+	suite.documentRepo.AssertExpectations(suite.T())
+	suite.workerRepo.AssertExpectations(suite.T())
+}
+
+func TestKYCServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(KYCServiceTestSuite))
+}
+
+func (suite *KYCServiceTestSuite) TestUploadDocument() {
+	type testCase struct {
+		name           string
+		input          Upload
+		setup          func()
+		expectedOutput Document
+		expectedError  error
+	}
+
+	pdf := []byte("%PDF-1.4\n1 0 obj << /Type /Catalog >> endobj\n")
+	created := repo.WorkerDocument{ID: 1, WorkerID: 3, WorkerName: "Ramesh", DocumentType: repo.AadhaarDocument, MaskedNumber: "XXXX-XXXX-1234", FileName: "aadhaar.pdf", ContentType: "application/pdf", Size: int64(len(pdf)), Status: repo.VerificationPending, UploadedAt: uploadedAt}
+
+	testCases := []testCase{
+		{
+			name:  "first aadhaar upload",
+			input: Upload{Type: Aadhaar, Number: " 1234 ", FileName: "C:\\scans\\aadhaar.pdf", Content: bytes.NewReader(pdf)},
+			setup: func() {
+				suite.workerRepo.On("FindWorkerById", mock.Anything, 3).Return(true)
+				suite.documentRepo.On("FetchWorkerDocuments", mock.Anything, 3).Return([]repo.WorkerDocument{{ID: 7, DocumentType: repo.AadhaarDocument, Status: repo.VerificationRejected}}, nil)
+				suite.documentRepo.On("CreateWorkerDocument", mock.Anything, mock.MatchedBy(func(document repo.WorkerDocument) bool {
+					return document.WorkerID == 3 && document.DocumentType == repo.AadhaarDocument && document.MaskedNumber == "XXXX-XXXX-1234" &&
+						document.FileName == "aadhaar.pdf" && document.ContentType == "application/pdf" && document.Size == int64(len(pdf)) && suite.storedContent(document.BlobKey, pdf)
+				})).Return(created, nil)
+			},
+			expectedOutput: Document{ID: 1, WorkerID: 3, WorkerName: "Ramesh", Type: Aadhaar, MaskedNumber: "XXXX-XXXX-1234", FileName: "aadhaar.pdf", ContentType: "application/pdf", Size: int64(len(pdf)), Status: Pending, UploadedAt: uploadedAt},
+			expectedError:  nil,
+		},
+		{
+			name:  "document of the type waiting for review",
+			input: Upload{Type: Aadhaar, Number: "1234", Content: bytes.NewReader(pdf)},
+			setup: func() {
+				suite.workerRepo.On("FindWorkerById", mock.Anything, 3).Return(true)
+				suite.documentRepo.On("FetchWorkerDocuments", mock.Anything, 3).Return([]repo.WorkerDocument{{ID: 7, DocumentType: repo.AadhaarDocument, Status: repo.VerificationPending}}, nil)
+			},
+			expectedError: apperrors.ErrWorkerDocumentPending,
+		},
+		{
+			name:  "document of the type already verified",
+			input: Upload{Type: ITICertificate, Number: "ITI/2019/4471", Content: bytes.NewReader(pdf)},
+			setup: func() {
+				suite.workerRepo.On("FindWorkerById", mock.Anything, 3).Return(true)
+				suite.documentRepo.On("FetchWorkerDocuments", mock.Anything, 3).Return([]repo.WorkerDocument{{ID: 7, DocumentType: repo.ITICertificateDocument, Status: repo.VerificationApproved}}, nil)
+			},
+			expectedError: apperrors.ErrWorkerDocumentVerified,
+		},
+		{
+			name:  "unsupported file",
+			input: Upload{Type: EShram, Number: "1002 3004 5006", Content: bytes.NewReader([]byte("plain text, not a scan"))},
+			setup: func() {
+				suite.workerRepo.On("FindWorkerById", mock.Anything, 3).Return(true)
+				suite.documentRepo.On("FetchWorkerDocuments", mock.Anything, 3).Return([]repo.WorkerDocument{}, nil)
+			},
+			expectedError: apperrors.ErrUnsupportedDocumentType,
+		},
+		{
+			name:  "file larger than the limit",
+			input: Upload{Type: EShram, Number: "100230045006", Content: io.MultiReader(bytes.NewReader(pdf), bytes.NewReader(make([]byte, maxDocumentSize)))},
+			setup: func() {
+				suite.workerRepo.On("FindWorkerById", mock.Anything, 3).Return(true)
+				suite.documentRepo.On("FetchWorkerDocuments", mock.Anything, 3).Return([]repo.WorkerDocument{}, nil)
+			},
+			expectedError: apperrors.ErrDocumentTooLarge,
+		},
+		{
+			name:          "full aadhaar number",
+			input:         Upload{Type: Aadhaar, Number: "1234 5678 9012", Content: bytes.NewReader(pdf)},
+			setup:         func() {},
+			expectedError: apperrors.ErrInvalidWorkerDocument,
+		},
+		{
+			name:  "unknown worker",
+			input: Upload{Type: Aadhaar, Number: "1234", Content: bytes.NewReader(pdf)},
+			setup: func() {
+				suite.workerRepo.On("FindWorkerById", mock.Anything, 3).Return(false)
+			},
+			expectedError: apperrors.ErrNoWorkerExists,
+		},
+	}
+
+	for _, test := range testCases {
+		suite.SetupTest()
+		suite.Run(test.name, func() {
+			test.setup()
+
+			document, err := suite.service.UploadDocument(context.Background(), 3, test.input)
+
+			suite.ErrorIs(err, test.expectedError)
+			if test.expectedError == nil {
+				suite.Equal(test.expectedOutput, document)
+			}
+		})
+		suite.TearDownTest()
+	}
+}
+
+func (suite *KYCServiceTestSuite) TestUploadDocumentNotSaved() {
+	errSave := errors.New("connection refused")
+	var blobKey string
+
+	suite.workerRepo.On("FindWorkerById", mock.Anything, 3).Return(true)
+	suite.documentRepo.On("FetchWorkerDocuments", mock.Anything, 3).Return([]repo.WorkerDocument{}, nil)
+	suite.documentRepo.On("CreateWorkerDocument", mock.Anything, mock.Anything).Return(repo.WorkerDocument{}, errSave).Run(func(args mock.Arguments) {
+		blobKey = args.Get(1).(repo.WorkerDocument).BlobKey
+	})
+
+	_, err := suite.service.UploadDocument(context.Background(), 3, Upload{Type: Aadhaar, Number: "1234", Content: bytes.NewReader([]byte("%PDF-1.4\n"))})
+	suite.ErrorIs(err, errSave)
+
+	// the file is removed with its document
+	_, err = suite.blobStore.Get(context.Background(), blobKey)
+	suite.ErrorIs(err, apperrors.ErrBlobNotFound)
+}
+
+func (suite *KYCServiceTestSuite) TestReviewDocument() {
+	type testCase struct {
+		name           string
+		review         func() (Document, error)
+		setup          func()
+		expectedStatus Status
+		expectedError  error
+	}
+
+	reviewedAt := uploadedAt.Add(time.Hour)
+
+	testCases := []testCase{
+		{
+			name: "approved",
+			review: func() (Document, error) {
+				return suite.service.ApproveDocument(context.Background(), 1, 9)
+			},
+			setup: func() {
+				suite.documentRepo.On("ReviewWorkerDocument", mock.Anything, 1, repo.VerificationApproved, "", 9).Return(repo.WorkerDocument{ID: 1, Status: repo.VerificationApproved, ReviewedBy: 9, ReviewedAt: &reviewedAt}, nil)
+			},
+			expectedStatus: Approved,
+			expectedError:  nil,
+		},
+		{
+			name: "rejected with a reason",
+			review: func() (Document, error) {
+				return suite.service.RejectDocument(context.Background(), 1, 9, Review{Reason: " photo is blurred "})
+			},
+			setup: func() {
+				suite.documentRepo.On("ReviewWorkerDocument", mock.Anything, 1, repo.VerificationRejected, "photo is blurred", 9).Return(repo.WorkerDocument{ID: 1, Status: repo.VerificationRejected, Reason: "photo is blurred", ReviewedBy: 9, ReviewedAt: &reviewedAt}, nil)
+			},
+			expectedStatus: Rejected,
+			expectedError:  nil,
+		},
+		{
+			name: "rejected without a reason",
+			review: func() (Document, error) {
+				return suite.service.RejectDocument(context.Background(), 1, 9, Review{Reason: "  "})
+			},
+			setup:         func() {},
+			expectedError: apperrors.ErrInvalidWorkerDocument,
+		},
+		{
+			name: "already reviewed",
+			review: func() (Document, error) {
+				return suite.service.ApproveDocument(context.Background(), 1, 9)
+			},
+			setup: func() {
+				suite.documentRepo.On("ReviewWorkerDocument", mock.Anything, 1, repo.VerificationApproved, "", 9).Return(repo.WorkerDocument{}, apperrors.ErrDocumentAlreadyReviewed)
+			},
+			expectedError: apperrors.ErrDocumentAlreadyReviewed,
+		},
+	}
+
+	for _, test := range testCases {
+		suite.SetupTest()
+		suite.Run(test.name, func() {
+			test.setup()
+
+			document, err := test.review()
+
+			suite.ErrorIs(err, test.expectedError)
+			if test.expectedError == nil {
+				suite.Equal(test.expectedStatus, document.Status)
+			}
+		})
+		suite.TearDownTest()
+	}
+}
+
+func (suite *KYCServiceTestSuite) TestOpenDocumentFile() {
+	content := []byte("\x89PNG\r\n\x1a\nscan")
+	err := suite.blobStore.Put(context.Background(), "worker-documents/3/abc.png", "image/png", bytes.NewReader(content))
+	suite.Require().NoError(err)
+
+	suite.documentRepo.On("FetchWorkerDocumentById", mock.Anything, 1).Return(repo.WorkerDocument{ID: 1, BlobKey: "worker-documents/3/abc.png", FileName: "eshram.png", ContentType: "image/png", Size: int64(len(content))}, nil)
+
+	file, err := suite.service.OpenDocumentFile(context.Background(), 1)
+	suite.Require().NoError(err)
+	defer file.Content.Close()
+
+	stored, err := io.ReadAll(file.Content)
+	suite.NoError(err)
+	suite.Equal(content, stored)
+	suite.Equal("eshram.png", file.Name)
+	suite.Equal("image/png", file.ContentType)
+}
+
+// storedContent checks the file stored under a key while the document is being created
+func (suite *KYCServiceTestSuite) storedContent(key string, expected []byte) bool {
+	file, err := suite.blobStore.Get(context.Background(), key)
+	if err != nil {
+		return false
+	}
+	defer file.Close()
+
+	content, err := io.ReadAll(file)
+	return err == nil && bytes.Equal(content, expected)
+}
@@ -0,0 +1,42 @@
+package media
+
+import (
+	"io"
+	"time"
+)
+
+type OwnerType string
+
+const (
+	Worker   OwnerType = "worker"
+	Employer OwnerType = "employer"
+	Job      OwnerType = "job"
+)
+
+// Owner is the worker, employer or job an image belongs to, workers and employers have a single
+// profile photo while jobs have a few images
+type Owner struct {
+	Type OwnerType
+	ID   int
+}
+
+// Media is an uploaded image, its links are signed and stop working at URLExpiresAt
+type Media struct {
+	ID           int       `json:"id"`
+	OwnerType    OwnerType `json:"owner_type"`
+	OwnerID      int       `json:"owner_id"`
+	ContentType  string    `json:"content_type"`
+	Size         int64     `json:"size"`
+	Width        int       `json:"width"`
+	Height       int       `json:"height"`
+	URL          string    `json:"url"`
+	ThumbnailURL string    `json:"thumbnail_url"`
+	URLExpiresAt time.Time `json:"url_expires_at"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// File is a stored image or thumbnail, the caller has to close its content
+type File struct {
+	ContentType string
+	Content     io.ReadCloser
+}
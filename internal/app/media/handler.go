@@ -0,0 +1,234 @@
+package media
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/logger"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/middleware"
+	"go.uber.org/zap"
+)
+
+// room for the multipart boundaries and headers around the image
+const multipartOverhead = 64 << 10
+
+// UploadMedia reads the image from the file field of a multipart form, the owner id comes from
+// the path variable named after the owner type (worker_id, employer_id or job_id)
+func UploadMedia(mediaService Service, ownerType OwnerType) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		owner, id := ownerFromPath(ctx, w, r, ownerType, apperrors.ErrUploadMedia)
+		if owner.ID == -1 {
+			return
+		}
+
+		r.Body = http.MaxBytesReader(w, r.Body, maxImageSize+multipartOverhead)
+		file, err := multipartFile(r)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrInvalidRequestBody.Error(), zap.Error(err))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrInvalidRequestBody.Error()+": "+err.Error(), mediaErrorStatusCode(err, http.StatusBadRequest))
+			return
+		}
+
+		media, err := mediaService.UploadMedia(ctx, owner, file)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrUploadMedia.Error(), zap.Error(err), zap.String("ID", id))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrUploadMedia.Error()+": "+err.Error(), mediaErrorStatusCode(err, http.StatusInternalServerError))
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, "image uploaded successfully", http.StatusCreated, media)
+	}
+}
+
+func FetchMedia(mediaService Service, ownerType OwnerType) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		owner, id := ownerFromPath(ctx, w, r, ownerType, apperrors.ErrFetchMedia)
+		if owner.ID == -1 {
+			return
+		}
+
+		media, err := mediaService.FetchMedia(ctx, owner)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrFetchMedia.Error(), zap.Error(err), zap.String("ID", id))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrFetchMedia.Error()+", "+err.Error(), mediaErrorStatusCode(err, http.StatusInternalServerError))
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, "images retrieved successfully", http.StatusOK, media)
+	}
+}
+
+// FetchPhoto returns the profile photo of a worker or employer
+func FetchPhoto(mediaService Service, ownerType OwnerType) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		owner, id := ownerFromPath(ctx, w, r, ownerType, apperrors.ErrFetchMedia)
+		if owner.ID == -1 {
+			return
+		}
+
+		media, err := mediaService.FetchMedia(ctx, owner)
+		if err == nil && len(media) == 0 {
+			err = apperrors.ErrNoMediaExists
+		}
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrFetchMedia.Error(), zap.Error(err), zap.String("ID", id))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrFetchMedia.Error()+", "+err.Error(), mediaErrorStatusCode(err, http.StatusInternalServerError))
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, "photo retrieved successfully", http.StatusOK, media[len(media)-1])
+	}
+}
+
+// DeletePhoto removes the profile photo of a worker or employer
+func DeletePhoto(mediaService Service, ownerType OwnerType) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		owner, id := ownerFromPath(ctx, w, r, ownerType, apperrors.ErrDeleteMedia)
+		if owner.ID == -1 {
+			return
+		}
+
+		err := mediaService.DeleteOwnerMedia(ctx, owner)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrDeleteMedia.Error(), zap.Error(err), zap.String("ID", id))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrDeleteMedia.Error()+", "+err.Error(), mediaErrorStatusCode(err, http.StatusInternalServerError))
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, "photo deleted successfully", http.StatusOK, owner.ID)
+	}
+}
+
+func DeleteMedia(mediaService Service, ownerType OwnerType) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		owner, _ := ownerFromPath(ctx, w, r, ownerType, apperrors.ErrDeleteMedia)
+		if owner.ID == -1 {
+			return
+		}
+
+		mediaId, id := isPathIdValid(ctx, w, r, "media_id", apperrors.MsgInvalidMediaId, apperrors.ErrDeleteMedia)
+		if mediaId == -1 {
+			return
+		}
+
+		err := mediaService.DeleteMedia(ctx, owner, mediaId)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrDeleteMedia.Error(), zap.Error(err), zap.String("ID", id))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrDeleteMedia.Error()+", "+err.Error(), mediaErrorStatusCode(err, http.StatusInternalServerError))
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, "image deleted successfully", http.StatusOK, mediaId)
+	}
+}
+
+// ServeFile serves an image or thumbnail through a signed link, no authentication is needed as
+// the signature grants access until the link expires
+func ServeFile(mediaService Service) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		key := mux.Vars(r)["key"]
+		query := r.URL.Query()
+		file, err := mediaService.OpenFile(ctx, key, query.Get("expires"), query.Get("signature"))
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrFetchMediaFile.Error(), zap.Error(err), zap.String("key", key))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrFetchMediaFile.Error()+", "+err.Error(), mediaErrorStatusCode(err, http.StatusInternalServerError))
+			return
+		}
+		defer file.Content.Close()
+
+		w.Header().Set("Content-Type", file.ContentType)
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+		// the link itself expires, caches must not keep the image longer than that
+		w.Header().Set("Cache-Control", "private, max-age="+strconv.Itoa(int(urlLifetime.Seconds())))
+		w.WriteHeader(http.StatusOK)
+
+		_, err = io.Copy(w, file.Content)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrFetchMediaFile.Error(), zap.Error(err), zap.String("key", key))
+		}
+	}
+}
+
+// multipartFile returns the file field of a multipart form without buffering it
+func multipartFile(r *http.Request) (io.Reader, error) {
+	reader, err := r.MultipartReader()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		part, err := reader.NextPart()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, errors.New("expected a file field")
+			}
+			return nil, err
+		}
+		if part.FormName() == "file" {
+			return part, nil
+		}
+	}
+}
+
+func ownerFromPath(ctx context.Context, w http.ResponseWriter, r *http.Request, ownerType OwnerType, errType error) (Owner, string) {
+	invalidMsg := apperrors.MsgInvalidJobId
+	switch ownerType {
+	case Worker:
+		invalidMsg = apperrors.MsgInvalidWorkerId
+	case Employer:
+		invalidMsg = apperrors.MsgInvalidEmployerId
+	}
+
+	ownerId, id := isPathIdValid(ctx, w, r, string(ownerType)+"_id", invalidMsg, errType)
+	return Owner{Type: ownerType, ID: ownerId}, id
+}
+
+func isPathIdValid(ctx context.Context, w http.ResponseWriter, r *http.Request, key string, invalidMsg string, errType error) (int, string) {
+	vars := mux.Vars(r)
+	id := vars[key]
+	pathId, err := strconv.Atoi(id)
+	if err != nil {
+		logger.Errorw(ctx, invalidMsg, zap.Error(err), zap.String("ID", id))
+		httpResponseMsg := apperrors.HttpErrorResponseMessage(errType.Error(), invalidMsg, id)
+		middleware.HandleErrorResponse(ctx, w, httpResponseMsg, http.StatusBadRequest)
+		return -1, id
+	}
+	return pathId, id
+}
+
+// mediaErrorStatusCode maps the known errors, other errors get the fallback status code
+func mediaErrorStatusCode(err error, fallback int) int {
+	var maxBytesErr *http.MaxBytesError
+	switch {
+	case errors.As(err, &maxBytesErr), errors.Is(err, apperrors.ErrMediaTooLarge):
+		return http.StatusRequestEntityTooLarge
+	case errors.Is(err, apperrors.ErrInvalidMedia):
+		return http.StatusBadRequest
+	case errors.Is(err, apperrors.ErrUnsupportedMediaType):
+		return http.StatusUnsupportedMediaType
+	case errors.Is(err, apperrors.ErrInvalidMediaSignature), errors.Is(err, apperrors.ErrMediaURLExpired):
+		return http.StatusForbidden
+	case errors.Is(err, apperrors.ErrNoMediaExists), errors.Is(err, apperrors.ErrBlobNotFound), errors.Is(err, apperrors.ErrNoWorkerExists),
+		errors.Is(err, apperrors.ErrNoEmployerExists), errors.Is(err, apperrors.ErrNoJobExists):
+		return http.StatusNotFound
+	case errors.Is(err, apperrors.ErrMediaLimitReached):
+		return http.StatusConflict
+	}
+	return fallback
+}
@@ -0,0 +1,159 @@
+package media
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"image"
+	"image/color"
+	"image/jpeg"
+	_ "image/png"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/blobstore"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo"
+)
+
+const (
+	maxImageSize     = 5 << 20
+	maxImagePixels   = 25_000_000
+	maxJobImages     = 6
+	thumbnailSize    = 320
+	thumbnailType    = "image/jpeg"
+	thumbnailQuality = 80
+	urlLifetime      = 15 * time.Minute
+)
+
+// extensions of the sniffed content types, the name sent by the client is never trusted
+var imageExtensions = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+}
+
+func MapMediaRepoToService(media repo.Media, signer blobstore.URLSigner, now time.Time) Media {
+	expiresAt := now.Add(urlLifetime).Truncate(time.Second)
+	return Media{
+		ID:           media.ID,
+		OwnerType:    OwnerType(media.OwnerType),
+		OwnerID:      media.OwnerID,
+		ContentType:  media.ContentType,
+		Size:         media.Size,
+		Width:        media.Width,
+		Height:       media.Height,
+		URL:          signer.Sign(media.BlobKey, expiresAt),
+		ThumbnailURL: signer.Sign(media.ThumbnailKey, expiresAt),
+		URLExpiresAt: expiresAt,
+		CreatedAt:    media.CreatedAt,
+	}
+}
+
+// readImage reads an uploaded image up to the size limit and detects its type from its content
+func readImage(content io.Reader) ([]byte, string, error) {
+	data, err := io.ReadAll(io.LimitReader(content, maxImageSize+1))
+	if err != nil {
+		return nil, "", err
+	}
+	if len(data) == 0 {
+		return nil, "", fmt.Errorf("%w: the file is empty", apperrors.ErrInvalidMedia)
+	}
+	if len(data) > maxImageSize {
+		return nil, "", apperrors.ErrMediaTooLarge
+	}
+
+	contentType := http.DetectContentType(data)
+	if _, ok := imageExtensions[contentType]; !ok {
+		return nil, "", apperrors.ErrUnsupportedMediaType
+	}
+	return data, contentType, nil
+}
+
+// decodeImage decodes an image, the dimensions are checked first so that small files declaring
+// huge images are not decoded
+func decodeImage(data []byte) (image.Image, error) {
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", apperrors.ErrInvalidMedia, err.Error())
+	}
+	if config.Width <= 0 || config.Height <= 0 || config.Width*config.Height > maxImagePixels {
+		return nil, fmt.Errorf("%w: images must be at most %d megapixels", apperrors.ErrInvalidMedia, maxImagePixels/1_000_000)
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", apperrors.ErrInvalidMedia, err.Error())
+	}
+	return img, nil
+}
+
+// makeThumbnail scales an image down to fit a square of thumbnailSize pixels, averaging the
+// pixels each thumbnail pixel covers. Transparent pixels are laid on a white background as the
+// thumbnail is a jpeg.
+func makeThumbnail(img image.Image) ([]byte, error) {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+
+	thumbWidth, thumbHeight := width, height
+	if width > thumbnailSize || height > thumbnailSize {
+		if width >= height {
+			thumbWidth, thumbHeight = thumbnailSize, max(1, height*thumbnailSize/width)
+		} else {
+			thumbWidth, thumbHeight = max(1, width*thumbnailSize/height), thumbnailSize
+		}
+	}
+
+	thumb := image.NewRGBA(image.Rect(0, 0, thumbWidth, thumbHeight))
+	for y := 0; y < thumbHeight; y++ {
+		y0 := bounds.Min.Y + y*height/thumbHeight
+		y1 := max(y0+1, bounds.Min.Y+(y+1)*height/thumbHeight)
+		for x := 0; x < thumbWidth; x++ {
+			x0 := bounds.Min.X + x*width/thumbWidth
+			x1 := max(x0+1, bounds.Min.X+(x+1)*width/thumbWidth)
+
+			var red, green, blue, count uint64
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					r, g, b, a := img.At(sx, sy).RGBA()
+					// the colours are premultiplied by alpha, the rest is white
+					background := uint64(0xffff - a)
+					red += uint64(r) + background
+					green += uint64(g) + background
+					blue += uint64(b) + background
+					count++
+				}
+			}
+			thumb.Set(x, y, color.RGBA64{R: uint16(red / count), G: uint16(green / count), B: uint16(blue / count), A: 0xffff})
+		}
+	}
+
+	var encoded bytes.Buffer
+	err := jpeg.Encode(&encoded, thumb, &jpeg.Options{Quality: thumbnailQuality})
+	if err != nil {
+		return nil, err
+	}
+	return encoded.Bytes(), nil
+}
+
+// mediaBlobKeys are random keys of an image and its thumbnail, so keys cannot be guessed from ids
+func mediaBlobKeys(owner Owner, contentType string) (string, string, error) {
+	random := make([]byte, 16)
+	_, err := rand.Read(random)
+	if err != nil {
+		return "", "", err
+	}
+	prefix := fmt.Sprintf("media/%s/%d/%s", owner.Type, owner.ID, hex.EncodeToString(random))
+	return prefix + imageExtensions[contentType], prefix + "-thumbnail.jpg", nil
+}
+
+func ownerTypeRepo(ownerType OwnerType) repo.MediaOwnerType {
+	switch ownerType {
+	case Worker:
+		return repo.WorkerMedia
+	case Employer:
+		return repo.EmployerMedia
+	}
+	return repo.JobMedia
+}
@@ -0,0 +1,121 @@
+package media
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+)
+
+func encodePNG(width int, height int, fill color.Color) []byte {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			img.Set(x, y, fill)
+		}
+	}
+	var encoded bytes.Buffer
+	png.Encode(&encoded, img)
+	return encoded.Bytes()
+}
+
+func TestReadImage(t *testing.T) {
+	type testCase struct {
+		name                string
+		input               []byte
+		expectedContentType string
+		expectedError       error
+	}
+
+	testCases := []testCase{
+		{name: "png image", input: encodePNG(4, 4, color.White), expectedContentType: "image/png"},
+		{name: "pdf file", input: []byte("%PDF-1.4\n"), expectedError: apperrors.ErrUnsupportedMediaType},
+		{name: "empty file", input: []byte{}, expectedError: apperrors.ErrInvalidMedia},
+		{name: "file over the limit", input: append(encodePNG(4, 4, color.White), make([]byte, maxImageSize)...), expectedError: apperrors.ErrMediaTooLarge},
+	}
+
+	for _, test := range testCases {
+		_, contentType, err := readImage(bytes.NewReader(test.input))
+		if !errors.Is(err, test.expectedError) {
+			t.Errorf("%s: expected error %v, got %v", test.name, test.expectedError, err)
+			continue
+		}
+		if contentType != test.expectedContentType {
+			t.Errorf("%s: expected content type %q, got %q", test.name, test.expectedContentType, contentType)
+		}
+	}
+}
+
+func TestMakeThumbnail(t *testing.T) {
+	type testCase struct {
+		name           string
+		width          int
+		height         int
+		fill           color.Color
+		expectedWidth  int
+		expectedHeight int
+	}
+
+	testCases := []testCase{
+		{name: "landscape photo", width: 1280, height: 960, fill: color.RGBA{R: 200, G: 40, B: 40, A: 255}, expectedWidth: 320, expectedHeight: 240},
+		{name: "portrait photo", width: 600, height: 1200, fill: color.RGBA{R: 200, G: 40, B: 40, A: 255}, expectedWidth: 160, expectedHeight: 320},
+		{name: "small image keeps its size", width: 100, height: 50, fill: color.RGBA{R: 200, G: 40, B: 40, A: 255}, expectedWidth: 100, expectedHeight: 50},
+		{name: "transparent image on white", width: 400, height: 400, fill: color.RGBA{}, expectedWidth: 320, expectedHeight: 320},
+	}
+
+	for _, test := range testCases {
+		img, err := decodeImage(encodePNG(test.width, test.height, test.fill))
+		if err != nil {
+			t.Fatalf("%s: failed to decode image: %v", test.name, err)
+		}
+
+		thumbnail, err := makeThumbnail(img)
+		if err != nil {
+			t.Fatalf("%s: failed to make thumbnail: %v", test.name, err)
+		}
+
+		decoded, err := jpeg.Decode(bytes.NewReader(thumbnail))
+		if err != nil {
+			t.Fatalf("%s: thumbnail is not a jpeg: %v", test.name, err)
+		}
+		if decoded.Bounds().Dx() != test.expectedWidth || decoded.Bounds().Dy() != test.expectedHeight {
+			t.Errorf("%s: expected %dx%d thumbnail, got %dx%d", test.name, test.expectedWidth, test.expectedHeight, decoded.Bounds().Dx(), decoded.Bounds().Dy())
+		}
+
+		// jpeg compression shifts colours slightly
+		expectedRed, _, _, _ := color.RGBA64Model.Convert(test.fill).RGBA()
+		if _, _, _, alpha := test.fill.RGBA(); alpha == 0 {
+			expectedRed = 0xffff
+		}
+		red, _, _, _ := decoded.At(test.expectedWidth/2, test.expectedHeight/2).RGBA()
+		if diff := int(red) - int(expectedRed); diff > 0x0800 || diff < -0x0800 {
+			t.Errorf("%s: expected red %d in the thumbnail, got %d", test.name, expectedRed>>8, red>>8)
+		}
+	}
+}
+
+func TestDecodeImageRejectsHugeImages(t *testing.T) {
+	// a valid png header declaring a 10000x10000 image
+	header := encodePNG(1, 1, color.White)
+	huge := append([]byte{}, header...)
+	huge[16], huge[17], huge[18], huge[19] = 0, 0, 0x27, 0x10
+	huge[20], huge[21], huge[22], huge[23] = 0, 0, 0x27, 0x10
+	binary.BigEndian.PutUint32(huge[29:33], crc32.ChecksumIEEE(huge[12:29]))
+
+	_, _, err := image.DecodeConfig(bytes.NewReader(huge))
+	if err != nil {
+		t.Fatalf("expected a readable png header, got %v", err)
+	}
+
+	_, err = decodeImage(huge)
+	if !errors.Is(err, apperrors.ErrInvalidMedia) {
+		t.Errorf("expected ErrInvalidMedia, got %v", err)
+	}
+}
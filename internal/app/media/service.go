@@ -0,0 +1,236 @@
+package media
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"time"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/blobstore"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/logger"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo"
+	"go.uber.org/zap"
+)
+
+type mediaService struct {
+	mediaRepo    repo.MediaStorer
+	workerRepo   repo.WorkerStorer
+	employerRepo repo.EmployerStorer
+	jobRepo      repo.JobStorer
+	blobStore    blobstore.BlobStore
+	signer       blobstore.URLSigner
+	now          func() time.Time
+}
+
+type Service interface {
+	UploadMedia(ctx context.Context, owner Owner, content io.Reader) (Media, error)
+	FetchMedia(ctx context.Context, owner Owner) ([]Media, error)
+	DeleteMedia(ctx context.Context, owner Owner, mediaId int) error
+	DeleteOwnerMedia(ctx context.Context, owner Owner) error
+	OpenFile(ctx context.Context, key string, expires string, signature string) (File, error)
+}
+
+func NewService(mediaRepo repo.MediaStorer, workerRepo repo.WorkerStorer, employerRepo repo.EmployerStorer, jobRepo repo.JobStorer, blobStore blobstore.BlobStore, signer blobstore.URLSigner) Service {
+	return &mediaService{
+		mediaRepo:    mediaRepo,
+		workerRepo:   workerRepo,
+		employerRepo: employerRepo,
+		jobRepo:      jobRepo,
+		blobStore:    blobStore,
+		signer:       signer,
+		now:          time.Now,
+	}
+}
+
+// UploadMedia stores an image with its thumbnail. A new profile photo of a worker or employer
+// replaces the previous one, jobs can have up to maxJobImages images.
+func (ms *mediaService) UploadMedia(ctx context.Context, owner Owner, content io.Reader) (Media, error) {
+	err := ms.checkOwner(ctx, owner)
+	if err != nil {
+		return Media{}, err
+	}
+
+	data, contentType, err := readImage(content)
+	if err != nil {
+		return Media{}, err
+	}
+
+	img, err := decodeImage(data)
+	if err != nil {
+		return Media{}, err
+	}
+
+	thumbnail, err := makeThumbnail(img)
+	if err != nil {
+		return Media{}, err
+	}
+
+	blobKey, thumbnailKey, err := mediaBlobKeys(owner, contentType)
+	if err != nil {
+		return Media{}, err
+	}
+
+	err = ms.blobStore.Put(ctx, blobKey, contentType, bytes.NewReader(data))
+	if err != nil {
+		return Media{}, err
+	}
+	err = ms.blobStore.Put(ctx, thumbnailKey, thumbnailType, bytes.NewReader(thumbnail))
+	if err != nil {
+		ms.deleteFiles(ctx, repo.Media{BlobKey: blobKey})
+		return Media{}, err
+	}
+
+	media := repo.Media{
+		OwnerType:    ownerTypeRepo(owner.Type),
+		OwnerID:      owner.ID,
+		BlobKey:      blobKey,
+		ThumbnailKey: thumbnailKey,
+		ContentType:  contentType,
+		Size:         int64(len(data)),
+		Width:        img.Bounds().Dx(),
+		Height:       img.Bounds().Dy(),
+	}
+
+	var createdMedia repo.Media
+	replacedMedia := make([]repo.Media, 0)
+	if owner.Type == Job {
+		createdMedia, err = ms.mediaRepo.CreateMedia(ctx, media, maxJobImages)
+	} else {
+		createdMedia, replacedMedia, err = ms.mediaRepo.ReplaceMedia(ctx, media)
+	}
+	if err != nil {
+		// the files are useless without their row
+		ms.deleteFiles(ctx, media)
+		return Media{}, err
+	}
+
+	for _, replaced := range replacedMedia {
+		ms.deleteFiles(ctx, replaced)
+	}
+
+	return MapMediaRepoToService(createdMedia, ms.signer, ms.now()), nil
+}
+
+func (ms *mediaService) FetchMedia(ctx context.Context, owner Owner) ([]Media, error) {
+	err := ms.checkOwner(ctx, owner)
+	if err != nil {
+		return []Media{}, err
+	}
+
+	media, err := ms.mediaRepo.FetchMedia(ctx, ownerTypeRepo(owner.Type), owner.ID)
+	if err != nil {
+		return []Media{}, err
+	}
+
+	now := ms.now()
+	mappedMedia := make([]Media, 0)
+	for _, item := range media {
+		mappedMedia = append(mappedMedia, MapMediaRepoToService(item, ms.signer, now))
+	}
+	return mappedMedia, nil
+}
+
+func (ms *mediaService) DeleteMedia(ctx context.Context, owner Owner, mediaId int) error {
+	media, err := ms.mediaRepo.FetchMediaById(ctx, mediaId)
+	if err != nil {
+		return err
+	}
+	if media.OwnerType != ownerTypeRepo(owner.Type) || media.OwnerID != owner.ID {
+		return apperrors.ErrNoMediaExists
+	}
+
+	_, err = ms.mediaRepo.DeleteMedia(ctx, mediaId)
+	if err != nil {
+		return err
+	}
+
+	ms.deleteFiles(ctx, media)
+	return nil
+}
+
+// DeleteOwnerMedia removes every image of an owner, the files go first so that a failure leaves
+// the rows in place for a retry
+func (ms *mediaService) DeleteOwnerMedia(ctx context.Context, owner Owner) error {
+	media, err := ms.mediaRepo.FetchMedia(ctx, ownerTypeRepo(owner.Type), owner.ID)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range media {
+		err = ms.blobStore.Delete(ctx, item.BlobKey)
+		if err != nil {
+			return err
+		}
+		err = ms.blobStore.Delete(ctx, item.ThumbnailKey)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = ms.mediaRepo.DeleteOwnerMedia(ctx, ownerTypeRepo(owner.Type), owner.ID)
+	return err
+}
+
+// OpenFile opens the image or thumbnail behind a signed link, only keys of stored images can be
+// opened this way
+func (ms *mediaService) OpenFile(ctx context.Context, key string, expires string, signature string) (File, error) {
+	err := ms.signer.Verify(key, expires, signature, ms.now())
+	if err != nil {
+		return File{}, err
+	}
+
+	media, err := ms.mediaRepo.FetchMediaByBlobKey(ctx, key)
+	if err != nil {
+		return File{}, err
+	}
+
+	contentType := media.ContentType
+	if key == media.ThumbnailKey {
+		contentType = thumbnailType
+	}
+
+	content, err := ms.blobStore.Get(ctx, key)
+	if err != nil {
+		return File{}, err
+	}
+
+	return File{
+		ContentType: contentType,
+		Content:     content,
+	}, nil
+}
+
+func (ms *mediaService) checkOwner(ctx context.Context, owner Owner) error {
+	switch owner.Type {
+	case Worker:
+		if !ms.workerRepo.FindWorkerById(ctx, owner.ID) {
+			return apperrors.ErrNoWorkerExists
+		}
+	case Employer:
+		if !ms.employerRepo.FindEmployerById(ctx, owner.ID) {
+			return apperrors.ErrNoEmployerExists
+		}
+	case Job:
+		if !ms.jobRepo.FindJobById(ctx, owner.ID) {
+			return apperrors.ErrNoJobExists
+		}
+	default:
+		return apperrors.ErrInvalidMedia
+	}
+	return nil
+}
+
+// deleteFiles removes the files of an image that is no longer referenced, failures only leave
+// unreachable files behind so they are logged
+func (ms *mediaService) deleteFiles(ctx context.Context, media repo.Media) {
+	for _, key := range []string{media.BlobKey, media.ThumbnailKey} {
+		if key == "" {
+			continue
+		}
+		err := ms.blobStore.Delete(ctx, key)
+		if err != nil {
+			logger.Errorw(ctx, "failed to delete image file", zap.Error(err), zap.String("blob_key", key))
+		}
+	}
+}
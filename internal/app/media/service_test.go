@@ -0,0 +1,316 @@
+package media
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image/color"
+	"io"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/outbox"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/blobstore"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type MediaServiceTestSuite struct {
+	suite.Suite
+	service      Service
+	mediaRepo    mocks.MediaStorer
+	workerRepo   mocks.WorkerStorer
+	employerRepo mocks.EmployerStorer
+	jobRepo      mocks.JobStorer
+	blobStore    blobstore.BlobStore
+}
+
+var (
+	createdAt = time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC)
+	signer    = blobstore.NewURLSigner("http://localhost:8080/media/files", "secret")
+)
+
+func (suite *MediaServiceTestSuite) SetupTest() {
+	suite.mediaRepo = mocks.MediaStorer{}
+	suite.workerRepo = mocks.WorkerStorer{}
+	suite.employerRepo = mocks.EmployerStorer{}
+	suite.jobRepo = mocks.JobStorer{}
+	suite.blobStore = blobstore.NewLocalStore(suite.T().TempDir())
+	suite.service = &mediaService{
+		mediaRepo:    &suite.mediaRepo,
+		workerRepo:   &suite.workerRepo,
+		employerRepo: &suite.employerRepo,
+		jobRepo:      &suite.jobRepo,
+		blobStore:    suite.blobStore,
+		signer:       signer,
+		now: func() time.Time {
+			return createdAt
+		},
+	}
+}
+
+func (suite *MediaServiceTestSuite) TearDownTest() {
+	suite.mediaRepo.AssertExpectations(suite.T())
+	suite.workerRepo.AssertExpectations(suite.T())
+	suite.employerRepo.AssertExpectations(suite.T())
+	suite.jobRepo.AssertExpectations(suite.T())
+}
+
+func TestMediaServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(MediaServiceTestSuite))
+}
+
+func (suite *MediaServiceTestSuite) TestUploadMedia() {
+	type testCase struct {
+		name          string
+		owner         Owner
+		input         []byte
+		setup         func()
+		expectedError error
+	}
+
+	photo := encodePNG(640, 480, color.RGBA{R: 90, G: 120, B: 200, A: 255})
+	created := func(media repo.Media) repo.Media {
+		media.ID = 11
+		media.CreatedAt = createdAt
+		return media
+	}
+
+	testCases := []testCase{
+		{
+			name:  "worker photo replaces the previous one",
+			owner: Owner{Type: Worker, ID: 3},
+			input: photo,
+			setup: func() {
+				suite.Require().NoError(suite.blobStore.Put(context.Background(), "media/worker/3/old.png", "image/png", strings.NewReader("old")))
+				suite.workerRepo.On("FindWorkerById", mock.Anything, 3).Return(true)
+				suite.mediaRepo.On("ReplaceMedia", mock.Anything, mock.MatchedBy(func(media repo.Media) bool {
+					return media.OwnerType == repo.WorkerMedia && media.OwnerID == 3 && media.ContentType == "image/png" &&
+						media.Size == int64(len(photo)) && media.Width == 640 && media.Height == 480 &&
+						strings.HasPrefix(media.BlobKey, "media/worker/3/") && suite.stored(media.BlobKey) && suite.stored(media.ThumbnailKey)
+				})).Return(func(ctx context.Context, media repo.Media) repo.Media {
+					return created(media)
+				}, []repo.Media{{ID: 4, BlobKey: "media/worker/3/old.png", ThumbnailKey: "media/worker/3/old-thumbnail.jpg"}}, nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:  "job image",
+			owner: Owner{Type: Job, ID: 7},
+			input: photo,
+			setup: func() {
+				suite.jobRepo.On("FindJobById", mock.Anything, 7).Return(true)
+				suite.mediaRepo.On("CreateMedia", mock.Anything, mock.Anything, maxJobImages).Return(func(ctx context.Context, media repo.Media, limit int) repo.Media {
+					return created(media)
+				}, nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:  "job with all its images",
+			owner: Owner{Type: Job, ID: 7},
+			input: photo,
+			setup: func() {
+				suite.jobRepo.On("FindJobById", mock.Anything, 7).Return(true)
+				suite.mediaRepo.On("CreateMedia", mock.Anything, mock.Anything, maxJobImages).Return(repo.Media{}, apperrors.ErrMediaLimitReached)
+			},
+			expectedError: apperrors.ErrMediaLimitReached,
+		},
+		{
+			name:  "not an image",
+			owner: Owner{Type: Employer, ID: 5},
+			input: []byte("%PDF-1.4\n"),
+			setup: func() {
+				suite.employerRepo.On("FindEmployerById", mock.Anything, 5).Return(true)
+			},
+			expectedError: apperrors.ErrUnsupportedMediaType,
+		},
+		{
+			name:  "unknown employer",
+			owner: Owner{Type: Employer, ID: 5},
+			input: photo,
+			setup: func() {
+				suite.employerRepo.On("FindEmployerById", mock.Anything, 5).Return(false)
+			},
+			expectedError: apperrors.ErrNoEmployerExists,
+		},
+	}
+
+	for _, test := range testCases {
+		suite.SetupTest()
+		suite.Run(test.name, func() {
+			test.setup()
+
+			media, err := suite.service.UploadMedia(context.Background(), test.owner, bytes.NewReader(test.input))
+
+			suite.ErrorIs(err, test.expectedError)
+			if test.expectedError == nil {
+				suite.Equal(11, media.ID)
+				suite.Equal(createdAt.Add(urlLifetime), media.URLExpiresAt)
+				suite.True(strings.HasPrefix(media.URL, "http://localhost:8080/media/files/media/"+string(test.owner.Type)+"/"))
+				suite.True(strings.Contains(media.ThumbnailURL, "-thumbnail.jpg?"))
+			}
+			// replaced photos lose their files
+			suite.False(suite.stored("media/worker/3/old.png"))
+		})
+		suite.TearDownTest()
+	}
+}
+
+func (suite *MediaServiceTestSuite) TestUploadMediaNotSaved() {
+	errSave := errors.New("connection refused")
+	var media repo.Media
+
+	suite.workerRepo.On("FindWorkerById", mock.Anything, 3).Return(true)
+	suite.mediaRepo.On("ReplaceMedia", mock.Anything, mock.Anything).Return(repo.Media{}, []repo.Media{}, errSave).Run(func(args mock.Arguments) {
+		media = args.Get(1).(repo.Media)
+	})
+
+	_, err := suite.service.UploadMedia(context.Background(), Owner{Type: Worker, ID: 3}, bytes.NewReader(encodePNG(8, 8, color.White)))
+	suite.ErrorIs(err, errSave)
+
+	// the files are removed with their row
+	suite.False(suite.stored(media.BlobKey))
+	suite.False(suite.stored(media.ThumbnailKey))
+}
+
+func (suite *MediaServiceTestSuite) TestDeleteMedia() {
+	type testCase struct {
+		name          string
+		owner         Owner
+		setup         func()
+		expectedError error
+	}
+
+	media := repo.Media{ID: 11, OwnerType: repo.JobMedia, OwnerID: 7, BlobKey: "media/job/7/front.jpg", ThumbnailKey: "media/job/7/front-thumbnail.jpg"}
+
+	testCases := []testCase{
+		{
+			name:  "image of the job",
+			owner: Owner{Type: Job, ID: 7},
+			setup: func() {
+				suite.mediaRepo.On("FetchMediaById", mock.Anything, 11).Return(media, nil)
+				suite.mediaRepo.On("DeleteMedia", mock.Anything, 11).Return(media, nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:  "image of another job",
+			owner: Owner{Type: Job, ID: 8},
+			setup: func() {
+				suite.mediaRepo.On("FetchMediaById", mock.Anything, 11).Return(media, nil)
+			},
+			expectedError: apperrors.ErrNoMediaExists,
+		},
+	}
+
+	for _, test := range testCases {
+		suite.SetupTest()
+		suite.Run(test.name, func() {
+			for _, key := range []string{media.BlobKey, media.ThumbnailKey} {
+				suite.Require().NoError(suite.blobStore.Put(context.Background(), key, "image/jpeg", strings.NewReader("jpeg")))
+			}
+			test.setup()
+
+			err := suite.service.DeleteMedia(context.Background(), test.owner, 11)
+
+			suite.ErrorIs(err, test.expectedError)
+			suite.Equal(test.expectedError != nil, suite.stored(media.BlobKey))
+			suite.Equal(test.expectedError != nil, suite.stored(media.ThumbnailKey))
+		})
+		suite.TearDownTest()
+	}
+}
+
+func (suite *MediaServiceTestSuite) TestOutboxHandlerDeletesOwnerMedia() {
+	media := []repo.Media{{ID: 11, OwnerType: repo.WorkerMedia, OwnerID: 3, BlobKey: "media/worker/3/photo.png", ThumbnailKey: "media/worker/3/photo-thumbnail.jpg"}}
+	for _, key := range []string{media[0].BlobKey, media[0].ThumbnailKey} {
+		suite.Require().NoError(suite.blobStore.Put(context.Background(), key, "image/png", strings.NewReader("png")))
+	}
+
+	suite.mediaRepo.On("FetchMedia", mock.Anything, repo.WorkerMedia, 3).Return(media, nil)
+	suite.mediaRepo.On("DeleteOwnerMedia", mock.Anything, repo.WorkerMedia, 3).Return(media, nil)
+
+	handler := OutboxHandler(suite.service)
+	err := handler(context.Background(), outboxEvent("worker_deleted", 3))
+	suite.NoError(err)
+	suite.False(suite.stored(media[0].BlobKey))
+	suite.False(suite.stored(media[0].ThumbnailKey))
+
+	// other events are ignored
+	err = handler(context.Background(), outboxEvent("worker_registered", 3))
+	suite.NoError(err)
+}
+
+func (suite *MediaServiceTestSuite) TestOpenFile() {
+	media := repo.Media{ID: 11, OwnerType: repo.JobMedia, OwnerID: 7, BlobKey: "media/job/7/front.png", ThumbnailKey: "media/job/7/front-thumbnail.jpg", ContentType: "image/png"}
+	suite.Require().NoError(suite.blobStore.Put(context.Background(), media.ThumbnailKey, "image/jpeg", strings.NewReader("jpeg")))
+
+	type testCase struct {
+		name                string
+		link                string
+		setup               func()
+		expectedContentType string
+		expectedError       error
+	}
+
+	testCases := []testCase{
+		{
+			name: "signed thumbnail link",
+			link: signer.Sign(media.ThumbnailKey, createdAt.Add(time.Minute)),
+			setup: func() {
+				suite.mediaRepo.On("FetchMediaByBlobKey", mock.Anything, media.ThumbnailKey).Return(media, nil)
+			},
+			expectedContentType: "image/jpeg",
+			expectedError:       nil,
+		},
+		{
+			name:          "expired link",
+			link:          signer.Sign(media.ThumbnailKey, createdAt.Add(-time.Minute)),
+			setup:         func() {},
+			expectedError: apperrors.ErrMediaURLExpired,
+		},
+		{
+			name:          "link signed with another secret",
+			link:          blobstore.NewURLSigner("http://localhost:8080/media/files", "guess").Sign(media.ThumbnailKey, createdAt.Add(time.Minute)),
+			setup:         func() {},
+			expectedError: apperrors.ErrInvalidMediaSignature,
+		},
+	}
+
+	for _, test := range testCases {
+		suite.Run(test.name, func() {
+			test.setup()
+
+			link, _ := url.Parse(test.link)
+			key := strings.TrimPrefix(link.Path, "/media/files/")
+			file, err := suite.service.OpenFile(context.Background(), key, link.Query().Get("expires"), link.Query().Get("signature"))
+
+			suite.ErrorIs(err, test.expectedError)
+			if test.expectedError == nil {
+				content, _ := io.ReadAll(file.Content)
+				file.Content.Close()
+				suite.Equal("jpeg", string(content))
+				suite.Equal(test.expectedContentType, file.ContentType)
+			}
+		})
+	}
+}
+
+func (suite *MediaServiceTestSuite) stored(key string) bool {
+	file, err := suite.blobStore.Get(context.Background(), key)
+	if err != nil {
+		return false
+	}
+	file.Close()
+	return true
+}
+
+func outboxEvent(eventType string, workerId int) outbox.Event {
+	return outbox.Event{ID: 1, Type: outbox.EventType(eventType), WorkerID: workerId}
+}
@@ -0,0 +1,30 @@
+package media
+
+import (
+	"context"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/outbox"
+)
+
+// OutboxHandler removes the images of deleted workers, employers and jobs
+func OutboxHandler(mediaService Service) outbox.Handler {
+	return func(ctx context.Context, event outbox.Event) error {
+		owner, ok := ownerFromOutbox(event)
+		if !ok {
+			return nil
+		}
+		return mediaService.DeleteOwnerMedia(ctx, owner)
+	}
+}
+
+func ownerFromOutbox(event outbox.Event) (Owner, bool) {
+	switch event.Type {
+	case outbox.WorkerDeleted:
+		return Owner{Type: Worker, ID: event.WorkerID}, true
+	case outbox.EmployerDeleted:
+		return Owner{Type: Employer, ID: event.EmployerID}, true
+	case outbox.JobDeleted:
+		return Owner{Type: Job, ID: event.JobID}, true
+	}
+	return Owner{}, false
+}
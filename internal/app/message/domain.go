@@ -0,0 +1,66 @@
+package message
+
+import (
+	"time"
+)
+
+type Role string
+
+const (
+	WorkerRole   Role = "worker"
+	EmployerRole Role = "employer"
+)
+
+// Attachment is the metadata of a file shared in a thread, the file itself is uploaded separately
+type Attachment struct {
+	Name        string `json:"name"`
+	ContentType string `json:"content_type"`
+	Size        int64  `json:"size"`
+	URL         string `json:"url"`
+}
+
+type Message struct {
+	ID            int         `json:"id"`
+	ApplicationID int         `json:"application_id"`
+	SenderRole    Role        `json:"sender_role"`
+	SenderID      int         `json:"sender_id"`
+	Body          string      `json:"body"`
+	Attachment    *Attachment `json:"attachment,omitempty"`
+	CreatedAt     time.Time   `json:"created_at"`
+	ReadAt        *time.Time  `json:"read_at,omitempty"`
+}
+
+// Participant is the employer or the worker of an application reading or writing its thread
+type Participant struct {
+	Role Role `json:"role"`
+	ID   int  `json:"id"`
+}
+
+type ReadReceipt struct {
+	ApplicationID int       `json:"application_id"`
+	Reader        Role      `json:"reader"`
+	MarkedRead    int       `json:"marked_read"`
+	ReadAt        time.Time `json:"read_at"`
+}
+
+type Thread struct {
+	ApplicationID int       `json:"application_id"`
+	JobID         int       `json:"job_id"`
+	JobTitle      string    `json:"job_title"`
+	WorkerID      int       `json:"worker_id"`
+	EmployerID    int       `json:"employer_id"`
+	Status        string    `json:"status"`
+	Unread        int       `json:"unread"`
+	Messages      []Message `json:"messages"`
+}
+
+type ThreadSummary struct {
+	ApplicationID int        `json:"application_id"`
+	JobID         int        `json:"job_id"`
+	JobTitle      string     `json:"job_title"`
+	WorkerID      int        `json:"worker_id"`
+	EmployerID    int        `json:"employer_id"`
+	Status        string     `json:"status"`
+	LastMessageAt *time.Time `json:"last_message_at,omitempty"`
+	Unread        int        `json:"unread"`
+}
@@ -0,0 +1,156 @@
+package message
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/logger"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/middleware"
+	"go.uber.org/zap"
+)
+
+func SendMessage(messageService Service) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		applicationId, _ := isPathIdValid(ctx, w, r, "application_id", apperrors.MsgInvalidApplicationId, apperrors.ErrSendMessage)
+		if applicationId == -1 {
+			return
+		}
+
+		var message Message
+		err := json.NewDecoder(r.Body).Decode(&message)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrInvalidRequestBody.Error(), zap.Error(err))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrInvalidRequestBody.Error()+": "+err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		sentMessage, err := messageService.SendMessage(ctx, applicationId, currentParticipant(ctx), message)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrSendMessage.Error(), zap.Error(err))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrSendMessage.Error()+": "+err.Error(), messageErrorStatusCode(err))
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, "message sent successfully", http.StatusCreated, sentMessage)
+	}
+}
+
+// FetchThread returns the messages of an application to the worker or employer in the JWT
+func FetchThread(messageService Service) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		applicationId, id := isPathIdValid(ctx, w, r, "application_id", apperrors.MsgInvalidApplicationId, apperrors.ErrFetchMessages)
+		if applicationId == -1 {
+			return
+		}
+
+		thread, err := messageService.FetchThread(ctx, applicationId, currentParticipant(ctx))
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrFetchMessages.Error(), zap.Error(err), zap.String("ID", id))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrFetchMessages.Error()+", "+err.Error(), messageErrorStatusCode(err))
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, "messages retrieved successfully", http.StatusOK, thread)
+	}
+}
+
+func MarkThreadRead(messageService Service) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		applicationId, _ := isPathIdValid(ctx, w, r, "application_id", apperrors.MsgInvalidApplicationId, apperrors.ErrMarkMessagesRead)
+		if applicationId == -1 {
+			return
+		}
+
+		receipt, err := messageService.MarkThreadRead(ctx, applicationId, currentParticipant(ctx))
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrMarkMessagesRead.Error(), zap.Error(err))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrMarkMessagesRead.Error()+": "+err.Error(), messageErrorStatusCode(err))
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, "messages marked as read successfully", http.StatusOK, receipt)
+	}
+}
+
+func FetchWorkerThreads(messageService Service) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		workerId, id := isPathIdValid(ctx, w, r, "worker_id", apperrors.MsgInvalidWorkerId, apperrors.ErrFetchMessages)
+		if workerId == -1 {
+			return
+		}
+
+		threads, err := messageService.FetchWorkerThreads(ctx, workerId)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrFetchMessages.Error(), zap.Error(err), zap.String("ID", id))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrFetchMessages.Error()+", "+err.Error(), messageErrorStatusCode(err))
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, "message threads retrieved successfully", http.StatusOK, threads)
+	}
+}
+
+func FetchEmployerThreads(messageService Service) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		employerId, id := isPathIdValid(ctx, w, r, "employer_id", apperrors.MsgInvalidEmployerId, apperrors.ErrFetchMessages)
+		if employerId == -1 {
+			return
+		}
+
+		threads, err := messageService.FetchEmployerThreads(ctx, employerId)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrFetchMessages.Error(), zap.Error(err), zap.String("ID", id))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrFetchMessages.Error()+", "+err.Error(), messageErrorStatusCode(err))
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, "message threads retrieved successfully", http.StatusOK, threads)
+	}
+}
+
+// currentParticipant is the worker or employer reading or writing a thread, taken from the JWT
+func currentParticipant(ctx context.Context) Participant {
+	userId, _ := ctx.Value("user_id").(int)
+	role, _ := ctx.Value("role").(string)
+	return Participant{Role: Role(role), ID: userId}
+}
+
+func isPathIdValid(ctx context.Context, w http.ResponseWriter, r *http.Request, key string, invalidMsg string, errType error) (int, string) {
+	vars := mux.Vars(r)
+	id := vars[key]
+	pathId, err := strconv.Atoi(id)
+	if err != nil {
+		logger.Errorw(ctx, invalidMsg, zap.Error(err), zap.String("ID", id))
+		httpResponseMsg := apperrors.HttpErrorResponseMessage(errType.Error(), invalidMsg, id)
+		middleware.HandleErrorResponse(ctx, w, httpResponseMsg, http.StatusBadRequest)
+		return -1, id
+	}
+	return pathId, id
+}
+
+func messageErrorStatusCode(err error) int {
+	switch {
+	case errors.Is(err, apperrors.ErrInvalidMessage), errors.Is(err, apperrors.ErrInvalidAttachment), errors.Is(err, apperrors.ErrInvalidParticipant):
+		return http.StatusBadRequest
+	case errors.Is(err, apperrors.ErrNotThreadParticipant):
+		return http.StatusForbidden
+	case errors.Is(err, apperrors.ErrNoApplicationExists), errors.Is(err, apperrors.ErrNoWorkerExists), errors.Is(err, apperrors.ErrNoEmployerExists):
+		return http.StatusNotFound
+	}
+	return http.StatusInternalServerError
+}
@@ -0,0 +1,125 @@
+package message
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo"
+)
+
+const (
+	maxBodyLength     = 2000
+	maxAttachmentSize = 10 << 20
+	hiddenPhoneNumber = "[phone number hidden]"
+)
+
+var (
+	// phoneNumberPattern matches Indian mobile numbers, optionally prefixed with +91 or 0 and split by spaces or dashes
+	phoneNumberPattern = regexp.MustCompile(`(?:\+?91[\s-]?|0)?[6-9](?:[\s-]?\d){9}`)
+
+	attachmentTypes = []string{"image/", "application/pdf", "audio/"}
+)
+
+func MapMessageRepoToService(message repo.Message) Message {
+	serviceMessage := Message{
+		ID:            message.ID,
+		ApplicationID: message.ApplicationID,
+		SenderRole:    Role(message.SenderRole),
+		SenderID:      message.SenderID,
+		Body:          message.Body,
+		CreatedAt:     message.CreatedAt,
+		ReadAt:        message.ReadAt,
+	}
+
+	if message.AttachmentURL != "" {
+		serviceMessage.Attachment = &Attachment{
+			Name:        message.AttachmentName,
+			ContentType: message.AttachmentType,
+			Size:        message.AttachmentSize,
+			URL:         message.AttachmentURL,
+		}
+	}
+	return serviceMessage
+}
+
+func MapMessageServiceToRepo(message Message) repo.Message {
+	repoMessage := repo.Message{
+		ApplicationID: message.ApplicationID,
+		SenderRole:    string(message.SenderRole),
+		SenderID:      message.SenderID,
+		Body:          message.Body,
+	}
+
+	if message.Attachment != nil {
+		repoMessage.AttachmentName = message.Attachment.Name
+		repoMessage.AttachmentType = message.Attachment.ContentType
+		repoMessage.AttachmentSize = message.Attachment.Size
+		repoMessage.AttachmentURL = message.Attachment.URL
+	}
+	return repoMessage
+}
+
+func MapThreadRepoToSummary(thread repo.MessageThread) ThreadSummary {
+	return ThreadSummary{
+		ApplicationID: thread.ApplicationID,
+		JobID:         thread.JobID,
+		JobTitle:      thread.JobTitle,
+		WorkerID:      thread.WorkerID,
+		EmployerID:    thread.EmployerID,
+		Status:        string(thread.Status),
+		LastMessageAt: thread.LastMessageAt,
+		Unread:        thread.Unread,
+	}
+}
+
+func validateParticipant(participant Participant) error {
+	if participant.Role != WorkerRole && participant.Role != EmployerRole {
+		return apperrors.ErrInvalidParticipant
+	}
+	return nil
+}
+
+// isParticipant tells if the participant is the worker or the employer of the thread
+func isParticipant(thread repo.MessageThread, participant Participant) bool {
+	if participant.Role == WorkerRole {
+		return participant.ID == thread.WorkerID
+	}
+	return participant.ID == thread.EmployerID
+}
+
+// validateMessage checks that a message has a body or an attachment, and that the attachment
+// metadata describes a supported file within the size limit
+func validateMessage(message Message) error {
+	body := strings.TrimSpace(message.Body)
+	if body == "" && message.Attachment == nil {
+		return apperrors.ErrInvalidMessage
+	}
+	if len(body) > maxBodyLength {
+		return apperrors.ErrInvalidMessage
+	}
+
+	if message.Attachment == nil {
+		return nil
+	}
+
+	attachment := message.Attachment
+	if strings.TrimSpace(attachment.Name) == "" || strings.TrimSpace(attachment.URL) == "" {
+		return apperrors.ErrInvalidAttachment
+	}
+	if attachment.Size <= 0 || attachment.Size > maxAttachmentSize {
+		return apperrors.ErrInvalidAttachment
+	}
+	for _, prefix := range attachmentTypes {
+		if strings.HasPrefix(attachment.ContentType, prefix) {
+			return nil
+		}
+	}
+	return apperrors.ErrInvalidAttachment
+}
+
+// maskPhoneNumbers hides phone numbers written in a message, so that numbers are not exchanged
+// before the application is confirmed
+func maskPhoneNumbers(body string) string {
+	return phoneNumberPattern.ReplaceAllString(body, hiddenPhoneNumber)
+}
@@ -0,0 +1,26 @@
+package message
+
+import "testing"
+
+func TestMaskPhoneNumbers(t *testing.T) {
+	type testCase struct {
+		name           string
+		input          string
+		expectedOutput string
+	}
+
+	testCases := []testCase{
+		{name: "plain number", input: "call 9876543210", expectedOutput: "call [phone number hidden]"},
+		{name: "country code and spaces", input: "call +91 98765 43210 today", expectedOutput: "call [phone number hidden] today"},
+		{name: "dashes", input: "987-654-3210", expectedOutput: "[phone number hidden]"},
+		{name: "leading zero", input: "09876543210", expectedOutput: "[phone number hidden]"},
+		{name: "wage and dates are kept", input: "wage 800 from 2025-03-10 at pincode 411001", expectedOutput: "wage 800 from 2025-03-10 at pincode 411001"},
+	}
+
+	for _, test := range testCases {
+		output := maskPhoneNumbers(test.input)
+		if output != test.expectedOutput {
+			t.Errorf("%s: expected %q, got %q", test.name, test.expectedOutput, output)
+		}
+	}
+}
@@ -0,0 +1,163 @@
+package message
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo"
+)
+
+type messageService struct {
+	messageRepo  repo.MessageStorer
+	workerRepo   repo.WorkerStorer
+	employerRepo repo.EmployerStorer
+}
+
+type Service interface {
+	SendMessage(ctx context.Context, applicationId int, sender Participant, newMessage Message) (Message, error)
+	FetchThread(ctx context.Context, applicationId int, reader Participant) (Thread, error)
+	MarkThreadRead(ctx context.Context, applicationId int, reader Participant) (ReadReceipt, error)
+	FetchWorkerThreads(ctx context.Context, workerId int) ([]ThreadSummary, error)
+	FetchEmployerThreads(ctx context.Context, employerId int) ([]ThreadSummary, error)
+}
+
+func NewService(messageRepo repo.MessageStorer, workerRepo repo.WorkerStorer, employerRepo repo.EmployerStorer) Service {
+	return &messageService{
+		messageRepo:  messageRepo,
+		workerRepo:   workerRepo,
+		employerRepo: employerRepo,
+	}
+}
+
+// SendMessage adds a message from the sender to the thread of an application, phone numbers in the
+// message are hidden until the application is confirmed
+func (msgS *messageService) SendMessage(ctx context.Context, applicationId int, sender Participant, message Message) (Message, error) {
+	err := validateMessage(message)
+	if err != nil {
+		return Message{}, err
+	}
+
+	thread, err := msgS.participantThread(ctx, applicationId, sender)
+	if err != nil {
+		return Message{}, err
+	}
+
+	message.ApplicationID = applicationId
+	message.SenderRole = sender.Role
+	message.SenderID = sender.ID
+	message.Body = strings.TrimSpace(message.Body)
+	if thread.Status != repo.Confirmed {
+		message.Body = maskPhoneNumbers(message.Body)
+	}
+
+	createdMessage, err := msgS.messageRepo.CreateMessage(ctx, MapMessageServiceToRepo(message))
+	if err != nil {
+		return Message{}, err
+	}
+	return MapMessageRepoToService(createdMessage), nil
+}
+
+// FetchThread returns the messages of an application with the number of messages the reader has not read
+func (msgS *messageService) FetchThread(ctx context.Context, applicationId int, reader Participant) (Thread, error) {
+	thread, err := msgS.participantThread(ctx, applicationId, reader)
+	if err != nil {
+		return Thread{}, err
+	}
+
+	messages, err := msgS.messageRepo.FetchMessagesByApplicationId(ctx, applicationId)
+	if err != nil {
+		return Thread{}, err
+	}
+
+	serviceMessages := make([]Message, 0)
+	for _, message := range messages {
+		serviceMessages = append(serviceMessages, MapMessageRepoToService(message))
+	}
+
+	return Thread{
+		ApplicationID: thread.ApplicationID,
+		JobID:         thread.JobID,
+		JobTitle:      thread.JobTitle,
+		WorkerID:      thread.WorkerID,
+		EmployerID:    thread.EmployerID,
+		Status:        string(thread.Status),
+		Unread:        thread.Unread,
+		Messages:      serviceMessages,
+	}, nil
+}
+
+// MarkThreadRead marks the messages sent to the reader as read, the sender sees them through read_at
+func (msgS *messageService) MarkThreadRead(ctx context.Context, applicationId int, reader Participant) (ReadReceipt, error) {
+	_, err := msgS.participantThread(ctx, applicationId, reader)
+	if err != nil {
+		return ReadReceipt{}, err
+	}
+
+	marked, err := msgS.messageRepo.MarkMessagesRead(ctx, applicationId, string(reader.Role))
+	if err != nil {
+		return ReadReceipt{}, err
+	}
+
+	return ReadReceipt{
+		ApplicationID: applicationId,
+		Reader:        reader.Role,
+		MarkedRead:    marked,
+		ReadAt:        time.Now(),
+	}, nil
+}
+
+func (msgS *messageService) FetchWorkerThreads(ctx context.Context, workerId int) ([]ThreadSummary, error) {
+	exists := msgS.workerRepo.FindWorkerById(ctx, workerId)
+	if !exists {
+		return []ThreadSummary{}, apperrors.ErrNoWorkerExists
+	}
+
+	threads, err := msgS.messageRepo.FetchThreadsByWorkerId(ctx, workerId)
+	if err != nil {
+		return []ThreadSummary{}, err
+	}
+
+	summaries := make([]ThreadSummary, 0)
+	for _, thread := range threads {
+		summaries = append(summaries, MapThreadRepoToSummary(thread))
+	}
+	return summaries, nil
+}
+
+func (msgS *messageService) FetchEmployerThreads(ctx context.Context, employerId int) ([]ThreadSummary, error) {
+	exists := msgS.employerRepo.FindEmployerById(ctx, employerId)
+	if !exists {
+		return []ThreadSummary{}, apperrors.ErrNoEmployerExists
+	}
+
+	threads, err := msgS.messageRepo.FetchThreadsByEmployerId(ctx, employerId)
+	if err != nil {
+		return []ThreadSummary{}, err
+	}
+
+	summaries := make([]ThreadSummary, 0)
+	for _, thread := range threads {
+		summaries = append(summaries, MapThreadRepoToSummary(thread))
+	}
+	return summaries, nil
+}
+
+// participantThread fetches the thread of an application and checks that the participant is its worker or employer
+func (msgS *messageService) participantThread(ctx context.Context, applicationId int, participant Participant) (repo.MessageThread, error) {
+	err := validateParticipant(participant)
+	if err != nil {
+		return repo.MessageThread{}, err
+	}
+
+	thread, err := msgS.messageRepo.FetchMessageThread(ctx, applicationId, string(participant.Role))
+	if err != nil {
+		return repo.MessageThread{}, err
+	}
+
+	if !isParticipant(thread, participant) {
+		return repo.MessageThread{}, apperrors.ErrNotThreadParticipant
+	}
+	return thread, nil
+}
@@ -0,0 +1,293 @@
+package message
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type MessageServiceTestSuite struct {
+	suite.Suite
+	service      Service
+	messageRepo  mocks.MessageStorer
+	workerRepo   mocks.WorkerStorer
+	employerRepo mocks.EmployerStorer
+}
+
+func (suite *MessageServiceTestSuite) SetupTest() {
+	suite.messageRepo = mocks.MessageStorer{}
+	suite.workerRepo = mocks.WorkerStorer{}
+	suite.employerRepo = mocks.EmployerStorer{}
+	suite.service = NewService(&suite.messageRepo, &suite.workerRepo, &suite.employerRepo)
+}
+
+func (suite *MessageServiceTestSuite) TearDownTest() {
+	suite.messageRepo.AssertExpectations(suite.T())
+	suite.workerRepo.AssertExpectations(suite.T())
+	suite.employerRepo.AssertExpectations(suite.T())
+}
+
+func TestMessageServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(MessageServiceTestSuite))
+}
+
+func (suite *MessageServiceTestSuite) TestSendMessage() {
+	type testCase struct {
+		name           string
+		sender         Participant
+		input          Message
+		setup          func()
+		expectedOutput Message
+		expectedError  error
+	}
+
+	createdAt := time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC)
+	pendingThread := repo.MessageThread{ApplicationID: 1, JobID: 2, WorkerID: 5, EmployerID: 9, Status: repo.Pending}
+	confirmedThread := repo.MessageThread{ApplicationID: 1, JobID: 2, WorkerID: 5, EmployerID: 9, Status: repo.Confirmed}
+
+	testCases := []testCase{
+		{
+			name:   "phone number hidden before confirmation",
+			sender: Participant{Role: EmployerRole, ID: 9},
+			input:  Message{Body: "call me on +91 98765 43210"},
+			setup: func() {
+				suite.messageRepo.On("FetchMessageThread", mock.Anything, 1, "employer").Return(pendingThread, nil)
+				suite.messageRepo.On("CreateMessage", mock.Anything, repo.Message{ApplicationID: 1, SenderRole: "employer", SenderID: 9, Body: "call me on [phone number hidden]"}).Return(repo.Message{ID: 1, ApplicationID: 1, SenderRole: "employer", SenderID: 9, Body: "call me on [phone number hidden]", CreatedAt: createdAt}, nil)
+			},
+			expectedOutput: Message{ID: 1, ApplicationID: 1, SenderRole: EmployerRole, SenderID: 9, Body: "call me on [phone number hidden]", CreatedAt: createdAt},
+			expectedError:  nil,
+		},
+		{
+			name:   "phone number kept once confirmed, with attachment",
+			sender: Participant{Role: WorkerRole, ID: 5},
+			input:  Message{Body: "my number is 9876543210", Attachment: &Attachment{Name: "card.jpg", ContentType: "image/jpeg", Size: 2048, URL: "https://files.example.com/card.jpg"}},
+			setup: func() {
+				suite.messageRepo.On("FetchMessageThread", mock.Anything, 1, "worker").Return(confirmedThread, nil)
+				suite.messageRepo.On("CreateMessage", mock.Anything, repo.Message{ApplicationID: 1, SenderRole: "worker", SenderID: 5, Body: "my number is 9876543210", AttachmentName: "card.jpg", AttachmentType: "image/jpeg", AttachmentSize: 2048, AttachmentURL: "https://files.example.com/card.jpg"}).Return(repo.Message{ID: 2, ApplicationID: 1, SenderRole: "worker", SenderID: 5, Body: "my number is 9876543210", AttachmentName: "card.jpg", AttachmentType: "image/jpeg", AttachmentSize: 2048, AttachmentURL: "https://files.example.com/card.jpg", CreatedAt: createdAt}, nil)
+			},
+			expectedOutput: Message{ID: 2, ApplicationID: 1, SenderRole: WorkerRole, SenderID: 5, Body: "my number is 9876543210", Attachment: &Attachment{Name: "card.jpg", ContentType: "image/jpeg", Size: 2048, URL: "https://files.example.com/card.jpg"}, CreatedAt: createdAt},
+			expectedError:  nil,
+		},
+		{
+			name:   "sender is taken from the caller, not the message",
+			sender: Participant{Role: WorkerRole, ID: 5},
+			input:  Message{SenderRole: EmployerRole, SenderID: 9, Body: "hello"},
+			setup: func() {
+				suite.messageRepo.On("FetchMessageThread", mock.Anything, 1, "worker").Return(confirmedThread, nil)
+				suite.messageRepo.On("CreateMessage", mock.Anything, repo.Message{ApplicationID: 1, SenderRole: "worker", SenderID: 5, Body: "hello"}).Return(repo.Message{ID: 3, ApplicationID: 1, SenderRole: "worker", SenderID: 5, Body: "hello", CreatedAt: createdAt}, nil)
+			},
+			expectedOutput: Message{ID: 3, ApplicationID: 1, SenderRole: WorkerRole, SenderID: 5, Body: "hello", CreatedAt: createdAt},
+			expectedError:  nil,
+		},
+		{
+			name:   "sender is not part of the application",
+			sender: Participant{Role: WorkerRole, ID: 6},
+			input:  Message{Body: "hello"},
+			setup: func() {
+				suite.messageRepo.On("FetchMessageThread", mock.Anything, 1, "worker").Return(pendingThread, nil)
+			},
+			expectedOutput: Message{},
+			expectedError:  apperrors.ErrNotThreadParticipant,
+		},
+		{
+			name:           "empty message",
+			sender:         Participant{Role: WorkerRole, ID: 5},
+			input:          Message{Body: "  "},
+			setup:          func() {},
+			expectedOutput: Message{},
+			expectedError:  apperrors.ErrInvalidMessage,
+		},
+		{
+			name:           "unsupported attachment",
+			sender:         Participant{Role: WorkerRole, ID: 5},
+			input:          Message{Attachment: &Attachment{Name: "run.exe", ContentType: "application/octet-stream", Size: 2048, URL: "https://files.example.com/run.exe"}},
+			setup:          func() {},
+			expectedOutput: Message{},
+			expectedError:  apperrors.ErrInvalidAttachment,
+		},
+		{
+			name:           "unknown sender role",
+			sender:         Participant{Role: "admin", ID: 1},
+			input:          Message{Body: "hello"},
+			setup:          func() {},
+			expectedOutput: Message{},
+			expectedError:  apperrors.ErrInvalidParticipant,
+		},
+		{
+			name:   "application not found",
+			sender: Participant{Role: WorkerRole, ID: 5},
+			input:  Message{Body: "hello"},
+			setup: func() {
+				suite.messageRepo.On("FetchMessageThread", mock.Anything, 1, "worker").Return(repo.MessageThread{}, apperrors.ErrNoApplicationExists)
+			},
+			expectedOutput: Message{},
+			expectedError:  apperrors.ErrNoApplicationExists,
+		},
+	}
+
+	for _, test := range testCases {
+		suite.SetupTest()
+		suite.Run(test.name, func() {
+			test.setup()
+
+			message, err := suite.service.SendMessage(context.Background(), 1, test.sender, test.input)
+			suite.Equal(test.expectedOutput, message)
+			suite.ErrorIs(err, test.expectedError)
+		})
+		suite.TearDownTest()
+	}
+}
+
+func (suite *MessageServiceTestSuite) TestFetchThread() {
+	type testCase struct {
+		name           string
+		reader         Participant
+		setup          func()
+		expectedOutput Thread
+		expectedError  error
+	}
+
+	sentAt := time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC)
+	readAt := sentAt.Add(time.Hour)
+
+	testCases := []testCase{
+		{
+			name:   "success",
+			reader: Participant{Role: WorkerRole, ID: 5},
+			setup: func() {
+				suite.messageRepo.On("FetchMessageThread", mock.Anything, 1, "worker").Return(repo.MessageThread{ApplicationID: 1, JobID: 2, JobTitle: "Mason", WorkerID: 5, EmployerID: 9, Status: repo.Shortlisted, Unread: 1}, nil)
+				suite.messageRepo.On("FetchMessagesByApplicationId", mock.Anything, 1).Return([]repo.Message{
+					{ID: 1, ApplicationID: 1, SenderRole: "worker", SenderID: 5, Body: "is the job still open?", CreatedAt: sentAt, ReadAt: &readAt},
+					{ID: 2, ApplicationID: 1, SenderRole: "employer", SenderID: 9, Body: "yes", CreatedAt: readAt},
+				}, nil)
+			},
+			expectedOutput: Thread{
+				ApplicationID: 1,
+				JobID:         2,
+				JobTitle:      "Mason",
+				WorkerID:      5,
+				EmployerID:    9,
+				Status:        "shortlisted",
+				Unread:        1,
+				Messages: []Message{
+					{ID: 1, ApplicationID: 1, SenderRole: WorkerRole, SenderID: 5, Body: "is the job still open?", CreatedAt: sentAt, ReadAt: &readAt},
+					{ID: 2, ApplicationID: 1, SenderRole: EmployerRole, SenderID: 9, Body: "yes", CreatedAt: readAt},
+				},
+			},
+			expectedError: nil,
+		},
+		{
+			name:   "reader is not part of the application",
+			reader: Participant{Role: EmployerRole, ID: 10},
+			setup: func() {
+				suite.messageRepo.On("FetchMessageThread", mock.Anything, 1, "employer").Return(repo.MessageThread{ApplicationID: 1, WorkerID: 5, EmployerID: 9}, nil)
+			},
+			expectedOutput: Thread{},
+			expectedError:  apperrors.ErrNotThreadParticipant,
+		},
+	}
+
+	for _, test := range testCases {
+		suite.SetupTest()
+		suite.Run(test.name, func() {
+			test.setup()
+
+			thread, err := suite.service.FetchThread(context.Background(), 1, test.reader)
+			suite.Equal(test.expectedOutput, thread)
+			suite.ErrorIs(err, test.expectedError)
+		})
+		suite.TearDownTest()
+	}
+}
+
+func (suite *MessageServiceTestSuite) TestMarkThreadRead() {
+	type testCase struct {
+		name          string
+		setup         func()
+		expectedRead  int
+		expectedError error
+	}
+
+	testCases := []testCase{
+		{
+			name: "success",
+			setup: func() {
+				suite.messageRepo.On("FetchMessageThread", mock.Anything, 1, "employer").Return(repo.MessageThread{ApplicationID: 1, WorkerID: 5, EmployerID: 9}, nil)
+				suite.messageRepo.On("MarkMessagesRead", mock.Anything, 1, "employer").Return(3, nil)
+			},
+			expectedRead:  3,
+			expectedError: nil,
+		},
+		{
+			name: "error from db",
+			setup: func() {
+				suite.messageRepo.On("FetchMessageThread", mock.Anything, 1, "employer").Return(repo.MessageThread{ApplicationID: 1, WorkerID: 5, EmployerID: 9}, nil)
+				suite.messageRepo.On("MarkMessagesRead", mock.Anything, 1, "employer").Return(0, errors.New("some db error"))
+			},
+			expectedRead:  0,
+			expectedError: errors.New("some db error"),
+		},
+	}
+
+	for _, test := range testCases {
+		suite.SetupTest()
+		suite.Run(test.name, func() {
+			test.setup()
+
+			receipt, err := suite.service.MarkThreadRead(context.Background(), 1, Participant{Role: EmployerRole, ID: 9})
+			suite.Equal(test.expectedRead, receipt.MarkedRead)
+			suite.Equal(test.expectedError, err)
+		})
+		suite.TearDownTest()
+	}
+}
+
+func (suite *MessageServiceTestSuite) TestFetchWorkerThreads() {
+	type testCase struct {
+		name           string
+		setup          func()
+		expectedOutput []ThreadSummary
+		expectedError  error
+	}
+
+	lastMessageAt := time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC)
+
+	testCases := []testCase{
+		{
+			name: "success",
+			setup: func() {
+				suite.workerRepo.On("FindWorkerById", mock.Anything, 5).Return(true)
+				suite.messageRepo.On("FetchThreadsByWorkerId", mock.Anything, 5).Return([]repo.MessageThread{{ApplicationID: 1, JobID: 2, JobTitle: "Mason", WorkerID: 5, EmployerID: 9, Status: repo.Pending, LastMessageAt: &lastMessageAt, Unread: 2}}, nil)
+			},
+			expectedOutput: []ThreadSummary{{ApplicationID: 1, JobID: 2, JobTitle: "Mason", WorkerID: 5, EmployerID: 9, Status: "pending", LastMessageAt: &lastMessageAt, Unread: 2}},
+			expectedError:  nil,
+		},
+		{
+			name: "worker not found",
+			setup: func() {
+				suite.workerRepo.On("FindWorkerById", mock.Anything, 5).Return(false)
+			},
+			expectedOutput: []ThreadSummary{},
+			expectedError:  apperrors.ErrNoWorkerExists,
+		},
+	}
+
+	for _, test := range testCases {
+		suite.SetupTest()
+		suite.Run(test.name, func() {
+			test.setup()
+
+			threads, err := suite.service.FetchWorkerThreads(context.Background(), 5)
+			suite.Equal(test.expectedOutput, threads)
+			suite.ErrorIs(err, test.expectedError)
+		})
+		suite.TearDownTest()
+	}
+}
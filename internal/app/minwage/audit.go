@@ -0,0 +1,74 @@
+package minwage
+
+import (
+	"context"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/audit"
+)
+
+// auditedService records every minimum wage that is saved or deleted in the audit log
+type auditedService struct {
+	Service
+	auditService audit.Service
+}
+
+func NewAuditedService(minimumWageService Service, auditService audit.Service) Service {
+	return &auditedService{
+		Service:      minimumWageService,
+		auditService: auditService,
+	}
+}
+
+// SaveMinimumWage records a new minimum wage as created and a replaced one as updated
+func (auditedS *auditedService) SaveMinimumWage(ctx context.Context, adminId int, minimumWage MinimumWage) (MinimumWage, error) {
+	var before interface{}
+	if previous, ok := auditedS.findMinimumWage(ctx, func(existing MinimumWage) bool {
+		return existing.State == normalizeName(minimumWage.State) && existing.Sector == normalizeName(minimumWage.Sector) && existing.SkillLevel == minimumWage.SkillLevel
+	}); ok {
+		before = previous
+	}
+
+	savedMinimumWage, err := auditedS.Service.SaveMinimumWage(ctx, adminId, minimumWage)
+	if err != nil {
+		return MinimumWage{}, err
+	}
+
+	action := audit.Update
+	if before == nil {
+		action = audit.Create
+	}
+	auditedS.auditService.Record(ctx, action, audit.MinimumWage, savedMinimumWage.ID, before, savedMinimumWage)
+	return savedMinimumWage, nil
+}
+
+func (auditedS *auditedService) DeleteMinimumWage(ctx context.Context, minimumWageId int) error {
+	var before interface{}
+	if previous, ok := auditedS.findMinimumWage(ctx, func(existing MinimumWage) bool {
+		return existing.ID == minimumWageId
+	}); ok {
+		before = previous
+	}
+
+	err := auditedS.Service.DeleteMinimumWage(ctx, minimumWageId)
+	if err != nil {
+		return err
+	}
+
+	auditedS.auditService.Record(ctx, audit.Delete, audit.MinimumWage, minimumWageId, before, nil)
+	return nil
+}
+
+// findMinimumWage looks for the current state of a minimum wage, it is recorded without one when
+// the minimum wages cannot be fetched
+func (auditedS *auditedService) findMinimumWage(ctx context.Context, match func(MinimumWage) bool) (MinimumWage, bool) {
+	minimumWages, err := auditedS.Service.FetchMinimumWages(ctx, "")
+	if err != nil {
+		return MinimumWage{}, false
+	}
+	for _, minimumWage := range minimumWages {
+		if match(minimumWage) {
+			return minimumWage, true
+		}
+	}
+	return MinimumWage{}, false
+}
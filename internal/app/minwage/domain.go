@@ -0,0 +1,91 @@
+package minwage
+
+import "time"
+
+type SkillLevel string
+
+const (
+	Unskilled     SkillLevel = "unskilled"
+	SemiSkilled   SkillLevel = "semi_skilled"
+	Skilled       SkillLevel = "skilled"
+	HighlySkilled SkillLevel = "highly_skilled"
+)
+
+type ComplianceStatus string
+
+const (
+	Compliant    ComplianceStatus = "compliant"
+	BelowMinimum ComplianceStatus = "below_minimum"
+	// NoMinimum is the status of a job with no minimum wage set for its state, sectors and skill level
+	NoMinimum ComplianceStatus = "no_minimum"
+)
+
+// Enforcement decides what happens to a job paying below the minimum wage, it is rejected or saved
+// and flagged in the compliance report
+type Enforcement string
+
+const (
+	Reject Enforcement = "reject"
+	Flag   Enforcement = "flag"
+)
+
+// StandardWorkingHours are the hours of work a minimum daily wage is paid for
+const StandardWorkingHours = 8
+
+// MinimumWage is the lowest daily wage for StandardWorkingHours of work, an empty State or Sector
+// applies to every state or sector without a minimum wage of its own
+type MinimumWage struct {
+	ID         int        `json:"id"`
+	State      string     `json:"state"`
+	Sector     string     `json:"sector"`
+	SkillLevel SkillLevel `json:"skill_level"`
+	DailyWage  int        `json:"daily_wage"`
+	UpdatedBy  int        `json:"updated_by,omitempty"`
+	UpdatedAt  time.Time  `json:"updated_at"`
+}
+
+// JobWage is what a job pays for each shift, checked against the minimum wage
+type JobWage struct {
+	State           string
+	Sectors         string
+	SkillLevel      SkillLevel
+	Wage            int
+	DurationInHours int
+}
+
+type Compliance struct {
+	Status     ComplianceStatus `json:"status"`
+	State      string           `json:"state"`
+	Sector     string           `json:"sector"`
+	SkillLevel SkillLevel       `json:"skill_level"`
+	// MinimumDailyWage is the highest minimum wage of the job's sectors
+	MinimumDailyWage int     `json:"minimum_daily_wage"`
+	HourlyRate       float64 `json:"hourly_rate"`
+	// RequiredWage is the minimum daily wage prorated to the hours of a shift
+	RequiredWage int `json:"required_wage"`
+	Shortfall    int `json:"shortfall,omitempty"`
+}
+
+type JobCompliance struct {
+	JobID           int       `json:"job_id"`
+	Title           string    `json:"title"`
+	EmployerID      int       `json:"employer_id"`
+	Wage            int       `json:"wage"`
+	DurationInHours int       `json:"duration_in_hours"`
+	CheckedAt       time.Time `json:"checked_at"`
+	Compliance
+}
+
+type ComplianceReport struct {
+	Compliant    int             `json:"compliant"`
+	BelowMinimum int             `json:"below_minimum"`
+	NoMinimum    int             `json:"no_minimum"`
+	Jobs         []JobCompliance `json:"jobs"`
+}
+
+type ComplianceFilter struct {
+	State  string
+	Status ComplianceStatus
+	Limit  int
+	Offset int
+}
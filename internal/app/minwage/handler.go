@@ -0,0 +1,134 @@
+package minwage
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/logger"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/middleware"
+	"go.uber.org/zap"
+)
+
+func FetchMinimumWages(minimumWageService Service) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		state := r.URL.Query().Get("state")
+		minimumWages, err := minimumWageService.FetchMinimumWages(ctx, state)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrFetchMinimumWages.Error(), zap.Error(err), zap.String("state", state))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrFetchMinimumWages.Error()+", "+err.Error(), minimumWageErrorStatusCode(err))
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, "minimum wages retrieved successfully", http.StatusOK, minimumWages)
+	}
+}
+
+func SaveMinimumWage(minimumWageService Service) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		var minimumWage MinimumWage
+		err := json.NewDecoder(r.Body).Decode(&minimumWage)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrInvalidRequestBody.Error(), zap.Error(err))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrInvalidRequestBody.Error()+": "+err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		savedMinimumWage, err := minimumWageService.SaveMinimumWage(ctx, currentAdminId(ctx), minimumWage)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrSaveMinimumWage.Error(), zap.Error(err), zap.String("state", minimumWage.State), zap.String("sector", minimumWage.Sector))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrSaveMinimumWage.Error()+": "+err.Error(), minimumWageErrorStatusCode(err))
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, "minimum wage saved successfully", http.StatusOK, savedMinimumWage)
+	}
+}
+
+func DeleteMinimumWage(minimumWageService Service) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		minimumWageId, id := isPathIdValid(ctx, w, r, "minimum_wage_id", apperrors.MsgInvalidMinimumWageId, apperrors.ErrDeleteMinimumWage)
+		if minimumWageId == -1 {
+			return
+		}
+
+		err := minimumWageService.DeleteMinimumWage(ctx, minimumWageId)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrDeleteMinimumWage.Error(), zap.Error(err), zap.String("ID", id))
+			middleware.HandleErrorResponse(ctx, w, apperrors.HttpErrorResponseMessage(apperrors.ErrDeleteMinimumWage.Error(), err.Error(), id), minimumWageErrorStatusCode(err))
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, "minimum wage deleted successfully", http.StatusOK, minimumWageId)
+	}
+}
+
+func FetchComplianceReport(minimumWageService Service) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		query := r.URL.Query()
+		filter := ComplianceFilter{State: query.Get("state"), Status: ComplianceStatus(query.Get("status"))}
+
+		var err error
+		if limit := query.Get("limit"); limit != "" {
+			filter.Limit, err = strconv.Atoi(limit)
+		}
+		if offset := query.Get("offset"); offset != "" && err == nil {
+			filter.Offset, err = strconv.Atoi(offset)
+		}
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrInvalidComplianceQuery.Error(), zap.Error(err))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrFetchComplianceReport.Error()+": "+apperrors.ErrInvalidComplianceQuery.Error(), http.StatusBadRequest)
+			return
+		}
+
+		report, err := minimumWageService.FetchComplianceReport(ctx, filter)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrFetchComplianceReport.Error(), zap.Error(err))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrFetchComplianceReport.Error()+", "+err.Error(), minimumWageErrorStatusCode(err))
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, "wage compliance report retrieved successfully", http.StatusOK, report)
+	}
+}
+
+// currentAdminId is the admin changing a minimum wage, taken from the JWT
+func currentAdminId(ctx context.Context) int {
+	userId, _ := ctx.Value("user_id").(int)
+	return userId
+}
+
+func isPathIdValid(ctx context.Context, w http.ResponseWriter, r *http.Request, key string, invalidMsg string, errType error) (int, string) {
+	vars := mux.Vars(r)
+	id := vars[key]
+	pathId, err := strconv.Atoi(id)
+	if err != nil {
+		logger.Errorw(ctx, invalidMsg, zap.Error(err), zap.String("ID", id))
+		httpResponseMsg := apperrors.HttpErrorResponseMessage(errType.Error(), invalidMsg, id)
+		middleware.HandleErrorResponse(ctx, w, httpResponseMsg, http.StatusBadRequest)
+		return -1, id
+	}
+	return pathId, id
+}
+
+func minimumWageErrorStatusCode(err error) int {
+	switch {
+	case errors.Is(err, apperrors.ErrInvalidMinimumWage), errors.Is(err, apperrors.ErrInvalidSkillLevel), errors.Is(err, apperrors.ErrInvalidComplianceQuery):
+		return http.StatusBadRequest
+	case errors.Is(err, apperrors.ErrNoMinimumWageExists):
+		return http.StatusNotFound
+	}
+	return http.StatusInternalServerError
+}
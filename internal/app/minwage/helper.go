@@ -0,0 +1,199 @@
+package minwage
+
+import (
+	"math"
+	"strings"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo"
+)
+
+const (
+	defaultReportLimit = 50
+	maxReportLimit     = 200
+)
+
+var skillLevels = map[SkillLevel]bool{
+	Unskilled:     true,
+	SemiSkilled:   true,
+	Skilled:       true,
+	HighlySkilled: true,
+}
+
+var complianceStatuses = map[ComplianceStatus]bool{
+	Compliant:    true,
+	BelowMinimum: true,
+	NoMinimum:    true,
+}
+
+func MapMinimumWageRepoToService(minimumWage repo.MinimumWage) MinimumWage {
+	return MinimumWage{
+		ID:         minimumWage.ID,
+		State:      minimumWage.State,
+		Sector:     minimumWage.Sector,
+		SkillLevel: SkillLevel(minimumWage.SkillLevel),
+		DailyWage:  minimumWage.DailyWage,
+		UpdatedBy:  minimumWage.UpdatedBy,
+		UpdatedAt:  minimumWage.UpdatedAt,
+	}
+}
+
+func MapJobWageCheckRepoToService(check repo.JobWageCheck) JobCompliance {
+	return JobCompliance{
+		JobID:           check.JobID,
+		Title:           check.Title,
+		EmployerID:      check.EmployerID,
+		Wage:            check.Wage,
+		DurationInHours: check.DurationInHours,
+		CheckedAt:       check.CheckedAt,
+		Compliance: Compliance{
+			Status:           ComplianceStatus(check.Status),
+			State:            check.State,
+			Sector:           check.Sector,
+			SkillLevel:       SkillLevel(check.SkillLevel),
+			MinimumDailyWage: check.MinimumDailyWage,
+			HourlyRate:       hourlyRate(check.Wage, check.DurationInHours),
+			RequiredWage:     check.RequiredWage,
+			Shortfall:        shortfall(check.Wage, check.RequiredWage),
+		},
+	}
+}
+
+// normalizeName makes states and sectors match whatever case and spacing they were typed in
+func normalizeName(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+// normalizeSkillLevel treats a job without a skill level as unskilled work, the lowest minimum wage
+func normalizeSkillLevel(skillLevel SkillLevel) (SkillLevel, error) {
+	if skillLevel == "" {
+		return Unskilled, nil
+	}
+	if !skillLevels[skillLevel] {
+		return "", apperrors.ErrInvalidSkillLevel
+	}
+	return skillLevel, nil
+}
+
+func validateMinimumWage(minimumWage MinimumWage) (MinimumWage, error) {
+	minimumWage.State = normalizeName(minimumWage.State)
+	minimumWage.Sector = normalizeName(minimumWage.Sector)
+	if !skillLevels[minimumWage.SkillLevel] || minimumWage.DailyWage <= 0 {
+		return MinimumWage{}, apperrors.ErrInvalidMinimumWage
+	}
+	return minimumWage, nil
+}
+
+func splitSectors(sectors string) []string {
+	names := make([]string, 0)
+	for _, sector := range strings.Split(sectors, ",") {
+		if name := normalizeName(sector); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// specificity ranks how closely a minimum wage matches a state and sector, lower is closer and -1
+// means it does not apply
+func specificity(minimumWage repo.MinimumWage, state string, sector string) int {
+	switch {
+	case minimumWage.State == state && state != "" && minimumWage.Sector == sector && sector != "":
+		return 0
+	case minimumWage.State == state && state != "" && minimumWage.Sector == "":
+		return 1
+	case minimumWage.State == "" && minimumWage.Sector == sector && sector != "":
+		return 2
+	case minimumWage.State == "" && minimumWage.Sector == "":
+		return 3
+	}
+	return -1
+}
+
+// applicableMinimum picks the closest minimum wage for each sector of a job and returns the highest
+// of them, a job spanning several sectors has to meet the minimum of each one
+func applicableMinimum(minimumWages []repo.MinimumWage, state string, sectors []string) (repo.MinimumWage, bool) {
+	if len(sectors) == 0 {
+		sectors = []string{""}
+	}
+
+	var applicable repo.MinimumWage
+	found := false
+	for _, sector := range sectors {
+		closest, rank := repo.MinimumWage{}, -1
+		for _, minimumWage := range minimumWages {
+			matchRank := specificity(minimumWage, state, sector)
+			if matchRank != -1 && (rank == -1 || matchRank < rank) {
+				closest, rank = minimumWage, matchRank
+			}
+		}
+		if rank != -1 && (!found || closest.DailyWage > applicable.DailyWage) {
+			applicable, found = closest, true
+		}
+	}
+	return applicable, found
+}
+
+// requiredWage prorates a minimum daily wage to the hours of a shift, rounding up to the next rupee
+func requiredWage(dailyWage int, durationInHours int) int {
+	if durationInHours <= 0 {
+		durationInHours = StandardWorkingHours
+	}
+	return (dailyWage*durationInHours + StandardWorkingHours - 1) / StandardWorkingHours
+}
+
+func hourlyRate(wage int, durationInHours int) float64 {
+	if durationInHours <= 0 {
+		durationInHours = StandardWorkingHours
+	}
+	return math.Round(float64(wage)/float64(durationInHours)*100) / 100
+}
+
+func shortfall(wage int, requiredWage int) int {
+	if wage >= requiredWage {
+		return 0
+	}
+	return requiredWage - wage
+}
+
+func checkWage(jobWage JobWage, minimumWage repo.MinimumWage, found bool) Compliance {
+	compliance := Compliance{
+		Status:     NoMinimum,
+		State:      jobWage.State,
+		SkillLevel: jobWage.SkillLevel,
+		HourlyRate: hourlyRate(jobWage.Wage, jobWage.DurationInHours),
+	}
+	if !found {
+		return compliance
+	}
+
+	compliance.Sector = minimumWage.Sector
+	compliance.MinimumDailyWage = minimumWage.DailyWage
+	compliance.RequiredWage = requiredWage(minimumWage.DailyWage, jobWage.DurationInHours)
+	compliance.Shortfall = shortfall(jobWage.Wage, compliance.RequiredWage)
+
+	compliance.Status = Compliant
+	if compliance.Shortfall > 0 {
+		compliance.Status = BelowMinimum
+	}
+	return compliance
+}
+
+func normalizeComplianceFilter(filter ComplianceFilter) (repo.JobWageCheckFilter, error) {
+	if (filter.Status != "" && !complianceStatuses[filter.Status]) || filter.Limit < 0 || filter.Offset < 0 {
+		return repo.JobWageCheckFilter{}, apperrors.ErrInvalidComplianceQuery
+	}
+
+	if filter.Limit == 0 {
+		filter.Limit = defaultReportLimit
+	}
+	if filter.Limit > maxReportLimit {
+		filter.Limit = maxReportLimit
+	}
+	return repo.JobWageCheckFilter{
+		State:  normalizeName(filter.State),
+		Status: string(filter.Status),
+		Limit:  filter.Limit,
+		Offset: filter.Offset,
+	}, nil
+}
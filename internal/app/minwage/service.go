@@ -0,0 +1,145 @@
+package minwage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/logger"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo"
+	"go.uber.org/zap"
+)
+
+type minimumWageService struct {
+	minimumWageRepo repo.MinimumWageStorer
+	enforcement     Enforcement
+}
+
+type Service interface {
+	FetchMinimumWages(ctx context.Context, state string) ([]MinimumWage, error)
+	SaveMinimumWage(ctx context.Context, adminId int, minimumWage MinimumWage) (MinimumWage, error)
+	DeleteMinimumWage(ctx context.Context, minimumWageId int) error
+	CheckJobWage(ctx context.Context, jobWage JobWage) (Compliance, error)
+	RecordJobWageCheck(ctx context.Context, jobId int, jobWage JobWage, compliance Compliance)
+	FetchComplianceReport(ctx context.Context, filter ComplianceFilter) (ComplianceReport, error)
+}
+
+// NewService rejects jobs paying below the minimum wage unless enforcement is Flag, in which case
+// they are saved and only show up in the compliance report
+func NewService(minimumWageRepo repo.MinimumWageStorer, enforcement Enforcement) Service {
+	return &minimumWageService{
+		minimumWageRepo: minimumWageRepo,
+		enforcement:     enforcement,
+	}
+}
+
+func (minWageS *minimumWageService) FetchMinimumWages(ctx context.Context, state string) ([]MinimumWage, error) {
+	minimumWages, err := minWageS.minimumWageRepo.FetchMinimumWages(ctx, normalizeName(state))
+	if err != nil {
+		return []MinimumWage{}, err
+	}
+
+	mappedMinimumWages := make([]MinimumWage, 0, len(minimumWages))
+	for _, minimumWage := range minimumWages {
+		mappedMinimumWages = append(mappedMinimumWages, MapMinimumWageRepoToService(minimumWage))
+	}
+	return mappedMinimumWages, nil
+}
+
+func (minWageS *minimumWageService) SaveMinimumWage(ctx context.Context, adminId int, minimumWage MinimumWage) (MinimumWage, error) {
+	minimumWage, err := validateMinimumWage(minimumWage)
+	if err != nil {
+		return MinimumWage{}, err
+	}
+
+	savedMinimumWage, err := minWageS.minimumWageRepo.SaveMinimumWage(ctx, repo.MinimumWage{
+		State:      minimumWage.State,
+		Sector:     minimumWage.Sector,
+		SkillLevel: string(minimumWage.SkillLevel),
+		DailyWage:  minimumWage.DailyWage,
+		UpdatedBy:  adminId,
+	})
+	if err != nil {
+		return MinimumWage{}, err
+	}
+	return MapMinimumWageRepoToService(savedMinimumWage), nil
+}
+
+func (minWageS *minimumWageService) DeleteMinimumWage(ctx context.Context, minimumWageId int) error {
+	return minWageS.minimumWageRepo.DeleteMinimumWage(ctx, minimumWageId)
+}
+
+// CheckJobWage compares the wage of a shift with the minimum wage prorated to its hours
+func (minWageS *minimumWageService) CheckJobWage(ctx context.Context, jobWage JobWage) (Compliance, error) {
+	skillLevel, err := normalizeSkillLevel(jobWage.SkillLevel)
+	if err != nil {
+		return Compliance{}, err
+	}
+	jobWage.SkillLevel = skillLevel
+	jobWage.State = normalizeName(jobWage.State)
+
+	minimumWages, err := minWageS.minimumWageRepo.FetchApplicableMinimumWages(ctx, jobWage.State, string(jobWage.SkillLevel))
+	if err != nil {
+		return Compliance{}, fmt.Errorf("%w: %w", apperrors.ErrFetchMinimumWages, err)
+	}
+
+	minimumWage, found := applicableMinimum(minimumWages, jobWage.State, splitSectors(jobWage.Sectors))
+	compliance := checkWage(jobWage, minimumWage, found)
+
+	if compliance.Status == BelowMinimum && minWageS.enforcement != Flag {
+		return compliance, fmt.Errorf("%w: %d for %d hours of %s work, at least %d is required", apperrors.ErrBelowMinimumWage, jobWage.Wage, jobWage.DurationInHours, jobWage.SkillLevel, compliance.RequiredWage)
+	}
+	return compliance, nil
+}
+
+// RecordJobWageCheck keeps the result of a job's check for the compliance report, failing to save
+// it never fails the job
+func (minWageS *minimumWageService) RecordJobWageCheck(ctx context.Context, jobId int, jobWage JobWage, compliance Compliance) {
+	err := minWageS.minimumWageRepo.SaveJobWageCheck(ctx, repo.JobWageCheck{
+		JobID:            jobId,
+		State:            compliance.State,
+		Sector:           compliance.Sector,
+		SkillLevel:       string(compliance.SkillLevel),
+		Wage:             jobWage.Wage,
+		DurationInHours:  jobWage.DurationInHours,
+		MinimumDailyWage: compliance.MinimumDailyWage,
+		RequiredWage:     compliance.RequiredWage,
+		Status:           string(compliance.Status),
+	})
+	if err != nil {
+		logger.Errorw(ctx, apperrors.ErrRecordWageCheck.Error(), zap.Error(err), zap.Int("job_id", jobId))
+	}
+}
+
+func (minWageS *minimumWageService) FetchComplianceReport(ctx context.Context, filter ComplianceFilter) (ComplianceReport, error) {
+	repoFilter, err := normalizeComplianceFilter(filter)
+	if err != nil {
+		return ComplianceReport{}, err
+	}
+
+	counts, err := minWageS.minimumWageRepo.CountJobWageChecks(ctx, repoFilter.State)
+	if err != nil {
+		return ComplianceReport{}, err
+	}
+
+	checks, err := minWageS.minimumWageRepo.FetchJobWageChecks(ctx, repoFilter)
+	if err != nil {
+		return ComplianceReport{}, err
+	}
+
+	report := ComplianceReport{Jobs: make([]JobCompliance, 0, len(checks))}
+	for _, count := range counts {
+		switch ComplianceStatus(count.Status) {
+		case Compliant:
+			report.Compliant = count.Count
+		case BelowMinimum:
+			report.BelowMinimum = count.Count
+		case NoMinimum:
+			report.NoMinimum = count.Count
+		}
+	}
+	for _, check := range checks {
+		report.Jobs = append(report.Jobs, MapJobWageCheckRepoToService(check))
+	}
+	return report, nil
+}
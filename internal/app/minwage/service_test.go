@@ -0,0 +1,218 @@
+package minwage
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type MinimumWageServiceTestSuite struct {
+	suite.Suite
+	service         Service
+	minimumWageRepo mocks.MinimumWageStorer
+}
+
+func (suite *MinimumWageServiceTestSuite) SetupTest() {
+	suite.minimumWageRepo = mocks.MinimumWageStorer{}
+	suite.service = NewService(&suite.minimumWageRepo, Reject)
+}
+
+func (suite *MinimumWageServiceTestSuite) TearDownTest() {
+	suite.minimumWageRepo.AssertExpectations(suite.T())
+}
+
+func TestMinimumWageServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(MinimumWageServiceTestSuite))
+}
+
+func (suite *MinimumWageServiceTestSuite) TestCheckJobWage() {
+	type testCase struct {
+		name               string
+		enforcement        Enforcement
+		input              JobWage
+		minimumWages       []repo.MinimumWage
+		expectedCompliance Compliance
+		expectedError      error
+	}
+
+	minimumWages := []repo.MinimumWage{
+		{ID: 1, State: "", Sector: "", SkillLevel: "unskilled", DailyWage: 178},
+		{ID: 2, State: "maharashtra", Sector: "", SkillLevel: "unskilled", DailyWage: 400},
+		{ID: 3, State: "maharashtra", Sector: "construction", SkillLevel: "unskilled", DailyWage: 520},
+		{ID: 4, State: "", Sector: "agriculture", SkillLevel: "unskilled", DailyWage: 450},
+	}
+
+	testCases := []testCase{
+		{
+			name:               "state and sector minimum",
+			input:              JobWage{State: " Maharashtra", Sectors: "Construction", Wage: 600, DurationInHours: 8},
+			minimumWages:       minimumWages,
+			expectedCompliance: Compliance{Status: Compliant, State: "maharashtra", Sector: "construction", SkillLevel: Unskilled, MinimumDailyWage: 520, HourlyRate: 75, RequiredWage: 520},
+		},
+		{
+			name:               "state minimum is closer than a national sector minimum",
+			input:              JobWage{State: "Maharashtra", Sectors: "Agriculture", Wage: 420, DurationInHours: 8},
+			minimumWages:       minimumWages,
+			expectedCompliance: Compliance{Status: Compliant, State: "maharashtra", Sector: "", SkillLevel: Unskilled, MinimumDailyWage: 400, HourlyRate: 52.5, RequiredWage: 400},
+		},
+		{
+			name:               "highest minimum of the job's sectors",
+			input:              JobWage{State: "Maharashtra", Sectors: "Agriculture, Construction", Wage: 500, DurationInHours: 8},
+			minimumWages:       minimumWages,
+			expectedCompliance: Compliance{Status: BelowMinimum, State: "maharashtra", Sector: "construction", SkillLevel: Unskilled, MinimumDailyWage: 520, HourlyRate: 62.5, RequiredWage: 520, Shortfall: 20},
+			expectedError:      apperrors.ErrBelowMinimumWage,
+		},
+		{
+			name:               "minimum prorated to a short shift",
+			input:              JobWage{State: "Kerala", Sectors: "Agriculture", Wage: 170, DurationInHours: 3},
+			minimumWages:       minimumWages,
+			expectedCompliance: Compliance{Status: Compliant, State: "kerala", Sector: "agriculture", SkillLevel: Unskilled, MinimumDailyWage: 450, HourlyRate: 56.67, RequiredWage: 169},
+		},
+		{
+			name:               "flagged instead of rejected",
+			enforcement:        Flag,
+			input:              JobWage{State: "Goa", Wage: 150, DurationInHours: 8},
+			minimumWages:       minimumWages,
+			expectedCompliance: Compliance{Status: BelowMinimum, State: "goa", Sector: "", SkillLevel: Unskilled, MinimumDailyWage: 178, HourlyRate: 18.75, RequiredWage: 178, Shortfall: 28},
+		},
+		{
+			name:               "no minimum for the skill level",
+			input:              JobWage{State: "Goa", SkillLevel: Skilled, Wage: 150, DurationInHours: 8},
+			minimumWages:       []repo.MinimumWage{},
+			expectedCompliance: Compliance{Status: NoMinimum, State: "goa", SkillLevel: Skilled, HourlyRate: 18.75},
+		},
+		{
+			name:          "unknown skill level",
+			input:         JobWage{State: "Goa", SkillLevel: "expert", Wage: 150, DurationInHours: 8},
+			expectedError: apperrors.ErrInvalidSkillLevel,
+		},
+	}
+
+	for _, test := range testCases {
+		suite.SetupTest()
+		suite.Run(test.name, func() {
+			if test.enforcement != "" {
+				suite.service = NewService(&suite.minimumWageRepo, test.enforcement)
+			}
+			if test.minimumWages != nil {
+				suite.minimumWageRepo.On("FetchApplicableMinimumWages", mock.Anything, test.expectedCompliance.State, string(test.expectedCompliance.SkillLevel)).Return(test.minimumWages, nil)
+			}
+
+			compliance, err := suite.service.CheckJobWage(context.Background(), test.input)
+
+			suite.ErrorIs(err, test.expectedError)
+			suite.Equal(test.expectedCompliance, compliance)
+		})
+		suite.TearDownTest()
+	}
+}
+
+func (suite *MinimumWageServiceTestSuite) TestSaveMinimumWage() {
+	type testCase struct {
+		name          string
+		input         MinimumWage
+		setup         func()
+		expectedError error
+	}
+
+	testCases := []testCase{
+		{
+			name:  "success",
+			input: MinimumWage{State: "Maharashtra ", Sector: "Construction", SkillLevel: Skilled, DailyWage: 650},
+			setup: func() {
+				suite.minimumWageRepo.On("SaveMinimumWage", mock.Anything, repo.MinimumWage{State: "maharashtra", Sector: "construction", SkillLevel: "skilled", DailyWage: 650, UpdatedBy: 2}).Return(repo.MinimumWage{ID: 7, State: "maharashtra", Sector: "construction", SkillLevel: "skilled", DailyWage: 650, UpdatedBy: 2}, nil)
+			},
+		},
+		{
+			name:          "no skill level",
+			input:         MinimumWage{State: "Maharashtra", DailyWage: 650},
+			setup:         func() {},
+			expectedError: apperrors.ErrInvalidMinimumWage,
+		},
+		{
+			name:          "daily wage not positive",
+			input:         MinimumWage{State: "Maharashtra", SkillLevel: Unskilled},
+			setup:         func() {},
+			expectedError: apperrors.ErrInvalidMinimumWage,
+		},
+	}
+
+	for _, test := range testCases {
+		suite.SetupTest()
+		suite.Run(test.name, func() {
+			test.setup()
+
+			_, err := suite.service.SaveMinimumWage(context.Background(), 2, test.input)
+
+			suite.ErrorIs(err, test.expectedError)
+		})
+		suite.TearDownTest()
+	}
+}
+
+func (suite *MinimumWageServiceTestSuite) TestFetchComplianceReport() {
+	type testCase struct {
+		name           string
+		input          ComplianceFilter
+		setup          func()
+		expectedOutput ComplianceReport
+		expectedError  error
+	}
+
+	testCases := []testCase{
+		{
+			name:  "success",
+			input: ComplianceFilter{State: "Maharashtra", Status: BelowMinimum},
+			setup: func() {
+				suite.minimumWageRepo.On("CountJobWageChecks", mock.Anything, "maharashtra").Return([]repo.WageCheckCount{{Status: "compliant", Count: 12}, {Status: "below_minimum", Count: 1}}, nil)
+				suite.minimumWageRepo.On("FetchJobWageChecks", mock.Anything, repo.JobWageCheckFilter{State: "maharashtra", Status: "below_minimum", Limit: 50}).Return([]repo.JobWageCheck{
+					{JobID: 4, Title: "Mason", EmployerID: 9, State: "maharashtra", Sector: "construction", SkillLevel: "unskilled", Wage: 250, DurationInHours: 4, MinimumDailyWage: 520, RequiredWage: 260, Status: "below_minimum"},
+				}, nil)
+			},
+			expectedOutput: ComplianceReport{
+				Compliant:    12,
+				BelowMinimum: 1,
+				Jobs: []JobCompliance{
+					{JobID: 4, Title: "Mason", EmployerID: 9, Wage: 250, DurationInHours: 4, Compliance: Compliance{Status: BelowMinimum, State: "maharashtra", Sector: "construction", SkillLevel: Unskilled, MinimumDailyWage: 520, HourlyRate: 62.5, RequiredWage: 260, Shortfall: 10}},
+				},
+			},
+		},
+		{
+			name:          "unknown status",
+			input:         ComplianceFilter{Status: "underpaid"},
+			setup:         func() {},
+			expectedError: apperrors.ErrInvalidComplianceQuery,
+		},
+		{
+			name:  "db error",
+			input: ComplianceFilter{},
+			setup: func() {
+				suite.minimumWageRepo.On("CountJobWageChecks", mock.Anything, "").Return([]repo.WageCheckCount{}, errors.New("db error"))
+			},
+			expectedError: errors.New("db error"),
+		},
+	}
+
+	for _, test := range testCases {
+		suite.SetupTest()
+		suite.Run(test.name, func() {
+			test.setup()
+
+			report, err := suite.service.FetchComplianceReport(context.Background(), test.input)
+
+			if test.expectedError != nil {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Equal(test.expectedOutput, report)
+		})
+		suite.TearDownTest()
+	}
+}
@@ -0,0 +1,60 @@
+package notification
+
+import (
+	"time"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/notifychannel"
+)
+
+type EventType string
+type Role string
+
+const (
+	ApplicationSubmitted   EventType = "application_submitted"
+	ApplicationShortlisted EventType = "application_shortlisted"
+	ApplicationConfirmed   EventType = "application_confirmed"
+	JobPosted              EventType = "job_posted"
+	JobUpdated             EventType = "job_updated"
+	NewLogin               EventType = "new_login"
+	ReportActioned         EventType = "report_actioned"
+	ReportDismissed        EventType = "report_dismissed"
+
+	WorkerRecipient   Role = "worker"
+	EmployerRecipient Role = "employer"
+)
+
+// Event is something that happened on the platform that workers or employers are told about, the
+// notification service works out the recipients from the ids it carries
+type Event struct {
+	Type          EventType
+	ApplicationID int
+	JobID         int
+	WorkerID      int
+	EmployerID    int
+}
+
+type Notification struct {
+	ID        int        `json:"id"`
+	Event     EventType  `json:"event"`
+	Title     string     `json:"title"`
+	Body      string     `json:"body"`
+	CreatedAt time.Time  `json:"created_at"`
+	ReadAt    *time.Time `json:"read_at,omitempty"`
+}
+
+type Inbox struct {
+	Unread        int            `json:"unread"`
+	Notifications []Notification `json:"notifications"`
+}
+
+type Preference struct {
+	Channel notifychannel.Channel `json:"channel"`
+	Enabled bool                  `json:"enabled"`
+}
+
+// templateData is the data available to notification templates
+type templateData struct {
+	Name     string
+	JobTitle string
+	JobDate  string
+}
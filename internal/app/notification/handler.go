@@ -0,0 +1,141 @@
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/logger"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/middleware"
+	"go.uber.org/zap"
+)
+
+// the handlers below serve both workers and employers, the recipient id is read from the
+// {worker_id} or {employer_id} path variable depending on the role they are registered for
+
+func FetchInbox(notificationService Service, role Role) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		recipientId, id := isRecipientIdValid(ctx, w, r, role, apperrors.ErrFetchNotifications)
+		if recipientId == -1 {
+			return
+		}
+
+		inbox, err := notificationService.FetchInbox(ctx, role, recipientId)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrFetchNotifications.Error(), zap.Error(err), zap.String("ID", id))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrFetchNotifications.Error()+", "+err.Error(), notificationErrorStatusCode(err))
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, "notifications retrieved successfully", http.StatusOK, inbox)
+	}
+}
+
+func MarkRead(notificationService Service, role Role) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		recipientId, _ := isRecipientIdValid(ctx, w, r, role, apperrors.ErrMarkNotificationRead)
+		if recipientId == -1 {
+			return
+		}
+
+		notificationId, id := isPathIdValid(ctx, w, r, "notification_id", apperrors.MsgInvalidNotificationId, apperrors.ErrMarkNotificationRead)
+		if notificationId == -1 {
+			return
+		}
+
+		notification, err := notificationService.MarkRead(ctx, role, recipientId, notificationId)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrMarkNotificationRead.Error(), zap.Error(err), zap.String("ID", id))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrMarkNotificationRead.Error()+", "+err.Error(), notificationErrorStatusCode(err))
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, "notification marked as read successfully", http.StatusOK, notification)
+	}
+}
+
+func FetchPreferences(notificationService Service, role Role) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		recipientId, id := isRecipientIdValid(ctx, w, r, role, apperrors.ErrFetchNotificationPrefs)
+		if recipientId == -1 {
+			return
+		}
+
+		preferences, err := notificationService.FetchPreferences(ctx, role, recipientId)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrFetchNotificationPrefs.Error(), zap.Error(err), zap.String("ID", id))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrFetchNotificationPrefs.Error()+", "+err.Error(), notificationErrorStatusCode(err))
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, "notification preferences retrieved successfully", http.StatusOK, preferences)
+	}
+}
+
+func UpdatePreferences(notificationService Service, role Role) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		recipientId, id := isRecipientIdValid(ctx, w, r, role, apperrors.ErrUpdateNotificationPrefs)
+		if recipientId == -1 {
+			return
+		}
+
+		var preferences []Preference
+		err := json.NewDecoder(r.Body).Decode(&preferences)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrInvalidRequestBody.Error(), zap.Error(err))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrInvalidRequestBody.Error()+": "+err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		updatedPreferences, err := notificationService.UpdatePreferences(ctx, role, recipientId, preferences)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrUpdateNotificationPrefs.Error(), zap.Error(err), zap.String("ID", id))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrUpdateNotificationPrefs.Error()+", "+err.Error(), notificationErrorStatusCode(err))
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, "notification preferences updated successfully", http.StatusOK, updatedPreferences)
+	}
+}
+
+func isRecipientIdValid(ctx context.Context, w http.ResponseWriter, r *http.Request, role Role, errType error) (int, string) {
+	if role == EmployerRecipient {
+		return isPathIdValid(ctx, w, r, "employer_id", apperrors.MsgInvalidEmployerId, errType)
+	}
+	return isPathIdValid(ctx, w, r, "worker_id", apperrors.MsgInvalidWorkerId, errType)
+}
+
+func isPathIdValid(ctx context.Context, w http.ResponseWriter, r *http.Request, key string, invalidMsg string, errType error) (int, string) {
+	vars := mux.Vars(r)
+	id := vars[key]
+	pathId, err := strconv.Atoi(id)
+	if err != nil {
+		logger.Errorw(ctx, invalidMsg, zap.Error(err), zap.String("ID", id))
+		httpResponseMsg := apperrors.HttpErrorResponseMessage(errType.Error(), invalidMsg, id)
+		middleware.HandleErrorResponse(ctx, w, httpResponseMsg, http.StatusBadRequest)
+		return -1, id
+	}
+	return pathId, id
+}
+
+func notificationErrorStatusCode(err error) int {
+	switch {
+	case errors.Is(err, apperrors.ErrInvalidRecipient), errors.Is(err, apperrors.ErrInvalidPreference):
+		return http.StatusBadRequest
+	case errors.Is(err, apperrors.ErrNoNotificationExists), errors.Is(err, apperrors.ErrNoWorkerExists), errors.Is(err, apperrors.ErrNoEmployerExists):
+		return http.StatusNotFound
+	}
+	return http.StatusInternalServerError
+}
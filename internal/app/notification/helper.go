@@ -0,0 +1,73 @@
+package notification
+
+import (
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/notifychannel"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo"
+)
+
+func MapNotificationRepoToService(notification repo.Notification) Notification {
+	return Notification{
+		ID:        notification.ID,
+		Event:     EventType(notification.Event),
+		Title:     notification.Title,
+		Body:      notification.Body,
+		CreatedAt: notification.CreatedAt,
+		ReadAt:    notification.ReadAt,
+	}
+}
+
+func validateRole(role Role) error {
+	if role != WorkerRecipient && role != EmployerRecipient {
+		return apperrors.ErrInvalidRecipient
+	}
+	return nil
+}
+
+func validatePreferences(preferences []Preference) error {
+	if len(preferences) == 0 {
+		return apperrors.ErrInvalidPreference
+	}
+
+	for _, preference := range preferences {
+		known := false
+		for _, channel := range notifychannel.Channels {
+			if preference.Channel == channel {
+				known = true
+			}
+		}
+		if !known {
+			return apperrors.ErrInvalidPreference
+		}
+	}
+	return nil
+}
+
+// mergePreferences returns the preference of every channel, channels without a stored preference are enabled
+func mergePreferences(stored []repo.NotificationPreference) []Preference {
+	enabled := make(map[notifychannel.Channel]bool)
+	for _, channel := range notifychannel.Channels {
+		enabled[channel] = true
+	}
+	for _, preference := range stored {
+		enabled[notifychannel.Channel(preference.Channel)] = preference.Enabled
+	}
+
+	preferences := make([]Preference, 0)
+	for _, channel := range notifychannel.Channels {
+		preferences = append(preferences, Preference{Channel: channel, Enabled: enabled[channel]})
+	}
+	return preferences
+}
+
+// channelAddress returns where a message is sent on a channel, and false when the recipient
+// cannot be reached on it
+func channelAddress(channel notifychannel.Channel, recipient repo.NotificationRecipient) (string, bool) {
+	switch channel {
+	case notifychannel.SMS:
+		return recipient.ContactNumber, recipient.ContactNumber != ""
+	case notifychannel.Email:
+		return recipient.Email, recipient.Email != ""
+	}
+	return "", true
+}
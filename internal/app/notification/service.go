@@ -0,0 +1,290 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/logger"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/notifychannel"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo"
+	"go.uber.org/zap"
+)
+
+// a message is tried maxAttempts times on a channel, waiting twice as long before every retry
+const (
+	maxAttempts       = 3
+	defaultRetryDelay = 500 * time.Millisecond
+)
+
+type notificationService struct {
+	notificationRepo repo.NotificationStorer
+	jobRepo          repo.JobStorer
+	senders          map[notifychannel.Channel]notifychannel.Sender
+	retryDelay       time.Duration
+}
+
+type Service interface {
+	Notify(ctx context.Context, event Event)
+	Dispatch(ctx context.Context, event Event) error
+	FetchInbox(ctx context.Context, role Role, recipientId int) (Inbox, error)
+	MarkRead(ctx context.Context, role Role, recipientId int, notificationId int) (Notification, error)
+	FetchPreferences(ctx context.Context, role Role, recipientId int) ([]Preference, error)
+	UpdatePreferences(ctx context.Context, role Role, recipientId int, preferences []Preference) ([]Preference, error)
+}
+
+// NewService delivers notifications through the given senders, the in-app inbox is always
+// available and stores notifications in the database
+func NewService(notificationRepo repo.NotificationStorer, jobRepo repo.JobStorer, senders map[notifychannel.Channel]notifychannel.Sender) Service {
+	channelSenders := make(map[notifychannel.Channel]notifychannel.Sender)
+	for channel, sender := range senders {
+		channelSenders[channel] = sender
+	}
+	channelSenders[notifychannel.InApp] = inboxSender{notificationRepo: notificationRepo}
+
+	return &notificationService{
+		notificationRepo: notificationRepo,
+		jobRepo:          jobRepo,
+		senders:          channelSenders,
+		retryDelay:       defaultRetryDelay,
+	}
+}
+
+// Notify dispatches an event in the background, a failed notification never fails the operation
+// that emitted it
+func (notS *notificationService) Notify(ctx context.Context, event Event) {
+	go func() {
+		err := notS.Dispatch(context.WithoutCancel(ctx), event)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrNotificationDeliveryFailed.Error(), zap.Error(err), zap.String("event", string(event.Type)))
+		}
+	}()
+}
+
+// Dispatch renders the event for each of its recipients in their language and delivers it on
+// every channel they have enabled
+func (notS *notificationService) Dispatch(ctx context.Context, event Event) error {
+	recipients, data, err := notS.recipients(ctx, event)
+	if err != nil {
+		return err
+	}
+
+	var deliveryErrors []error
+	for role, ids := range recipients {
+		for _, id := range ids {
+			err = notS.deliver(ctx, event, role, id, data)
+			if err != nil {
+				deliveryErrors = append(deliveryErrors, err)
+			}
+		}
+	}
+
+	if len(deliveryErrors) > 0 {
+		return fmt.Errorf("%w: %w", apperrors.ErrNotificationDeliveryFailed, errors.Join(deliveryErrors...))
+	}
+	return nil
+}
+
+func (notS *notificationService) FetchInbox(ctx context.Context, role Role, recipientId int) (Inbox, error) {
+	_, err := notS.recipient(ctx, role, recipientId)
+	if err != nil {
+		return Inbox{}, err
+	}
+
+	notifications, err := notS.notificationRepo.FetchNotifications(ctx, string(role), recipientId)
+	if err != nil {
+		return Inbox{}, err
+	}
+
+	inbox := Inbox{Notifications: make([]Notification, 0)}
+	for _, notification := range notifications {
+		if notification.ReadAt == nil {
+			inbox.Unread++
+		}
+		inbox.Notifications = append(inbox.Notifications, MapNotificationRepoToService(notification))
+	}
+	return inbox, nil
+}
+
+func (notS *notificationService) MarkRead(ctx context.Context, role Role, recipientId int, notificationId int) (Notification, error) {
+	err := validateRole(role)
+	if err != nil {
+		return Notification{}, err
+	}
+
+	notification, err := notS.notificationRepo.MarkNotificationRead(ctx, string(role), recipientId, notificationId)
+	if err != nil {
+		return Notification{}, err
+	}
+	return MapNotificationRepoToService(notification), nil
+}
+
+func (notS *notificationService) FetchPreferences(ctx context.Context, role Role, recipientId int) ([]Preference, error) {
+	_, err := notS.recipient(ctx, role, recipientId)
+	if err != nil {
+		return []Preference{}, err
+	}
+
+	preferences, err := notS.notificationRepo.FetchNotificationPreferences(ctx, string(role), recipientId)
+	if err != nil {
+		return []Preference{}, err
+	}
+	return mergePreferences(preferences), nil
+}
+
+func (notS *notificationService) UpdatePreferences(ctx context.Context, role Role, recipientId int, preferences []Preference) ([]Preference, error) {
+	err := validatePreferences(preferences)
+	if err != nil {
+		return []Preference{}, err
+	}
+
+	_, err = notS.recipient(ctx, role, recipientId)
+	if err != nil {
+		return []Preference{}, err
+	}
+
+	repoPreferences := make([]repo.NotificationPreference, 0)
+	for _, preference := range preferences {
+		repoPreferences = append(repoPreferences, repo.NotificationPreference{
+			RecipientRole: string(role),
+			RecipientID:   recipientId,
+			Channel:       string(preference.Channel),
+			Enabled:       preference.Enabled,
+		})
+	}
+
+	err = notS.notificationRepo.SaveNotificationPreferences(ctx, repoPreferences)
+	if err != nil {
+		return []Preference{}, err
+	}
+	return mergePreferences(repoPreferences), nil
+}
+
+// recipients works out who is told about an event, and the job details used by the templates
+func (notS *notificationService) recipients(ctx context.Context, event Event) (map[Role][]int, templateData, error) {
+	// these events are about the account of the recipient and not about a job
+	if event.Type == NewLogin || event.Type == ReportActioned || event.Type == ReportDismissed {
+		if event.WorkerID != 0 {
+			return map[Role][]int{WorkerRecipient: {event.WorkerID}}, templateData{}, nil
+		}
+		return map[Role][]int{EmployerRecipient: {event.EmployerID}}, templateData{}, nil
+	}
+
+	job, err := notS.jobRepo.FetchJobById(ctx, event.JobID)
+	if err != nil {
+		return nil, templateData{}, err
+	}
+	data := templateData{JobTitle: job.Title, JobDate: string(job.Date)}
+
+	switch event.Type {
+	case ApplicationSubmitted, JobPosted:
+		return map[Role][]int{EmployerRecipient: {job.EmployerID}}, data, nil
+	case ApplicationShortlisted, ApplicationConfirmed:
+		return map[Role][]int{WorkerRecipient: {event.WorkerID}}, data, nil
+	case JobUpdated:
+		applications, err := notS.jobRepo.FetchApplicationsByJobId(ctx, event.JobID)
+		if err != nil {
+			return nil, templateData{}, err
+		}
+
+		workers := make([]int, 0)
+		notified := make(map[int]bool)
+		for _, application := range applications {
+			if !notified[application.WorkerID] {
+				notified[application.WorkerID] = true
+				workers = append(workers, application.WorkerID)
+			}
+		}
+		return map[Role][]int{WorkerRecipient: workers}, data, nil
+	}
+	return nil, templateData{}, fmt.Errorf("%w: unknown event %s", apperrors.ErrNotificationDeliveryFailed, event.Type)
+}
+
+// deliver sends the event to one recipient on every channel they have enabled
+func (notS *notificationService) deliver(ctx context.Context, event Event, role Role, recipientId int, data templateData) error {
+	recipient, err := notS.recipient(ctx, role, recipientId)
+	if err != nil {
+		return err
+	}
+
+	storedPreferences, err := notS.notificationRepo.FetchNotificationPreferences(ctx, string(role), recipientId)
+	if err != nil {
+		return err
+	}
+
+	data.Name = recipient.Name
+	title, body, err := render(recipient.Language, event.Type, data)
+	if err != nil {
+		return err
+	}
+
+	var deliveryErrors []error
+	for _, preference := range mergePreferences(storedPreferences) {
+		sender, ok := notS.senders[preference.Channel]
+		if !preference.Enabled || !ok {
+			continue
+		}
+
+		address, reachable := channelAddress(preference.Channel, recipient)
+		if !reachable {
+			continue
+		}
+
+		err = notS.sendWithRetry(ctx, sender, notifychannel.Message{
+			Channel:       preference.Channel,
+			Event:         string(event.Type),
+			RecipientRole: string(role),
+			RecipientID:   recipientId,
+			Address:       address,
+			Title:         title,
+			Body:          body,
+		})
+		if err != nil {
+			deliveryErrors = append(deliveryErrors, fmt.Errorf("%s to %s %d: %w", preference.Channel, role, recipientId, err))
+		}
+	}
+	return errors.Join(deliveryErrors...)
+}
+
+func (notS *notificationService) sendWithRetry(ctx context.Context, sender notifychannel.Sender, message notifychannel.Message) error {
+	delay := notS.retryDelay
+	for attempt := 1; ; attempt++ {
+		err := sender.Send(ctx, message)
+		if err == nil || attempt == maxAttempts {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+		delay *= 2
+	}
+}
+
+func (notS *notificationService) recipient(ctx context.Context, role Role, recipientId int) (repo.NotificationRecipient, error) {
+	err := validateRole(role)
+	if err != nil {
+		return repo.NotificationRecipient{}, err
+	}
+	return notS.notificationRepo.FetchNotificationRecipient(ctx, string(role), recipientId)
+}
+
+// inboxSender delivers in-app notifications by storing them in the inbox of the recipient
+type inboxSender struct {
+	notificationRepo repo.NotificationStorer
+}
+
+func (inbox inboxSender) Send(ctx context.Context, message notifychannel.Message) error {
+	_, err := inbox.notificationRepo.CreateNotification(ctx, repo.Notification{
+		RecipientRole: message.RecipientRole,
+		RecipientID:   message.RecipientID,
+		Event:         message.Event,
+		Title:         message.Title,
+		Body:          message.Body,
+	})
+	return err
+}
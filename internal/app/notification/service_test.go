@@ -0,0 +1,358 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/notifychannel"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+// recordingSender keeps the messages it is asked to send and fails the first `failures` attempts
+type recordingSender struct {
+	mu       sync.Mutex
+	failures int
+	attempts int
+	sent     []notifychannel.Message
+}
+
+func (rs *recordingSender) Send(ctx context.Context, message notifychannel.Message) error {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+
+	rs.attempts++
+	if rs.attempts <= rs.failures {
+		return errors.New("gateway unavailable")
+	}
+	rs.sent = append(rs.sent, message)
+	return nil
+}
+
+type NotificationServiceTestSuite struct {
+	suite.Suite
+	service          Service
+	notificationRepo mocks.NotificationStorer
+	jobRepo          mocks.JobStorer
+	sms              *recordingSender
+	email            *recordingSender
+}
+
+func (suite *NotificationServiceTestSuite) SetupTest() {
+	suite.notificationRepo = mocks.NotificationStorer{}
+	suite.jobRepo = mocks.JobStorer{}
+	suite.sms = &recordingSender{}
+	suite.email = &recordingSender{}
+
+	service := NewService(&suite.notificationRepo, &suite.jobRepo, map[notifychannel.Channel]notifychannel.Sender{
+		notifychannel.SMS:   suite.sms,
+		notifychannel.Email: suite.email,
+	}).(*notificationService)
+	service.retryDelay = 0
+	suite.service = service
+}
+
+func (suite *NotificationServiceTestSuite) TearDownTest() {
+	suite.notificationRepo.AssertExpectations(suite.T())
+	suite.jobRepo.AssertExpectations(suite.T())
+}
+
+func TestNotificationServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(NotificationServiceTestSuite))
+}
+
+func (suite *NotificationServiceTestSuite) TestDispatch() {
+	type testCase struct {
+		name          string
+		input         Event
+		setup         func()
+		expectedSMS   []notifychannel.Message
+		expectedEmail []notifychannel.Message
+		expectedError error
+	}
+
+	job := repo.Job{ID: 2, EmployerID: 9, Title: "Painter", Date: "2025-03-15"}
+	employer := repo.NotificationRecipient{ID: 9, Name: "Asha", ContactNumber: "9876543210", Email: "asha@example.com", Language: "english"}
+	worker := repo.NotificationRecipient{ID: 5, Name: "रमेश", ContactNumber: "9123456780", Language: "hindi"}
+
+	testCases := []testCase{
+		{
+			name:  "new application told to the employer on every channel",
+			input: Event{Type: ApplicationSubmitted, ApplicationID: 1, JobID: 2, WorkerID: 5},
+			setup: func() {
+				suite.jobRepo.On("FetchJobById", mock.Anything, 2).Return(job, nil)
+				suite.notificationRepo.On("FetchNotificationRecipient", mock.Anything, "employer", 9).Return(employer, nil)
+				suite.notificationRepo.On("FetchNotificationPreferences", mock.Anything, "employer", 9).Return([]repo.NotificationPreference{}, nil)
+				suite.notificationRepo.On("CreateNotification", mock.Anything, repo.Notification{RecipientRole: "employer", RecipientID: 9, Event: "application_submitted", Title: "New application", Body: `Hello Asha, a worker has applied to your job "Painter".`}).Return(repo.Notification{ID: 1}, nil)
+			},
+			expectedSMS:   []notifychannel.Message{{Channel: notifychannel.SMS, Event: "application_submitted", RecipientRole: "employer", RecipientID: 9, Address: "9876543210", Title: "New application", Body: `Hello Asha, a worker has applied to your job "Painter".`}},
+			expectedEmail: []notifychannel.Message{{Channel: notifychannel.Email, Event: "application_submitted", RecipientRole: "employer", RecipientID: 9, Address: "asha@example.com", Title: "New application", Body: `Hello Asha, a worker has applied to your job "Painter".`}},
+			expectedError: nil,
+		},
+		{
+			name:  "confirmation rendered in the language of the worker, disabled channels skipped",
+			input: Event{Type: ApplicationConfirmed, ApplicationID: 1, JobID: 2, WorkerID: 5},
+			setup: func() {
+				suite.jobRepo.On("FetchJobById", mock.Anything, 2).Return(job, nil)
+				suite.notificationRepo.On("FetchNotificationRecipient", mock.Anything, "worker", 5).Return(worker, nil)
+				suite.notificationRepo.On("FetchNotificationPreferences", mock.Anything, "worker", 5).Return([]repo.NotificationPreference{{RecipientRole: "worker", RecipientID: 5, Channel: "in_app", Enabled: false}}, nil)
+			},
+			expectedSMS:   []notifychannel.Message{{Channel: notifychannel.SMS, Event: "application_confirmed", RecipientRole: "worker", RecipientID: 5, Address: "9123456780", Title: "नौकरी पक्की", Body: `नमस्ते रमेश, 2025-03-15 की नौकरी "Painter" आपके लिए पक्की हो गई है।`}},
+			expectedEmail: nil,
+			expectedError: nil,
+		},
+		{
+			name:  "job update told once to every applicant",
+			input: Event{Type: JobUpdated, JobID: 2},
+			setup: func() {
+				suite.jobRepo.On("FetchJobById", mock.Anything, 2).Return(job, nil)
+				suite.jobRepo.On("FetchApplicationsByJobId", mock.Anything, 2).Return([]repo.ApplicationCompleteEmp{{ID: 1, WorkerID: 5}, {ID: 3, WorkerID: 5}}, nil)
+				suite.notificationRepo.On("FetchNotificationRecipient", mock.Anything, "worker", 5).Return(worker, nil)
+				suite.notificationRepo.On("FetchNotificationPreferences", mock.Anything, "worker", 5).Return([]repo.NotificationPreference{{Channel: "in_app", Enabled: false}, {Channel: "sms", Enabled: false}}, nil)
+			},
+			expectedSMS:   nil,
+			expectedEmail: nil,
+			expectedError: nil,
+		},
+		{
+			name:  "failed sms retried until delivered",
+			input: Event{Type: NewLogin, EmployerID: 9},
+			setup: func() {
+				suite.sms.failures = 2
+				suite.notificationRepo.On("FetchNotificationRecipient", mock.Anything, "employer", 9).Return(employer, nil)
+				suite.notificationRepo.On("FetchNotificationPreferences", mock.Anything, "employer", 9).Return([]repo.NotificationPreference{{Channel: "in_app", Enabled: false}, {Channel: "email", Enabled: false}}, nil)
+			},
+			expectedSMS:   []notifychannel.Message{{Channel: notifychannel.SMS, Event: "new_login", RecipientRole: "employer", RecipientID: 9, Address: "9876543210", Title: "New login", Body: "Hello Asha, your account was just logged into. If this was not you, change your password now."}},
+			expectedEmail: nil,
+			expectedError: nil,
+		},
+		{
+			name:  "sms failing on every attempt",
+			input: Event{Type: NewLogin, EmployerID: 9},
+			setup: func() {
+				suite.sms.failures = maxAttempts
+				suite.notificationRepo.On("FetchNotificationRecipient", mock.Anything, "employer", 9).Return(employer, nil)
+				suite.notificationRepo.On("FetchNotificationPreferences", mock.Anything, "employer", 9).Return([]repo.NotificationPreference{{Channel: "in_app", Enabled: false}, {Channel: "email", Enabled: false}}, nil)
+			},
+			expectedSMS:   nil,
+			expectedEmail: nil,
+			expectedError: apperrors.ErrNotificationDeliveryFailed,
+		},
+		{
+			name:  "report outcome told to the reporter without a job",
+			input: Event{Type: ReportDismissed, WorkerID: 5},
+			setup: func() {
+				suite.notificationRepo.On("FetchNotificationRecipient", mock.Anything, "worker", 5).Return(worker, nil)
+				suite.notificationRepo.On("FetchNotificationPreferences", mock.Anything, "worker", 5).Return([]repo.NotificationPreference{{Channel: "in_app", Enabled: false}}, nil)
+			},
+			expectedSMS:   []notifychannel.Message{{Channel: notifychannel.SMS, Event: "report_dismissed", RecipientRole: "worker", RecipientID: 5, Address: "9123456780", Title: "शिकायत की जांच हुई", Body: "नमस्ते रमेश, आपकी शिकायत के लिए धन्यवाद। हमने इसकी जांच की और पाया कि यह सामग्री हमारे नियमों का उल्लंघन नहीं करती।"}},
+			expectedEmail: nil,
+			expectedError: nil,
+		},
+		{
+			name:  "job does not exist",
+			input: Event{Type: JobPosted, JobID: 2},
+			setup: func() {
+				suite.jobRepo.On("FetchJobById", mock.Anything, 2).Return(repo.Job{}, apperrors.ErrNoJobExists)
+			},
+			expectedSMS:   nil,
+			expectedEmail: nil,
+			expectedError: apperrors.ErrNoJobExists,
+		},
+	}
+
+	for _, test := range testCases {
+		suite.SetupTest()
+		suite.Run(test.name, func() {
+			test.setup()
+
+			err := suite.service.Dispatch(context.Background(), test.input)
+
+			suite.ErrorIs(err, test.expectedError)
+			suite.Equal(test.expectedSMS, suite.sms.sent)
+			suite.Equal(test.expectedEmail, suite.email.sent)
+		})
+		suite.TearDownTest()
+	}
+}
+
+func (suite *NotificationServiceTestSuite) TestFetchInbox() {
+	type testCase struct {
+		name           string
+		role           Role
+		setup          func()
+		expectedOutput Inbox
+		expectedError  error
+	}
+
+	createdAt := time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC)
+	readAt := createdAt.Add(time.Hour)
+
+	testCases := []testCase{
+		{
+			name: "success",
+			role: WorkerRecipient,
+			setup: func() {
+				suite.notificationRepo.On("FetchNotificationRecipient", mock.Anything, "worker", 5).Return(repo.NotificationRecipient{ID: 5}, nil)
+				suite.notificationRepo.On("FetchNotifications", mock.Anything, "worker", 5).Return([]repo.Notification{
+					{ID: 2, RecipientRole: "worker", RecipientID: 5, Event: "application_confirmed", Title: "Job confirmed", Body: "confirmed", CreatedAt: createdAt},
+					{ID: 1, RecipientRole: "worker", RecipientID: 5, Event: "application_shortlisted", Title: "You are shortlisted", Body: "shortlisted", CreatedAt: createdAt, ReadAt: &readAt},
+				}, nil)
+			},
+			expectedOutput: Inbox{Unread: 1, Notifications: []Notification{
+				{ID: 2, Event: ApplicationConfirmed, Title: "Job confirmed", Body: "confirmed", CreatedAt: createdAt},
+				{ID: 1, Event: ApplicationShortlisted, Title: "You are shortlisted", Body: "shortlisted", CreatedAt: createdAt, ReadAt: &readAt},
+			}},
+			expectedError: nil,
+		},
+		{
+			name:           "invalid role",
+			role:           Role("admin"),
+			setup:          func() {},
+			expectedOutput: Inbox{},
+			expectedError:  apperrors.ErrInvalidRecipient,
+		},
+		{
+			name: "worker does not exist",
+			role: WorkerRecipient,
+			setup: func() {
+				suite.notificationRepo.On("FetchNotificationRecipient", mock.Anything, "worker", 5).Return(repo.NotificationRecipient{}, apperrors.ErrNoWorkerExists)
+			},
+			expectedOutput: Inbox{},
+			expectedError:  apperrors.ErrNoWorkerExists,
+		},
+	}
+
+	for _, test := range testCases {
+		suite.SetupTest()
+		suite.Run(test.name, func() {
+			test.setup()
+
+			inbox, err := suite.service.FetchInbox(context.Background(), test.role, 5)
+
+			suite.ErrorIs(err, test.expectedError)
+			suite.Equal(test.expectedOutput, inbox)
+		})
+		suite.TearDownTest()
+	}
+}
+
+func (suite *NotificationServiceTestSuite) TestMarkRead() {
+	type testCase struct {
+		name           string
+		setup          func()
+		expectedOutput Notification
+		expectedError  error
+	}
+
+	createdAt := time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC)
+	readAt := createdAt.Add(time.Hour)
+
+	testCases := []testCase{
+		{
+			name: "success",
+			setup: func() {
+				suite.notificationRepo.On("MarkNotificationRead", mock.Anything, "employer", 9, 4).Return(repo.Notification{ID: 4, RecipientRole: "employer", RecipientID: 9, Event: "job_posted", Title: "Job posted", Body: "posted", CreatedAt: createdAt, ReadAt: &readAt}, nil)
+			},
+			expectedOutput: Notification{ID: 4, Event: JobPosted, Title: "Job posted", Body: "posted", CreatedAt: createdAt, ReadAt: &readAt},
+			expectedError:  nil,
+		},
+		{
+			name: "notification does not exist",
+			setup: func() {
+				suite.notificationRepo.On("MarkNotificationRead", mock.Anything, "employer", 9, 4).Return(repo.Notification{}, apperrors.ErrNoNotificationExists)
+			},
+			expectedOutput: Notification{},
+			expectedError:  apperrors.ErrNoNotificationExists,
+		},
+	}
+
+	for _, test := range testCases {
+		suite.SetupTest()
+		suite.Run(test.name, func() {
+			test.setup()
+
+			notification, err := suite.service.MarkRead(context.Background(), EmployerRecipient, 9, 4)
+
+			suite.ErrorIs(err, test.expectedError)
+			suite.Equal(test.expectedOutput, notification)
+		})
+		suite.TearDownTest()
+	}
+}
+
+func (suite *NotificationServiceTestSuite) TestFetchPreferences() {
+	suite.notificationRepo.On("FetchNotificationRecipient", mock.Anything, "worker", 5).Return(repo.NotificationRecipient{ID: 5}, nil)
+	suite.notificationRepo.On("FetchNotificationPreferences", mock.Anything, "worker", 5).Return([]repo.NotificationPreference{{RecipientRole: "worker", RecipientID: 5, Channel: "email", Enabled: false}}, nil)
+
+	preferences, err := suite.service.FetchPreferences(context.Background(), WorkerRecipient, 5)
+
+	suite.NoError(err)
+	suite.Equal([]Preference{
+		{Channel: notifychannel.InApp, Enabled: true},
+		{Channel: notifychannel.SMS, Enabled: true},
+		{Channel: notifychannel.Push, Enabled: true},
+		{Channel: notifychannel.Email, Enabled: false},
+	}, preferences)
+}
+
+func (suite *NotificationServiceTestSuite) TestUpdatePreferences() {
+	type testCase struct {
+		name           string
+		input          []Preference
+		setup          func()
+		expectedOutput []Preference
+		expectedError  error
+	}
+
+	testCases := []testCase{
+		{
+			name:  "success",
+			input: []Preference{{Channel: notifychannel.SMS, Enabled: false}},
+			setup: func() {
+				suite.notificationRepo.On("FetchNotificationRecipient", mock.Anything, "worker", 5).Return(repo.NotificationRecipient{ID: 5}, nil)
+				suite.notificationRepo.On("SaveNotificationPreferences", mock.Anything, []repo.NotificationPreference{{RecipientRole: "worker", RecipientID: 5, Channel: "sms", Enabled: false}}).Return(nil)
+			},
+			expectedOutput: []Preference{
+				{Channel: notifychannel.InApp, Enabled: true},
+				{Channel: notifychannel.SMS, Enabled: false},
+				{Channel: notifychannel.Push, Enabled: true},
+				{Channel: notifychannel.Email, Enabled: true},
+			},
+			expectedError: nil,
+		},
+		{
+			name:           "unknown channel",
+			input:          []Preference{{Channel: notifychannel.Channel("fax"), Enabled: true}},
+			setup:          func() {},
+			expectedOutput: []Preference{},
+			expectedError:  apperrors.ErrInvalidPreference,
+		},
+		{
+			name:           "no preferences",
+			input:          []Preference{},
+			setup:          func() {},
+			expectedOutput: []Preference{},
+			expectedError:  apperrors.ErrInvalidPreference,
+		},
+	}
+
+	for _, test := range testCases {
+		suite.SetupTest()
+		suite.Run(test.name, func() {
+			test.setup()
+
+			preferences, err := suite.service.UpdatePreferences(context.Background(), WorkerRecipient, 5, test.input)
+
+			suite.ErrorIs(err, test.expectedError)
+			suite.Equal(test.expectedOutput, preferences)
+		})
+		suite.TearDownTest()
+	}
+}
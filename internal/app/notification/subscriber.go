@@ -0,0 +1,69 @@
+package notification
+
+import (
+	"context"
+	"errors"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/outbox"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/logger"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo"
+	"go.uber.org/zap"
+)
+
+// OutboxHandler notifies workers and employers about outbox events. Failures to send on a channel
+// are already retried by the service and only logged here, so that a retried event does not add
+// the notification to the inbox again, other failures are returned for the outbox to retry.
+func OutboxHandler(notificationService Service) outbox.Handler {
+	return func(ctx context.Context, event outbox.Event) error {
+		notificationEvent, ok := eventFromOutbox(event)
+		if !ok {
+			return nil
+		}
+
+		err := notificationService.Dispatch(ctx, notificationEvent)
+		if errors.Is(err, apperrors.ErrNotificationDeliveryFailed) {
+			logger.Errorw(ctx, apperrors.ErrNotificationDeliveryFailed.Error(), zap.Error(err), zap.Int("event_id", event.ID))
+			return nil
+		}
+		return err
+	}
+}
+
+// eventFromOutbox returns the notification for an outbox event, and false for events nobody is
+// notified about
+func eventFromOutbox(event outbox.Event) (Event, bool) {
+	notificationEvent := Event{
+		ApplicationID: event.ApplicationID,
+		JobID:         event.JobID,
+		WorkerID:      event.WorkerID,
+		EmployerID:    event.EmployerID,
+	}
+
+	switch event.Type {
+	case outbox.JobPosted:
+		notificationEvent.Type = JobPosted
+	case outbox.JobUpdated:
+		notificationEvent.Type = JobUpdated
+	case outbox.ApplicationSubmitted:
+		notificationEvent.Type = ApplicationSubmitted
+	case outbox.ApplicationStatusChanged:
+		switch repo.Status(event.Status) {
+		case repo.Shortlisted:
+			notificationEvent.Type = ApplicationShortlisted
+		case repo.Confirmed:
+			notificationEvent.Type = ApplicationConfirmed
+		default:
+			return Event{}, false
+		}
+	case outbox.ReportResolved:
+		// the status is the one the reports of the reporter were closed with
+		notificationEvent.Type = ReportActioned
+		if event.Status == "dismissed" {
+			notificationEvent.Type = ReportDismissed
+		}
+	default:
+		return Event{}, false
+	}
+	return notificationEvent, true
+}
@@ -0,0 +1,101 @@
+package notification_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/notification"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/notification/mocks"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/outbox"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestOutboxHandler(t *testing.T) {
+	type testCase struct {
+		name          string
+		input         outbox.Event
+		setup         func(notificationService *mocks.Service)
+		expectedError error
+	}
+
+	dbErr := errors.New("db error")
+
+	testCases := []testCase{
+		{
+			name:  "confirmation notified to the worker",
+			input: outbox.Event{ID: 7, Type: outbox.ApplicationStatusChanged, ApplicationID: 1, JobID: 3, WorkerID: 12, Status: "confirmed", PreviousStatus: "shortlisted"},
+			setup: func(notificationService *mocks.Service) {
+				notificationService.On("Dispatch", mock.Anything, notification.Event{Type: notification.ApplicationConfirmed, ApplicationID: 1, JobID: 3, WorkerID: 12}).Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:  "new job notified to the employer",
+			input: outbox.Event{ID: 8, Type: outbox.JobPosted, JobID: 3, EmployerID: 9},
+			setup: func(notificationService *mocks.Service) {
+				notificationService.On("Dispatch", mock.Anything, notification.Event{Type: notification.JobPosted, JobID: 3, EmployerID: 9}).Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:  "dismissed report notified to the reporter",
+			input: outbox.Event{ID: 12, Type: outbox.ReportResolved, WorkerID: 5, Status: "dismissed"},
+			setup: func(notificationService *mocks.Service) {
+				notificationService.On("Dispatch", mock.Anything, notification.Event{Type: notification.ReportDismissed, WorkerID: 5}).Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:  "actioned report notified to the reporter",
+			input: outbox.Event{ID: 13, Type: outbox.ReportResolved, EmployerID: 8, Status: "actioned"},
+			setup: func(notificationService *mocks.Service) {
+				notificationService.On("Dispatch", mock.Anything, notification.Event{Type: notification.ReportActioned, EmployerID: 8}).Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:          "status change without a notification",
+			input:         outbox.Event{ID: 7, Type: outbox.ApplicationStatusChanged, ApplicationID: 1, JobID: 3, WorkerID: 12, Status: "pending", PreviousStatus: "shortlisted"},
+			setup:         func(notificationService *mocks.Service) {},
+			expectedError: nil,
+		},
+		{
+			name:          "event nobody is notified about",
+			input:         outbox.Event{ID: 9, Type: outbox.WorkerRegistered, WorkerID: 12},
+			setup:         func(notificationService *mocks.Service) {},
+			expectedError: nil,
+		},
+		{
+			name:  "channel failures are not retried by the outbox",
+			input: outbox.Event{ID: 10, Type: outbox.ApplicationSubmitted, ApplicationID: 1, JobID: 3, WorkerID: 12},
+			setup: func(notificationService *mocks.Service) {
+				notificationService.On("Dispatch", mock.Anything, notification.Event{Type: notification.ApplicationSubmitted, ApplicationID: 1, JobID: 3, WorkerID: 12}).Return(fmt.Errorf("%w: sms unavailable", apperrors.ErrNotificationDeliveryFailed))
+			},
+			expectedError: nil,
+		},
+		{
+			name:  "other failures are retried",
+			input: outbox.Event{ID: 11, Type: outbox.JobUpdated, JobID: 3, EmployerID: 9},
+			setup: func(notificationService *mocks.Service) {
+				notificationService.On("Dispatch", mock.Anything, notification.Event{Type: notification.JobUpdated, JobID: 3, EmployerID: 9}).Return(dbErr)
+			},
+			expectedError: dbErr,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			notificationService := &mocks.Service{}
+			test.setup(notificationService)
+
+			err := notification.OutboxHandler(notificationService)(context.Background(), test.input)
+
+			assert.Equal(t, test.expectedError, err)
+			notificationService.AssertExpectations(t)
+		})
+	}
+}
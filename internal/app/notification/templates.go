@@ -0,0 +1,102 @@
+package notification
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+)
+
+const defaultLanguage = "english"
+
+type messageTemplate struct {
+	title *template.Template
+	body  *template.Template
+}
+
+// languageAliases maps the ways a language can be written in a profile to the template language
+var languageAliases = map[string]string{
+	"en":      "english",
+	"english": "english",
+	"hi":      "hindi",
+	"hindi":   "hindi",
+	"हिंदी":   "hindi",
+	"हिन्दी":  "hindi",
+	"mr":      "marathi",
+	"marathi": "marathi",
+	"मराठी":   "marathi",
+}
+
+var templates = map[string]map[EventType]messageTemplate{
+	"english": {
+		ApplicationSubmitted:   newTemplate("New application", `Hello {{.Name}}, a worker has applied to your job "{{.JobTitle}}".`),
+		ApplicationShortlisted: newTemplate("You are shortlisted", `Hello {{.Name}}, you have been shortlisted for "{{.JobTitle}}".`),
+		ApplicationConfirmed:   newTemplate("Job confirmed", `Hello {{.Name}}, your job "{{.JobTitle}}" on {{.JobDate}} is confirmed.`),
+		JobPosted:              newTemplate("Job posted", `Hello {{.Name}}, your job "{{.JobTitle}}" is now visible to workers.`),
+		JobUpdated:             newTemplate("Job updated", `Hello {{.Name}}, the job "{{.JobTitle}}" you applied to has changed. Please check the details.`),
+		NewLogin:               newTemplate("New login", `Hello {{.Name}}, your account was just logged into. If this was not you, change your password now.`),
+		ReportActioned:         newTemplate("Report reviewed", `Hello {{.Name}}, thank you for your report. We reviewed it and took action on the reported content.`),
+		ReportDismissed:        newTemplate("Report reviewed", `Hello {{.Name}}, thank you for your report. We reviewed it and found that the content does not break our rules.`),
+	},
+	"hindi": {
+		ApplicationSubmitted:   newTemplate("नया आवेदन", `नमस्ते {{.Name}}, आपकी नौकरी "{{.JobTitle}}" के लिए एक नया आवेदन आया है।`),
+		ApplicationShortlisted: newTemplate("आप शॉर्टलिस्ट हुए", `नमस्ते {{.Name}}, "{{.JobTitle}}" के लिए आपको शॉर्टलिस्ट किया गया है।`),
+		ApplicationConfirmed:   newTemplate("नौकरी पक्की", `नमस्ते {{.Name}}, {{.JobDate}} की नौकरी "{{.JobTitle}}" आपके लिए पक्की हो गई है।`),
+		JobPosted:              newTemplate("नौकरी प्रकाशित", `नमस्ते {{.Name}}, आपकी नौकरी "{{.JobTitle}}" अब कामगारों को दिख रही है।`),
+		JobUpdated:             newTemplate("नौकरी में बदलाव", `नमस्ते {{.Name}}, जिस नौकरी "{{.JobTitle}}" के लिए आपने आवेदन किया है उसमें बदलाव हुआ है। कृपया विवरण देखें।`),
+		NewLogin:               newTemplate("नया लॉगिन", `नमस्ते {{.Name}}, आपके खाते में अभी लॉगिन किया गया है। अगर यह आप नहीं थे, तो तुरंत पासवर्ड बदलें।`),
+		ReportActioned:         newTemplate("शिकायत की जांच हुई", `नमस्ते {{.Name}}, आपकी शिकायत के लिए धन्यवाद। हमने इसकी जांच की और शिकायत की गई सामग्री पर कार्रवाई की है।`),
+		ReportDismissed:        newTemplate("शिकायत की जांच हुई", `नमस्ते {{.Name}}, आपकी शिकायत के लिए धन्यवाद। हमने इसकी जांच की और पाया कि यह सामग्री हमारे नियमों का उल्लंघन नहीं करती।`),
+	},
+	"marathi": {
+		ApplicationSubmitted:   newTemplate("नवीन अर्ज", `नमस्कार {{.Name}}, तुमच्या "{{.JobTitle}}" या कामासाठी नवीन अर्ज आला आहे.`),
+		ApplicationShortlisted: newTemplate("निवड यादीत नाव", `नमस्कार {{.Name}}, "{{.JobTitle}}" या कामासाठी तुमचे नाव निवड यादीत आले आहे.`),
+		ApplicationConfirmed:   newTemplate("काम निश्चित", `नमस्कार {{.Name}}, {{.JobDate}} रोजीचे "{{.JobTitle}}" हे काम तुमच्यासाठी निश्चित झाले आहे.`),
+		JobPosted:              newTemplate("काम प्रकाशित", `नमस्कार {{.Name}}, तुमचे "{{.JobTitle}}" हे काम आता कामगारांना दिसत आहे.`),
+		JobUpdated:             newTemplate("कामात बदल", `नमस्कार {{.Name}}, तुम्ही अर्ज केलेल्या "{{.JobTitle}}" या कामात बदल झाला आहे. कृपया तपशील पहा.`),
+		NewLogin:               newTemplate("नवीन लॉगिन", `नमस्कार {{.Name}}, तुमच्या खात्यात आत्ताच लॉगिन झाले. हे तुम्ही नसल्यास लगेच पासवर्ड बदला.`),
+		ReportActioned:         newTemplate("तक्रारीची तपासणी झाली", `नमस्कार {{.Name}}, तुमच्या तक्रारीबद्दल धन्यवाद. आम्ही तिची तपासणी करून तक्रार केलेल्या मजकुरावर कारवाई केली आहे.`),
+		ReportDismissed:        newTemplate("तक्रारीची तपासणी झाली", `नमस्कार {{.Name}}, तुमच्या तक्रारीबद्दल धन्यवाद. आम्ही तिची तपासणी केली आणि हा मजकूर आमच्या नियमांचे उल्लंघन करत नाही असे आढळले.`),
+	},
+}
+
+func newTemplate(title, body string) messageTemplate {
+	return messageTemplate{
+		title: template.Must(template.New("title").Parse(title)),
+		body:  template.Must(template.New("body").Parse(body)),
+	}
+}
+
+// normalizeLanguage returns the template language for the language of a profile, english when
+// the language has no templates
+func normalizeLanguage(language string) string {
+	normalized, ok := languageAliases[strings.ToLower(strings.TrimSpace(language))]
+	if !ok {
+		return defaultLanguage
+	}
+	return normalized
+}
+
+// render returns the title and body of an event in the given language
+func render(language string, eventType EventType, data templateData) (string, string, error) {
+	eventTemplate, ok := templates[normalizeLanguage(language)][eventType]
+	if !ok {
+		eventTemplate, ok = templates[defaultLanguage][eventType]
+		if !ok {
+			return "", "", fmt.Errorf("%w: no template for event %s", apperrors.ErrNotificationDeliveryFailed, eventType)
+		}
+	}
+
+	var title, body bytes.Buffer
+	err := eventTemplate.title.Execute(&title, data)
+	if err != nil {
+		return "", "", err
+	}
+	err = eventTemplate.body.Execute(&body, data)
+	if err != nil {
+		return "", "", err
+	}
+	return title.String(), body.String(), nil
+}
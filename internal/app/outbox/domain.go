@@ -0,0 +1,46 @@
+package outbox
+
+import (
+	"context"
+	"time"
+)
+
+type EventType string
+
+const (
+	JobPosted                EventType = "job_posted"
+	JobUpdated               EventType = "job_updated"
+	ApplicationSubmitted     EventType = "application_submitted"
+	ApplicationStatusChanged EventType = "application_status_changed"
+	WorkerRegistered         EventType = "worker_registered"
+	EmployerRegistered       EventType = "employer_registered"
+	MessageSent              EventType = "message_sent"
+	WorkerDeleted            EventType = "worker_deleted"
+	EmployerDeleted          EventType = "employer_deleted"
+	JobDeleted               EventType = "job_deleted"
+	ReportResolved           EventType = "report_resolved"
+)
+
+// Event is a domain event read from the outbox, only the ids relevant to its type are set
+type Event struct {
+	ID             int
+	Type           EventType
+	ApplicationID  int
+	JobID          int
+	WorkerID       int
+	EmployerID     int
+	MessageID      int
+	SenderRole     string
+	Status         string
+	PreviousStatus string
+	OccurredAt     time.Time
+}
+
+// Handler reacts to an event, an error makes the dispatcher retry the event later. Events are
+// delivered at least once so handlers must tolerate receiving the same event again.
+type Handler func(ctx context.Context, event Event) error
+
+type subscriber struct {
+	name    string
+	handler Handler
+}
@@ -0,0 +1,41 @@
+package outbox
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo"
+)
+
+func MapOutboxEventRepoToService(event repo.OutboxEvent) (Event, error) {
+	var payload repo.EventPayload
+	err := json.Unmarshal(event.Payload, &payload)
+	if err != nil {
+		return Event{}, fmt.Errorf("%w: %s", apperrors.ErrInvalidEventPayload, err.Error())
+	}
+
+	return Event{
+		ID:             event.ID,
+		Type:           EventType(event.Type),
+		ApplicationID:  payload.ApplicationID,
+		JobID:          payload.JobID,
+		WorkerID:       payload.WorkerID,
+		EmployerID:     payload.EmployerID,
+		MessageID:      payload.MessageID,
+		SenderRole:     payload.SenderRole,
+		Status:         payload.Status,
+		PreviousStatus: payload.PreviousStatus,
+		OccurredAt:     event.CreatedAt,
+	}, nil
+}
+
+// retryDelay doubles the wait after every failed attempt, up to maxRetryDelay
+func retryDelay(attempts int) time.Duration {
+	delay := initialRetryDelay
+	for i := 0; i < attempts && delay < maxRetryDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, maxRetryDelay)
+}
@@ -0,0 +1,142 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/logger"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo"
+	"go.uber.org/zap"
+)
+
+const (
+	batchSize    = 50
+	pollInterval = 2 * time.Second
+	// a claimed event is handed to another dispatcher if it is not finished within the lease
+	claimLease = time.Minute
+
+	maxAttempts       = 10
+	initialRetryDelay = 5 * time.Second
+	maxRetryDelay     = time.Hour
+)
+
+type outboxService struct {
+	outboxRepo  repo.OutboxStorer
+	subscribers map[EventType][]subscriber
+	now         func() time.Time
+}
+
+// Service delivers the events written to the outbox to the subscribers of their type
+type Service interface {
+	Subscribe(eventType EventType, name string, handler Handler)
+	Run(ctx context.Context)
+	DispatchPending(ctx context.Context) (int, error)
+}
+
+func NewService(outboxRepo repo.OutboxStorer) Service {
+	return &outboxService{
+		outboxRepo:  outboxRepo,
+		subscribers: make(map[EventType][]subscriber),
+		now:         time.Now,
+	}
+}
+
+// Subscribe registers a handler for an event type, the name identifies the subscriber in the
+// delivery log so it must stay the same across releases. Subscribers are registered before Run.
+func (outS *outboxService) Subscribe(eventType EventType, name string, handler Handler) {
+	outS.subscribers[eventType] = append(outS.subscribers[eventType], subscriber{name: name, handler: handler})
+}
+
+// Run dispatches pending events until the context is cancelled
+func (outS *outboxService) Run(ctx context.Context) {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		dispatched, err := outS.DispatchPending(ctx)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrDispatchEvents.Error(), zap.Error(err))
+		}
+
+		// a full batch means more events are probably waiting, keep going without waiting for the ticker
+		if dispatched == batchSize && ctx.Err() == nil {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// DispatchPending claims a batch of due events and delivers each of them, it returns the number
+// of events claimed
+func (outS *outboxService) DispatchPending(ctx context.Context) (int, error) {
+	events, err := outS.outboxRepo.ClaimPendingEvents(ctx, batchSize, claimLease)
+	if err != nil {
+		return 0, err
+	}
+
+	var dispatchErrors []error
+	for _, event := range events {
+		err = outS.dispatch(ctx, event)
+		if err != nil {
+			dispatchErrors = append(dispatchErrors, err)
+		}
+	}
+	return len(events), errors.Join(dispatchErrors...)
+}
+
+// dispatch delivers an event to the subscribers that have not received it yet and records the
+// outcome, the returned error is only about recording it
+func (outS *outboxService) dispatch(ctx context.Context, repoEvent repo.OutboxEvent) error {
+	event, err := MapOutboxEventRepoToService(repoEvent)
+	if err != nil {
+		// retrying cannot fix a payload that does not decode
+		logger.Errorw(ctx, apperrors.ErrInvalidEventPayload.Error(), zap.Error(err), zap.Int("event_id", repoEvent.ID))
+		return outS.outboxRepo.AbandonEvent(ctx, repoEvent.ID, err.Error())
+	}
+
+	delivered, err := outS.outboxRepo.FetchDeliveredSubscribers(ctx, event.ID)
+	if err != nil {
+		return err
+	}
+	isDelivered := make(map[string]bool)
+	for _, name := range delivered {
+		isDelivered[name] = true
+	}
+
+	var deliveryErrors []error
+	for _, sub := range outS.subscribers[event.Type] {
+		if isDelivered[sub.name] {
+			continue
+		}
+
+		err = sub.handler(ctx, event)
+		if err != nil {
+			deliveryErrors = append(deliveryErrors, fmt.Errorf("%s: %w", sub.name, err))
+			continue
+		}
+
+		err = outS.outboxRepo.SaveDelivery(ctx, event.ID, sub.name)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(deliveryErrors) == 0 {
+		return outS.outboxRepo.MarkEventDispatched(ctx, event.ID)
+	}
+
+	deliveryErr := fmt.Errorf("%w: %w", apperrors.ErrEventDeliveryFailed, errors.Join(deliveryErrors...))
+	if repoEvent.Attempts+1 >= maxAttempts {
+		logger.Errorw(ctx, deliveryErr.Error(), zap.Int("event_id", event.ID), zap.String("type", string(event.Type)), zap.Int("attempts", maxAttempts))
+		return outS.outboxRepo.AbandonEvent(ctx, event.ID, deliveryErr.Error())
+	}
+	return outS.outboxRepo.MarkEventFailed(ctx, event.ID, deliveryErr.Error(), outS.now().Add(retryDelay(repoEvent.Attempts)))
+}
@@ -0,0 +1,183 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type OutboxServiceTestSuite struct {
+	suite.Suite
+	service    *outboxService
+	outboxRepo mocks.OutboxStorer
+	received   map[string][]Event
+	failing    map[string]bool
+}
+
+var now = time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC)
+
+func (suite *OutboxServiceTestSuite) SetupTest() {
+	suite.outboxRepo = mocks.OutboxStorer{}
+	suite.received = make(map[string][]Event)
+	suite.failing = make(map[string]bool)
+
+	suite.service = NewService(&suite.outboxRepo).(*outboxService)
+	suite.service.now = func() time.Time { return now }
+	suite.service.Subscribe(ApplicationStatusChanged, "notifications", suite.handler("notifications"))
+	suite.service.Subscribe(ApplicationStatusChanged, "webhooks", suite.handler("webhooks"))
+}
+
+func (suite *OutboxServiceTestSuite) TearDownTest() {
+	suite.outboxRepo.AssertExpectations(suite.T())
+}
+
+func TestOutboxServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(OutboxServiceTestSuite))
+}
+
+func (suite *OutboxServiceTestSuite) handler(name string) Handler {
+	return func(ctx context.Context, event Event) error {
+		if suite.failing[name] {
+			return errors.New("subscriber unavailable")
+		}
+		suite.received[name] = append(suite.received[name], event)
+		return nil
+	}
+}
+
+func (suite *OutboxServiceTestSuite) TestDispatchPending() {
+	type testCase struct {
+		name             string
+		setup            func()
+		expectedCount    int
+		expectedReceived map[string][]Event
+		expectedError    error
+	}
+
+	statusChanged := repo.OutboxEvent{ID: 7, Type: repo.ApplicationStatusChangedEvent, Payload: []byte(`{"application_id":1,"job_id":3,"worker_id":12,"status":"confirmed","previous_status":"shortlisted"}`), CreatedAt: now}
+	event := Event{ID: 7, Type: ApplicationStatusChanged, ApplicationID: 1, JobID: 3, WorkerID: 12, Status: "confirmed", PreviousStatus: "shortlisted", OccurredAt: now}
+
+	testCases := []testCase{
+		{
+			name: "delivered to every subscriber",
+			setup: func() {
+				suite.outboxRepo.On("ClaimPendingEvents", mock.Anything, batchSize, claimLease).Return([]repo.OutboxEvent{statusChanged}, nil)
+				suite.outboxRepo.On("FetchDeliveredSubscribers", mock.Anything, 7).Return([]string{}, nil)
+				suite.outboxRepo.On("SaveDelivery", mock.Anything, 7, "notifications").Return(nil)
+				suite.outboxRepo.On("SaveDelivery", mock.Anything, 7, "webhooks").Return(nil)
+				suite.outboxRepo.On("MarkEventDispatched", mock.Anything, 7).Return(nil)
+			},
+			expectedCount:    1,
+			expectedReceived: map[string][]Event{"notifications": {event}, "webhooks": {event}},
+			expectedError:    nil,
+		},
+		{
+			name: "retried event skips subscribers that already received it",
+			setup: func() {
+				retried := statusChanged
+				retried.Attempts = 2
+				suite.outboxRepo.On("ClaimPendingEvents", mock.Anything, batchSize, claimLease).Return([]repo.OutboxEvent{retried}, nil)
+				suite.outboxRepo.On("FetchDeliveredSubscribers", mock.Anything, 7).Return([]string{"notifications"}, nil)
+				suite.outboxRepo.On("SaveDelivery", mock.Anything, 7, "webhooks").Return(nil)
+				suite.outboxRepo.On("MarkEventDispatched", mock.Anything, 7).Return(nil)
+			},
+			expectedCount:    1,
+			expectedReceived: map[string][]Event{"webhooks": {event}},
+			expectedError:    nil,
+		},
+		{
+			name: "failing subscriber retried with backoff",
+			setup: func() {
+				suite.failing["webhooks"] = true
+				retried := statusChanged
+				retried.Attempts = 2
+				suite.outboxRepo.On("ClaimPendingEvents", mock.Anything, batchSize, claimLease).Return([]repo.OutboxEvent{retried}, nil)
+				suite.outboxRepo.On("FetchDeliveredSubscribers", mock.Anything, 7).Return([]string{}, nil)
+				suite.outboxRepo.On("SaveDelivery", mock.Anything, 7, "notifications").Return(nil)
+				suite.outboxRepo.On("MarkEventFailed", mock.Anything, 7, "failed to deliver event to subscribers: webhooks: subscriber unavailable", now.Add(20*time.Second)).Return(nil)
+			},
+			expectedCount:    1,
+			expectedReceived: map[string][]Event{"notifications": {event}},
+			expectedError:    nil,
+		},
+		{
+			name: "event abandoned after the last attempt",
+			setup: func() {
+				suite.failing["webhooks"] = true
+				exhausted := statusChanged
+				exhausted.Attempts = maxAttempts - 1
+				suite.outboxRepo.On("ClaimPendingEvents", mock.Anything, batchSize, claimLease).Return([]repo.OutboxEvent{exhausted}, nil)
+				suite.outboxRepo.On("FetchDeliveredSubscribers", mock.Anything, 7).Return([]string{"notifications"}, nil)
+				suite.outboxRepo.On("AbandonEvent", mock.Anything, 7, "failed to deliver event to subscribers: webhooks: subscriber unavailable").Return(nil)
+			},
+			expectedCount:    1,
+			expectedReceived: map[string][]Event{},
+			expectedError:    nil,
+		},
+		{
+			name: "event without subscribers marked dispatched",
+			setup: func() {
+				suite.outboxRepo.On("ClaimPendingEvents", mock.Anything, batchSize, claimLease).Return([]repo.OutboxEvent{{ID: 8, Type: repo.WorkerRegisteredEvent, Payload: []byte(`{"worker_id":12}`), CreatedAt: now}}, nil)
+				suite.outboxRepo.On("FetchDeliveredSubscribers", mock.Anything, 8).Return([]string{}, nil)
+				suite.outboxRepo.On("MarkEventDispatched", mock.Anything, 8).Return(nil)
+			},
+			expectedCount:    1,
+			expectedReceived: map[string][]Event{},
+			expectedError:    nil,
+		},
+		{
+			name: "undecodable payload abandoned",
+			setup: func() {
+				suite.outboxRepo.On("ClaimPendingEvents", mock.Anything, batchSize, claimLease).Return([]repo.OutboxEvent{{ID: 9, Type: repo.JobPostedEvent, Payload: []byte(`{"job_id":`)}}, nil)
+				suite.outboxRepo.On("AbandonEvent", mock.Anything, 9, mock.Anything).Return(nil)
+			},
+			expectedCount:    1,
+			expectedReceived: map[string][]Event{},
+			expectedError:    nil,
+		},
+		{
+			name: "outbox unavailable",
+			setup: func() {
+				suite.outboxRepo.On("ClaimPendingEvents", mock.Anything, batchSize, claimLease).Return([]repo.OutboxEvent{}, errors.New("db error"))
+			},
+			expectedCount:    0,
+			expectedReceived: map[string][]Event{},
+			expectedError:    errors.New("db error"),
+		},
+	}
+
+	for _, test := range testCases {
+		suite.SetupTest()
+		suite.Run(test.name, func() {
+			test.setup()
+
+			count, err := suite.service.DispatchPending(context.Background())
+
+			suite.Equal(test.expectedError, err)
+			suite.Equal(test.expectedCount, count)
+			suite.Equal(test.expectedReceived, suite.received)
+		})
+		suite.TearDownTest()
+	}
+}
+
+func (suite *OutboxServiceTestSuite) TestMapOutboxEventRepoToService() {
+	_, err := MapOutboxEventRepoToService(repo.OutboxEvent{ID: 1, Type: repo.JobPostedEvent, Payload: []byte(`not json`)})
+	suite.ErrorIs(err, apperrors.ErrInvalidEventPayload)
+}
+
+func TestRetryDelay(t *testing.T) {
+	delays := map[int]time.Duration{0: 5 * time.Second, 1: 10 * time.Second, 3: 40 * time.Second, 10: time.Hour}
+	for attempts, expected := range delays {
+		if delay := retryDelay(attempts); delay != expected {
+			t.Errorf("retryDelay(%d) = %s, expected %s", attempts, delay, expected)
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package payment
+
+import (
+	"time"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/paymentgateway"
+)
+
+type EntryType string
+type Method string
+
+const (
+	Advance   EntryType = "advance"
+	Final     EntryType = "final"
+	Deduction EntryType = "deduction"
+	Refund    EntryType = "refund"
+
+	Cash         Method = "cash"
+	UPI          Method = "upi"
+	BankTransfer Method = "bank_transfer"
+)
+
+// Payer is the worker or employer calling a payment API, taken from their JWT
+type Payer struct {
+	Role string
+	ID   int
+}
+
+type Entry struct {
+	ID             int        `json:"id"`
+	ApplicationID  int        `json:"application_id"`
+	Type           EntryType  `json:"type"`
+	Amount         int        `json:"amount"`
+	Method         Method     `json:"method,omitempty"`
+	Reference      string     `json:"reference,omitempty"`
+	Note           string     `json:"note,omitempty"`
+	RecordedAt     time.Time  `json:"recorded_at"`
+	AcknowledgedAt *time.Time `json:"acknowledged_at,omitempty"`
+}
+
+// Dues is the wage balance of a confirmed application, outstanding is what the employer still owes
+type Dues struct {
+	ApplicationID int    `json:"application_id"`
+	JobID         int    `json:"job_id"`
+	JobTitle      string `json:"job_title"`
+	WorkerID      int    `json:"worker_id"`
+	EmployerID    int    `json:"employer_id"`
+	Owed          int    `json:"owed"`
+	Paid          int    `json:"paid"`
+	Deducted      int    `json:"deducted"`
+	Outstanding   int    `json:"outstanding"`
+}
+
+type Ledger struct {
+	Dues
+	Entries []Entry `json:"entries"`
+}
+
+// DuesSummary lists the applications of a worker or employer with wages still outstanding
+type DuesSummary struct {
+	TotalOutstanding int    `json:"total_outstanding"`
+	Dues             []Dues `json:"dues"`
+}
+
+// PaymentRequest asks for a wage payment to be collected from the employer through the payment
+// gateway, retrying with the same idempotency key returns the order created by the first attempt
+type PaymentRequest struct {
+	ApplicationID  int       `json:"application_id"`
+	Type           EntryType `json:"type"`
+	Amount         int       `json:"amount"`
+	VPA            string    `json:"vpa"`
+	IdempotencyKey string    `json:"-"`
+}
+
+// RefundPending is the status of a paid order whose refund was asked for but is not yet recorded,
+// refunding it again completes the refund
+const RefundPending paymentgateway.OrderStatus = "refund_pending"
+
+type Order struct {
+	ID                int                        `json:"id"`
+	ApplicationID     int                        `json:"application_id"`
+	Type              EntryType                  `json:"type"`
+	Amount            int                        `json:"amount"`
+	ProviderOrderID   string                     `json:"provider_order_id"`
+	ProviderPaymentID string                     `json:"provider_payment_id,omitempty"`
+	Status            paymentgateway.OrderStatus `json:"status"`
+	PaymentID         *int                       `json:"payment_id,omitempty"`
+	CreatedAt         time.Time                  `json:"created_at"`
+	UpdatedAt         time.Time                  `json:"updated_at"`
+}
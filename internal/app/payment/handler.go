@@ -0,0 +1,277 @@
+package payment
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/logger"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/middleware"
+	"go.uber.org/zap"
+)
+
+func RecordPayment(paymentService Service) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		applicationId, _ := isPathIdValid(ctx, w, r, "application_id", apperrors.MsgInvalidApplicationId, apperrors.ErrRecordPayment)
+		if applicationId == -1 {
+			return
+		}
+
+		var entryData Entry
+		err := json.NewDecoder(r.Body).Decode(&entryData)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrInvalidRequestBody.Error(), zap.Error(err))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrInvalidRequestBody.Error()+": "+err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		entryData.ApplicationID = applicationId
+		entry, err := paymentService.RecordPayment(ctx, currentPayer(ctx), entryData)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrRecordPayment.Error(), zap.Error(err))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrRecordPayment.Error()+": "+err.Error(), paymentErrorStatusCode(err))
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, "successfully recorded payment", http.StatusCreated, entry)
+	}
+}
+
+func AcknowledgePayment(paymentService Service) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		applicationId, _ := isPathIdValid(ctx, w, r, "application_id", apperrors.MsgInvalidApplicationId, apperrors.ErrAcknowledgePayment)
+		if applicationId == -1 {
+			return
+		}
+
+		paymentId, id := isPathIdValid(ctx, w, r, "payment_id", apperrors.MsgInvalidPaymentId, apperrors.ErrAcknowledgePayment)
+		if paymentId == -1 {
+			return
+		}
+
+		entry, err := paymentService.AcknowledgePayment(ctx, currentPayer(ctx), applicationId, paymentId)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrAcknowledgePayment.Error(), zap.Error(err), zap.String("ID", id))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrAcknowledgePayment.Error()+", "+err.Error(), paymentErrorStatusCode(err))
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, "payment acknowledged successfully", http.StatusOK, entry)
+	}
+}
+
+func FetchApplicationLedger(paymentService Service) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		applicationId, id := isPathIdValid(ctx, w, r, "application_id", apperrors.MsgInvalidApplicationId, apperrors.ErrFetchPayments)
+		if applicationId == -1 {
+			return
+		}
+
+		ledger, err := paymentService.FetchApplicationLedger(ctx, applicationId)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrFetchPayments.Error(), zap.Error(err), zap.String("ID", id))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrFetchPayments.Error()+", "+err.Error(), paymentErrorStatusCode(err))
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, "payment ledger retrieved successfully", http.StatusOK, ledger)
+	}
+}
+
+func FetchWorkerDues(paymentService Service) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		workerId, id := isPathIdValid(ctx, w, r, "worker_id", apperrors.MsgInvalidWorkerId, apperrors.ErrFetchDues)
+		if workerId == -1 {
+			return
+		}
+
+		dues, err := paymentService.FetchWorkerDues(ctx, workerId)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrFetchDues.Error(), zap.Error(err), zap.String("ID", id))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrFetchDues.Error()+", "+err.Error(), paymentErrorStatusCode(err))
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, "outstanding dues retrieved successfully", http.StatusOK, dues)
+	}
+}
+
+func FetchEmployerDues(paymentService Service) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		employerId, id := isPathIdValid(ctx, w, r, "employer_id", apperrors.MsgInvalidEmployerId, apperrors.ErrFetchDues)
+		if employerId == -1 {
+			return
+		}
+
+		dues, err := paymentService.FetchEmployerDues(ctx, employerId)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrFetchDues.Error(), zap.Error(err), zap.String("ID", id))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrFetchDues.Error()+", "+err.Error(), paymentErrorStatusCode(err))
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, "outstanding dues retrieved successfully", http.StatusOK, dues)
+	}
+}
+
+func InitiatePayment(paymentService Service) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		applicationId, _ := isPathIdValid(ctx, w, r, "application_id", apperrors.MsgInvalidApplicationId, apperrors.ErrInitiatePayment)
+		if applicationId == -1 {
+			return
+		}
+
+		var request PaymentRequest
+		err := json.NewDecoder(r.Body).Decode(&request)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrInvalidRequestBody.Error(), zap.Error(err))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrInvalidRequestBody.Error()+": "+err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		request.ApplicationID = applicationId
+		request.IdempotencyKey = r.Header.Get("Idempotency-Key")
+		order, err := paymentService.InitiatePayment(ctx, currentPayer(ctx), request)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrInitiatePayment.Error(), zap.Error(err))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrInitiatePayment.Error()+": "+err.Error(), paymentErrorStatusCode(err))
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, "payment initiated successfully", http.StatusCreated, order)
+	}
+}
+
+func FetchPaymentOrder(paymentService Service) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		applicationId, _ := isPathIdValid(ctx, w, r, "application_id", apperrors.MsgInvalidApplicationId, apperrors.ErrFetchPayments)
+		if applicationId == -1 {
+			return
+		}
+
+		orderId, id := isPathIdValid(ctx, w, r, "order_id", apperrors.MsgInvalidOrderId, apperrors.ErrFetchPayments)
+		if orderId == -1 {
+			return
+		}
+
+		order, err := paymentService.FetchPaymentOrder(ctx, applicationId, orderId)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrFetchPayments.Error(), zap.Error(err), zap.String("ID", id))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrFetchPayments.Error()+", "+err.Error(), paymentErrorStatusCode(err))
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, "payment order retrieved successfully", http.StatusOK, order)
+	}
+}
+
+func RefundPayment(paymentService Service) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		applicationId, _ := isPathIdValid(ctx, w, r, "application_id", apperrors.MsgInvalidApplicationId, apperrors.ErrRefundPayment)
+		if applicationId == -1 {
+			return
+		}
+
+		orderId, id := isPathIdValid(ctx, w, r, "order_id", apperrors.MsgInvalidOrderId, apperrors.ErrRefundPayment)
+		if orderId == -1 {
+			return
+		}
+
+		order, err := paymentService.RefundPayment(ctx, currentPayer(ctx), applicationId, orderId)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrRefundPayment.Error(), zap.Error(err), zap.String("ID", id))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrRefundPayment.Error()+", "+err.Error(), paymentErrorStatusCode(err))
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, "payment refunded successfully", http.StatusOK, order)
+	}
+}
+
+// HandleWebhook receives payment gateway callbacks, the raw body is needed to verify the signature
+func HandleWebhook(paymentService Service) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		payload, err := io.ReadAll(r.Body)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrInvalidRequestBody.Error(), zap.Error(err))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrInvalidRequestBody.Error()+": "+err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		order, err := paymentService.HandleWebhook(ctx, payload, r.Header.Get("X-Payment-Signature"))
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrHandleWebhook.Error(), zap.Error(err))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrHandleWebhook.Error()+", "+err.Error(), paymentErrorStatusCode(err))
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, "payment webhook processed successfully", http.StatusOK, order)
+	}
+}
+
+func isPathIdValid(ctx context.Context, w http.ResponseWriter, r *http.Request, key string, invalidMsg string, errType error) (int, string) {
+	vars := mux.Vars(r)
+	id := vars[key]
+	pathId, err := strconv.Atoi(id)
+	if err != nil {
+		logger.Errorw(ctx, invalidMsg, zap.Error(err), zap.String("ID", id))
+		httpResponseMsg := apperrors.HttpErrorResponseMessage(errType.Error(), invalidMsg, id)
+		middleware.HandleErrorResponse(ctx, w, httpResponseMsg, http.StatusBadRequest)
+		return -1, id
+	}
+	return pathId, id
+}
+
+// currentPayer is the worker or employer calling a payment API, taken from the JWT
+func currentPayer(ctx context.Context) Payer {
+	userId, _ := ctx.Value("user_id").(int)
+	role, _ := ctx.Value("role").(string)
+	return Payer{Role: role, ID: userId}
+}
+
+func paymentErrorStatusCode(err error) int {
+	switch {
+	case errors.Is(err, apperrors.ErrInvalidPayment), errors.Is(err, apperrors.ErrMissingIdempotencyKey),
+		errors.Is(err, apperrors.ErrInvalidWebhookPayload):
+		return http.StatusBadRequest
+	case errors.Is(err, apperrors.ErrInvalidWebhookSignature):
+		return http.StatusUnauthorized
+	case errors.Is(err, apperrors.ErrNotPaymentEmployer), errors.Is(err, apperrors.ErrNotPaymentWorker):
+		return http.StatusForbidden
+	case errors.Is(err, apperrors.ErrNoApplicationExists), errors.Is(err, apperrors.ErrNoPaymentExists),
+		errors.Is(err, apperrors.ErrNoWorkerExists), errors.Is(err, apperrors.ErrNoEmployerExists),
+		errors.Is(err, apperrors.ErrNoPaymentOrderExists):
+		return http.StatusNotFound
+	case errors.Is(err, apperrors.ErrPaymentExceedsDues), errors.Is(err, apperrors.ErrApplicationNotPayable),
+		errors.Is(err, apperrors.ErrIdempotencyKeyReused), errors.Is(err, apperrors.ErrPaymentNotRefundable):
+		return http.StatusConflict
+	case errors.Is(err, apperrors.ErrPaymentProviderTimeout):
+		return http.StatusGatewayTimeout
+	case errors.Is(err, apperrors.ErrPaymentProvider):
+		return http.StatusBadGateway
+	}
+	return http.StatusInternalServerError
+}
@@ -0,0 +1,131 @@
+package payment
+
+import (
+	"fmt"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/paymentgateway"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo"
+)
+
+func MapEntryRepoToService(entry repo.PaymentEntry) Entry {
+	return Entry{
+		ID:             entry.ID,
+		ApplicationID:  entry.ApplicationID,
+		Type:           EntryType(entry.Type),
+		Amount:         entry.Amount,
+		Method:         Method(entry.Method),
+		Reference:      entry.Reference,
+		Note:           entry.Note,
+		RecordedAt:     entry.RecordedAt,
+		AcknowledgedAt: entry.AcknowledgedAt,
+	}
+}
+
+func MapEntryServiceToRepo(entry Entry) repo.PaymentEntry {
+	return repo.PaymentEntry{
+		ID:             entry.ID,
+		ApplicationID:  entry.ApplicationID,
+		Type:           string(entry.Type),
+		Amount:         entry.Amount,
+		Method:         string(entry.Method),
+		Reference:      entry.Reference,
+		Note:           entry.Note,
+		RecordedAt:     entry.RecordedAt,
+		AcknowledgedAt: entry.AcknowledgedAt,
+	}
+}
+
+func MapOrderRepoToService(order repo.PaymentOrder) Order {
+	return Order{
+		ID:                order.ID,
+		ApplicationID:     order.ApplicationID,
+		Type:              EntryType(order.Type),
+		Amount:            order.Amount,
+		ProviderOrderID:   order.ProviderOrderID,
+		ProviderPaymentID: order.ProviderPaymentID,
+		Status:            paymentgateway.OrderStatus(order.Status),
+		PaymentID:         order.PaymentID,
+		CreatedAt:         order.CreatedAt,
+		UpdatedAt:         order.UpdatedAt,
+	}
+}
+
+func MapDuesRepoToService(dues repo.Dues) Dues {
+	return Dues{
+		ApplicationID: dues.ApplicationID,
+		JobID:         dues.JobID,
+		JobTitle:      dues.JobTitle,
+		WorkerID:      dues.WorkerID,
+		EmployerID:    dues.EmployerID,
+		Owed:          dues.Owed,
+		Paid:          dues.Paid,
+		Deducted:      dues.Deducted,
+		Outstanding:   dues.Owed - dues.Paid - dues.Deducted,
+	}
+}
+
+// validateEntry checks the type, amount and method of a ledger entry, deductions are not paid out
+// so they carry no method
+func validateEntry(entry Entry) error {
+	if entry.Amount <= 0 {
+		return fmt.Errorf("%w: amount must be greater than zero", apperrors.ErrInvalidPayment)
+	}
+
+	switch entry.Type {
+	case Advance, Final:
+		switch entry.Method {
+		case Cash, UPI, BankTransfer:
+		default:
+			return fmt.Errorf("%w: method must be one of cash, upi or bank_transfer", apperrors.ErrInvalidPayment)
+		}
+		if entry.Method != Cash && entry.Reference == "" {
+			return fmt.Errorf("%w: reference is required for %s payments", apperrors.ErrInvalidPayment, entry.Method)
+		}
+	case Deduction:
+		if entry.Method != "" {
+			return fmt.Errorf("%w: deductions do not have a payment method", apperrors.ErrInvalidPayment)
+		}
+		if entry.Note == "" {
+			return fmt.Errorf("%w: note is required to explain a deduction", apperrors.ErrInvalidPayment)
+		}
+	default:
+		return fmt.Errorf("%w: type must be one of advance, final or deduction", apperrors.ErrInvalidPayment)
+	}
+	return nil
+}
+
+func validatePaymentRequest(request PaymentRequest) error {
+	if request.IdempotencyKey == "" {
+		return apperrors.ErrMissingIdempotencyKey
+	}
+	if request.Type != Advance && request.Type != Final {
+		return fmt.Errorf("%w: gateway payments must be of type advance or final", apperrors.ErrInvalidPayment)
+	}
+	if request.Amount <= 0 {
+		return fmt.Errorf("%w: amount must be greater than zero", apperrors.ErrInvalidPayment)
+	}
+	if request.VPA == "" {
+		return fmt.Errorf("%w: vpa (UPI id) is required", apperrors.ErrInvalidPayment)
+	}
+	return nil
+}
+
+// sameRequest tells whether a retried request matches the order created for its idempotency key
+func sameRequest(order repo.PaymentOrder, request PaymentRequest) bool {
+	return order.ApplicationID == request.ApplicationID && order.Type == string(request.Type) && order.Amount == request.Amount
+}
+
+func summarizeDues(dues []repo.Dues) DuesSummary {
+	summary := DuesSummary{Dues: make([]Dues, 0)}
+	for _, applicationDues := range dues {
+		mapped := MapDuesRepoToService(applicationDues)
+		if mapped.Outstanding <= 0 {
+			continue
+		}
+
+		summary.Dues = append(summary.Dues, mapped)
+		summary.TotalOutstanding += mapped.Outstanding
+	}
+	return summary
+}
@@ -0,0 +1,332 @@
+package payment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/paymentgateway"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo"
+)
+
+// providerTimeout bounds every call made to the payment gateway
+const providerTimeout = 10 * time.Second
+
+// roles of the JWT allowed to record and acknowledge payments
+const (
+	employerRole = "employer"
+	workerRole   = "worker"
+)
+
+type paymentService struct {
+	paymentRepo  repo.PaymentStorer
+	workerRepo   repo.WorkerStorer
+	employerRepo repo.EmployerStorer
+	provider     paymentgateway.PaymentProvider
+}
+
+type Service interface {
+	RecordPayment(ctx context.Context, payer Payer, entryData Entry) (Entry, error)
+	AcknowledgePayment(ctx context.Context, payer Payer, applicationId int, entryId int) (Entry, error)
+	FetchApplicationLedger(ctx context.Context, applicationId int) (Ledger, error)
+	FetchWorkerDues(ctx context.Context, workerId int) (DuesSummary, error)
+	FetchEmployerDues(ctx context.Context, employerId int) (DuesSummary, error)
+	InitiatePayment(ctx context.Context, payer Payer, request PaymentRequest) (Order, error)
+	FetchPaymentOrder(ctx context.Context, applicationId int, orderId int) (Order, error)
+	RefundPayment(ctx context.Context, payer Payer, applicationId int, orderId int) (Order, error)
+	HandleWebhook(ctx context.Context, payload []byte, signature string) (Order, error)
+}
+
+func NewService(paymentRepo repo.PaymentStorer, workerRepo repo.WorkerStorer, employerRepo repo.EmployerStorer, provider paymentgateway.PaymentProvider) Service {
+	return &paymentService{
+		paymentRepo:  paymentRepo,
+		workerRepo:   workerRepo,
+		employerRepo: employerRepo,
+		provider:     provider,
+	}
+}
+
+// RecordPayment adds an entry recorded by the employer to the ledger of a confirmed application, the
+// entries of an application can never add up to more than the wage owed for it
+func (payS *paymentService) RecordPayment(ctx context.Context, payer Payer, entryData Entry) (Entry, error) {
+	err := validateEntry(entryData)
+	if err != nil {
+		return Entry{}, err
+	}
+
+	dues, err := payS.paymentRepo.FetchDuesByApplicationId(ctx, entryData.ApplicationID)
+	if err != nil {
+		return Entry{}, err
+	}
+	if payer.Role != employerRole || payer.ID != dues.EmployerID {
+		return Entry{}, apperrors.ErrNotPaymentEmployer
+	}
+
+	// the status and outstanding wage are checked by the repo with the application locked
+	entry, err := payS.paymentRepo.CreatePaymentEntry(ctx, MapEntryServiceToRepo(entryData))
+	if err != nil {
+		return Entry{}, err
+	}
+	return MapEntryRepoToService(entry), nil
+}
+
+// AcknowledgePayment marks an entry as received, only the worker of the application can do so
+func (payS *paymentService) AcknowledgePayment(ctx context.Context, payer Payer, applicationId int, entryId int) (Entry, error) {
+	dues, err := payS.paymentRepo.FetchDuesByApplicationId(ctx, applicationId)
+	if err != nil {
+		return Entry{}, err
+	}
+	if payer.Role != workerRole || payer.ID != dues.WorkerID {
+		return Entry{}, apperrors.ErrNotPaymentWorker
+	}
+
+	entry, err := payS.paymentRepo.AcknowledgePaymentEntry(ctx, applicationId, entryId)
+	if err != nil {
+		return Entry{}, err
+	}
+	return MapEntryRepoToService(entry), nil
+}
+
+func (payS *paymentService) FetchApplicationLedger(ctx context.Context, applicationId int) (Ledger, error) {
+	dues, err := payS.paymentRepo.FetchDuesByApplicationId(ctx, applicationId)
+	if err != nil {
+		return Ledger{}, err
+	}
+
+	entries, err := payS.paymentRepo.FetchPaymentEntriesByApplicationId(ctx, applicationId)
+	if err != nil {
+		return Ledger{}, err
+	}
+
+	ledger := Ledger{Dues: MapDuesRepoToService(dues), Entries: make([]Entry, 0)}
+	for _, entry := range entries {
+		ledger.Entries = append(ledger.Entries, MapEntryRepoToService(entry))
+	}
+	return ledger, nil
+}
+
+func (payS *paymentService) FetchWorkerDues(ctx context.Context, workerId int) (DuesSummary, error) {
+	exists := payS.workerRepo.FindWorkerById(ctx, workerId)
+	if !exists {
+		return DuesSummary{}, apperrors.ErrNoWorkerExists
+	}
+
+	dues, err := payS.paymentRepo.FetchDuesByWorkerId(ctx, workerId)
+	if err != nil {
+		return DuesSummary{}, err
+	}
+	return summarizeDues(dues), nil
+}
+
+func (payS *paymentService) FetchEmployerDues(ctx context.Context, employerId int) (DuesSummary, error) {
+	exists := payS.employerRepo.FindEmployerById(ctx, employerId)
+	if !exists {
+		return DuesSummary{}, apperrors.ErrNoEmployerExists
+	}
+
+	dues, err := payS.paymentRepo.FetchDuesByEmployerId(ctx, employerId)
+	if err != nil {
+		return DuesSummary{}, err
+	}
+	return summarizeDues(dues), nil
+}
+
+// InitiatePayment creates a gateway order for a wage payment. Requests are idempotent: a retry with
+// the same key returns the stored order, and the key is passed on to the provider as the receipt so
+// an order created by a request that timed out is picked up instead of being created twice. Only the
+// employer of the application can pay through the gateway.
+func (payS *paymentService) InitiatePayment(ctx context.Context, payer Payer, request PaymentRequest) (Order, error) {
+	err := validatePaymentRequest(request)
+	if err != nil {
+		return Order{}, err
+	}
+
+	dues, err := payS.paymentRepo.FetchDuesByApplicationId(ctx, request.ApplicationID)
+	if err != nil {
+		return Order{}, err
+	}
+	if payer.Role != employerRole || payer.ID != dues.EmployerID {
+		return Order{}, apperrors.ErrNotPaymentEmployer
+	}
+
+	existing, err := payS.paymentRepo.FetchPaymentOrderByIdempotencyKey(ctx, request.IdempotencyKey)
+	if err == nil {
+		return storedOrder(existing, request)
+	}
+	if !errors.Is(err, apperrors.ErrNoPaymentOrderExists) {
+		return Order{}, err
+	}
+
+	if dues.Status != repo.Confirmed {
+		return Order{}, apperrors.ErrApplicationNotPayable
+	}
+
+	// orders still waiting for the provider may yet be paid, so they are taken off the outstanding wage
+	pending, err := payS.paymentRepo.FetchPendingOrderAmount(ctx, request.ApplicationID)
+	if err != nil {
+		return Order{}, err
+	}
+
+	outstanding := MapDuesRepoToService(dues).Outstanding - pending
+	if request.Amount > outstanding {
+		return Order{}, fmt.Errorf("%w: %d outstanding", apperrors.ErrPaymentExceedsDues, outstanding)
+	}
+
+	providerCtx, cancel := context.WithTimeout(ctx, providerTimeout)
+	defer cancel()
+
+	providerOrder, err := payS.provider.CreateOrder(providerCtx, paymentgateway.OrderRequest{
+		Amount:   request.Amount,
+		Currency: "INR",
+		Receipt:  request.IdempotencyKey,
+		VPA:      request.VPA,
+	})
+	if err != nil {
+		if errors.Is(providerCtx.Err(), context.DeadlineExceeded) {
+			return Order{}, fmt.Errorf("%w: %s", apperrors.ErrPaymentProviderTimeout, err.Error())
+		}
+		return Order{}, err
+	}
+
+	order, err := payS.paymentRepo.CreatePaymentOrder(ctx, repo.PaymentOrder{
+		ApplicationID:   request.ApplicationID,
+		Type:            string(request.Type),
+		Amount:          request.Amount,
+		IdempotencyKey:  request.IdempotencyKey,
+		ProviderOrderID: providerOrder.ID,
+		Status:          string(paymentgateway.Created),
+	})
+	if err != nil {
+		if errors.Is(err, apperrors.ErrPaymentOrderExists) {
+			// a concurrent request with the same key stored its order first
+			existing, err = payS.paymentRepo.FetchPaymentOrderByIdempotencyKey(ctx, request.IdempotencyKey)
+			if err != nil {
+				return Order{}, err
+			}
+			return storedOrder(existing, request)
+		}
+		return Order{}, err
+	}
+
+	return payS.settle(ctx, order, providerOrder)
+}
+
+// storedOrder returns the order already created for an idempotency key, as long as the key is not
+// reused for another payment
+func storedOrder(existing repo.PaymentOrder, request PaymentRequest) (Order, error) {
+	if !sameRequest(existing, request) {
+		return Order{}, apperrors.ErrIdempotencyKeyReused
+	}
+	return MapOrderRepoToService(existing), nil
+}
+
+// FetchPaymentOrder returns a gateway order, pending orders are first reconciled with the provider
+// so that a payment whose webhook never arrived still reaches the ledger
+func (payS *paymentService) FetchPaymentOrder(ctx context.Context, applicationId int, orderId int) (Order, error) {
+	order, err := payS.paymentRepo.FetchPaymentOrderById(ctx, applicationId, orderId)
+	if err != nil {
+		return Order{}, err
+	}
+	if order.Status != string(paymentgateway.Created) {
+		return MapOrderRepoToService(order), nil
+	}
+
+	providerCtx, cancel := context.WithTimeout(ctx, providerTimeout)
+	defer cancel()
+
+	providerOrder, err := payS.provider.FetchStatus(providerCtx, order.ProviderOrderID)
+	if err != nil {
+		return Order{}, err
+	}
+	return payS.settle(ctx, order, providerOrder)
+}
+
+// RefundPayment refunds a paid gateway order. The order is marked as being refunded before the provider
+// is called and refunds are idempotent on the order, so when the provider call or recording the refund
+// fails, refunding the order again picks up the refund the provider already made. Only the employer of
+// the application can refund its orders.
+func (payS *paymentService) RefundPayment(ctx context.Context, payer Payer, applicationId int, orderId int) (Order, error) {
+	dues, err := payS.paymentRepo.FetchDuesByApplicationId(ctx, applicationId)
+	if err != nil {
+		return Order{}, err
+	}
+	if payer.Role != employerRole || payer.ID != dues.EmployerID {
+		return Order{}, apperrors.ErrNotPaymentEmployer
+	}
+
+	order, err := payS.paymentRepo.FetchPaymentOrderById(ctx, applicationId, orderId)
+	if err != nil {
+		return Order{}, err
+	}
+
+	switch paymentgateway.OrderStatus(order.Status) {
+	case paymentgateway.Paid:
+		order, err = payS.paymentRepo.StartPaymentOrderRefund(ctx, order.ID)
+		if err != nil {
+			return Order{}, err
+		}
+	case RefundPending:
+		// an earlier refund did not finish
+	default:
+		return Order{}, apperrors.ErrPaymentNotRefundable
+	}
+
+	providerCtx, cancel := context.WithTimeout(ctx, providerTimeout)
+	defer cancel()
+
+	refund, err := payS.provider.Refund(providerCtx, order.ProviderPaymentID, order.Amount, fmt.Sprintf("refund-%d", order.ID))
+	if err != nil {
+		if errors.Is(providerCtx.Err(), context.DeadlineExceeded) {
+			return Order{}, fmt.Errorf("%w: %s", apperrors.ErrPaymentProviderTimeout, err.Error())
+		}
+		return Order{}, err
+	}
+
+	refundedOrder, err := payS.paymentRepo.RefundPaymentOrder(ctx, order.ID, refund.ID)
+	if err != nil {
+		return Order{}, err
+	}
+	return MapOrderRepoToService(refundedOrder), nil
+}
+
+// HandleWebhook applies a signed provider callback, callbacks for orders that are already settled are
+// acknowledged without side effects since providers deliver them at least once
+func (payS *paymentService) HandleWebhook(ctx context.Context, payload []byte, signature string) (Order, error) {
+	event, err := payS.provider.VerifyCallback(payload, signature)
+	if err != nil {
+		return Order{}, err
+	}
+
+	order, err := payS.paymentRepo.FetchPaymentOrderByProviderOrderId(ctx, event.OrderID)
+	if err != nil {
+		return Order{}, err
+	}
+
+	return payS.settle(ctx, order, paymentgateway.Order{ID: event.OrderID, Status: event.Status, PaymentID: event.PaymentID})
+}
+
+// settle moves a pending order to the final status reported by the provider
+func (payS *paymentService) settle(ctx context.Context, order repo.PaymentOrder, providerOrder paymentgateway.Order) (Order, error) {
+	if order.Status != string(paymentgateway.Created) {
+		return MapOrderRepoToService(order), nil
+	}
+	if providerOrder.Status != paymentgateway.Paid && providerOrder.Status != paymentgateway.Failed {
+		return MapOrderRepoToService(order), nil
+	}
+
+	settledOrder, err := payS.paymentRepo.SettlePaymentOrder(ctx, order.ID, string(providerOrder.Status), providerOrder.PaymentID)
+	if err != nil {
+		if errors.Is(err, apperrors.ErrPaymentOrderSettled) {
+			// settled concurrently by another callback or status check
+			settledOrder, err = payS.paymentRepo.FetchPaymentOrderById(ctx, order.ApplicationID, order.ID)
+			if err != nil {
+				return Order{}, err
+			}
+			return MapOrderRepoToService(settledOrder), nil
+		}
+		return Order{}, err
+	}
+	return MapOrderRepoToService(settledOrder), nil
+}
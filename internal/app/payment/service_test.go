@@ -0,0 +1,649 @@
+package payment
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/paymentgateway"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type PaymentServiceTestSuite struct {
+	suite.Suite
+	service      Service
+	paymentRepo  mocks.PaymentStorer
+	workerRepo   mocks.WorkerStorer
+	employerRepo mocks.EmployerStorer
+	provider     *paymentgateway.FakeProvider
+}
+
+func (suite *PaymentServiceTestSuite) SetupTest() {
+	suite.paymentRepo = mocks.PaymentStorer{}
+	suite.workerRepo = mocks.WorkerStorer{}
+	suite.employerRepo = mocks.EmployerStorer{}
+	suite.provider = paymentgateway.NewFakeProvider("webhook-secret")
+	suite.service = NewService(&suite.paymentRepo, &suite.workerRepo, &suite.employerRepo, suite.provider)
+}
+
+func (suite *PaymentServiceTestSuite) TearDownTest() {
+	suite.paymentRepo.AssertExpectations(suite.T())
+	suite.workerRepo.AssertExpectations(suite.T())
+	suite.employerRepo.AssertExpectations(suite.T())
+}
+
+func TestPaymentServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(PaymentServiceTestSuite))
+}
+
+func (suite *PaymentServiceTestSuite) TestRecordPayment() {
+	type testCase struct {
+		name           string
+		payer          Payer
+		input          Entry
+		setup          func()
+		expectedOutput Entry
+		expectedError  error
+	}
+
+	employer := Payer{Role: "employer", ID: 4}
+	confirmedDues := repo.Dues{ApplicationID: 1, JobID: 2, WorkerID: 3, EmployerID: 4, Status: repo.Confirmed, Owed: 2400, Paid: 500, Deducted: 100}
+
+	testCases := []testCase{
+		{
+			name:  "success",
+			payer: employer,
+			input: Entry{ApplicationID: 1, Type: Final, Amount: 1800, Method: UPI, Reference: "UPI123"},
+			setup: func() {
+				suite.paymentRepo.On("FetchDuesByApplicationId", mock.Anything, 1).Return(confirmedDues, nil)
+				suite.paymentRepo.On("CreatePaymentEntry", mock.Anything, repo.PaymentEntry{ApplicationID: 1, Type: "final", Amount: 1800, Method: "upi", Reference: "UPI123"}).Return(repo.PaymentEntry{ID: 7, ApplicationID: 1, Type: "final", Amount: 1800, Method: "upi", Reference: "UPI123"}, nil)
+			},
+			expectedOutput: Entry{ID: 7, ApplicationID: 1, Type: Final, Amount: 1800, Method: UPI, Reference: "UPI123"},
+			expectedError:  nil,
+		},
+		{
+			name:           "upi payment without reference",
+			payer:          employer,
+			input:          Entry{ApplicationID: 1, Type: Advance, Amount: 500, Method: UPI},
+			setup:          func() {},
+			expectedOutput: Entry{},
+			expectedError:  apperrors.ErrInvalidPayment,
+		},
+		{
+			name:           "deduction without note",
+			payer:          employer,
+			input:          Entry{ApplicationID: 1, Type: Deduction, Amount: 100},
+			setup:          func() {},
+			expectedOutput: Entry{},
+			expectedError:  apperrors.ErrInvalidPayment,
+		},
+		{
+			name:           "negative amount",
+			payer:          employer,
+			input:          Entry{ApplicationID: 1, Type: Advance, Amount: -10, Method: Cash},
+			setup:          func() {},
+			expectedOutput: Entry{},
+			expectedError:  apperrors.ErrInvalidPayment,
+		},
+		{
+			name:  "recorded by another employer",
+			payer: Payer{Role: "employer", ID: 9},
+			input: Entry{ApplicationID: 1, Type: Advance, Amount: 500, Method: Cash},
+			setup: func() {
+				suite.paymentRepo.On("FetchDuesByApplicationId", mock.Anything, 1).Return(confirmedDues, nil)
+			},
+			expectedOutput: Entry{},
+			expectedError:  apperrors.ErrNotPaymentEmployer,
+		},
+		{
+			name:  "recorded by the worker",
+			payer: Payer{Role: "worker", ID: 4},
+			input: Entry{ApplicationID: 1, Type: Advance, Amount: 500, Method: Cash},
+			setup: func() {
+				suite.paymentRepo.On("FetchDuesByApplicationId", mock.Anything, 1).Return(confirmedDues, nil)
+			},
+			expectedOutput: Entry{},
+			expectedError:  apperrors.ErrNotPaymentEmployer,
+		},
+		{
+			name:  "application not confirmed",
+			payer: employer,
+			input: Entry{ApplicationID: 1, Type: Advance, Amount: 500, Method: Cash},
+			setup: func() {
+				suite.paymentRepo.On("FetchDuesByApplicationId", mock.Anything, 1).Return(repo.Dues{ApplicationID: 1, EmployerID: 4, Status: repo.Pending, Owed: 2400}, nil)
+				suite.paymentRepo.On("CreatePaymentEntry", mock.Anything, repo.PaymentEntry{ApplicationID: 1, Type: "advance", Amount: 500, Method: "cash"}).Return(repo.PaymentEntry{}, apperrors.ErrApplicationNotPayable)
+			},
+			expectedOutput: Entry{},
+			expectedError:  apperrors.ErrApplicationNotPayable,
+		},
+		{
+			name:  "payment exceeds outstanding wage",
+			payer: employer,
+			input: Entry{ApplicationID: 1, Type: Final, Amount: 2000, Method: Cash},
+			setup: func() {
+				suite.paymentRepo.On("FetchDuesByApplicationId", mock.Anything, 1).Return(confirmedDues, nil)
+				suite.paymentRepo.On("CreatePaymentEntry", mock.Anything, repo.PaymentEntry{ApplicationID: 1, Type: "final", Amount: 2000, Method: "cash"}).Return(repo.PaymentEntry{}, apperrors.ErrPaymentExceedsDues)
+			},
+			expectedOutput: Entry{},
+			expectedError:  apperrors.ErrPaymentExceedsDues,
+		},
+		{
+			name:  "application not found",
+			payer: employer,
+			input: Entry{ApplicationID: 1, Type: Advance, Amount: 500, Method: Cash},
+			setup: func() {
+				suite.paymentRepo.On("FetchDuesByApplicationId", mock.Anything, 1).Return(repo.Dues{}, apperrors.ErrNoApplicationExists)
+			},
+			expectedOutput: Entry{},
+			expectedError:  apperrors.ErrNoApplicationExists,
+		},
+	}
+
+	for _, test := range testCases {
+		suite.SetupTest()
+		suite.Run(test.name, func() {
+			test.setup()
+
+			entry, err := suite.service.RecordPayment(context.Background(), test.payer, test.input)
+			suite.Equal(test.expectedOutput, entry)
+			suite.ErrorIs(err, test.expectedError)
+		})
+		suite.TearDownTest()
+	}
+}
+
+func (suite *PaymentServiceTestSuite) TestAcknowledgePayment() {
+	type testCase struct {
+		name          string
+		payer         Payer
+		setup         func()
+		expectedError error
+	}
+
+	dues := repo.Dues{ApplicationID: 1, WorkerID: 3, EmployerID: 4, Status: repo.Confirmed}
+
+	testCases := []testCase{
+		{
+			name:  "success",
+			payer: Payer{Role: "worker", ID: 3},
+			setup: func() {
+				suite.paymentRepo.On("FetchDuesByApplicationId", mock.Anything, 1).Return(dues, nil)
+				suite.paymentRepo.On("AcknowledgePaymentEntry", mock.Anything, 1, 7).Return(repo.PaymentEntry{ID: 7, ApplicationID: 1}, nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:  "payment not found",
+			payer: Payer{Role: "worker", ID: 3},
+			setup: func() {
+				suite.paymentRepo.On("FetchDuesByApplicationId", mock.Anything, 1).Return(dues, nil)
+				suite.paymentRepo.On("AcknowledgePaymentEntry", mock.Anything, 1, 7).Return(repo.PaymentEntry{}, apperrors.ErrNoPaymentExists)
+			},
+			expectedError: apperrors.ErrNoPaymentExists,
+		},
+		{
+			name:  "acknowledged by another worker",
+			payer: Payer{Role: "worker", ID: 5},
+			setup: func() {
+				suite.paymentRepo.On("FetchDuesByApplicationId", mock.Anything, 1).Return(dues, nil)
+			},
+			expectedError: apperrors.ErrNotPaymentWorker,
+		},
+		{
+			name:  "acknowledged by the employer",
+			payer: Payer{Role: "employer", ID: 4},
+			setup: func() {
+				suite.paymentRepo.On("FetchDuesByApplicationId", mock.Anything, 1).Return(dues, nil)
+			},
+			expectedError: apperrors.ErrNotPaymentWorker,
+		},
+	}
+
+	for _, test := range testCases {
+		suite.SetupTest()
+		suite.Run(test.name, func() {
+			test.setup()
+
+			_, err := suite.service.AcknowledgePayment(context.Background(), test.payer, 1, 7)
+			suite.ErrorIs(err, test.expectedError)
+		})
+		suite.TearDownTest()
+	}
+}
+
+func (suite *PaymentServiceTestSuite) TestFetchApplicationLedger() {
+	type testCase struct {
+		name           string
+		setup          func()
+		expectedOutput Ledger
+		expectedError  bool
+	}
+
+	testCases := []testCase{
+		{
+			name: "success",
+			setup: func() {
+				suite.paymentRepo.On("FetchDuesByApplicationId", mock.Anything, 1).Return(repo.Dues{ApplicationID: 1, Status: repo.Confirmed, Owed: 2400, Paid: 500, Deducted: 100}, nil)
+				suite.paymentRepo.On("FetchPaymentEntriesByApplicationId", mock.Anything, 1).Return([]repo.PaymentEntry{
+					{ID: 1, ApplicationID: 1, Type: "advance", Amount: 500, Method: "cash"},
+					{ID: 2, ApplicationID: 1, Type: "deduction", Amount: 100, Note: "broken tools"},
+				}, nil)
+			},
+			expectedOutput: Ledger{
+				Dues: Dues{ApplicationID: 1, Owed: 2400, Paid: 500, Deducted: 100, Outstanding: 1800},
+				Entries: []Entry{
+					{ID: 1, ApplicationID: 1, Type: Advance, Amount: 500, Method: Cash},
+					{ID: 2, ApplicationID: 1, Type: Deduction, Amount: 100, Note: "broken tools"},
+				},
+			},
+			expectedError: false,
+		},
+		{
+			name: "error from db",
+			setup: func() {
+				suite.paymentRepo.On("FetchDuesByApplicationId", mock.Anything, 1).Return(repo.Dues{}, errors.New("some db error"))
+			},
+			expectedOutput: Ledger{},
+			expectedError:  true,
+		},
+	}
+
+	for _, test := range testCases {
+		suite.SetupTest()
+		suite.Run(test.name, func() {
+			test.setup()
+
+			ledger, err := suite.service.FetchApplicationLedger(context.Background(), 1)
+			suite.Equal(test.expectedOutput, ledger)
+			suite.Equal(test.expectedError, err != nil)
+		})
+		suite.TearDownTest()
+	}
+}
+
+func (suite *PaymentServiceTestSuite) TestFetchWorkerDues() {
+	type testCase struct {
+		name           string
+		setup          func()
+		expectedOutput DuesSummary
+		expectedError  error
+	}
+
+	testCases := []testCase{
+		{
+			name: "settled applications are left out",
+			setup: func() {
+				suite.workerRepo.On("FindWorkerById", mock.Anything, 3).Return(true)
+				suite.paymentRepo.On("FetchDuesByWorkerId", mock.Anything, 3).Return([]repo.Dues{
+					{ApplicationID: 1, WorkerID: 3, Status: repo.Confirmed, Owed: 2400, Paid: 500},
+					{ApplicationID: 2, WorkerID: 3, Status: repo.Confirmed, Owed: 800, Paid: 700, Deducted: 100},
+				}, nil)
+			},
+			expectedOutput: DuesSummary{
+				TotalOutstanding: 1900,
+				Dues:             []Dues{{ApplicationID: 1, WorkerID: 3, Owed: 2400, Paid: 500, Outstanding: 1900}},
+			},
+			expectedError: nil,
+		},
+		{
+			name: "worker not found",
+			setup: func() {
+				suite.workerRepo.On("FindWorkerById", mock.Anything, 3).Return(false)
+			},
+			expectedOutput: DuesSummary{},
+			expectedError:  apperrors.ErrNoWorkerExists,
+		},
+	}
+
+	for _, test := range testCases {
+		suite.SetupTest()
+		suite.Run(test.name, func() {
+			test.setup()
+
+			dues, err := suite.service.FetchWorkerDues(context.Background(), 3)
+			suite.Equal(test.expectedOutput, dues)
+			suite.ErrorIs(err, test.expectedError)
+		})
+		suite.TearDownTest()
+	}
+}
+
+func (suite *PaymentServiceTestSuite) TestFetchEmployerDues() {
+	type testCase struct {
+		name           string
+		setup          func()
+		expectedOutput DuesSummary
+		expectedError  error
+	}
+
+	testCases := []testCase{
+		{
+			name: "success",
+			setup: func() {
+				suite.employerRepo.On("FindEmployerById", mock.Anything, 4).Return(true)
+				suite.paymentRepo.On("FetchDuesByEmployerId", mock.Anything, 4).Return([]repo.Dues{
+					{ApplicationID: 1, EmployerID: 4, Status: repo.Confirmed, Owed: 2400},
+				}, nil)
+			},
+			expectedOutput: DuesSummary{
+				TotalOutstanding: 2400,
+				Dues:             []Dues{{ApplicationID: 1, EmployerID: 4, Owed: 2400, Outstanding: 2400}},
+			},
+			expectedError: nil,
+		},
+		{
+			name: "employer not found",
+			setup: func() {
+				suite.employerRepo.On("FindEmployerById", mock.Anything, 4).Return(false)
+			},
+			expectedOutput: DuesSummary{},
+			expectedError:  apperrors.ErrNoEmployerExists,
+		},
+	}
+
+	for _, test := range testCases {
+		suite.SetupTest()
+		suite.Run(test.name, func() {
+			test.setup()
+
+			dues, err := suite.service.FetchEmployerDues(context.Background(), 4)
+			suite.Equal(test.expectedOutput, dues)
+			suite.ErrorIs(err, test.expectedError)
+		})
+		suite.TearDownTest()
+	}
+}
+
+func (suite *PaymentServiceTestSuite) TestInitiatePayment() {
+	type testCase struct {
+		name           string
+		payer          Payer
+		input          PaymentRequest
+		setup          func()
+		expectedOutput Order
+		expectedError  error
+	}
+
+	confirmedDues := repo.Dues{ApplicationID: 1, EmployerID: 4, Status: repo.Confirmed, Owed: 2400}
+	employer := Payer{Role: "employer", ID: 4}
+	paymentId := 9
+
+	testCases := []testCase{
+		{
+			name:  "paid through the gateway",
+			input: PaymentRequest{ApplicationID: 1, Type: Final, Amount: 2400, VPA: "employer@upi", IdempotencyKey: "key-1"},
+			setup: func() {
+				suite.paymentRepo.On("FetchPaymentOrderByIdempotencyKey", mock.Anything, "key-1").Return(repo.PaymentOrder{}, apperrors.ErrNoPaymentOrderExists)
+				suite.paymentRepo.On("FetchDuesByApplicationId", mock.Anything, 1).Return(confirmedDues, nil)
+				suite.paymentRepo.On("FetchPendingOrderAmount", mock.Anything, 1).Return(0, nil)
+				suite.paymentRepo.On("CreatePaymentOrder", mock.Anything, repo.PaymentOrder{ApplicationID: 1, Type: "final", Amount: 2400, IdempotencyKey: "key-1", ProviderOrderID: "order_fake_1", Status: "created"}).Return(repo.PaymentOrder{ID: 3, ApplicationID: 1, Type: "final", Amount: 2400, IdempotencyKey: "key-1", ProviderOrderID: "order_fake_1", Status: "created"}, nil)
+				suite.paymentRepo.On("SettlePaymentOrder", mock.Anything, 3, "paid", "pay_fake_2").Return(repo.PaymentOrder{ID: 3, ApplicationID: 1, Type: "final", Amount: 2400, ProviderOrderID: "order_fake_1", ProviderPaymentID: "pay_fake_2", Status: "paid", PaymentID: &paymentId}, nil)
+			},
+			expectedOutput: Order{ID: 3, ApplicationID: 1, Type: Final, Amount: 2400, ProviderOrderID: "order_fake_1", ProviderPaymentID: "pay_fake_2", Status: paymentgateway.Paid, PaymentID: &paymentId},
+			expectedError:  nil,
+		},
+		{
+			name:  "declined by the gateway",
+			input: PaymentRequest{ApplicationID: 1, Type: Advance, Amount: 500, VPA: paymentgateway.FakeFailureVPA, IdempotencyKey: "key-2"},
+			setup: func() {
+				suite.paymentRepo.On("FetchPaymentOrderByIdempotencyKey", mock.Anything, "key-2").Return(repo.PaymentOrder{}, apperrors.ErrNoPaymentOrderExists)
+				suite.paymentRepo.On("FetchDuesByApplicationId", mock.Anything, 1).Return(confirmedDues, nil)
+				suite.paymentRepo.On("FetchPendingOrderAmount", mock.Anything, 1).Return(0, nil)
+				suite.paymentRepo.On("CreatePaymentOrder", mock.Anything, mock.Anything).Return(repo.PaymentOrder{ID: 4, ApplicationID: 1, Type: "advance", Amount: 500, ProviderOrderID: "order_fake_1", Status: "created"}, nil)
+				suite.paymentRepo.On("SettlePaymentOrder", mock.Anything, 4, "failed", "").Return(repo.PaymentOrder{ID: 4, ApplicationID: 1, Type: "advance", Amount: 500, ProviderOrderID: "order_fake_1", Status: "failed"}, nil)
+			},
+			expectedOutput: Order{ID: 4, ApplicationID: 1, Type: Advance, Amount: 500, ProviderOrderID: "order_fake_1", Status: paymentgateway.Failed},
+			expectedError:  nil,
+		},
+		{
+			name:  "gateway timeout",
+			input: PaymentRequest{ApplicationID: 1, Type: Advance, Amount: 500, VPA: paymentgateway.FakeTimeoutVPA, IdempotencyKey: "key-3"},
+			setup: func() {
+				suite.paymentRepo.On("FetchPaymentOrderByIdempotencyKey", mock.Anything, "key-3").Return(repo.PaymentOrder{}, apperrors.ErrNoPaymentOrderExists)
+				suite.paymentRepo.On("FetchDuesByApplicationId", mock.Anything, 1).Return(confirmedDues, nil)
+				suite.paymentRepo.On("FetchPendingOrderAmount", mock.Anything, 1).Return(0, nil)
+			},
+			expectedOutput: Order{},
+			expectedError:  apperrors.ErrPaymentProviderTimeout,
+		},
+		{
+			name:  "retried request returns the stored order",
+			input: PaymentRequest{ApplicationID: 1, Type: Final, Amount: 2400, VPA: "employer@upi", IdempotencyKey: "key-1"},
+			setup: func() {
+				suite.paymentRepo.On("FetchDuesByApplicationId", mock.Anything, 1).Return(confirmedDues, nil)
+				suite.paymentRepo.On("FetchPaymentOrderByIdempotencyKey", mock.Anything, "key-1").Return(repo.PaymentOrder{ID: 3, ApplicationID: 1, Type: "final", Amount: 2400, ProviderOrderID: "order_fake_1", Status: "paid"}, nil)
+			},
+			expectedOutput: Order{ID: 3, ApplicationID: 1, Type: Final, Amount: 2400, ProviderOrderID: "order_fake_1", Status: paymentgateway.Paid},
+			expectedError:  nil,
+		},
+		{
+			name:  "concurrent request with the same key stored its order first",
+			input: PaymentRequest{ApplicationID: 1, Type: Final, Amount: 2400, VPA: "employer@upi", IdempotencyKey: "key-5"},
+			setup: func() {
+				suite.paymentRepo.On("FetchPaymentOrderByIdempotencyKey", mock.Anything, "key-5").Return(repo.PaymentOrder{}, apperrors.ErrNoPaymentOrderExists).Once()
+				suite.paymentRepo.On("FetchDuesByApplicationId", mock.Anything, 1).Return(confirmedDues, nil)
+				suite.paymentRepo.On("FetchPendingOrderAmount", mock.Anything, 1).Return(0, nil)
+				suite.paymentRepo.On("CreatePaymentOrder", mock.Anything, mock.Anything).Return(repo.PaymentOrder{}, apperrors.ErrPaymentOrderExists)
+				suite.paymentRepo.On("FetchPaymentOrderByIdempotencyKey", mock.Anything, "key-5").Return(repo.PaymentOrder{ID: 6, ApplicationID: 1, Type: "final", Amount: 2400, ProviderOrderID: "order_fake_1", Status: "paid"}, nil).Once()
+			},
+			expectedOutput: Order{ID: 6, ApplicationID: 1, Type: Final, Amount: 2400, ProviderOrderID: "order_fake_1", Status: paymentgateway.Paid},
+			expectedError:  nil,
+		},
+		{
+			name:  "idempotency key reused for another payment",
+			input: PaymentRequest{ApplicationID: 1, Type: Final, Amount: 1000, VPA: "employer@upi", IdempotencyKey: "key-1"},
+			setup: func() {
+				suite.paymentRepo.On("FetchDuesByApplicationId", mock.Anything, 1).Return(confirmedDues, nil)
+				suite.paymentRepo.On("FetchPaymentOrderByIdempotencyKey", mock.Anything, "key-1").Return(repo.PaymentOrder{ID: 3, ApplicationID: 1, Type: "final", Amount: 2400, Status: "paid"}, nil)
+			},
+			expectedOutput: Order{},
+			expectedError:  apperrors.ErrIdempotencyKeyReused,
+		},
+		{
+			name:  "pending orders are taken off the outstanding wage",
+			input: PaymentRequest{ApplicationID: 1, Type: Final, Amount: 500, VPA: "employer@upi", IdempotencyKey: "key-8"},
+			setup: func() {
+				suite.paymentRepo.On("FetchDuesByApplicationId", mock.Anything, 1).Return(confirmedDues, nil)
+				suite.paymentRepo.On("FetchPaymentOrderByIdempotencyKey", mock.Anything, "key-8").Return(repo.PaymentOrder{}, apperrors.ErrNoPaymentOrderExists)
+				suite.paymentRepo.On("FetchPendingOrderAmount", mock.Anything, 1).Return(2000, nil)
+			},
+			expectedOutput: Order{},
+			expectedError:  apperrors.ErrPaymentExceedsDues,
+		},
+		{
+			name:  "employer of another application",
+			payer: Payer{Role: "employer", ID: 9},
+			input: PaymentRequest{ApplicationID: 1, Type: Final, Amount: 2400, VPA: "employer@upi", IdempotencyKey: "key-6"},
+			setup: func() {
+				suite.paymentRepo.On("FetchDuesByApplicationId", mock.Anything, 1).Return(confirmedDues, nil)
+			},
+			expectedOutput: Order{},
+			expectedError:  apperrors.ErrNotPaymentEmployer,
+		},
+		{
+			name:  "worker cannot pay",
+			payer: Payer{Role: "worker", ID: 4},
+			input: PaymentRequest{ApplicationID: 1, Type: Final, Amount: 2400, VPA: "employer@upi", IdempotencyKey: "key-7"},
+			setup: func() {
+				suite.paymentRepo.On("FetchDuesByApplicationId", mock.Anything, 1).Return(confirmedDues, nil)
+			},
+			expectedOutput: Order{},
+			expectedError:  apperrors.ErrNotPaymentEmployer,
+		},
+		{
+			name:           "missing idempotency key",
+			input:          PaymentRequest{ApplicationID: 1, Type: Final, Amount: 2400, VPA: "employer@upi"},
+			setup:          func() {},
+			expectedOutput: Order{},
+			expectedError:  apperrors.ErrMissingIdempotencyKey,
+		},
+		{
+			name:           "deductions cannot be paid",
+			input:          PaymentRequest{ApplicationID: 1, Type: Deduction, Amount: 100, VPA: "employer@upi", IdempotencyKey: "key-4"},
+			setup:          func() {},
+			expectedOutput: Order{},
+			expectedError:  apperrors.ErrInvalidPayment,
+		},
+	}
+
+	for _, test := range testCases {
+		suite.SetupTest()
+		suite.Run(test.name, func() {
+			test.setup()
+
+			payer := test.payer
+			if payer == (Payer{}) {
+				payer = employer
+			}
+
+			order, err := suite.service.InitiatePayment(context.Background(), payer, test.input)
+			suite.Equal(test.expectedOutput, order)
+			suite.ErrorIs(err, test.expectedError)
+		})
+		suite.TearDownTest()
+	}
+}
+
+func (suite *PaymentServiceTestSuite) TestHandleWebhook() {
+	type testCase struct {
+		name          string
+		setup         func() ([]byte, string)
+		expectedError error
+	}
+
+	testCases := []testCase{
+		{
+			name: "payment recorded from callback",
+			setup: func() ([]byte, string) {
+				providerOrder, _ := suite.provider.CreateOrder(context.Background(), paymentgateway.OrderRequest{Amount: 500, Receipt: "key-1", VPA: "employer@upi"})
+				suite.paymentRepo.On("FetchPaymentOrderByProviderOrderId", mock.Anything, providerOrder.ID).Return(repo.PaymentOrder{ID: 3, ApplicationID: 1, Amount: 500, ProviderOrderID: providerOrder.ID, Status: "created"}, nil)
+				suite.paymentRepo.On("SettlePaymentOrder", mock.Anything, 3, "paid", providerOrder.PaymentID).Return(repo.PaymentOrder{ID: 3, Status: "paid"}, nil)
+
+				payload, signature, _ := suite.provider.SignedEvent(providerOrder.ID)
+				return payload, signature
+			},
+			expectedError: nil,
+		},
+		{
+			name: "duplicate callback is ignored",
+			setup: func() ([]byte, string) {
+				providerOrder, _ := suite.provider.CreateOrder(context.Background(), paymentgateway.OrderRequest{Amount: 500, Receipt: "key-1", VPA: "employer@upi"})
+				suite.paymentRepo.On("FetchPaymentOrderByProviderOrderId", mock.Anything, providerOrder.ID).Return(repo.PaymentOrder{ID: 3, Status: "paid"}, nil)
+
+				payload, signature, _ := suite.provider.SignedEvent(providerOrder.ID)
+				return payload, signature
+			},
+			expectedError: nil,
+		},
+		{
+			name: "invalid signature",
+			setup: func() ([]byte, string) {
+				return []byte(`{"order_id": "order_fake_1", "status": "paid"}`), "forged"
+			},
+			expectedError: apperrors.ErrInvalidWebhookSignature,
+		},
+	}
+
+	for _, test := range testCases {
+		suite.SetupTest()
+		suite.Run(test.name, func() {
+			payload, signature := test.setup()
+
+			_, err := suite.service.HandleWebhook(context.Background(), payload, signature)
+			suite.ErrorIs(err, test.expectedError)
+		})
+		suite.TearDownTest()
+	}
+}
+
+func (suite *PaymentServiceTestSuite) TestRefundPayment() {
+	type testCase struct {
+		name           string
+		payer          Payer
+		setup          func()
+		expectedOutput Order
+		expectedError  error
+	}
+
+	confirmedDues := repo.Dues{ApplicationID: 1, EmployerID: 4, Status: repo.Confirmed, Owed: 2400}
+
+	testCases := []testCase{
+		{
+			name: "success",
+			setup: func() {
+				providerOrder, _ := suite.provider.CreateOrder(context.Background(), paymentgateway.OrderRequest{Amount: 500, Receipt: "key-1", VPA: "employer@upi"})
+				paidOrder := repo.PaymentOrder{ID: 3, ApplicationID: 1, Amount: 500, ProviderOrderID: providerOrder.ID, ProviderPaymentID: providerOrder.PaymentID, Status: "paid"}
+				suite.paymentRepo.On("FetchDuesByApplicationId", mock.Anything, 1).Return(confirmedDues, nil)
+				suite.paymentRepo.On("FetchPaymentOrderById", mock.Anything, 1, 3).Return(paidOrder, nil)
+				pendingOrder := paidOrder
+				pendingOrder.Status = "refund_pending"
+				suite.paymentRepo.On("StartPaymentOrderRefund", mock.Anything, 3).Return(pendingOrder, nil)
+				suite.paymentRepo.On("RefundPaymentOrder", mock.Anything, 3, "rfnd_fake_3").Return(repo.PaymentOrder{ID: 3, Status: "refunded"}, nil)
+			},
+			expectedOutput: Order{ID: 3, Status: paymentgateway.Refunded},
+			expectedError:  nil,
+		},
+		{
+			name: "retry after recording the refund failed",
+			setup: func() {
+				providerOrder, _ := suite.provider.CreateOrder(context.Background(), paymentgateway.OrderRequest{Amount: 500, Receipt: "key-1", VPA: "employer@upi"})
+				pendingOrder := repo.PaymentOrder{ID: 3, ApplicationID: 1, Amount: 500, ProviderOrderID: providerOrder.ID, ProviderPaymentID: providerOrder.PaymentID, Status: "refund_pending"}
+				refund, _ := suite.provider.Refund(context.Background(), providerOrder.PaymentID, 500, "refund-3")
+				suite.paymentRepo.On("FetchDuesByApplicationId", mock.Anything, 1).Return(confirmedDues, nil)
+				suite.paymentRepo.On("FetchPaymentOrderById", mock.Anything, 1, 3).Return(pendingOrder, nil)
+				suite.paymentRepo.On("RefundPaymentOrder", mock.Anything, 3, refund.ID).Return(repo.PaymentOrder{ID: 3, Status: "refunded"}, nil)
+			},
+			expectedOutput: Order{ID: 3, Status: paymentgateway.Refunded},
+			expectedError:  nil,
+		},
+		{
+			name: "refunded concurrently",
+			setup: func() {
+				suite.paymentRepo.On("FetchDuesByApplicationId", mock.Anything, 1).Return(confirmedDues, nil)
+				suite.paymentRepo.On("FetchPaymentOrderById", mock.Anything, 1, 3).Return(repo.PaymentOrder{ID: 3, ApplicationID: 1, Status: "paid"}, nil)
+				suite.paymentRepo.On("StartPaymentOrderRefund", mock.Anything, 3).Return(repo.PaymentOrder{}, apperrors.ErrPaymentNotRefundable)
+			},
+			expectedOutput: Order{},
+			expectedError:  apperrors.ErrPaymentNotRefundable,
+		},
+		{
+			name:  "employer of another application",
+			payer: Payer{Role: "employer", ID: 9},
+			setup: func() {
+				suite.paymentRepo.On("FetchDuesByApplicationId", mock.Anything, 1).Return(confirmedDues, nil)
+			},
+			expectedOutput: Order{},
+			expectedError:  apperrors.ErrNotPaymentEmployer,
+		},
+		{
+			name: "order not paid",
+			setup: func() {
+				suite.paymentRepo.On("FetchDuesByApplicationId", mock.Anything, 1).Return(confirmedDues, nil)
+				suite.paymentRepo.On("FetchPaymentOrderById", mock.Anything, 1, 3).Return(repo.PaymentOrder{ID: 3, ApplicationID: 1, Status: "failed"}, nil)
+			},
+			expectedOutput: Order{},
+			expectedError:  apperrors.ErrPaymentNotRefundable,
+		},
+	}
+
+	for _, test := range testCases {
+		suite.SetupTest()
+		suite.Run(test.name, func() {
+			test.setup()
+
+			payer := test.payer
+			if payer == (Payer{}) {
+				payer = Payer{Role: "employer", ID: 4}
+			}
+
+			order, err := suite.service.RefundPayment(context.Background(), payer, 1, 3)
+			suite.Equal(test.expectedOutput, order)
+			suite.ErrorIs(err, test.expectedError)
+		})
+		suite.TearDownTest()
+	}
+}
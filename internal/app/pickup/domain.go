@@ -0,0 +1,65 @@
+package pickup
+
+import (
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/datetime"
+)
+
+type Address struct {
+	ID      int    `json:"id,omitempty"`
+	Details string `json:"details"`
+	Street  string `json:"street"`
+	City    string `json:"city"`
+	State   string `json:"state"`
+	Pincode int    `json:"pincode"`
+}
+
+type Passenger struct {
+	ApplicationID int     `json:"application_id"`
+	WorkerID      int     `json:"worker_id"`
+	WorkerName    string  `json:"name"`
+	ContactNumber string  `json:"contact_number"`
+	Address       Address `json:"address"`
+}
+
+// Stop groups the passengers living in the same pincode, stops are visited in the order of their sequence
+type Stop struct {
+	Sequence   int            `json:"sequence"`
+	Pincode    int            `json:"pincode"`
+	City       string         `json:"city"`
+	Vehicle    string         `json:"vehicle,omitempty"`
+	PickupTime datetime.Clock `json:"pickup_time,omitempty"`
+	Passengers []Passenger    `json:"passengers"`
+}
+
+// Plan is the pickup route of a job, ending at the job site
+type Plan struct {
+	JobID           int            `json:"job_id"`
+	JobTitle        string         `json:"title"`
+	Date            datetime.Date  `json:"date"`
+	EndDate         datetime.Date  `json:"end_date,omitempty"`
+	StartHour       datetime.Clock `json:"start_hour,omitempty"`
+	Site            Address        `json:"site"`
+	TotalPassengers int            `json:"total_passengers"`
+	Stops           []Stop         `json:"stops"`
+}
+
+// Assignment is the vehicle and pickup time the employer assigns to a stop
+type Assignment struct {
+	Vehicle    string         `json:"vehicle"`
+	PickupTime datetime.Clock `json:"pickup_time"`
+}
+
+// WorkerPickup is the pickup of a single worker, as shown to them
+type WorkerPickup struct {
+	ApplicationID int            `json:"application_id"`
+	JobID         int            `json:"job_id"`
+	JobTitle      string         `json:"title"`
+	Date          datetime.Date  `json:"date"`
+	EndDate       datetime.Date  `json:"end_date,omitempty"`
+	Site          Address        `json:"site"`
+	Sequence      int            `json:"sequence"`
+	TotalStops    int            `json:"total_stops"`
+	Address       Address        `json:"address"`
+	Vehicle       string         `json:"vehicle,omitempty"`
+	PickupTime    datetime.Clock `json:"pickup_time,omitempty"`
+}
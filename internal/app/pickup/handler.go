@@ -0,0 +1,123 @@
+package pickup
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/logger"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/middleware"
+	"go.uber.org/zap"
+)
+
+// FetchPickupPlan returns the pickup plan of a job, as JSON or as a printable manifest with ?format=manifest
+func FetchPickupPlan(pickupService Service) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		jobId, id := isPathIdValid(ctx, w, r, "job_id", apperrors.MsgInvalidJobId, apperrors.ErrFetchPickupPlan)
+		if jobId == -1 {
+			return
+		}
+
+		plan, err := pickupService.FetchPickupPlan(ctx, jobId)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrFetchPickupPlan.Error(), zap.Error(err), zap.String("ID", id))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrFetchPickupPlan.Error()+", "+err.Error(), pickupErrorStatusCode(err))
+			return
+		}
+
+		if r.URL.Query().Get("format") == "manifest" {
+			w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+			w.Header().Set("Content-Disposition", fmt.Sprintf("inline; filename=\"pickup-manifest-job-%d.txt\"", jobId))
+			w.WriteHeader(http.StatusOK)
+			w.Write(RenderManifest(plan))
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, "pickup plan retrieved successfully", http.StatusOK, plan)
+	}
+}
+
+func AssignStop(pickupService Service) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		jobId, _ := isPathIdValid(ctx, w, r, "job_id", apperrors.MsgInvalidJobId, apperrors.ErrAssignPickup)
+		if jobId == -1 {
+			return
+		}
+
+		pincode, _ := isPathIdValid(ctx, w, r, "pincode", apperrors.MsgInvalidPincode, apperrors.ErrAssignPickup)
+		if pincode == -1 {
+			return
+		}
+
+		var assignment Assignment
+		err := json.NewDecoder(r.Body).Decode(&assignment)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrInvalidRequestBody.Error(), zap.Error(err))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrInvalidRequestBody.Error()+": "+err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		stop, err := pickupService.AssignStop(ctx, jobId, pincode, assignment)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrAssignPickup.Error(), zap.Error(err))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrAssignPickup.Error()+": "+err.Error(), pickupErrorStatusCode(err))
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, "pickup vehicle assigned successfully", http.StatusOK, stop)
+	}
+}
+
+func FetchWorkerPickup(pickupService Service) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		applicationId, id := isPathIdValid(ctx, w, r, "application_id", apperrors.MsgInvalidApplicationId, apperrors.ErrFetchPickup)
+		if applicationId == -1 {
+			return
+		}
+
+		workerPickup, err := pickupService.FetchWorkerPickup(ctx, applicationId)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrFetchPickup.Error(), zap.Error(err), zap.String("ID", id))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrFetchPickup.Error()+", "+err.Error(), pickupErrorStatusCode(err))
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, "pickup details retrieved successfully", http.StatusOK, workerPickup)
+	}
+}
+
+func isPathIdValid(ctx context.Context, w http.ResponseWriter, r *http.Request, key string, invalidMsg string, errType error) (int, string) {
+	vars := mux.Vars(r)
+	id := vars[key]
+	pathId, err := strconv.Atoi(id)
+	if err != nil {
+		logger.Errorw(ctx, invalidMsg, zap.Error(err), zap.String("ID", id))
+		httpResponseMsg := apperrors.HttpErrorResponseMessage(errType.Error(), invalidMsg, id)
+		middleware.HandleErrorResponse(ctx, w, httpResponseMsg, http.StatusBadRequest)
+		return -1, id
+	}
+	return pathId, id
+}
+
+func pickupErrorStatusCode(err error) int {
+	switch {
+	case errors.Is(err, apperrors.ErrInvalidPickupAssignment), errors.Is(err, apperrors.ErrInvalidDateTime):
+		return http.StatusBadRequest
+	case errors.Is(err, apperrors.ErrNoJobExists), errors.Is(err, apperrors.ErrNoApplicationExists), errors.Is(err, apperrors.ErrNoPickupStop):
+		return http.StatusNotFound
+	case errors.Is(err, apperrors.ErrPickupNotArranged):
+		return http.StatusConflict
+	}
+	return http.StatusInternalServerError
+}
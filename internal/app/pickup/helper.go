@@ -0,0 +1,205 @@
+package pickup
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo"
+)
+
+func MapPassengerRepoToService(passenger repo.PickupPassenger) Passenger {
+	return Passenger{
+		ApplicationID: passenger.ApplicationID,
+		WorkerID:      passenger.WorkerID,
+		WorkerName:    passenger.WorkerName,
+		ContactNumber: passenger.ContactNumber,
+		Address: Address{
+			ID:      passenger.AddressID,
+			Details: passenger.Details,
+			Street:  passenger.Street,
+			City:    passenger.City,
+			State:   passenger.State,
+			Pincode: passenger.Pincode,
+		},
+	}
+}
+
+func MapJobSiteToService(job repo.Job) Address {
+	return Address{
+		ID:      job.Location,
+		Details: job.Details,
+		Street:  job.Street,
+		City:    job.City,
+		State:   job.State,
+		Pincode: job.Pincode,
+	}
+}
+
+// pincodeDistance estimates how far apart two pincodes are. Indian pincodes are assigned
+// hierarchically (region, sub-region, sorting district, post office), so pincodes sharing a longer
+// prefix are closer, and the numeric difference breaks ties within the same level
+func pincodeDistance(a, b int) int {
+	first, second := fmt.Sprintf("%06d", a), fmt.Sprintf("%06d", b)
+
+	shared := 0
+	for shared < len(first) && shared < len(second) && first[shared] == second[shared] {
+		shared++
+	}
+
+	difference := a - b
+	if difference < 0 {
+		difference = -difference
+	}
+	return (len(first)-shared)*1000000 + difference
+}
+
+// planRoute orders the stops into a route ending at the job site: it starts at the stop farthest
+// from the site and keeps moving to the nearest stop not visited yet
+func planRoute(sitePincode int, stops []Stop) []Stop {
+	if len(stops) == 0 {
+		return stops
+	}
+
+	remaining := append([]Stop{}, stops...)
+	current := 0
+	for i := range remaining {
+		if pincodeDistance(remaining[i].Pincode, sitePincode) > pincodeDistance(remaining[current].Pincode, sitePincode) {
+			current = i
+		}
+	}
+
+	route := make([]Stop, 0, len(stops))
+	for {
+		stop := remaining[current]
+		stop.Sequence = len(route) + 1
+		route = append(route, stop)
+		remaining = append(remaining[:current], remaining[current+1:]...)
+		if len(remaining) == 0 {
+			return route
+		}
+
+		current = 0
+		for i := range remaining {
+			if pincodeDistance(remaining[i].Pincode, stop.Pincode) < pincodeDistance(remaining[current].Pincode, stop.Pincode) {
+				current = i
+			}
+		}
+	}
+}
+
+// buildPlan groups the passengers by pincode into stops, applies the vehicle and pickup time assigned
+// to each stop and orders the stops into a route
+func buildPlan(job repo.Job, passengers []repo.PickupPassenger, assignments []repo.PickupAssignment) Plan {
+	assigned := make(map[int]repo.PickupAssignment)
+	for _, assignment := range assignments {
+		assigned[assignment.Pincode] = assignment
+	}
+
+	stops := make([]Stop, 0)
+	index := make(map[int]int)
+	for _, passenger := range passengers {
+		position, ok := index[passenger.Pincode]
+		if !ok {
+			position = len(stops)
+			index[passenger.Pincode] = position
+			stops = append(stops, Stop{
+				Pincode:    passenger.Pincode,
+				City:       passenger.City,
+				Vehicle:    assigned[passenger.Pincode].Vehicle,
+				PickupTime: assigned[passenger.Pincode].PickupTime,
+				Passengers: make([]Passenger, 0),
+			})
+		}
+		stops[position].Passengers = append(stops[position].Passengers, MapPassengerRepoToService(passenger))
+	}
+
+	return Plan{
+		JobID:           job.ID,
+		JobTitle:        job.Title,
+		Date:            job.Date,
+		EndDate:         job.EndDate,
+		StartHour:       job.StartHour,
+		Site:            MapJobSiteToService(job),
+		TotalPassengers: len(passengers),
+		Stops:           planRoute(job.Pincode, stops),
+	}
+}
+
+// validateAssignment checks that a vehicle and pickup time are given, and that the pickup happens
+// before the job starts when the job has working hours
+func validateAssignment(assignment Assignment, plan Plan) error {
+	if strings.TrimSpace(assignment.Vehicle) == "" || assignment.PickupTime.IsZero() {
+		return apperrors.ErrInvalidPickupAssignment
+	}
+
+	if plan.StartHour.IsZero() {
+		return nil
+	}
+
+	pickupTime, err := assignment.PickupTime.Duration()
+	if err != nil {
+		return err
+	}
+	startHour, err := plan.StartHour.Duration()
+	if err != nil {
+		return err
+	}
+	if pickupTime >= startHour {
+		return fmt.Errorf("%w: pickup time must be before the job starts at %s", apperrors.ErrInvalidPickupAssignment, plan.StartHour)
+	}
+	return nil
+}
+
+func formatAddress(address Address) string {
+	parts := make([]string, 0)
+	for _, part := range []string{address.Details, address.Street, address.City, address.State} {
+		if strings.TrimSpace(part) != "" {
+			parts = append(parts, part)
+		}
+	}
+	return strings.Join(parts, ", ") + " - " + strconv.Itoa(address.Pincode)
+}
+
+// RenderManifest writes the plan as a plain text manifest to be printed and handed to the drivers
+func RenderManifest(plan Plan) []byte {
+	var buffer bytes.Buffer
+
+	fmt.Fprintf(&buffer, "PICKUP MANIFEST\n\n")
+	fmt.Fprintf(&buffer, "Job: #%d %s\n", plan.JobID, plan.JobTitle)
+	if plan.EndDate.IsZero() || plan.EndDate == plan.Date {
+		fmt.Fprintf(&buffer, "Date: %s\n", plan.Date)
+	} else {
+		fmt.Fprintf(&buffer, "Dates: %s to %s\n", plan.Date, plan.EndDate)
+	}
+	if !plan.StartHour.IsZero() {
+		fmt.Fprintf(&buffer, "Work starts at: %s\n", plan.StartHour)
+	}
+	fmt.Fprintf(&buffer, "Drop at: %s\n", formatAddress(plan.Site))
+	fmt.Fprintf(&buffer, "Passengers: %d, stops: %d\n", plan.TotalPassengers, len(plan.Stops))
+
+	for _, stop := range plan.Stops {
+		vehicle, pickupTime := stop.Vehicle, string(stop.PickupTime)
+		if vehicle == "" {
+			vehicle = "not assigned"
+		}
+		if pickupTime == "" {
+			pickupTime = "not assigned"
+		}
+
+		fmt.Fprintf(&buffer, "\nStop %d - %d %s\n", stop.Sequence, stop.Pincode, stop.City)
+		fmt.Fprintf(&buffer, "Vehicle: %s, pickup time: %s\n", vehicle, pickupTime)
+
+		writer := tabwriter.NewWriter(&buffer, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(writer, "#\tName\tContact\tAddress\tSignature")
+		for i, passenger := range stop.Passengers {
+			fmt.Fprintf(writer, "%d\t%s\t%s\t%s\t__________\n", i+1, passenger.WorkerName, passenger.ContactNumber, formatAddress(passenger.Address))
+		}
+		writer.Flush()
+	}
+
+	return buffer.Bytes()
+}
@@ -0,0 +1,48 @@
+package pickup_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/pickup"
+)
+
+func TestRenderManifest(t *testing.T) {
+	plan := pickup.Plan{
+		JobID:           2,
+		JobTitle:        "Site Helper",
+		Date:            "2025-03-10",
+		EndDate:         "2025-03-19",
+		StartHour:       "09:00",
+		Site:            pickup.Address{Street: "FC Road", City: "Pune", State: "Maharashtra", Pincode: 411004},
+		TotalPassengers: 2,
+		Stops: []pickup.Stop{
+			{Sequence: 1, Pincode: 412105, City: "Chakan", Passengers: []pickup.Passenger{{WorkerName: "Dinesh", ContactNumber: "9876543210", Address: pickup.Address{Street: "Market Yard", City: "Chakan", Pincode: 412105}}}},
+			{Sequence: 2, Pincode: 411038, City: "Pune", Vehicle: "MH12 AB 1234", PickupTime: "07:45", Passengers: []pickup.Passenger{{WorkerName: "Ganesh", ContactNumber: "9123456780", Address: pickup.Address{City: "Pune", Pincode: 411038}}}},
+		},
+	}
+
+	manifest := string(pickup.RenderManifest(plan))
+
+	expectedLines := []string{
+		"Job: #2 Site Helper",
+		"Dates: 2025-03-10 to 2025-03-19",
+		"Work starts at: 09:00",
+		"Drop at: FC Road, Pune, Maharashtra - 411004",
+		"Passengers: 2, stops: 2",
+		"Stop 1 - 412105 Chakan",
+		"Vehicle: not assigned, pickup time: not assigned",
+		"Stop 2 - 411038 Pune",
+		"Vehicle: MH12 AB 1234, pickup time: 07:45",
+		"Dinesh  9876543210  Market Yard, Chakan - 412105",
+	}
+	for _, line := range expectedLines {
+		if !strings.Contains(manifest, line) {
+			t.Errorf("expected manifest to contain %q, got:\n%s", line, manifest)
+		}
+	}
+
+	if strings.Index(manifest, "Stop 1") > strings.Index(manifest, "Stop 2") {
+		t.Errorf("expected stops in route order, got:\n%s", manifest)
+	}
+}
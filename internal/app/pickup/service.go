@@ -0,0 +1,125 @@
+package pickup
+
+import (
+	"context"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo"
+)
+
+type pickupService struct {
+	pickupRepo      repo.PickupStorer
+	jobRepo         repo.JobStorer
+	applicationRepo repo.ApplicationStorer
+}
+
+type Service interface {
+	FetchPickupPlan(ctx context.Context, jobId int) (Plan, error)
+	AssignStop(ctx context.Context, jobId int, pincode int, assignment Assignment) (Stop, error)
+	FetchWorkerPickup(ctx context.Context, applicationId int) (WorkerPickup, error)
+}
+
+func NewService(pickupRepo repo.PickupStorer, jobRepo repo.JobStorer, applicationRepo repo.ApplicationStorer) Service {
+	return &pickupService{
+		pickupRepo:      pickupRepo,
+		jobRepo:         jobRepo,
+		applicationRepo: applicationRepo,
+	}
+}
+
+// FetchPickupPlan groups the confirmed workers who chose pickup into stops by pincode and orders
+// them into a route ending at the job site
+func (pickS *pickupService) FetchPickupPlan(ctx context.Context, jobId int) (Plan, error) {
+	job, err := pickS.jobRepo.FetchJobById(ctx, jobId)
+	if err != nil {
+		return Plan{}, err
+	}
+
+	passengers, err := pickS.pickupRepo.FetchPickupPassengersByJobId(ctx, jobId)
+	if err != nil {
+		return Plan{}, err
+	}
+
+	assignments, err := pickS.pickupRepo.FetchPickupAssignmentsByJobId(ctx, jobId)
+	if err != nil {
+		return Plan{}, err
+	}
+
+	return buildPlan(job, passengers, assignments), nil
+}
+
+// AssignStop sets the vehicle and pickup time of the stop at the given pincode
+func (pickS *pickupService) AssignStop(ctx context.Context, jobId int, pincode int, assignment Assignment) (Stop, error) {
+	plan, err := pickS.FetchPickupPlan(ctx, jobId)
+	if err != nil {
+		return Stop{}, err
+	}
+
+	err = validateAssignment(assignment, plan)
+	if err != nil {
+		return Stop{}, err
+	}
+
+	for _, stop := range plan.Stops {
+		if stop.Pincode != pincode {
+			continue
+		}
+
+		savedAssignment, err := pickS.pickupRepo.SavePickupAssignment(ctx, repo.PickupAssignment{
+			JobID:      jobId,
+			Pincode:    pincode,
+			Vehicle:    assignment.Vehicle,
+			PickupTime: assignment.PickupTime,
+		})
+		if err != nil {
+			return Stop{}, err
+		}
+
+		stop.Vehicle = savedAssignment.Vehicle
+		stop.PickupTime = savedAssignment.PickupTime
+		return stop, nil
+	}
+
+	return Stop{}, apperrors.ErrNoPickupStop
+}
+
+// FetchWorkerPickup returns the stop, vehicle and pickup time of a worker who chose pickup
+func (pickS *pickupService) FetchWorkerPickup(ctx context.Context, applicationId int) (WorkerPickup, error) {
+	application, err := pickS.applicationRepo.FetchApplicationByID(ctx, applicationId)
+	if err != nil {
+		return WorkerPickup{}, err
+	}
+
+	if application.Status != repo.Confirmed || application.ModeOfArrival != repo.PickUp {
+		return WorkerPickup{}, apperrors.ErrPickupNotArranged
+	}
+
+	plan, err := pickS.FetchPickupPlan(ctx, application.JobID)
+	if err != nil {
+		return WorkerPickup{}, err
+	}
+
+	for _, stop := range plan.Stops {
+		for _, passenger := range stop.Passengers {
+			if passenger.ApplicationID != applicationId {
+				continue
+			}
+
+			return WorkerPickup{
+				ApplicationID: applicationId,
+				JobID:         plan.JobID,
+				JobTitle:      plan.JobTitle,
+				Date:          plan.Date,
+				EndDate:       plan.EndDate,
+				Site:          plan.Site,
+				Sequence:      stop.Sequence,
+				TotalStops:    len(plan.Stops),
+				Address:       passenger.Address,
+				Vehicle:       stop.Vehicle,
+				PickupTime:    stop.PickupTime,
+			}, nil
+		}
+	}
+
+	return WorkerPickup{}, apperrors.ErrPickupNotArranged
+}
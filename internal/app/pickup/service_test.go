@@ -0,0 +1,253 @@
+package pickup
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type PickupServiceTestSuite struct {
+	suite.Suite
+	service         Service
+	pickupRepo      mocks.PickupStorer
+	jobRepo         mocks.JobStorer
+	applicationRepo mocks.ApplicationStorer
+}
+
+func (suite *PickupServiceTestSuite) SetupTest() {
+	suite.pickupRepo = mocks.PickupStorer{}
+	suite.jobRepo = mocks.JobStorer{}
+	suite.applicationRepo = mocks.ApplicationStorer{}
+	suite.service = NewService(&suite.pickupRepo, &suite.jobRepo, &suite.applicationRepo)
+}
+
+func (suite *PickupServiceTestSuite) TearDownTest() {
+	suite.pickupRepo.AssertExpectations(suite.T())
+	suite.jobRepo.AssertExpectations(suite.T())
+	suite.applicationRepo.AssertExpectations(suite.T())
+}
+
+func TestPickupServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(PickupServiceTestSuite))
+}
+
+var (
+	testJob = repo.Job{ID: 2, Title: "Site Helper", Date: "2025-03-10", StartHour: "09:00", EndHour: "17:00", Location: 7, City: "Pune", State: "Maharashtra", Pincode: 411001}
+
+	testPassengers = []repo.PickupPassenger{
+		{ApplicationID: 1, JobID: 2, WorkerID: 11, WorkerName: "Ramesh", AddressID: 21, City: "Pune", Pincode: 411001},
+		{ApplicationID: 2, JobID: 2, WorkerID: 12, WorkerName: "Suresh", AddressID: 22, City: "Pune", Pincode: 411014},
+		{ApplicationID: 3, JobID: 2, WorkerID: 13, WorkerName: "Mahesh", AddressID: 23, City: "Pune", Pincode: 411014},
+		{ApplicationID: 4, JobID: 2, WorkerID: 14, WorkerName: "Ganesh", AddressID: 24, City: "Pune", Pincode: 411038},
+		{ApplicationID: 5, JobID: 2, WorkerID: 15, WorkerName: "Dinesh", AddressID: 25, City: "Chakan", Pincode: 412105},
+	}
+)
+
+func (suite *PickupServiceTestSuite) TestFetchPickupPlan() {
+	type testCase struct {
+		name          string
+		setup         func()
+		expectedRoute []int
+		expectedError error
+	}
+
+	testCases := []testCase{
+		{
+			name: "stops ordered from the farthest pincode towards the site",
+			setup: func() {
+				suite.jobRepo.On("FetchJobById", mock.Anything, 2).Return(testJob, nil)
+				suite.pickupRepo.On("FetchPickupPassengersByJobId", mock.Anything, 2).Return(testPassengers, nil)
+				suite.pickupRepo.On("FetchPickupAssignmentsByJobId", mock.Anything, 2).Return([]repo.PickupAssignment{{JobID: 2, Pincode: 411014, Vehicle: "MH12 AB 1234", PickupTime: "07:30"}}, nil)
+			},
+			expectedRoute: []int{412105, 411038, 411014, 411001},
+			expectedError: nil,
+		},
+		{
+			name: "job not found",
+			setup: func() {
+				suite.jobRepo.On("FetchJobById", mock.Anything, 2).Return(repo.Job{}, apperrors.ErrNoJobExists)
+			},
+			expectedError: apperrors.ErrNoJobExists,
+		},
+		{
+			name: "error from db",
+			setup: func() {
+				suite.jobRepo.On("FetchJobById", mock.Anything, 2).Return(testJob, nil)
+				suite.pickupRepo.On("FetchPickupPassengersByJobId", mock.Anything, 2).Return([]repo.PickupPassenger{}, errors.New("some db error"))
+			},
+			expectedError: errors.New("some db error"),
+		},
+	}
+
+	for _, test := range testCases {
+		suite.SetupTest()
+		suite.Run(test.name, func() {
+			test.setup()
+
+			plan, err := suite.service.FetchPickupPlan(context.Background(), 2)
+			if test.expectedError != nil {
+				suite.EqualError(err, test.expectedError.Error())
+				return
+			}
+
+			suite.NoError(err)
+			suite.Equal(5, plan.TotalPassengers)
+			suite.Equal(411001, plan.Site.Pincode)
+
+			route := make([]int, 0)
+			for i, stop := range plan.Stops {
+				suite.Equal(i+1, stop.Sequence)
+				route = append(route, stop.Pincode)
+			}
+			suite.Equal(test.expectedRoute, route)
+
+			suite.Len(plan.Stops[2].Passengers, 2)
+			suite.Equal("MH12 AB 1234", plan.Stops[2].Vehicle)
+			suite.Equal("", plan.Stops[0].Vehicle)
+		})
+		suite.TearDownTest()
+	}
+}
+
+func (suite *PickupServiceTestSuite) TestAssignStop() {
+	type testCase struct {
+		name           string
+		pincode        int
+		input          Assignment
+		setup          func()
+		expectedOutput Stop
+		expectedError  error
+	}
+
+	testCases := []testCase{
+		{
+			name:    "success",
+			pincode: 412105,
+			input:   Assignment{Vehicle: "MH14 XY 9876", PickupTime: "07:00"},
+			setup: func() {
+				suite.jobRepo.On("FetchJobById", mock.Anything, 2).Return(testJob, nil)
+				suite.pickupRepo.On("FetchPickupPassengersByJobId", mock.Anything, 2).Return(testPassengers[4:], nil)
+				suite.pickupRepo.On("FetchPickupAssignmentsByJobId", mock.Anything, 2).Return([]repo.PickupAssignment{}, nil)
+				suite.pickupRepo.On("SavePickupAssignment", mock.Anything, repo.PickupAssignment{JobID: 2, Pincode: 412105, Vehicle: "MH14 XY 9876", PickupTime: "07:00"}).Return(repo.PickupAssignment{ID: 1, JobID: 2, Pincode: 412105, Vehicle: "MH14 XY 9876", PickupTime: "07:00"}, nil)
+			},
+			expectedOutput: Stop{Sequence: 1, Pincode: 412105, City: "Chakan", Vehicle: "MH14 XY 9876", PickupTime: "07:00", Passengers: []Passenger{MapPassengerRepoToService(testPassengers[4])}},
+			expectedError:  nil,
+		},
+		{
+			name:    "pickup after the job starts",
+			pincode: 412105,
+			input:   Assignment{Vehicle: "MH14 XY 9876", PickupTime: "09:30"},
+			setup: func() {
+				suite.jobRepo.On("FetchJobById", mock.Anything, 2).Return(testJob, nil)
+				suite.pickupRepo.On("FetchPickupPassengersByJobId", mock.Anything, 2).Return(testPassengers[4:], nil)
+				suite.pickupRepo.On("FetchPickupAssignmentsByJobId", mock.Anything, 2).Return([]repo.PickupAssignment{}, nil)
+			},
+			expectedOutput: Stop{},
+			expectedError:  apperrors.ErrInvalidPickupAssignment,
+		},
+		{
+			name:    "missing vehicle",
+			pincode: 412105,
+			input:   Assignment{PickupTime: "07:00"},
+			setup: func() {
+				suite.jobRepo.On("FetchJobById", mock.Anything, 2).Return(testJob, nil)
+				suite.pickupRepo.On("FetchPickupPassengersByJobId", mock.Anything, 2).Return(testPassengers[4:], nil)
+				suite.pickupRepo.On("FetchPickupAssignmentsByJobId", mock.Anything, 2).Return([]repo.PickupAssignment{}, nil)
+			},
+			expectedOutput: Stop{},
+			expectedError:  apperrors.ErrInvalidPickupAssignment,
+		},
+		{
+			name:    "no stop at pincode",
+			pincode: 400001,
+			input:   Assignment{Vehicle: "MH14 XY 9876", PickupTime: "07:00"},
+			setup: func() {
+				suite.jobRepo.On("FetchJobById", mock.Anything, 2).Return(testJob, nil)
+				suite.pickupRepo.On("FetchPickupPassengersByJobId", mock.Anything, 2).Return(testPassengers[4:], nil)
+				suite.pickupRepo.On("FetchPickupAssignmentsByJobId", mock.Anything, 2).Return([]repo.PickupAssignment{}, nil)
+			},
+			expectedOutput: Stop{},
+			expectedError:  apperrors.ErrNoPickupStop,
+		},
+	}
+
+	for _, test := range testCases {
+		suite.SetupTest()
+		suite.Run(test.name, func() {
+			test.setup()
+
+			stop, err := suite.service.AssignStop(context.Background(), 2, test.pincode, test.input)
+			suite.Equal(test.expectedOutput, stop)
+			suite.ErrorIs(err, test.expectedError)
+		})
+		suite.TearDownTest()
+	}
+}
+
+func (suite *PickupServiceTestSuite) TestFetchWorkerPickup() {
+	type testCase struct {
+		name           string
+		setup          func()
+		expectedOutput WorkerPickup
+		expectedError  error
+	}
+
+	testCases := []testCase{
+		{
+			name: "success",
+			setup: func() {
+				suite.applicationRepo.On("FetchApplicationByID", mock.Anything, 4).Return(repo.Application{ID: 4, JobID: 2, WorkerID: 14, Status: repo.Confirmed, ModeOfArrival: repo.PickUp}, nil)
+				suite.jobRepo.On("FetchJobById", mock.Anything, 2).Return(testJob, nil)
+				suite.pickupRepo.On("FetchPickupPassengersByJobId", mock.Anything, 2).Return(testPassengers, nil)
+				suite.pickupRepo.On("FetchPickupAssignmentsByJobId", mock.Anything, 2).Return([]repo.PickupAssignment{{JobID: 2, Pincode: 411038, Vehicle: "MH12 AB 1234", PickupTime: "07:45"}}, nil)
+			},
+			expectedOutput: WorkerPickup{
+				ApplicationID: 4,
+				JobID:         2,
+				JobTitle:      "Site Helper",
+				Date:          "2025-03-10",
+				Site:          MapJobSiteToService(testJob),
+				Sequence:      2,
+				TotalStops:    4,
+				Address:       MapPassengerRepoToService(testPassengers[3]).Address,
+				Vehicle:       "MH12 AB 1234",
+				PickupTime:    "07:45",
+			},
+			expectedError: nil,
+		},
+		{
+			name: "worker travels on their own",
+			setup: func() {
+				suite.applicationRepo.On("FetchApplicationByID", mock.Anything, 4).Return(repo.Application{ID: 4, JobID: 2, Status: repo.Confirmed, ModeOfArrival: repo.Personal}, nil)
+			},
+			expectedOutput: WorkerPickup{},
+			expectedError:  apperrors.ErrPickupNotArranged,
+		},
+		{
+			name: "application not confirmed",
+			setup: func() {
+				suite.applicationRepo.On("FetchApplicationByID", mock.Anything, 4).Return(repo.Application{ID: 4, JobID: 2, Status: repo.Pending, ModeOfArrival: repo.PickUp}, nil)
+			},
+			expectedOutput: WorkerPickup{},
+			expectedError:  apperrors.ErrPickupNotArranged,
+		},
+	}
+
+	for _, test := range testCases {
+		suite.SetupTest()
+		suite.Run(test.name, func() {
+			test.setup()
+
+			workerPickup, err := suite.service.FetchWorkerPickup(context.Background(), 4)
+			suite.Equal(test.expectedOutput, workerPickup)
+			suite.ErrorIs(err, test.expectedError)
+		})
+		suite.TearDownTest()
+	}
+}
@@ -0,0 +1,30 @@
+package report
+
+import (
+	"context"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/audit"
+)
+
+// auditedService records the resolution of reported content in the audit log
+type auditedService struct {
+	Service
+	auditService audit.Service
+}
+
+func NewAuditedService(reportService Service, auditService audit.Service) Service {
+	return &auditedService{
+		Service:      reportService,
+		auditService: auditService,
+	}
+}
+
+func (auditedS *auditedService) ResolveReports(ctx context.Context, targetType TargetType, targetId int, adminId int, resolution Resolution) ([]Report, error) {
+	reports, err := auditedS.Service.ResolveReports(ctx, targetType, targetId, adminId, resolution)
+	if err != nil {
+		return []Report{}, err
+	}
+
+	auditedS.auditService.Record(ctx, audit.Resolve, audit.EntityType(targetType), targetId, nil, resolution)
+	return reports, nil
+}
@@ -0,0 +1,87 @@
+package report
+
+import "time"
+
+type TargetType string
+
+const (
+	Job      TargetType = "job"
+	Employer TargetType = "employer"
+	Worker   TargetType = "worker"
+	Message  TargetType = "message"
+)
+
+type Reason string
+
+const (
+	BelowMinimumWage  Reason = "below_minimum_wage"
+	SuspiciousContact Reason = "suspicious_contact_request"
+	FakeJob           Reason = "fake_job"
+	Harassment        Reason = "harassment"
+	Fraud             Reason = "fraud"
+	Spam              Reason = "spam"
+	Inappropriate     Reason = "inappropriate_content"
+	OtherReason       Reason = "other"
+)
+
+type Status string
+
+const (
+	Open      Status = "open"
+	Dismissed Status = "dismissed"
+	Actioned  Status = "actioned"
+)
+
+// Action is what an admin does about reported content, dismiss shows hidden content again, hide
+// keeps it hidden and suspend also suspends the reported worker or employer
+type Action string
+
+const (
+	Dismiss Action = "dismiss"
+	Hide    Action = "hide"
+	Suspend Action = "suspend"
+)
+
+// Reporter is the worker or employer making a report, taken from their JWT
+type Reporter struct {
+	Role string
+	ID   int
+}
+
+type Report struct {
+	ID             int        `json:"id"`
+	TargetType     TargetType `json:"target_type"`
+	TargetID       int        `json:"target_id"`
+	ReporterRole   string     `json:"reporter_role"`
+	ReporterID     int        `json:"reporter_id"`
+	Reason         Reason     `json:"reason"`
+	Details        string     `json:"details,omitempty"`
+	Status         Status     `json:"status"`
+	Resolution     Action     `json:"resolution,omitempty"`
+	ResolutionNote string     `json:"resolution_note,omitempty"`
+	ResolvedBy     int        `json:"resolved_by,omitempty"`
+	ResolvedAt     *time.Time `json:"resolved_at,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+}
+
+// QueueItem is reported content waiting for an admin, OpenReports is the number of reporters
+type QueueItem struct {
+	TargetType      TargetType `json:"target_type"`
+	TargetID        int        `json:"target_id"`
+	OpenReports     int        `json:"open_reports"`
+	Reasons         []Reason   `json:"reasons"`
+	Hidden          bool       `json:"hidden"`
+	FirstReportedAt time.Time  `json:"first_reported_at"`
+	LastReportedAt  time.Time  `json:"last_reported_at"`
+}
+
+type QueueFilter struct {
+	TargetType TargetType
+	Limit      int
+	Offset     int
+}
+
+type Resolution struct {
+	Action Action `json:"action"`
+	Note   string `json:"note"`
+}
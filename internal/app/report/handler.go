@@ -0,0 +1,159 @@
+package report
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/logger"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/middleware"
+	"go.uber.org/zap"
+)
+
+func CreateReport(reportService Service) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		var report Report
+		err := json.NewDecoder(r.Body).Decode(&report)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrInvalidRequestBody.Error(), zap.Error(err))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrInvalidRequestBody.Error()+": "+err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		createdReport, err := reportService.CreateReport(ctx, currentReporter(ctx), report)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrCreateReport.Error(), zap.Error(err), zap.String("target_type", string(report.TargetType)), zap.Int("target_id", report.TargetID))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrCreateReport.Error()+": "+err.Error(), reportErrorStatusCode(err))
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, "report submitted, our team will review it", http.StatusCreated, createdReport)
+	}
+}
+
+func FetchQueue(reportService Service) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		query := r.URL.Query()
+		filter := QueueFilter{TargetType: TargetType(query.Get("target_type"))}
+
+		var err error
+		if limit := query.Get("limit"); limit != "" {
+			filter.Limit, err = strconv.Atoi(limit)
+		}
+		if offset := query.Get("offset"); offset != "" && err == nil {
+			filter.Offset, err = strconv.Atoi(offset)
+		}
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrInvalidReportQueue.Error(), zap.Error(err))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrFetchReports.Error()+": "+apperrors.ErrInvalidReportQueue.Error(), http.StatusBadRequest)
+			return
+		}
+
+		queue, err := reportService.FetchQueue(ctx, filter)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrFetchReports.Error(), zap.Error(err))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrFetchReports.Error()+", "+err.Error(), reportErrorStatusCode(err))
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, "reported content retrieved successfully", http.StatusOK, queue)
+	}
+}
+
+func FetchReports(reportService Service) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		targetId, id := isPathIdValid(ctx, w, r, "target_id", apperrors.MsgInvalidReportTargetId, apperrors.ErrFetchReports)
+		if targetId == -1 {
+			return
+		}
+
+		targetType := TargetType(mux.Vars(r)["target_type"])
+		reports, err := reportService.FetchReports(ctx, targetType, targetId)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrFetchReports.Error(), zap.Error(err), zap.String("target_type", string(targetType)), zap.String("ID", id))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrFetchReports.Error()+", "+err.Error(), reportErrorStatusCode(err))
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, "reports retrieved successfully", http.StatusOK, reports)
+	}
+}
+
+func ResolveReports(reportService Service) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		targetId, id := isPathIdValid(ctx, w, r, "target_id", apperrors.MsgInvalidReportTargetId, apperrors.ErrResolveReports)
+		if targetId == -1 {
+			return
+		}
+
+		var resolution Resolution
+		err := json.NewDecoder(r.Body).Decode(&resolution)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrInvalidRequestBody.Error(), zap.Error(err))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrInvalidRequestBody.Error()+": "+err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		targetType := TargetType(mux.Vars(r)["target_type"])
+		reports, err := reportService.ResolveReports(ctx, targetType, targetId, currentAdminId(ctx), resolution)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrResolveReports.Error(), zap.Error(err), zap.String("target_type", string(targetType)), zap.String("ID", id))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrResolveReports.Error()+", "+err.Error(), reportErrorStatusCode(err))
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, "reports resolved, reporters will be notified", http.StatusOK, reports)
+	}
+}
+
+// currentReporter is the worker or employer making a report, taken from the JWT
+func currentReporter(ctx context.Context) Reporter {
+	userId, _ := ctx.Value("user_id").(int)
+	role, _ := ctx.Value("role").(string)
+	return Reporter{Role: role, ID: userId}
+}
+
+// currentAdminId is the admin resolving reports, taken from the JWT
+func currentAdminId(ctx context.Context) int {
+	userId, _ := ctx.Value("user_id").(int)
+	return userId
+}
+
+func isPathIdValid(ctx context.Context, w http.ResponseWriter, r *http.Request, key string, invalidMsg string, errType error) (int, string) {
+	vars := mux.Vars(r)
+	id := vars[key]
+	pathId, err := strconv.Atoi(id)
+	if err != nil {
+		logger.Errorw(ctx, invalidMsg, zap.Error(err), zap.String("ID", id))
+		httpResponseMsg := apperrors.HttpErrorResponseMessage(errType.Error(), invalidMsg, id)
+		middleware.HandleErrorResponse(ctx, w, httpResponseMsg, http.StatusBadRequest)
+		return -1, id
+	}
+	return pathId, id
+}
+
+func reportErrorStatusCode(err error) int {
+	switch {
+	case errors.Is(err, apperrors.ErrInvalidReport), errors.Is(err, apperrors.ErrInvalidReportQueue), errors.Is(err, apperrors.ErrInvalidReportResolution):
+		return http.StatusBadRequest
+	case errors.Is(err, apperrors.ErrInvalidReporter), errors.Is(err, apperrors.ErrSelfReport), errors.Is(err, apperrors.ErrNotThreadParticipant):
+		return http.StatusForbidden
+	case errors.Is(err, apperrors.ErrNoReportTarget), errors.Is(err, apperrors.ErrNoOpenReports):
+		return http.StatusNotFound
+	case errors.Is(err, apperrors.ErrAlreadyReported):
+		return http.StatusConflict
+	}
+	return http.StatusInternalServerError
+}
@@ -0,0 +1,147 @@
+package report
+
+import (
+	"strings"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo"
+)
+
+const (
+	maxDetailsLength  = 500
+	defaultQueueLimit = 20
+	maxQueueLimit     = 100
+)
+
+var targetTypes = map[TargetType]bool{
+	Job:      true,
+	Employer: true,
+	Worker:   true,
+	Message:  true,
+}
+
+var reasons = map[Reason]bool{
+	BelowMinimumWage:  true,
+	SuspiciousContact: true,
+	FakeJob:           true,
+	Harassment:        true,
+	Fraud:             true,
+	Spam:              true,
+	Inappropriate:     true,
+	OtherReason:       true,
+}
+
+func MapReportRepoToService(report repo.Report) Report {
+	return Report{
+		ID:             report.ID,
+		TargetType:     TargetType(report.TargetType),
+		TargetID:       report.TargetID,
+		ReporterRole:   report.ReporterRole,
+		ReporterID:     report.ReporterID,
+		Reason:         Reason(report.Reason),
+		Details:        report.Details,
+		Status:         Status(report.Status),
+		Resolution:     Action(report.Resolution),
+		ResolutionNote: report.ResolutionNote,
+		ResolvedBy:     report.ResolvedBy,
+		ResolvedAt:     report.ResolvedAt,
+		CreatedAt:      report.CreatedAt,
+	}
+}
+
+func MapReportedContentRepoToService(content repo.ReportedContent) QueueItem {
+	contentReasons := make([]Reason, 0, len(content.Reasons))
+	for _, reason := range content.Reasons {
+		contentReasons = append(contentReasons, Reason(reason))
+	}
+
+	return QueueItem{
+		TargetType:      TargetType(content.TargetType),
+		TargetID:        content.TargetID,
+		OpenReports:     content.OpenReports,
+		Reasons:         contentReasons,
+		Hidden:          content.IsHidden,
+		FirstReportedAt: content.FirstReportedAt,
+		LastReportedAt:  content.LastReportedAt,
+	}
+}
+
+func validateReport(report Report) (Report, error) {
+	report.Details = strings.TrimSpace(report.Details)
+	if !targetTypes[report.TargetType] || report.TargetID <= 0 || !reasons[report.Reason] {
+		return Report{}, apperrors.ErrInvalidReport
+	}
+	if len(report.Details) > maxDetailsLength || (report.Reason == OtherReason && report.Details == "") {
+		return Report{}, apperrors.ErrInvalidReport
+	}
+	return report, nil
+}
+
+func validateReporter(reporter Reporter) error {
+	if (reporter.Role != "worker" && reporter.Role != "employer") || reporter.ID <= 0 {
+		return apperrors.ErrInvalidReporter
+	}
+	return nil
+}
+
+// checkTarget stops a reporter from reporting themselves or their own content, and from reporting
+// a message of a thread they are not part of
+func checkTarget(reporter Reporter, targetType TargetType, target repo.ReportTarget) error {
+	if target.OwnerRole == reporter.Role && target.OwnerID == reporter.ID {
+		return apperrors.ErrSelfReport
+	}
+
+	if targetType == Message {
+		participant := (reporter.Role == "worker" && target.WorkerID == reporter.ID) ||
+			(reporter.Role == "employer" && target.EmployerID == reporter.ID)
+		if !participant {
+			return apperrors.ErrNotThreadParticipant
+		}
+	}
+	return nil
+}
+
+func normalizeQueueFilter(filter QueueFilter) (repo.ReportQueueFilter, error) {
+	if (filter.TargetType != "" && !targetTypes[filter.TargetType]) || filter.Limit < 0 || filter.Offset < 0 {
+		return repo.ReportQueueFilter{}, apperrors.ErrInvalidReportQueue
+	}
+
+	if filter.Limit == 0 {
+		filter.Limit = defaultQueueLimit
+	}
+	if filter.Limit > maxQueueLimit {
+		filter.Limit = maxQueueLimit
+	}
+	return repo.ReportQueueFilter{TargetType: string(filter.TargetType), Limit: filter.Limit, Offset: filter.Offset}, nil
+}
+
+// mapResolution works out the status of the resolved reports and what happens to the content
+func mapResolution(targetType TargetType, targetId int, adminId int, resolution Resolution) (repo.ReportResolution, error) {
+	repoResolution := repo.ReportResolution{
+		TargetType: string(targetType),
+		TargetID:   targetId,
+		Status:     string(Actioned),
+		Action:     string(resolution.Action),
+		Note:       strings.TrimSpace(resolution.Note),
+		ResolvedBy: adminId,
+	}
+	if !targetTypes[targetType] || len(repoResolution.Note) > maxDetailsLength {
+		return repo.ReportResolution{}, apperrors.ErrInvalidReportResolution
+	}
+
+	switch resolution.Action {
+	case Dismiss:
+		repoResolution.Status = string(Dismissed)
+	case Hide:
+		repoResolution.HideContent = true
+	case Suspend:
+		if targetType != Worker && targetType != Employer {
+			return repo.ReportResolution{}, apperrors.ErrInvalidReportResolution
+		}
+		repoResolution.HideContent = true
+		repoResolution.SuspendAccount = true
+	default:
+		return repo.ReportResolution{}, apperrors.ErrInvalidReportResolution
+	}
+	return repoResolution, nil
+}
@@ -0,0 +1,118 @@
+package report
+
+import (
+	"context"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo"
+)
+
+type reportService struct {
+	reportRepo    repo.ReportStorer
+	hideThreshold int
+}
+
+type Service interface {
+	CreateReport(ctx context.Context, reporter Reporter, reportData Report) (Report, error)
+	FetchQueue(ctx context.Context, filter QueueFilter) ([]QueueItem, error)
+	FetchReports(ctx context.Context, targetType TargetType, targetId int) ([]Report, error)
+	ResolveReports(ctx context.Context, targetType TargetType, targetId int, adminId int, resolution Resolution) ([]Report, error)
+}
+
+// NewService hides reported content once hideThreshold different workers or employers have open
+// reports of it
+func NewService(reportRepo repo.ReportStorer, hideThreshold int) Service {
+	return &reportService{
+		reportRepo:    reportRepo,
+		hideThreshold: hideThreshold,
+	}
+}
+
+func (reportS *reportService) CreateReport(ctx context.Context, reporter Reporter, reportData Report) (Report, error) {
+	err := validateReporter(reporter)
+	if err != nil {
+		return Report{}, err
+	}
+
+	reportData, err = validateReport(reportData)
+	if err != nil {
+		return Report{}, err
+	}
+
+	target, err := reportS.reportRepo.FetchReportTarget(ctx, string(reportData.TargetType), reportData.TargetID)
+	if err != nil {
+		return Report{}, err
+	}
+
+	err = checkTarget(reporter, reportData.TargetType, target)
+	if err != nil {
+		return Report{}, err
+	}
+
+	createdReport, err := reportS.reportRepo.CreateReport(ctx, repo.Report{
+		TargetType:   string(reportData.TargetType),
+		TargetID:     reportData.TargetID,
+		ReporterRole: reporter.Role,
+		ReporterID:   reporter.ID,
+		Reason:       string(reportData.Reason),
+		Details:      reportData.Details,
+	}, reportS.hideThreshold)
+	if err != nil {
+		return Report{}, err
+	}
+	return MapReportRepoToService(createdReport), nil
+}
+
+func (reportS *reportService) FetchQueue(ctx context.Context, filter QueueFilter) ([]QueueItem, error) {
+	repoFilter, err := normalizeQueueFilter(filter)
+	if err != nil {
+		return []QueueItem{}, err
+	}
+
+	queue, err := reportS.reportRepo.FetchReportQueue(ctx, repoFilter)
+	if err != nil {
+		return []QueueItem{}, err
+	}
+
+	items := make([]QueueItem, 0, len(queue))
+	for _, content := range queue {
+		items = append(items, MapReportedContentRepoToService(content))
+	}
+	return items, nil
+}
+
+func (reportS *reportService) FetchReports(ctx context.Context, targetType TargetType, targetId int) ([]Report, error) {
+	if !targetTypes[targetType] {
+		return []Report{}, apperrors.ErrInvalidReportQueue
+	}
+
+	reports, err := reportS.reportRepo.FetchReportsByTarget(ctx, string(targetType), targetId)
+	if err != nil {
+		return []Report{}, err
+	}
+
+	mappedReports := make([]Report, 0, len(reports))
+	for _, report := range reports {
+		mappedReports = append(mappedReports, MapReportRepoToService(report))
+	}
+	return mappedReports, nil
+}
+
+// ResolveReports closes every open report of the content and tells each reporter the outcome
+func (reportS *reportService) ResolveReports(ctx context.Context, targetType TargetType, targetId int, adminId int, resolution Resolution) ([]Report, error) {
+	repoResolution, err := mapResolution(targetType, targetId, adminId, resolution)
+	if err != nil {
+		return []Report{}, err
+	}
+
+	reports, err := reportS.reportRepo.ResolveReports(ctx, repoResolution)
+	if err != nil {
+		return []Report{}, err
+	}
+
+	resolvedReports := make([]Report, 0, len(reports))
+	for _, report := range reports {
+		resolvedReports = append(resolvedReports, MapReportRepoToService(report))
+	}
+	return resolvedReports, nil
+}
@@ -0,0 +1,252 @@
+package report
+
+import (
+	"context"
+	"testing"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ReportServiceTestSuite struct {
+	suite.Suite
+	service    Service
+	reportRepo mocks.ReportStorer
+}
+
+func (suite *ReportServiceTestSuite) SetupTest() {
+	suite.reportRepo = mocks.ReportStorer{}
+	suite.service = NewService(&suite.reportRepo, 3)
+}
+
+func (suite *ReportServiceTestSuite) TearDownTest() {
+	suite.reportRepo.AssertExpectations(suite.T())
+}
+
+func TestReportServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(ReportServiceTestSuite))
+}
+
+func (suite *ReportServiceTestSuite) TestCreateReport() {
+	type testCase struct {
+		name          string
+		reporter      Reporter
+		input         Report
+		setup         func()
+		expectedError error
+	}
+
+	worker := Reporter{Role: "worker", ID: 5}
+
+	testCases := []testCase{
+		{
+			name:     "job below minimum wage",
+			reporter: worker,
+			input:    Report{TargetType: Job, TargetID: 12, Reason: BelowMinimumWage, Details: " 200 rupees for a full day "},
+			setup: func() {
+				suite.reportRepo.On("FetchReportTarget", mock.Anything, "job", 12).Return(repo.ReportTarget{OwnerRole: "employer", OwnerID: 9}, nil)
+				suite.reportRepo.On("CreateReport", mock.Anything, repo.Report{TargetType: "job", TargetID: 12, ReporterRole: "worker", ReporterID: 5, Reason: "below_minimum_wage", Details: "200 rupees for a full day"}, 3).Return(repo.Report{ID: 1, TargetType: "job", TargetID: 12, Status: "open"}, nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:          "unknown reason",
+			reporter:      worker,
+			input:         Report{TargetType: Job, TargetID: 12, Reason: "rude"},
+			setup:         func() {},
+			expectedError: apperrors.ErrInvalidReport,
+		},
+		{
+			name:          "other without details",
+			reporter:      worker,
+			input:         Report{TargetType: Employer, TargetID: 9, Reason: OtherReason, Details: "  "},
+			setup:         func() {},
+			expectedError: apperrors.ErrInvalidReport,
+		},
+		{
+			name:          "admins cannot report",
+			reporter:      Reporter{Role: "admin", ID: 1},
+			input:         Report{TargetType: Job, TargetID: 12, Reason: FakeJob},
+			setup:         func() {},
+			expectedError: apperrors.ErrInvalidReporter,
+		},
+		{
+			name:     "own job",
+			reporter: Reporter{Role: "employer", ID: 9},
+			input:    Report{TargetType: Job, TargetID: 12, Reason: FakeJob},
+			setup: func() {
+				suite.reportRepo.On("FetchReportTarget", mock.Anything, "job", 12).Return(repo.ReportTarget{OwnerRole: "employer", OwnerID: 9}, nil)
+			},
+			expectedError: apperrors.ErrSelfReport,
+		},
+		{
+			name:     "message of another thread",
+			reporter: worker,
+			input:    Report{TargetType: Message, TargetID: 40, Reason: SuspiciousContact},
+			setup: func() {
+				suite.reportRepo.On("FetchReportTarget", mock.Anything, "message", 40).Return(repo.ReportTarget{OwnerRole: "employer", OwnerID: 9, WorkerID: 6, EmployerID: 9}, nil)
+			},
+			expectedError: apperrors.ErrNotThreadParticipant,
+		},
+		{
+			name:     "content that does not exist",
+			reporter: worker,
+			input:    Report{TargetType: Worker, TargetID: 77, Reason: Harassment},
+			setup: func() {
+				suite.reportRepo.On("FetchReportTarget", mock.Anything, "worker", 77).Return(repo.ReportTarget{}, apperrors.ErrNoReportTarget)
+			},
+			expectedError: apperrors.ErrNoReportTarget,
+		},
+		{
+			name:     "already reported",
+			reporter: worker,
+			input:    Report{TargetType: Message, TargetID: 40, Reason: SuspiciousContact},
+			setup: func() {
+				suite.reportRepo.On("FetchReportTarget", mock.Anything, "message", 40).Return(repo.ReportTarget{OwnerRole: "employer", OwnerID: 9, WorkerID: 5, EmployerID: 9}, nil)
+				suite.reportRepo.On("CreateReport", mock.Anything, mock.Anything, 3).Return(repo.Report{}, apperrors.ErrAlreadyReported)
+			},
+			expectedError: apperrors.ErrAlreadyReported,
+		},
+	}
+
+	for _, test := range testCases {
+		suite.SetupTest()
+		suite.Run(test.name, func() {
+			test.setup()
+
+			_, err := suite.service.CreateReport(context.Background(), test.reporter, test.input)
+
+			suite.ErrorIs(err, test.expectedError)
+		})
+		suite.TearDownTest()
+	}
+}
+
+func (suite *ReportServiceTestSuite) TestResolveReports() {
+	type testCase struct {
+		name          string
+		targetType    TargetType
+		resolution    Resolution
+		setup         func()
+		expectedError error
+	}
+
+	reports := []repo.Report{
+		{ID: 1, TargetType: "job", TargetID: 12, ReporterRole: "worker", ReporterID: 5},
+		{ID: 2, TargetType: "job", TargetID: 12, ReporterRole: "employer", ReporterID: 8},
+	}
+
+	testCases := []testCase{
+		{
+			name:       "hidden job",
+			targetType: Job,
+			resolution: Resolution{Action: Hide, Note: "wage far below the state minimum"},
+			setup: func() {
+				suite.reportRepo.On("ResolveReports", mock.Anything, repo.ReportResolution{TargetType: "job", TargetID: 12, Status: "actioned", Action: "hide", Note: "wage far below the state minimum", ResolvedBy: 2, HideContent: true}).Return(reports, nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:       "dismissed, job shown again",
+			targetType: Job,
+			resolution: Resolution{Action: Dismiss},
+			setup: func() {
+				suite.reportRepo.On("ResolveReports", mock.Anything, repo.ReportResolution{TargetType: "job", TargetID: 12, Status: "dismissed", Action: "dismiss", ResolvedBy: 2}).Return(reports[:1], nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:          "jobs cannot be suspended",
+			targetType:    Job,
+			resolution:    Resolution{Action: Suspend},
+			setup:         func() {},
+			expectedError: apperrors.ErrInvalidReportResolution,
+		},
+		{
+			name:          "unknown action",
+			targetType:    Job,
+			resolution:    Resolution{Action: "delete"},
+			setup:         func() {},
+			expectedError: apperrors.ErrInvalidReportResolution,
+		},
+		{
+			name:       "no open reports",
+			targetType: Job,
+			resolution: Resolution{Action: Hide},
+			setup: func() {
+				suite.reportRepo.On("ResolveReports", mock.Anything, mock.Anything).Return([]repo.Report{}, apperrors.ErrNoOpenReports)
+			},
+			expectedError: apperrors.ErrNoOpenReports,
+		},
+	}
+
+	for _, test := range testCases {
+		suite.SetupTest()
+		suite.Run(test.name, func() {
+			test.setup()
+
+			_, err := suite.service.ResolveReports(context.Background(), test.targetType, 12, 2, test.resolution)
+
+			suite.ErrorIs(err, test.expectedError)
+		})
+		suite.TearDownTest()
+	}
+}
+
+func (suite *ReportServiceTestSuite) TestResolveReportsSuspendsAccount() {
+	suite.reportRepo.On("ResolveReports", mock.Anything, repo.ReportResolution{TargetType: "employer", TargetID: 9, Status: "actioned", Action: "suspend", Note: "asks workers for fees", ResolvedBy: 2, HideContent: true, SuspendAccount: true}).Return([]repo.Report{{ReporterRole: "worker", ReporterID: 5}}, nil)
+
+	reports, err := suite.service.ResolveReports(context.Background(), Employer, 9, 2, Resolution{Action: Suspend, Note: "asks workers for fees"})
+
+	suite.NoError(err)
+	suite.Len(reports, 1)
+}
+
+func (suite *ReportServiceTestSuite) TestFetchQueue() {
+	type testCase struct {
+		name          string
+		input         QueueFilter
+		setup         func()
+		expectedError error
+	}
+
+	testCases := []testCase{
+		{
+			name:  "default limit",
+			input: QueueFilter{TargetType: Message},
+			setup: func() {
+				suite.reportRepo.On("FetchReportQueue", mock.Anything, repo.ReportQueueFilter{TargetType: "message", Limit: defaultQueueLimit}).Return([]repo.ReportedContent{{TargetType: "message", TargetID: 40, OpenReports: 1, Reasons: []string{"harassment"}}}, nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:  "limit capped",
+			input: QueueFilter{Limit: 1000, Offset: 20},
+			setup: func() {
+				suite.reportRepo.On("FetchReportQueue", mock.Anything, repo.ReportQueueFilter{Limit: maxQueueLimit, Offset: 20}).Return([]repo.ReportedContent{}, nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:          "unknown target type",
+			input:         QueueFilter{TargetType: "review"},
+			setup:         func() {},
+			expectedError: apperrors.ErrInvalidReportQueue,
+		},
+	}
+
+	for _, test := range testCases {
+		suite.SetupTest()
+		suite.Run(test.name, func() {
+			test.setup()
+
+			_, err := suite.service.FetchQueue(context.Background(), test.input)
+
+			suite.ErrorIs(err, test.expectedError)
+		})
+		suite.TearDownTest()
+	}
+}
@@ -0,0 +1,25 @@
+package role
+
+import (
+	"time"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/middleware"
+)
+
+// built-in roles, the role of workers and employers never changes and the super admin is always
+// granted every permission
+const (
+	WorkerRole     = "worker"
+	EmployerRole   = "employer"
+	AdminRole      = "admin"
+	SuperAdminRole = "super-admin"
+)
+
+type Role struct {
+	Name        string                  `json:"name"`
+	Description string                  `json:"description"`
+	IsSystem    bool                    `json:"is_system"`
+	Permissions []middleware.Permission `json:"permissions"`
+	CreatedAt   time.Time               `json:"created_at"`
+	UpdatedAt   time.Time               `json:"updated_at"`
+}
@@ -0,0 +1,126 @@
+package role
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/logger"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/middleware"
+	"go.uber.org/zap"
+)
+
+func FetchPermissions() func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		middleware.HandleSuccessResponse(r.Context(), w, "permissions retrieved successfully", http.StatusOK, middleware.Permissions)
+	}
+}
+
+func FetchRoles(roleService Service) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		roles, err := roleService.FetchRoles(ctx)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrFetchRole.Error(), zap.Error(err))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrFetchRole.Error()+", "+err.Error(), roleErrorStatusCode(err))
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, "roles retrieved successfully", http.StatusOK, roles)
+	}
+}
+
+func FetchRole(roleService Service) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		name := mux.Vars(r)["role_name"]
+		role, err := roleService.FetchRole(ctx, name)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrFetchRole.Error(), zap.Error(err), zap.String("role", name))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrFetchRole.Error()+", "+err.Error(), roleErrorStatusCode(err))
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, "role retrieved successfully", http.StatusOK, role)
+	}
+}
+
+func CreateRole(roleService Service) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		var role Role
+		err := json.NewDecoder(r.Body).Decode(&role)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrInvalidRequestBody.Error(), zap.Error(err))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrInvalidRequestBody.Error()+": "+err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		createdRole, err := roleService.CreateRole(ctx, role)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrCreateRole.Error(), zap.Error(err), zap.String("role", role.Name))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrCreateRole.Error()+": "+err.Error(), roleErrorStatusCode(err))
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, "role created successfully", http.StatusCreated, createdRole)
+	}
+}
+
+func UpdateRole(roleService Service) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		name := mux.Vars(r)["role_name"]
+
+		var role Role
+		err := json.NewDecoder(r.Body).Decode(&role)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrInvalidRequestBody.Error(), zap.Error(err))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrInvalidRequestBody.Error()+": "+err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		updatedRole, err := roleService.UpdateRole(ctx, name, role)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrUpdateRole.Error(), zap.Error(err), zap.String("role", name))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrUpdateRole.Error()+": "+err.Error(), roleErrorStatusCode(err))
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, "role updated successfully", http.StatusOK, updatedRole)
+	}
+}
+
+func DeleteRole(roleService Service) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		name := mux.Vars(r)["role_name"]
+		err := roleService.DeleteRole(ctx, name)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrDeleteRole.Error(), zap.Error(err), zap.String("role", name))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrDeleteRole.Error()+": "+err.Error(), roleErrorStatusCode(err))
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, "role deleted successfully", http.StatusOK, name)
+	}
+}
+
+func roleErrorStatusCode(err error) int {
+	switch {
+	case errors.Is(err, apperrors.ErrInvalidRole):
+		return http.StatusBadRequest
+	case errors.Is(err, apperrors.ErrNoRoleExists):
+		return http.StatusNotFound
+	case errors.Is(err, apperrors.ErrRoleExists), errors.Is(err, apperrors.ErrSystemRole), errors.Is(err, apperrors.ErrRoleInUse):
+		return http.StatusConflict
+	}
+	return http.StatusInternalServerError
+}
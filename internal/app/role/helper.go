@@ -0,0 +1,80 @@
+package role
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/middleware"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo"
+)
+
+var roleNamePattern = regexp.MustCompile(`^[a-z][a-z0-9-]{2,29}$`)
+
+func MapRoleRepoToService(role repo.Role) Role {
+	permissions := make([]middleware.Permission, 0, len(role.Permissions))
+	for _, permission := range role.Permissions {
+		permissions = append(permissions, middleware.Permission(permission))
+	}
+
+	return Role{
+		Name:        role.Name,
+		Description: role.Description,
+		IsSystem:    role.IsSystem,
+		Permissions: permissions,
+		CreatedAt:   role.CreatedAt,
+		UpdatedAt:   role.UpdatedAt,
+	}
+}
+
+func MapRoleServiceToRepo(role Role) repo.Role {
+	permissions := make([]string, 0, len(role.Permissions))
+	for _, permission := range role.Permissions {
+		permissions = append(permissions, string(permission))
+	}
+
+	return repo.Role{
+		Name:        role.Name,
+		Description: role.Description,
+		Permissions: permissions,
+	}
+}
+
+// normalizeRole trims the role and sorts its permissions without duplicates
+func normalizeRole(role Role) Role {
+	role.Name = strings.ToLower(strings.TrimSpace(role.Name))
+	role.Description = strings.TrimSpace(role.Description)
+
+	seen := make(map[middleware.Permission]bool)
+	permissions := make([]middleware.Permission, 0, len(role.Permissions))
+	for _, permission := range role.Permissions {
+		permission = middleware.Permission(strings.TrimSpace(string(permission)))
+		if seen[permission] {
+			continue
+		}
+		seen[permission] = true
+		permissions = append(permissions, permission)
+	}
+	sort.Slice(permissions, func(i, j int) bool { return permissions[i] < permissions[j] })
+	role.Permissions = permissions
+
+	return role
+}
+
+// validateRole checks the name and permissions of a role, the worker, employer and super admin roles
+// can neither be created nor changed
+func validateRole(role Role) error {
+	if !roleNamePattern.MatchString(role.Name) {
+		return apperrors.ErrInvalidRole
+	}
+	if role.Name == WorkerRole || role.Name == EmployerRole || role.Name == SuperAdminRole {
+		return apperrors.ErrSystemRole
+	}
+	for _, permission := range role.Permissions {
+		if !middleware.IsPermission(string(permission)) {
+			return apperrors.ErrInvalidRole
+		}
+	}
+	return nil
+}
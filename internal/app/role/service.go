@@ -0,0 +1,158 @@
+package role
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/middleware"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo"
+)
+
+// permissions are checked on every admin request, they are cached for a minute so that changes
+// made on another server are picked up soon after
+const permissionCacheTTL = time.Minute
+
+type cachedPermissions struct {
+	permissions []middleware.Permission
+	expiresAt   time.Time
+}
+
+type service struct {
+	roleRepo repo.RoleStorer
+	now      func() time.Time
+
+	mutex sync.Mutex
+	cache map[string]cachedPermissions
+}
+
+type Service interface {
+	FetchRoles(ctx context.Context) ([]Role, error)
+	FetchRole(ctx context.Context, name string) (Role, error)
+	CreateRole(ctx context.Context, roleData Role) (Role, error)
+	UpdateRole(ctx context.Context, name string, roleData Role) (Role, error)
+	DeleteRole(ctx context.Context, name string) error
+	RolePermissions(ctx context.Context, name string) ([]middleware.Permission, error)
+}
+
+func NewService(roleRepo repo.RoleStorer) Service {
+	return &service{
+		roleRepo: roleRepo,
+		now:      time.Now,
+		cache:    make(map[string]cachedPermissions),
+	}
+}
+
+func (roleS *service) FetchRoles(ctx context.Context) ([]Role, error) {
+	roles, err := roleS.roleRepo.FetchRoles(ctx)
+	if err != nil {
+		return []Role{}, err
+	}
+
+	mappedRoles := make([]Role, 0, len(roles))
+	for _, role := range roles {
+		mappedRoles = append(mappedRoles, withResolvedPermissions(MapRoleRepoToService(role)))
+	}
+	return mappedRoles, nil
+}
+
+func (roleS *service) FetchRole(ctx context.Context, name string) (Role, error) {
+	role, err := roleS.roleRepo.FetchRole(ctx, strings.ToLower(strings.TrimSpace(name)))
+	if err != nil {
+		return Role{}, err
+	}
+	return withResolvedPermissions(MapRoleRepoToService(role)), nil
+}
+
+func (roleS *service) CreateRole(ctx context.Context, roleData Role) (Role, error) {
+	roleData = normalizeRole(roleData)
+	err := validateRole(roleData)
+	if err != nil {
+		return Role{}, err
+	}
+
+	createdRole, err := roleS.roleRepo.CreateRole(ctx, MapRoleServiceToRepo(roleData))
+	if err != nil {
+		return Role{}, err
+	}
+	return MapRoleRepoToService(createdRole), nil
+}
+
+// Replace the description and permissions of a role, the name of a role never changes
+func (roleS *service) UpdateRole(ctx context.Context, name string, roleData Role) (Role, error) {
+	roleData.Name = name
+	roleData = normalizeRole(roleData)
+	err := validateRole(roleData)
+	if err != nil {
+		return Role{}, err
+	}
+
+	updatedRole, err := roleS.roleRepo.UpdateRole(ctx, MapRoleServiceToRepo(roleData))
+	if err != nil {
+		return Role{}, err
+	}
+
+	roleS.forget(roleData.Name)
+	return MapRoleRepoToService(updatedRole), nil
+}
+
+func (roleS *service) DeleteRole(ctx context.Context, name string) error {
+	name = strings.ToLower(strings.TrimSpace(name))
+
+	err := roleS.roleRepo.DeleteRole(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	roleS.forget(name)
+	return nil
+}
+
+// RolePermissions resolves the permissions of the role in a JWT for middleware.RequirePermission,
+// the super admin is granted every permission whatever is stored for it
+func (roleS *service) RolePermissions(ctx context.Context, name string) ([]middleware.Permission, error) {
+	if name == SuperAdminRole {
+		return middleware.Permissions, nil
+	}
+	if name == "" {
+		return []middleware.Permission{}, nil
+	}
+
+	roleS.mutex.Lock()
+	cached, ok := roleS.cache[name]
+	roleS.mutex.Unlock()
+	if ok && roleS.now().Before(cached.expiresAt) {
+		return cached.permissions, nil
+	}
+
+	stored, err := roleS.roleRepo.FetchRolePermissions(ctx, name)
+	if err != nil {
+		return []middleware.Permission{}, err
+	}
+
+	permissions := make([]middleware.Permission, 0, len(stored))
+	for _, permission := range stored {
+		permissions = append(permissions, middleware.Permission(permission))
+	}
+
+	roleS.mutex.Lock()
+	roleS.cache[name] = cachedPermissions{permissions: permissions, expiresAt: roleS.now().Add(permissionCacheTTL)}
+	roleS.mutex.Unlock()
+
+	return permissions, nil
+}
+
+// withResolvedPermissions shows the super admin with every permission, as it is resolved
+func withResolvedPermissions(role Role) Role {
+	if role.Name == SuperAdminRole {
+		role.Permissions = middleware.Permissions
+	}
+	return role
+}
+
+func (roleS *service) forget(name string) {
+	roleS.mutex.Lock()
+	delete(roleS.cache, name)
+	roleS.mutex.Unlock()
+}
@@ -0,0 +1,252 @@
+package role
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/middleware"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type RoleServiceTestSuite struct {
+	suite.Suite
+	service  *service
+	roleRepo mocks.RoleStorer
+	now      time.Time
+}
+
+func (suite *RoleServiceTestSuite) SetupTest() {
+	suite.roleRepo = mocks.RoleStorer{}
+	suite.now = time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC)
+	suite.service = NewService(&suite.roleRepo).(*service)
+	suite.service.now = func() time.Time { return suite.now }
+}
+
+func (suite *RoleServiceTestSuite) TearDownTest() {
+	suite.roleRepo.AssertExpectations(suite.T())
+}
+
+func TestRoleServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(RoleServiceTestSuite))
+}
+
+func (suite *RoleServiceTestSuite) TestCreateRole() {
+	type testCase struct {
+		name          string
+		input         Role
+		setup         func()
+		expectedError error
+	}
+
+	testCases := []testCase{
+		{
+			name:  "moderator",
+			input: Role{Name: " Moderator ", Description: "reviews reported jobs", Permissions: []middleware.Permission{middleware.JobsModerate, middleware.UsersRead, middleware.JobsModerate}},
+			setup: func() {
+				suite.roleRepo.On("CreateRole", mock.Anything, repo.Role{Name: "moderator", Description: "reviews reported jobs", Permissions: []string{"jobs:moderate", "users:read"}}).Return(repo.Role{Name: "moderator", Permissions: []string{"jobs:moderate", "users:read"}}, nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:          "unknown permission",
+			input:         Role{Name: "moderator", Permissions: []middleware.Permission{"jobs:delete-all"}},
+			setup:         func() {},
+			expectedError: apperrors.ErrInvalidRole,
+		},
+		{
+			name:          "invalid name",
+			input:         Role{Name: "job moderator"},
+			setup:         func() {},
+			expectedError: apperrors.ErrInvalidRole,
+		},
+		{
+			name:          "role of workers",
+			input:         Role{Name: "worker", Permissions: []middleware.Permission{middleware.DashboardRead}},
+			setup:         func() {},
+			expectedError: apperrors.ErrSystemRole,
+		},
+		{
+			name:          "super admin",
+			input:         Role{Name: "Super-Admin"},
+			setup:         func() {},
+			expectedError: apperrors.ErrSystemRole,
+		},
+		{
+			name:  "name taken",
+			input: Role{Name: "admin"},
+			setup: func() {
+				suite.roleRepo.On("CreateRole", mock.Anything, mock.Anything).Return(repo.Role{}, apperrors.ErrRoleExists)
+			},
+			expectedError: apperrors.ErrRoleExists,
+		},
+	}
+
+	for _, test := range testCases {
+		suite.SetupTest()
+		suite.Run(test.name, func() {
+			test.setup()
+
+			role, err := suite.service.CreateRole(context.Background(), test.input)
+
+			suite.ErrorIs(err, test.expectedError)
+			if test.expectedError == nil {
+				suite.Equal([]middleware.Permission{middleware.JobsModerate, middleware.UsersRead}, role.Permissions)
+			}
+		})
+		suite.TearDownTest()
+	}
+}
+
+func (suite *RoleServiceTestSuite) TestUpdateRole() {
+	suite.Run("super admin cannot be changed", func() {
+		suite.SetupTest()
+
+		_, err := suite.service.UpdateRole(context.Background(), "super-admin", Role{Permissions: []middleware.Permission{middleware.DashboardRead}})
+
+		suite.ErrorIs(err, apperrors.ErrSystemRole)
+		suite.TearDownTest()
+	})
+
+	suite.Run("employer role cannot be changed", func() {
+		suite.SetupTest()
+
+		_, err := suite.service.UpdateRole(context.Background(), "employer", Role{Permissions: []middleware.Permission{middleware.UsersRead}})
+
+		suite.ErrorIs(err, apperrors.ErrSystemRole)
+		suite.TearDownTest()
+	})
+
+	suite.Run("cached permissions are dropped", func() {
+		suite.SetupTest()
+		suite.roleRepo.On("FetchRolePermissions", mock.Anything, "moderator").Return([]string{"jobs:moderate"}, nil).Once()
+		suite.roleRepo.On("UpdateRole", mock.Anything, repo.Role{Name: "moderator", Permissions: []string{"users:read"}}).Return(repo.Role{Name: "moderator", Permissions: []string{"users:read"}}, nil)
+		suite.roleRepo.On("FetchRolePermissions", mock.Anything, "moderator").Return([]string{"users:read"}, nil).Once()
+
+		_, err := suite.service.RolePermissions(context.Background(), "moderator")
+		suite.NoError(err)
+
+		_, err = suite.service.UpdateRole(context.Background(), "moderator", Role{Permissions: []middleware.Permission{middleware.UsersRead}})
+		suite.NoError(err)
+
+		permissions, err := suite.service.RolePermissions(context.Background(), "moderator")
+		suite.NoError(err)
+		suite.Equal([]middleware.Permission{middleware.UsersRead}, permissions)
+		suite.TearDownTest()
+	})
+}
+
+func (suite *RoleServiceTestSuite) TestRolePermissions() {
+	type testCase struct {
+		name           string
+		role           string
+		setup          func()
+		expectedOutput []middleware.Permission
+		expectedError  error
+	}
+
+	testCases := []testCase{
+		{
+			name:           "super admin has every permission",
+			role:           "super-admin",
+			setup:          func() {},
+			expectedOutput: middleware.Permissions,
+		},
+		{
+			name:           "no role",
+			role:           "",
+			setup:          func() {},
+			expectedOutput: []middleware.Permission{},
+		},
+		{
+			name: "stored permissions",
+			role: "admin",
+			setup: func() {
+				suite.roleRepo.On("FetchRolePermissions", mock.Anything, "admin").Return([]string{"dashboard:read", "jobs:moderate"}, nil)
+			},
+			expectedOutput: []middleware.Permission{middleware.DashboardRead, middleware.JobsModerate},
+		},
+		{
+			name: "database error",
+			role: "admin",
+			setup: func() {
+				suite.roleRepo.On("FetchRolePermissions", mock.Anything, "admin").Return([]string{}, errors.New("connection reset"))
+			},
+			expectedOutput: []middleware.Permission{},
+			expectedError:  errors.New("connection reset"),
+		},
+	}
+
+	for _, test := range testCases {
+		suite.SetupTest()
+		suite.Run(test.name, func() {
+			test.setup()
+
+			permissions, err := suite.service.RolePermissions(context.Background(), test.role)
+
+			if test.expectedError != nil {
+				suite.ErrorContains(err, test.expectedError.Error())
+			} else {
+				suite.NoError(err)
+			}
+			suite.Equal(test.expectedOutput, permissions)
+		})
+		suite.TearDownTest()
+	}
+}
+
+func (suite *RoleServiceTestSuite) TestRolePermissionsCache() {
+	suite.roleRepo.On("FetchRolePermissions", mock.Anything, "admin").Return([]string{"dashboard:read"}, nil).Twice()
+
+	for i := 0; i < 3; i++ {
+		_, err := suite.service.RolePermissions(context.Background(), "admin")
+		suite.NoError(err)
+	}
+
+	// after a minute the permissions are read again
+	suite.now = suite.now.Add(permissionCacheTTL)
+	_, err := suite.service.RolePermissions(context.Background(), "admin")
+	suite.NoError(err)
+}
+
+func (suite *RoleServiceTestSuite) TestDeleteRole() {
+	type testCase struct {
+		name          string
+		setup         func()
+		expectedError error
+	}
+
+	testCases := []testCase{
+		{
+			name: "deleted",
+			setup: func() {
+				suite.roleRepo.On("DeleteRole", mock.Anything, "moderator").Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name: "still assigned",
+			setup: func() {
+				suite.roleRepo.On("DeleteRole", mock.Anything, "moderator").Return(apperrors.ErrRoleInUse)
+			},
+			expectedError: apperrors.ErrRoleInUse,
+		},
+	}
+
+	for _, test := range testCases {
+		suite.SetupTest()
+		suite.Run(test.name, func() {
+			test.setup()
+
+			err := suite.service.DeleteRole(context.Background(), " Moderator")
+
+			suite.ErrorIs(err, test.expectedError)
+		})
+		suite.TearDownTest()
+	}
+}
@@ -2,34 +2,105 @@ package app
 
 import (
 	"net/http"
+	"os"
 
 	"github.com/gorilla/mux"
 	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/admin"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/analytics"
 	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/application"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/attendance"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/audit"
 	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/auth"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/earnings"
 	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/employer"
 	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/job"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/kyc"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/media"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/message"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/minwage"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/notification"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/payment"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/pickup"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/report"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/role"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/schedule"
 	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/sector"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/skill"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/stream"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/verification"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/webhook"
 	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/worker"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/middleware"
 )
 
 func NewRouter(deps Dependencies) *mux.Router {
 
 	router := mux.NewRouter()
 	router.Use(mux.CORSMethodMiddleware(router))
+	// X-Forwarded-For is only trusted when the server runs behind a proxy that sets it
+	router.Use(middleware.RequestMetadata(os.Getenv("TRUST_PROXY_HEADERS") == "true"))
 
 	// Auth Routes
 	router.HandleFunc("/login", auth.HandleLogin(deps.AuthService)).Methods(http.MethodPost)
 	router.HandleFunc("/register/worker", auth.RegisterWorker(deps.WorkerService)).Methods(http.MethodPost)
 	router.HandleFunc("/register/employer", employer.RegisterEmployer(deps.EmployerService)).Methods(http.MethodPost)
 
-	// adminRouter.Use(middleware.RequireSuperAdminRole)
+	// new admins are registered by an admin granted the admins:manage permission
 	adminRouter := router.PathPrefix("").Subrouter()
+	adminRouter.Use(middleware.ValidateJWT, middleware.RequirePermission(deps.RoleService, middleware.AdminsManage))
 	adminRouter.HandleFunc("/register/admin", admin.RegisterAdmin(deps.AdminService)).Methods(http.MethodPost)
 
+	// Admin Console Routes - every route needs the JWT of an admin whose role is granted the
+	// permission the route is registered with
+	adminConsoleRouter := router.PathPrefix("/admin").Subrouter()
+	adminConsoleRouter.Use(middleware.ValidateJWT)
+	requirePermission := func(permission middleware.Permission, handler http.HandlerFunc) http.Handler {
+		return middleware.RequirePermission(deps.RoleService, permission)(handler)
+	}
+	adminConsoleRouter.Handle("/audit-logs", requirePermission(middleware.AuditRead, audit.FetchLogs(deps.AuditService))).Methods(http.MethodGet)
+	adminConsoleRouter.Handle("/dashboard", requirePermission(middleware.DashboardRead, admin.FetchDashboard(deps.AdminService))).Methods(http.MethodGet)
+	adminConsoleRouter.Handle("/admins", requirePermission(middleware.AdminsManage, admin.FetchAdmins(deps.AdminService))).Methods(http.MethodGet)
+	adminConsoleRouter.Handle("/admins/{admin_id}"+"/role", requirePermission(middleware.AdminsManage, admin.UpdateAdminRole(deps.AdminService))).Methods(http.MethodPut)
+	adminConsoleRouter.Handle("/admins/{admin_id}", requirePermission(middleware.AdminsManage, admin.DeleteAdmin(deps.AdminService))).Methods(http.MethodDelete)
+	adminConsoleRouter.Handle("/permissions", requirePermission(middleware.RolesManage, role.FetchPermissions())).Methods(http.MethodGet)
+	adminConsoleRouter.Handle("/reports", requirePermission(middleware.ReportsReview, report.FetchQueue(deps.ReportService))).Methods(http.MethodGet)
+	adminConsoleRouter.Handle("/reports/{target_type}/{target_id}", requirePermission(middleware.ReportsReview, report.FetchReports(deps.ReportService))).Methods(http.MethodGet)
+	adminConsoleRouter.Handle("/reports/{target_type}/{target_id}"+"/resolve", requirePermission(middleware.ReportsReview, report.ResolveReports(deps.ReportService))).Methods(http.MethodPost)
+	adminConsoleRouter.Handle("/minimum-wages", requirePermission(middleware.WagesManage, minwage.FetchMinimumWages(deps.MinimumWageService))).Methods(http.MethodGet)
+	adminConsoleRouter.Handle("/minimum-wages", requirePermission(middleware.WagesManage, minwage.SaveMinimumWage(deps.MinimumWageService))).Methods(http.MethodPut)
+	adminConsoleRouter.Handle("/minimum-wages/compliance", requirePermission(middleware.WagesManage, minwage.FetchComplianceReport(deps.MinimumWageService))).Methods(http.MethodGet)
+	adminConsoleRouter.Handle("/minimum-wages/{minimum_wage_id}", requirePermission(middleware.WagesManage, minwage.DeleteMinimumWage(deps.MinimumWageService))).Methods(http.MethodDelete)
+	adminConsoleRouter.Handle("/roles", requirePermission(middleware.RolesManage, role.FetchRoles(deps.RoleService))).Methods(http.MethodGet)
+	adminConsoleRouter.Handle("/roles", requirePermission(middleware.RolesManage, role.CreateRole(deps.RoleService))).Methods(http.MethodPost)
+	adminConsoleRouter.Handle("/roles/{role_name}", requirePermission(middleware.RolesManage, role.FetchRole(deps.RoleService))).Methods(http.MethodGet)
+	adminConsoleRouter.Handle("/roles/{role_name}", requirePermission(middleware.RolesManage, role.UpdateRole(deps.RoleService))).Methods(http.MethodPut)
+	adminConsoleRouter.Handle("/roles/{role_name}", requirePermission(middleware.RolesManage, role.DeleteRole(deps.RoleService))).Methods(http.MethodDelete)
+	adminConsoleRouter.Handle("/workers", requirePermission(middleware.UsersRead, admin.SearchUsers(deps.AdminService, admin.Worker))).Methods(http.MethodGet)
+	adminConsoleRouter.Handle("/workers/{worker_id}"+"/suspend", requirePermission(middleware.UsersSuspend, admin.SuspendUser(deps.AdminService, admin.Worker))).Methods(http.MethodPost)
+	adminConsoleRouter.Handle("/workers/{worker_id}"+"/reactivate", requirePermission(middleware.UsersSuspend, admin.ReactivateUser(deps.AdminService, admin.Worker))).Methods(http.MethodPost)
+	adminConsoleRouter.Handle("/employers", requirePermission(middleware.UsersRead, admin.SearchUsers(deps.AdminService, admin.Employer))).Methods(http.MethodGet)
+	adminConsoleRouter.Handle("/employers/{employer_id}"+"/suspend", requirePermission(middleware.UsersSuspend, admin.SuspendUser(deps.AdminService, admin.Employer))).Methods(http.MethodPost)
+	adminConsoleRouter.Handle("/employers/{employer_id}"+"/reactivate", requirePermission(middleware.UsersSuspend, admin.ReactivateUser(deps.AdminService, admin.Employer))).Methods(http.MethodPost)
+	adminConsoleRouter.Handle("/jobs/hidden", requirePermission(middleware.JobsModerate, admin.FetchHiddenJobs(deps.AdminService))).Methods(http.MethodGet)
+	adminConsoleRouter.Handle("/jobs/{job_id}"+"/hide", requirePermission(middleware.JobsModerate, admin.HideJob(deps.AdminService))).Methods(http.MethodPost)
+	adminConsoleRouter.Handle("/jobs/{job_id}"+"/unhide", requirePermission(middleware.JobsModerate, admin.UnhideJob(deps.AdminService))).Methods(http.MethodPost)
+	adminConsoleRouter.Handle("/jobs/{job_id}", requirePermission(middleware.JobsModerate, admin.RemoveJob(deps.AdminService))).Methods(http.MethodDelete)
+	adminConsoleRouter.Handle("/verifications", requirePermission(middleware.VerificationsReview, verification.FetchVerifications(deps.VerificationService))).Methods(http.MethodGet)
+	adminConsoleRouter.Handle("/verifications/{verification_id}", requirePermission(middleware.VerificationsReview, verification.FetchVerificationById(deps.VerificationService))).Methods(http.MethodGet)
+	adminConsoleRouter.Handle("/verifications/{verification_id}"+"/approve", requirePermission(middleware.VerificationsReview, verification.ApproveVerification(deps.VerificationService))).Methods(http.MethodPost)
+	adminConsoleRouter.Handle("/verifications/{verification_id}"+"/reject", requirePermission(middleware.VerificationsReview, verification.RejectVerification(deps.VerificationService))).Methods(http.MethodPost)
+	adminConsoleRouter.Handle("/worker-documents", requirePermission(middleware.DocumentsReview, kyc.FetchDocuments(deps.KYCService))).Methods(http.MethodGet)
+	adminConsoleRouter.Handle("/worker-documents/{document_id}", requirePermission(middleware.DocumentsReview, kyc.FetchDocumentById(deps.KYCService))).Methods(http.MethodGet)
+	adminConsoleRouter.Handle("/worker-documents/{document_id}"+"/approve", requirePermission(middleware.DocumentsReview, kyc.ApproveDocument(deps.KYCService))).Methods(http.MethodPost)
+	adminConsoleRouter.Handle("/worker-documents/{document_id}"+"/reject", requirePermission(middleware.DocumentsReview, kyc.RejectDocument(deps.KYCService))).Methods(http.MethodPost)
+	adminConsoleRouter.Handle("/worker-documents/{document_id}"+"/file", requirePermission(middleware.DocumentsReview, kyc.DownloadDocumentFile(deps.KYCService))).Methods(http.MethodGet)
+
 	// Worker Routes - protected routes
 	workerRouter := router.PathPrefix("/worker").Subrouter()
 
+	// the changes made through the worker, employer, job and application routes are audited with the
+	// user of the JWT when one is sent
+	workerRouter.Use(middleware.IdentifyJWT)
 	// workerRouter.Use(middleware.ValidateJWT)            // validate JWT token
 	// workerRouter.Use(middleware.RequireSameUserOrAdmin) // only worker with same ID has access to or admin
 
@@ -37,37 +108,130 @@ func NewRouter(deps Dependencies) *mux.Router {
 	workerRouter.HandleFunc("/{worker_id}", worker.UpdateWorkerByID(deps.WorkerService)).Methods(http.MethodPut)
 	workerRouter.HandleFunc("/{worker_id}", worker.DeleteWorkerByID(deps.WorkerService)).Methods(http.MethodDelete)
 	workerRouter.HandleFunc("/{worker_id}"+"/applications", worker.FetchApplicationsByWorkerId(deps.WorkerService)).Methods(http.MethodGet)
+	workerRouter.HandleFunc("/{worker_id}"+"/history", earnings.FetchWorkHistory(deps.EarningsService)).Methods(http.MethodGet)
+	workerRouter.HandleFunc("/{worker_id}"+"/history/statement", earnings.DownloadStatement(deps.EarningsService)).Methods(http.MethodGet)
+	workerRouter.HandleFunc("/{worker_id}"+"/schedule", schedule.FetchWorkerSchedule(deps.ScheduleService)).Methods(http.MethodGet)
+	workerRouter.HandleFunc("/{worker_id}"+"/availability", schedule.CreateAvailability(deps.ScheduleService)).Methods(http.MethodPost)
+	workerRouter.HandleFunc("/{worker_id}"+"/availability/{availability_id}", schedule.DeleteAvailability(deps.ScheduleService)).Methods(http.MethodDelete)
+	workerRouter.HandleFunc("/{worker_id}"+"/blackout-dates", schedule.CreateBlackoutDate(deps.ScheduleService)).Methods(http.MethodPost)
+	workerRouter.HandleFunc("/{worker_id}"+"/blackout-dates/{blackout_id}", schedule.DeleteBlackoutDate(deps.ScheduleService)).Methods(http.MethodDelete)
+	workerRouter.HandleFunc("/{worker_id}"+"/photo", media.FetchPhoto(deps.MediaService, media.Worker)).Methods(http.MethodGet)
+	workerRouter.HandleFunc("/{worker_id}"+"/photo", media.UploadMedia(deps.MediaService, media.Worker)).Methods(http.MethodPost)
+	workerRouter.HandleFunc("/{worker_id}"+"/photo", media.DeletePhoto(deps.MediaService, media.Worker)).Methods(http.MethodDelete)
+	workerRouter.HandleFunc("/{worker_id}"+"/documents", kyc.FetchWorkerDocuments(deps.KYCService)).Methods(http.MethodGet)
+	workerRouter.HandleFunc("/{worker_id}"+"/documents", kyc.UploadDocument(deps.KYCService)).Methods(http.MethodPost)
+	workerRouter.HandleFunc("/{worker_id}"+"/dues", payment.FetchWorkerDues(deps.PaymentService)).Methods(http.MethodGet)
+	workerRouter.HandleFunc("/{worker_id}"+"/messages", message.FetchWorkerThreads(deps.MessageService)).Methods(http.MethodGet)
+	workerRouter.HandleFunc("/{worker_id}"+"/notifications", notification.FetchInbox(deps.NotificationService, notification.WorkerRecipient)).Methods(http.MethodGet)
+	workerRouter.HandleFunc("/{worker_id}"+"/notifications/{notification_id}/read", notification.MarkRead(deps.NotificationService, notification.WorkerRecipient)).Methods(http.MethodPost)
+	workerRouter.HandleFunc("/{worker_id}"+"/notification-preferences", notification.FetchPreferences(deps.NotificationService, notification.WorkerRecipient)).Methods(http.MethodGet)
+	workerRouter.HandleFunc("/{worker_id}"+"/notification-preferences", notification.UpdatePreferences(deps.NotificationService, notification.WorkerRecipient)).Methods(http.MethodPut)
 
 	// Employer Routes
 	employerRouter := router.PathPrefix("/employer").Subrouter()
+	employerRouter.Use(middleware.IdentifyJWT)
 	employerRouter.HandleFunc("/{employer_id}", employer.FetchEmployerByID(deps.EmployerService)).Methods(http.MethodGet)
 	employerRouter.HandleFunc("/{employer_id}", employer.UpdateEmployerById(deps.EmployerService)).Methods(http.MethodPut)
 	employerRouter.HandleFunc("/{employer_id}", employer.DeleteEmployerByID(deps.EmployerService)).Methods(http.MethodDelete)
 	employerRouter.HandleFunc("/{employer_id}"+"/jobs", employer.FetchJobsByEmployerId(deps.EmployerService)).Methods(http.MethodGet)
+	employerRouter.HandleFunc("/{employer_id}"+"/analytics", analytics.FetchEmployerAnalytics(deps.AnalyticsService)).Methods(http.MethodGet)
+	employerRouter.HandleFunc("/{employer_id}"+"/photo", media.FetchPhoto(deps.MediaService, media.Employer)).Methods(http.MethodGet)
+	employerRouter.HandleFunc("/{employer_id}"+"/photo", media.UploadMedia(deps.MediaService, media.Employer)).Methods(http.MethodPost)
+	employerRouter.HandleFunc("/{employer_id}"+"/photo", media.DeletePhoto(deps.MediaService, media.Employer)).Methods(http.MethodDelete)
+	employerRouter.HandleFunc("/{employer_id}"+"/dues", payment.FetchEmployerDues(deps.PaymentService)).Methods(http.MethodGet)
+	employerRouter.HandleFunc("/{employer_id}"+"/messages", message.FetchEmployerThreads(deps.MessageService)).Methods(http.MethodGet)
+	employerRouter.HandleFunc("/{employer_id}"+"/notifications", notification.FetchInbox(deps.NotificationService, notification.EmployerRecipient)).Methods(http.MethodGet)
+	employerRouter.HandleFunc("/{employer_id}"+"/notifications/{notification_id}/read", notification.MarkRead(deps.NotificationService, notification.EmployerRecipient)).Methods(http.MethodPost)
+	employerRouter.HandleFunc("/{employer_id}"+"/notification-preferences", notification.FetchPreferences(deps.NotificationService, notification.EmployerRecipient)).Methods(http.MethodGet)
+	employerRouter.HandleFunc("/{employer_id}"+"/notification-preferences", notification.UpdatePreferences(deps.NotificationService, notification.EmployerRecipient)).Methods(http.MethodPut)
+	employerRouter.HandleFunc("/{employer_id}"+"/verification", verification.FetchEmployerVerification(deps.VerificationService)).Methods(http.MethodGet)
+	employerRouter.HandleFunc("/{employer_id}"+"/verification", verification.SubmitVerification(deps.VerificationService)).Methods(http.MethodPost)
+	employerRouter.HandleFunc("/{employer_id}"+"/webhooks", webhook.FetchWebhooks(deps.WebhookService)).Methods(http.MethodGet)
+	employerRouter.HandleFunc("/{employer_id}"+"/webhooks", webhook.CreateWebhook(deps.WebhookService)).Methods(http.MethodPost)
+	employerRouter.HandleFunc("/{employer_id}"+"/webhooks/{webhook_id}", webhook.UpdateWebhook(deps.WebhookService)).Methods(http.MethodPut)
+	employerRouter.HandleFunc("/{employer_id}"+"/webhooks/{webhook_id}", webhook.DeleteWebhook(deps.WebhookService)).Methods(http.MethodDelete)
+	employerRouter.HandleFunc("/{employer_id}"+"/webhooks/{webhook_id}/deliveries", webhook.FetchDeliveries(deps.WebhookService)).Methods(http.MethodGet)
+	employerRouter.HandleFunc("/{employer_id}"+"/webhooks/{webhook_id}/deliveries/{delivery_id}/redeliver", webhook.Redeliver(deps.WebhookService)).Methods(http.MethodPost)
 
 	// Job Routes
 	jobRouter := router.PathPrefix("/job").Subrouter()
+	jobRouter.Use(middleware.IdentifyJWT)
 	jobRouter.HandleFunc("/create", job.CreateJob(deps.JobService)).Methods(http.MethodPost)
 	jobRouter.HandleFunc("/all", job.FetchAllJobs(deps.JobService)).Methods(http.MethodGet)
 	jobRouter.HandleFunc("/{job_id}", job.FetchJobByID(deps.JobService)).Methods(http.MethodGet)
 	jobRouter.HandleFunc("/{job_id}", job.UpdateJobById(deps.JobService)).Methods(http.MethodPut)
 	jobRouter.HandleFunc("/{job_id}", job.DeleteJobByID(deps.JobService)).Methods(http.MethodDelete)
 	jobRouter.HandleFunc("/{job_id}"+"/applications", job.FetchApplicationsByJobId(deps.JobService)).Methods(http.MethodGet)
+	jobRouter.HandleFunc("/{job_id}"+"/images", media.FetchMedia(deps.MediaService, media.Job)).Methods(http.MethodGet)
+	jobRouter.HandleFunc("/{job_id}"+"/images", media.UploadMedia(deps.MediaService, media.Job)).Methods(http.MethodPost)
+	jobRouter.HandleFunc("/{job_id}"+"/images/{media_id}", media.DeleteMedia(deps.MediaService, media.Job)).Methods(http.MethodDelete)
+	jobRouter.HandleFunc("/{job_id}"+"/attendance", attendance.FetchJobAttendance(deps.AttendanceService)).Methods(http.MethodGet)
+	jobRouter.HandleFunc("/{job_id}"+"/pickup-plan", pickup.FetchPickupPlan(deps.PickupService)).Methods(http.MethodGet)
+	jobRouter.HandleFunc("/{job_id}"+"/pickup-plan/stops/{pincode}", pickup.AssignStop(deps.PickupService)).Methods(http.MethodPut)
+
+	// Analytics Routes
+	analyticsRouter := router.PathPrefix("/analytics").Subrouter()
+	analyticsRouter.HandleFunc("/wages", analytics.FetchWageAnalytics(deps.AnalyticsService)).Methods(http.MethodGet)
+	analyticsRouter.HandleFunc("/wages"+"/suggestion", analytics.SuggestWage(deps.AnalyticsService)).Methods(http.MethodPost)
 
 	// Application Routes
 	applicationRouter := router.PathPrefix("/application").Subrouter()
+	applicationRouter.Use(middleware.IdentifyJWT)
 	applicationRouter.HandleFunc("/create", application.CreateNewApplication(deps.ApplicationService)).Methods(http.MethodPost)
 	applicationRouter.HandleFunc("/{application_id}", application.FetchApplicationByID(deps.ApplicationService)).Methods(http.MethodGet)
 	applicationRouter.HandleFunc("/{application_id}", application.UpdateApplicationByID(deps.ApplicationService)).Methods(http.MethodPut)
 	applicationRouter.HandleFunc("/{application_id}", application.DeleteApplicationByID(deps.ApplicationService)).Methods(http.MethodDelete)
+	applicationRouter.HandleFunc("/{application_id}"+"/payments", payment.FetchApplicationLedger(deps.PaymentService)).Methods(http.MethodGet)
+	// payments are recorded, paid through the gateway and refunded by the employer and acknowledged by
+	// the worker in the JWT
+	applicationRouter.Handle("/{application_id}"+"/payments", middleware.ValidateJWT(http.HandlerFunc(payment.RecordPayment(deps.PaymentService)))).Methods(http.MethodPost)
+	applicationRouter.Handle("/{application_id}"+"/payments/{payment_id}/acknowledge", middleware.ValidateJWT(http.HandlerFunc(payment.AcknowledgePayment(deps.PaymentService)))).Methods(http.MethodPost)
+	applicationRouter.Handle("/{application_id}"+"/payments/gateway", middleware.ValidateJWT(http.HandlerFunc(payment.InitiatePayment(deps.PaymentService)))).Methods(http.MethodPost)
+	applicationRouter.HandleFunc("/{application_id}"+"/payments/gateway/{order_id}", payment.FetchPaymentOrder(deps.PaymentService)).Methods(http.MethodGet)
+	applicationRouter.Handle("/{application_id}"+"/payments/gateway/{order_id}/refund", middleware.ValidateJWT(http.HandlerFunc(payment.RefundPayment(deps.PaymentService)))).Methods(http.MethodPost)
+	applicationRouter.HandleFunc("/{application_id}"+"/attendance", attendance.FetchApplicationAttendance(deps.AttendanceService)).Methods(http.MethodGet)
+	// attendance codes are issued and no-shows are marked by the employer in the JWT
+	applicationRouter.Handle("/{application_id}"+"/attendance/code", middleware.ValidateJWT(http.HandlerFunc(attendance.IssueCode(deps.AttendanceService)))).Methods(http.MethodPost)
+	applicationRouter.Handle("/{application_id}"+"/attendance/no-show", middleware.ValidateJWT(http.HandlerFunc(attendance.MarkNoShow(deps.AttendanceService)))).Methods(http.MethodPost)
+	applicationRouter.HandleFunc("/{application_id}"+"/attendance/check-in", attendance.CheckIn(deps.AttendanceService)).Methods(http.MethodPost)
+	applicationRouter.HandleFunc("/{application_id}"+"/attendance/check-out", attendance.CheckOut(deps.AttendanceService)).Methods(http.MethodPost)
+	applicationRouter.HandleFunc("/{application_id}"+"/pickup", pickup.FetchWorkerPickup(deps.PickupService)).Methods(http.MethodGet)
+	// messages are read and written by the worker or employer in the JWT
+	applicationRouter.Handle("/{application_id}"+"/messages", middleware.ValidateJWT(http.HandlerFunc(message.FetchThread(deps.MessageService)))).Methods(http.MethodGet)
+	applicationRouter.Handle("/{application_id}"+"/messages", middleware.ValidateJWT(http.HandlerFunc(message.SendMessage(deps.MessageService)))).Methods(http.MethodPost)
+	applicationRouter.Handle("/{application_id}"+"/messages/read", middleware.ValidateJWT(http.HandlerFunc(message.MarkThreadRead(deps.MessageService)))).Methods(http.MethodPost)
+
+	// Payment gateway callbacks - authenticated by the provider signature instead of a JWT
+	router.HandleFunc("/payments/webhook", payment.HandleWebhook(deps.PaymentService)).Methods(http.MethodPost)
+
+	// Real-time updates - always authenticated, the worker or employer is taken from the JWT
+	router.Handle("/stream", middleware.TokenFromQuery(middleware.ValidateJWT(http.HandlerFunc(stream.StreamUpdates(deps.StreamService))))).Methods(http.MethodGet)
 
-	// Sectors Routes - Only Admin has access to all these routes
+	// Image links - authenticated by the signature of the link instead of a JWT
+	router.HandleFunc("/media/files/{key:.+}", media.ServeFile(deps.MediaService)).Methods(http.MethodGet)
+
+	// Sectors Routes - anyone can read sectors, changing them needs the sectors:write permission
 	sectorRouter := router.PathPrefix("/sector").Subrouter()
-	sectorRouter.HandleFunc("/create", sector.CreateSector(deps.SectorService)).Methods(http.MethodPost)
+	sectorRouter.Handle("/create", middleware.ValidateJWT(requirePermission(middleware.SectorsWrite, sector.CreateSector(deps.SectorService)))).Methods(http.MethodPost)
 	sectorRouter.HandleFunc("/all", sector.FetchAllSectors(deps.SectorService)).Methods(http.MethodGet)
 	sectorRouter.HandleFunc("/{sector_id}", sector.FetchSectorById(deps.SectorService)).Methods(http.MethodGet)
-	sectorRouter.HandleFunc("/{sector_id}", sector.UpdateSectorById(deps.SectorService)).Methods(http.MethodPut)
-	sectorRouter.HandleFunc("/{sector_id}", sector.DeleteSectorById(deps.SectorService)).Methods(http.MethodDelete)
+	sectorRouter.Handle("/{sector_id}", middleware.ValidateJWT(requirePermission(middleware.SectorsWrite, sector.UpdateSectorById(deps.SectorService)))).Methods(http.MethodPut)
+	sectorRouter.Handle("/{sector_id}", middleware.ValidateJWT(requirePermission(middleware.SectorsWrite, sector.DeleteSectorById(deps.SectorService)))).Methods(http.MethodDelete)
+	sectorRouter.Handle("/{sector_id}"+"/merge-into/{other_id}", middleware.ValidateJWT(requirePermission(middleware.SectorsWrite, sector.MergeSector(deps.SectorService)))).Methods(http.MethodPost)
+	sectorRouter.HandleFunc("/{sector_id}"+"/stats", sector.FetchSectorStats(deps.SectorService)).Methods(http.MethodGet)
+
+	// Skills Routes - anyone can read skills, changing skills and synonyms needs the skills:write permission
+	skillRouter := router.PathPrefix("/skill").Subrouter()
+	skillRouter.Handle("/create", middleware.ValidateJWT(requirePermission(middleware.SkillsWrite, skill.CreateSkill(deps.SkillService)))).Methods(http.MethodPost)
+	skillRouter.HandleFunc("/all", skill.FetchAllSkills(deps.SkillService)).Methods(http.MethodGet)
+	skillRouter.HandleFunc("/{skill_id}", skill.FetchSkillById(deps.SkillService)).Methods(http.MethodGet)
+	skillRouter.Handle("/{skill_id}", middleware.ValidateJWT(requirePermission(middleware.SkillsWrite, skill.UpdateSkillById(deps.SkillService)))).Methods(http.MethodPut)
+	skillRouter.Handle("/{skill_id}", middleware.ValidateJWT(requirePermission(middleware.SkillsWrite, skill.DeleteSkillById(deps.SkillService)))).Methods(http.MethodDelete)
+	skillRouter.Handle("/{skill_id}"+"/synonyms", middleware.ValidateJWT(requirePermission(middleware.SkillsWrite, skill.AddSkillSynonym(deps.SkillService)))).Methods(http.MethodPost)
+	skillRouter.Handle("/{skill_id}"+"/synonyms/{synonym_id}", middleware.ValidateJWT(requirePermission(middleware.SkillsWrite, skill.DeleteSkillSynonym(deps.SkillService)))).Methods(http.MethodDelete)
+
+	// Report Routes - reports are made by the worker or employer in the JWT
+	router.Handle("/reports", middleware.ValidateJWT(http.HandlerFunc(report.CreateReport(deps.ReportService)))).Methods(http.MethodPost)
 
 	// Routes to Fetch Complete Data
 	router.HandleFunc("/workers", worker.FetchAllWorkers(deps.WorkerService)).Methods(http.MethodGet)
@@ -0,0 +1,41 @@
+package schedule
+
+import (
+	"time"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/datetime"
+)
+
+type Availability struct {
+	ID        int            `json:"id"`
+	WorkerID  int            `json:"worker_id"`
+	DayOfWeek int            `json:"day_of_week"`
+	StartHour datetime.Clock `json:"start_hour"`
+	EndHour   datetime.Clock `json:"end_hour"`
+}
+
+type BlackoutDate struct {
+	ID       int           `json:"id"`
+	WorkerID int           `json:"worker_id"`
+	Date     datetime.Date `json:"date"`
+	Reason   string        `json:"reason"`
+}
+
+// Engagement is a confirmed job occupying a block of the worker calendar
+type Engagement struct {
+	ApplicationID int       `json:"application_id"`
+	JobID         int       `json:"job_id"`
+	ShiftID       int       `json:"shift_id,omitempty"`
+	JobTitle      string    `json:"job_title"`
+	StartsAt      time.Time `json:"starts_at"`
+	EndsAt        time.Time `json:"ends_at"`
+}
+
+type WorkerSchedule struct {
+	WorkerID      int            `json:"worker_id"`
+	From          datetime.Date  `json:"from"`
+	To            datetime.Date  `json:"to"`
+	Availability  []Availability `json:"availability"`
+	BlackoutDates []BlackoutDate `json:"blackout_dates"`
+	Engagements   []Engagement   `json:"engagements"`
+}
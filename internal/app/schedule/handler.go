@@ -0,0 +1,197 @@
+package schedule
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/datetime"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/logger"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/middleware"
+	"go.uber.org/zap"
+)
+
+func FetchWorkerSchedule(scheduleService Service) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		workerId, id := isPathIdValid(ctx, w, r, "worker_id", apperrors.MsgInvalidWorkerId, apperrors.ErrFetchSchedule)
+		if workerId == -1 {
+			return
+		}
+
+		from, to, err := scheduleRangeParams(r)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.MsgInvalidDateRange, zap.Error(err), zap.String("ID", id))
+			middleware.HandleErrorResponse(ctx, w, apperrors.MsgInvalidDateRange+", "+err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		schedule, err := scheduleService.FetchWorkerSchedule(ctx, workerId, from, to)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrFetchSchedule.Error(), zap.Error(err), zap.String("ID", id))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrFetchSchedule.Error()+", "+err.Error(), ScheduleErrorStatusCode(err))
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, "worker schedule retrieved successfully", http.StatusOK, schedule)
+	}
+}
+
+func CreateAvailability(scheduleService Service) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		workerId, _ := isPathIdValid(ctx, w, r, "worker_id", apperrors.MsgInvalidWorkerId, apperrors.ErrCreateAvailability)
+		if workerId == -1 {
+			return
+		}
+
+		var availabilityData Availability
+		err := json.NewDecoder(r.Body).Decode(&availabilityData)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrInvalidRequestBody.Error(), zap.Error(err))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrInvalidRequestBody.Error()+": "+err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		availabilityData.WorkerID = workerId
+		availability, err := scheduleService.CreateAvailability(ctx, availabilityData)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrCreateAvailability.Error(), zap.Error(err))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrCreateAvailability.Error()+": "+err.Error(), ScheduleErrorStatusCode(err))
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, "successfully created availability window", http.StatusCreated, availability)
+	}
+}
+
+func DeleteAvailability(scheduleService Service) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		workerId, _ := isPathIdValid(ctx, w, r, "worker_id", apperrors.MsgInvalidWorkerId, apperrors.ErrDeleteAvailability)
+		if workerId == -1 {
+			return
+		}
+
+		availabilityId, id := isPathIdValid(ctx, w, r, "availability_id", apperrors.MsgInvalidAvailabilityId, apperrors.ErrDeleteAvailability)
+		if availabilityId == -1 {
+			return
+		}
+
+		_, err := scheduleService.DeleteAvailability(ctx, workerId, availabilityId)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrDeleteAvailability.Error(), zap.Error(err), zap.String("ID", id))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrDeleteAvailability.Error()+", "+err.Error(), ScheduleErrorStatusCode(err))
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func CreateBlackoutDate(scheduleService Service) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		workerId, _ := isPathIdValid(ctx, w, r, "worker_id", apperrors.MsgInvalidWorkerId, apperrors.ErrCreateBlackoutDate)
+		if workerId == -1 {
+			return
+		}
+
+		var blackoutData BlackoutDate
+		err := json.NewDecoder(r.Body).Decode(&blackoutData)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrInvalidRequestBody.Error(), zap.Error(err))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrInvalidRequestBody.Error()+": "+err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		blackoutData.WorkerID = workerId
+		blackout, err := scheduleService.CreateBlackoutDate(ctx, blackoutData)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrCreateBlackoutDate.Error(), zap.Error(err))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrCreateBlackoutDate.Error()+": "+err.Error(), ScheduleErrorStatusCode(err))
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, "successfully created blackout date", http.StatusCreated, blackout)
+	}
+}
+
+func DeleteBlackoutDate(scheduleService Service) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		workerId, _ := isPathIdValid(ctx, w, r, "worker_id", apperrors.MsgInvalidWorkerId, apperrors.ErrDeleteBlackoutDate)
+		if workerId == -1 {
+			return
+		}
+
+		blackoutId, id := isPathIdValid(ctx, w, r, "blackout_id", apperrors.MsgInvalidBlackoutId, apperrors.ErrDeleteBlackoutDate)
+		if blackoutId == -1 {
+			return
+		}
+
+		_, err := scheduleService.DeleteBlackoutDate(ctx, workerId, blackoutId)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrDeleteBlackoutDate.Error(), zap.Error(err), zap.String("ID", id))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrDeleteBlackoutDate.Error()+", "+err.Error(), ScheduleErrorStatusCode(err))
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// scheduleRangeParams strictly parses the optional from and to query params
+func scheduleRangeParams(r *http.Request) (datetime.Date, datetime.Date, error) {
+	var from, to datetime.Date
+	var err error
+
+	if value := r.URL.Query().Get("from"); value != "" {
+		from, err = datetime.ParseDate(value)
+		if err != nil {
+			return "", "", err
+		}
+	}
+	if value := r.URL.Query().Get("to"); value != "" {
+		to, err = datetime.ParseDate(value)
+		if err != nil {
+			return "", "", err
+		}
+	}
+	return from, to, nil
+}
+
+func isPathIdValid(ctx context.Context, w http.ResponseWriter, r *http.Request, key string, invalidMsg string, errType error) (int, string) {
+	vars := mux.Vars(r)
+	id := vars[key]
+	pathId, err := strconv.Atoi(id)
+	if err != nil {
+		logger.Errorw(ctx, invalidMsg, zap.Error(err), zap.String("ID", id))
+		httpResponseMsg := apperrors.HttpErrorResponseMessage(errType.Error(), invalidMsg, id)
+		middleware.HandleErrorResponse(ctx, w, httpResponseMsg, http.StatusBadRequest)
+		return -1, id
+	}
+	return pathId, id
+}
+
+// ScheduleErrorStatusCode maps scheduling errors to http status codes, it is shared with handlers
+// of other packages that run schedule checks such as application confirmation
+func ScheduleErrorStatusCode(err error) int {
+	switch {
+	case errors.Is(err, apperrors.ErrInvalidSchedule), errors.Is(err, apperrors.ErrInvalidDateTime):
+		return http.StatusBadRequest
+	case errors.Is(err, apperrors.ErrNoWorkerExists), errors.Is(err, apperrors.ErrNoApplicationExists),
+		errors.Is(err, apperrors.ErrNoAvailabilityExists), errors.Is(err, apperrors.ErrNoBlackoutDateExists):
+		return http.StatusNotFound
+	case errors.Is(err, apperrors.ErrScheduleConflict), errors.Is(err, apperrors.ErrWorkerBlackout):
+		return http.StatusConflict
+	}
+	return http.StatusInternalServerError
+}
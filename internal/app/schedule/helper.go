@@ -0,0 +1,134 @@
+package schedule
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo"
+)
+
+func MapAvailabilityRepoToService(window repo.AvailabilityWindow) Availability {
+	return Availability{
+		ID:        window.ID,
+		WorkerID:  window.WorkerID,
+		DayOfWeek: window.DayOfWeek,
+		StartHour: window.StartHour,
+		EndHour:   window.EndHour,
+	}
+}
+
+func MapAvailabilityServiceToRepo(window Availability) repo.AvailabilityWindow {
+	return repo.AvailabilityWindow{
+		ID:        window.ID,
+		WorkerID:  window.WorkerID,
+		DayOfWeek: window.DayOfWeek,
+		StartHour: window.StartHour,
+		EndHour:   window.EndHour,
+	}
+}
+
+func MapBlackoutDateRepoToService(blackout repo.BlackoutDate) BlackoutDate {
+	return BlackoutDate{
+		ID:       blackout.ID,
+		WorkerID: blackout.WorkerID,
+		Date:     blackout.Date,
+		Reason:   blackout.Reason,
+	}
+}
+
+func MapBlackoutDateServiceToRepo(blackout BlackoutDate) repo.BlackoutDate {
+	return repo.BlackoutDate{
+		ID:       blackout.ID,
+		WorkerID: blackout.WorkerID,
+		Date:     blackout.Date,
+		Reason:   blackout.Reason,
+	}
+}
+
+// EngagementInterval converts the job slot of an engagement into absolute start and end times,
+// shifts whose end hour is not after the start hour are treated as running past midnight
+func EngagementInterval(engagement repo.Engagement) (time.Time, time.Time, error) {
+	day, err := engagement.Date.Time()
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	var start time.Duration
+	if !engagement.StartHour.IsZero() {
+		start, err = engagement.StartHour.Duration()
+		if err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+	}
+
+	end := start + time.Duration(engagement.DurationInHours)*time.Hour
+	if !engagement.EndHour.IsZero() {
+		end, err = engagement.EndHour.Duration()
+		if err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+		if end <= start {
+			end += 24 * time.Hour
+		}
+	}
+
+	if end <= start {
+		return time.Time{}, time.Time{}, fmt.Errorf("%w: job %d has no duration", apperrors.ErrInvalidSchedule, engagement.JobID)
+	}
+
+	return day.Add(start), day.Add(end), nil
+}
+
+func MapEngagementRepoToService(engagement repo.Engagement, startsAt time.Time, endsAt time.Time) Engagement {
+	return Engagement{
+		ApplicationID: engagement.ApplicationID,
+		JobID:         engagement.JobID,
+		ShiftID:       engagement.ShiftID,
+		JobTitle:      engagement.JobTitle,
+		StartsAt:      startsAt,
+		EndsAt:        endsAt,
+	}
+}
+
+func overlaps(aStart, aEnd, bStart, bEnd time.Time) bool {
+	return aStart.Before(bEnd) && bStart.Before(aEnd)
+}
+
+// CheckConflict refuses the confirmation of an application when its job overlaps another confirmed
+// job of the same worker or falls on one of the worker's blackout dates
+func CheckConflict(candidates []repo.Engagement, engagements []repo.Engagement, blackouts []repo.BlackoutDate) error {
+	// every shift covered by the application has to fit into the calendar
+	for _, candidate := range candidates {
+		start, end, err := EngagementInterval(candidate)
+		if err != nil {
+			return err
+		}
+
+		for _, engagement := range engagements {
+			if engagement.ApplicationID == candidate.ApplicationID {
+				continue
+			}
+
+			otherStart, otherEnd, err := EngagementInterval(engagement)
+			if err != nil {
+				continue
+			}
+			if overlaps(start, end, otherStart, otherEnd) {
+				return fmt.Errorf("%w: overlaps job %d (%s) from %s to %s", apperrors.ErrScheduleConflict, engagement.JobID, engagement.JobTitle, otherStart.Format(time.RFC3339), otherEnd.Format(time.RFC3339))
+			}
+		}
+
+		for _, blackout := range blackouts {
+			day, err := blackout.Date.Time()
+			if err != nil {
+				continue
+			}
+			if overlaps(start, end, day, day.AddDate(0, 0, 1)) {
+				return fmt.Errorf("%w: %s", apperrors.ErrWorkerBlackout, blackout.Date)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package schedule
+
+import (
+	"testing"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckConflict(t *testing.T) {
+	type testCase struct {
+		name          string
+		candidates    []repo.Engagement
+		engagements   []repo.Engagement
+		blackouts     []repo.BlackoutDate
+		expectedError error
+	}
+
+	candidate := repo.Engagement{ApplicationID: 1, JobID: 3, WorkerID: 12, JobTitle: "Painting", Date: "2025-03-10", StartHour: "09:00", EndHour: "17:00", DurationInHours: 8}
+
+	testCases := []testCase{
+		{
+			name:       "no conflict",
+			candidates: []repo.Engagement{candidate},
+			engagements: []repo.Engagement{
+				{ApplicationID: 2, JobID: 4, WorkerID: 12, Date: "2025-03-10", StartHour: "17:00", EndHour: "21:00", DurationInHours: 4},
+				{ApplicationID: 3, JobID: 5, WorkerID: 12, Date: "2025-03-11", StartHour: "09:00", EndHour: "17:00", DurationInHours: 8},
+			},
+			blackouts:     []repo.BlackoutDate{{ID: 1, WorkerID: 12, Date: "2025-03-12"}},
+			expectedError: nil,
+		},
+		{
+			name:       "overlaps another confirmed job",
+			candidates: []repo.Engagement{candidate},
+			engagements: []repo.Engagement{
+				{ApplicationID: 2, JobID: 4, WorkerID: 12, JobTitle: "Loading", Date: "2025-03-10", StartHour: "16:00", EndHour: "20:00", DurationInHours: 4},
+			},
+			blackouts:     []repo.BlackoutDate{},
+			expectedError: apperrors.ErrScheduleConflict,
+		},
+		{
+			name:       "overlaps overnight shift of previous day",
+			candidates: []repo.Engagement{candidate},
+			engagements: []repo.Engagement{
+				{ApplicationID: 2, JobID: 4, WorkerID: 12, Date: "2025-03-09", StartHour: "22:00", EndHour: "10:00", DurationInHours: 12},
+			},
+			blackouts:     []repo.BlackoutDate{},
+			expectedError: apperrors.ErrScheduleConflict,
+		},
+		{
+			name:          "falls on a blackout date",
+			candidates:    []repo.Engagement{candidate},
+			engagements:   []repo.Engagement{candidate},
+			blackouts:     []repo.BlackoutDate{{ID: 1, WorkerID: 12, Date: "2025-03-10", Reason: "festival"}},
+			expectedError: apperrors.ErrWorkerBlackout,
+		},
+		{
+			name: "later shift of a series overlaps another confirmed job",
+			candidates: []repo.Engagement{
+				{ApplicationID: 1, JobID: 3, ShiftID: 7, WorkerID: 12, Date: "2025-03-10", StartHour: "09:00", EndHour: "17:00", DurationInHours: 8},
+				{ApplicationID: 1, JobID: 3, ShiftID: 8, WorkerID: 12, Date: "2025-03-11", StartHour: "09:00", EndHour: "17:00", DurationInHours: 8},
+			},
+			engagements: []repo.Engagement{
+				{ApplicationID: 2, JobID: 4, ShiftID: 9, WorkerID: 12, Date: "2025-03-11", StartHour: "12:00", EndHour: "14:00", DurationInHours: 2},
+			},
+			blackouts:     []repo.BlackoutDate{},
+			expectedError: apperrors.ErrScheduleConflict,
+		},
+		{
+			name:          "job date cannot be parsed",
+			candidates:    []repo.Engagement{{ApplicationID: 1, JobID: 3, WorkerID: 12, Date: "10th March", DurationInHours: 8}},
+			engagements:   []repo.Engagement{},
+			blackouts:     []repo.BlackoutDate{},
+			expectedError: apperrors.ErrInvalidDateTime,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			err := CheckConflict(test.candidates, test.engagements, test.blackouts)
+			assert.ErrorIs(t, err, test.expectedError)
+		})
+	}
+}
@@ -0,0 +1,184 @@
+package schedule
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/datetime"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo"
+)
+
+// defaultScheduleDays is the length of the schedule window when no end date is requested
+const defaultScheduleDays = 30
+
+type scheduleService struct {
+	scheduleRepo repo.ScheduleStorer
+	workerRepo   repo.WorkerStorer
+}
+
+type Service interface {
+	CreateAvailability(ctx context.Context, availabilityData Availability) (Availability, error)
+	DeleteAvailability(ctx context.Context, workerId int, availabilityId int) (int, error)
+	CreateBlackoutDate(ctx context.Context, blackoutData BlackoutDate) (BlackoutDate, error)
+	DeleteBlackoutDate(ctx context.Context, workerId int, blackoutId int) (int, error)
+	FetchWorkerSchedule(ctx context.Context, workerId int, from datetime.Date, to datetime.Date) (WorkerSchedule, error)
+}
+
+func NewService(scheduleRepo repo.ScheduleStorer, workerRepo repo.WorkerStorer) Service {
+	return &scheduleService{
+		scheduleRepo: scheduleRepo,
+		workerRepo:   workerRepo,
+	}
+}
+
+func (schS *scheduleService) CreateAvailability(ctx context.Context, availabilityData Availability) (Availability, error) {
+	if availabilityData.DayOfWeek < 0 || availabilityData.DayOfWeek > 6 {
+		return Availability{}, fmt.Errorf("%w: day of week must be between 0 (sunday) and 6 (saturday)", apperrors.ErrInvalidSchedule)
+	}
+
+	start, err := availabilityData.StartHour.Duration()
+	if err != nil {
+		return Availability{}, err
+	}
+	end, err := availabilityData.EndHour.Duration()
+	if err != nil {
+		return Availability{}, err
+	}
+	if end <= start {
+		return Availability{}, fmt.Errorf("%w: end hour must be after start hour", apperrors.ErrInvalidSchedule)
+	}
+
+	exists := schS.workerRepo.FindWorkerById(ctx, availabilityData.WorkerID)
+	if !exists {
+		return Availability{}, apperrors.ErrNoWorkerExists
+	}
+
+	window, err := schS.scheduleRepo.CreateAvailability(ctx, MapAvailabilityServiceToRepo(availabilityData))
+	if err != nil {
+		return Availability{}, err
+	}
+
+	return MapAvailabilityRepoToService(window), nil
+}
+
+func (schS *scheduleService) DeleteAvailability(ctx context.Context, workerId int, availabilityId int) (int, error) {
+	id, err := schS.scheduleRepo.DeleteAvailability(ctx, workerId, availabilityId)
+	if err != nil {
+		return -1, err
+	}
+	return id, nil
+}
+
+func (schS *scheduleService) CreateBlackoutDate(ctx context.Context, blackoutData BlackoutDate) (BlackoutDate, error) {
+	_, err := blackoutData.Date.Time()
+	if err != nil {
+		return BlackoutDate{}, err
+	}
+
+	exists := schS.workerRepo.FindWorkerById(ctx, blackoutData.WorkerID)
+	if !exists {
+		return BlackoutDate{}, apperrors.ErrNoWorkerExists
+	}
+
+	blackout, err := schS.scheduleRepo.CreateBlackoutDate(ctx, MapBlackoutDateServiceToRepo(blackoutData))
+	if err != nil {
+		return BlackoutDate{}, err
+	}
+
+	return MapBlackoutDateRepoToService(blackout), nil
+}
+
+func (schS *scheduleService) DeleteBlackoutDate(ctx context.Context, workerId int, blackoutId int) (int, error) {
+	id, err := schS.scheduleRepo.DeleteBlackoutDate(ctx, workerId, blackoutId)
+	if err != nil {
+		return -1, err
+	}
+	return id, nil
+}
+
+// FetchWorkerSchedule returns the weekly availability of the worker along with the blackout dates and
+// confirmed engagements falling between from and to (both inclusive, defaulting to the next 30 days)
+func (schS *scheduleService) FetchWorkerSchedule(ctx context.Context, workerId int, from datetime.Date, to datetime.Date) (WorkerSchedule, error) {
+	rangeStart, rangeEnd, err := scheduleRange(from, to)
+	if err != nil {
+		return WorkerSchedule{}, err
+	}
+
+	exists := schS.workerRepo.FindWorkerById(ctx, workerId)
+	if !exists {
+		return WorkerSchedule{}, apperrors.ErrNoWorkerExists
+	}
+
+	schedule := WorkerSchedule{
+		WorkerID:      workerId,
+		From:          datetime.Date(rangeStart.Format(datetime.DateLayout)),
+		To:            datetime.Date(rangeEnd.AddDate(0, 0, -1).Format(datetime.DateLayout)),
+		Availability:  make([]Availability, 0),
+		BlackoutDates: make([]BlackoutDate, 0),
+		Engagements:   make([]Engagement, 0),
+	}
+
+	windows, err := schS.scheduleRepo.FetchAvailabilityByWorkerId(ctx, workerId)
+	if err != nil {
+		return WorkerSchedule{}, err
+	}
+	for _, window := range windows {
+		schedule.Availability = append(schedule.Availability, MapAvailabilityRepoToService(window))
+	}
+
+	blackouts, err := schS.scheduleRepo.FetchBlackoutDatesByWorkerId(ctx, workerId)
+	if err != nil {
+		return WorkerSchedule{}, err
+	}
+	for _, blackout := range blackouts {
+		day, err := blackout.Date.Time()
+		if err != nil || !overlaps(day, day.AddDate(0, 0, 1), rangeStart, rangeEnd) {
+			continue
+		}
+		schedule.BlackoutDates = append(schedule.BlackoutDates, MapBlackoutDateRepoToService(blackout))
+	}
+
+	engagements, err := schS.scheduleRepo.FetchEngagementsByWorkerId(ctx, workerId)
+	if err != nil {
+		return WorkerSchedule{}, err
+	}
+	for _, engagement := range engagements {
+		// jobs with a date or hours that cannot be placed on the calendar are left out of the view
+		start, end, err := EngagementInterval(engagement)
+		if err != nil || !overlaps(start, end, rangeStart, rangeEnd) {
+			continue
+		}
+		schedule.Engagements = append(schedule.Engagements, MapEngagementRepoToService(engagement, start, end))
+	}
+
+	return schedule, nil
+}
+
+// scheduleRange returns the half open interval [from, to+1 day) requested for a schedule
+func scheduleRange(from datetime.Date, to datetime.Date) (time.Time, time.Time, error) {
+	if from.IsZero() {
+		from = datetime.Today()
+	}
+
+	rangeStart, err := from.Time()
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	rangeEnd := rangeStart.AddDate(0, 0, defaultScheduleDays)
+	if !to.IsZero() {
+		rangeEnd, err = to.Time()
+		if err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+		rangeEnd = rangeEnd.AddDate(0, 0, 1)
+	}
+
+	if !rangeEnd.After(rangeStart) {
+		return time.Time{}, time.Time{}, fmt.Errorf("%w: end date must not be before start date", apperrors.ErrInvalidSchedule)
+	}
+
+	return rangeStart, rangeEnd, nil
+}
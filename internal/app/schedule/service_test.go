@@ -0,0 +1,171 @@
+package schedule
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/datetime"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ScheduleServiceTestSuite struct {
+	suite.Suite
+	service      Service
+	scheduleRepo mocks.ScheduleStorer
+	workerRepo   mocks.WorkerStorer
+}
+
+func (suite *ScheduleServiceTestSuite) SetupTest() {
+	suite.scheduleRepo = mocks.ScheduleStorer{}
+	suite.workerRepo = mocks.WorkerStorer{}
+	suite.service = NewService(&suite.scheduleRepo, &suite.workerRepo)
+}
+
+func (suite *ScheduleServiceTestSuite) TearDownTest() {
+	suite.scheduleRepo.AssertExpectations(suite.T())
+	suite.workerRepo.AssertExpectations(suite.T())
+}
+
+func TestScheduleServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(ScheduleServiceTestSuite))
+}
+
+func (suite *ScheduleServiceTestSuite) TestCreateAvailability() {
+	type testCase struct {
+		name           string
+		input          Availability
+		setup          func()
+		expectedOutput Availability
+		expectedError  error
+	}
+
+	testCases := []testCase{
+		{
+			name:  "success",
+			input: Availability{WorkerID: 12, DayOfWeek: 1, StartHour: "09:00", EndHour: "18:00"},
+			setup: func() {
+				suite.workerRepo.On("FindWorkerById", mock.Anything, 12).Return(true)
+				suite.scheduleRepo.On("CreateAvailability", mock.Anything, repo.AvailabilityWindow{WorkerID: 12, DayOfWeek: 1, StartHour: "09:00", EndHour: "18:00"}).Return(repo.AvailabilityWindow{ID: 1, WorkerID: 12, DayOfWeek: 1, StartHour: "09:00", EndHour: "18:00"}, nil)
+			},
+			expectedOutput: Availability{ID: 1, WorkerID: 12, DayOfWeek: 1, StartHour: "09:00", EndHour: "18:00"},
+			expectedError:  nil,
+		},
+		{
+			name:           "invalid day of week",
+			input:          Availability{WorkerID: 12, DayOfWeek: 7, StartHour: "09:00", EndHour: "18:00"},
+			setup:          func() {},
+			expectedOutput: Availability{},
+			expectedError:  apperrors.ErrInvalidSchedule,
+		},
+		{
+			name:           "end before start",
+			input:          Availability{WorkerID: 12, DayOfWeek: 1, StartHour: "18:00", EndHour: "09:00"},
+			setup:          func() {},
+			expectedOutput: Availability{},
+			expectedError:  apperrors.ErrInvalidSchedule,
+		},
+		{
+			name:  "worker not found",
+			input: Availability{WorkerID: 12, DayOfWeek: 1, StartHour: "09:00", EndHour: "18:00"},
+			setup: func() {
+				suite.workerRepo.On("FindWorkerById", mock.Anything, 12).Return(false)
+			},
+			expectedOutput: Availability{},
+			expectedError:  apperrors.ErrNoWorkerExists,
+		},
+	}
+
+	for _, test := range testCases {
+		suite.SetupTest()
+		suite.Run(test.name, func() {
+			test.setup()
+
+			availability, err := suite.service.CreateAvailability(context.Background(), test.input)
+			suite.Equal(test.expectedOutput, availability)
+			suite.ErrorIs(err, test.expectedError)
+		})
+		suite.TearDownTest()
+	}
+}
+
+func (suite *ScheduleServiceTestSuite) TestFetchWorkerSchedule() {
+	type testCase struct {
+		name           string
+		from           datetime.Date
+		to             datetime.Date
+		setup          func()
+		expectedOutput WorkerSchedule
+		expectedError  bool
+	}
+
+	testCases := []testCase{
+		{
+			name: "success",
+			from: "2025-03-01",
+			to:   "2025-03-10",
+			setup: func() {
+				suite.workerRepo.On("FindWorkerById", mock.Anything, 12).Return(true)
+				suite.scheduleRepo.On("FetchAvailabilityByWorkerId", mock.Anything, 12).Return([]repo.AvailabilityWindow{{ID: 1, WorkerID: 12, DayOfWeek: 1, StartHour: "09:00", EndHour: "18:00"}}, nil)
+				suite.scheduleRepo.On("FetchBlackoutDatesByWorkerId", mock.Anything, 12).Return([]repo.BlackoutDate{
+					{ID: 1, WorkerID: 12, Date: "2025-03-05", Reason: "festival"},
+					{ID: 2, WorkerID: 12, Date: "2025-04-05"},
+				}, nil)
+				suite.scheduleRepo.On("FetchEngagementsByWorkerId", mock.Anything, 12).Return([]repo.Engagement{
+					{ApplicationID: 1, JobID: 3, WorkerID: 12, JobTitle: "Painting", Date: "2025-03-10", StartHour: "09:00", EndHour: "17:00", DurationInHours: 8},
+					{ApplicationID: 2, JobID: 4, WorkerID: 12, JobTitle: "Loading", Date: "2025-03-11", StartHour: "09:00", EndHour: "17:00", DurationInHours: 8},
+				}, nil)
+			},
+			expectedOutput: WorkerSchedule{
+				WorkerID:      12,
+				From:          "2025-03-01",
+				To:            "2025-03-10",
+				Availability:  []Availability{{ID: 1, WorkerID: 12, DayOfWeek: 1, StartHour: "09:00", EndHour: "18:00"}},
+				BlackoutDates: []BlackoutDate{{ID: 1, WorkerID: 12, Date: "2025-03-05", Reason: "festival"}},
+				Engagements: []Engagement{{
+					ApplicationID: 1,
+					JobID:         3,
+					JobTitle:      "Painting",
+					StartsAt:      time.Date(2025, 3, 10, 9, 0, 0, 0, datetime.Location),
+					EndsAt:        time.Date(2025, 3, 10, 17, 0, 0, 0, datetime.Location),
+				}},
+			},
+			expectedError: false,
+		},
+		{
+			name:           "end date before start date",
+			from:           "2025-03-10",
+			to:             "2025-03-01",
+			setup:          func() {},
+			expectedOutput: WorkerSchedule{},
+			expectedError:  true,
+		},
+		{
+			name: "error from db",
+			from: "2025-03-01",
+			setup: func() {
+				suite.workerRepo.On("FindWorkerById", mock.Anything, 12).Return(true)
+				suite.scheduleRepo.On("FetchAvailabilityByWorkerId", mock.Anything, 12).Return([]repo.AvailabilityWindow{}, errors.New("some db error"))
+			},
+			expectedOutput: WorkerSchedule{},
+			expectedError:  true,
+		},
+	}
+
+	for _, test := range testCases {
+		suite.SetupTest()
+		suite.Run(test.name, func() {
+			test.setup()
+
+			schedule, err := suite.service.FetchWorkerSchedule(context.Background(), 12, test.from, test.to)
+			suite.Equal(test.expectedOutput, schedule)
+			suite.Equal(test.expectedError, err != nil)
+		})
+		suite.TearDownTest()
+	}
+}
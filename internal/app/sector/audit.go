@@ -0,0 +1,77 @@
+package sector
+
+import (
+	"context"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/audit"
+)
+
+// auditedService records every sector that is created, updated, merged or deleted in the audit log
+type auditedService struct {
+	Service
+	auditService audit.Service
+}
+
+func NewAuditedService(sectorService Service, auditService audit.Service) Service {
+	return &auditedService{
+		Service:      sectorService,
+		auditService: auditService,
+	}
+}
+
+func (auditedS *auditedService) CreateNewSector(ctx context.Context, sectorData Sector) (Sector, error) {
+	createdSector, err := auditedS.Service.CreateNewSector(ctx, sectorData)
+	if err != nil {
+		return Sector{}, err
+	}
+
+	auditedS.auditService.Record(ctx, audit.Create, audit.Sector, createdSector.ID, nil, createdSector)
+	return createdSector, nil
+}
+
+func (auditedS *auditedService) UpdateSectorById(ctx context.Context, sectorData Sector) (Sector, error) {
+	// the sector that cannot be fetched is recorded without its previous state
+	var before interface{}
+	if fetchedSector, err := auditedS.Service.FetchSectorById(ctx, sectorData.ID); err == nil {
+		before = fetchedSector
+	}
+
+	updatedSector, err := auditedS.Service.UpdateSectorById(ctx, sectorData)
+	if err != nil {
+		return Sector{}, err
+	}
+
+	auditedS.auditService.Record(ctx, audit.Update, audit.Sector, updatedSector.ID, before, updatedSector)
+	return updatedSector, nil
+}
+
+func (auditedS *auditedService) DeleteSectorById(ctx context.Context, sectorId int) (int, error) {
+	var before interface{}
+	if fetchedSector, err := auditedS.Service.FetchSectorById(ctx, sectorId); err == nil {
+		before = fetchedSector
+	}
+
+	deletedId, err := auditedS.Service.DeleteSectorById(ctx, sectorId)
+	if err != nil {
+		return deletedId, err
+	}
+
+	auditedS.auditService.Record(ctx, audit.Delete, audit.Sector, sectorId, before, nil)
+	return deletedId, nil
+}
+
+// MergeSector records the source sector as merged, the target keeps its own fields
+func (auditedS *auditedService) MergeSector(ctx context.Context, sectorId int, targetId int) (Sector, error) {
+	var source interface{}
+	if fetchedSector, err := auditedS.Service.FetchSectorById(ctx, sectorId); err == nil {
+		source = fetchedSector
+	}
+
+	mergedSector, err := auditedS.Service.MergeSector(ctx, sectorId, targetId)
+	if err != nil {
+		return Sector{}, err
+	}
+
+	auditedS.auditService.Record(ctx, audit.Merge, audit.Sector, sectorId, source, map[string]int{"merged_into": targetId})
+	return mergedSector, nil
+}
@@ -0,0 +1,97 @@
+package sector
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/audit"
+	auditMocks "github.com/harsh-jagtap-josh/RozgarLink/internal/app/audit/mocks"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type AuditedServiceTestSuite struct {
+	suite.Suite
+	service      Service
+	sectorRepo   mocks.SectoreStorer
+	auditService auditMocks.Service
+}
+
+func (suite *AuditedServiceTestSuite) SetupTest() {
+	suite.sectorRepo = mocks.SectoreStorer{}
+	suite.auditService = auditMocks.Service{}
+	suite.service = NewAuditedService(NewService(&suite.sectorRepo), &suite.auditService)
+}
+
+func (suite *AuditedServiceTestSuite) TearDownTest() {
+	suite.sectorRepo.AssertExpectations(suite.T())
+	suite.auditService.AssertExpectations(suite.T())
+}
+
+func TestAuditedServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(AuditedServiceTestSuite))
+}
+
+func (suite *AuditedServiceTestSuite) TestUpdateSectorById() {
+	type testCase struct {
+		name          string
+		setup         func()
+		expectedError error
+	}
+
+	testCases := []testCase{
+		{
+			name: "previous state recorded",
+			setup: func() {
+				suite.sectorRepo.On("FetchSectorById", mock.Anything, 4).Return(repo.Sector{ID: 4, Name: "Construction"}, nil)
+				suite.sectorRepo.On("UpdateSectorById", mock.Anything, repo.Sector{ID: 4, Name: "Building"}).Return(repo.Sector{ID: 4, Name: "Building"}, nil)
+				suite.auditService.On("Record", mock.Anything, audit.Update, audit.Sector, 4, Sector{ID: 4, Name: "Construction"}, Sector{ID: 4, Name: "Building"}).Return()
+			},
+			expectedError: nil,
+		},
+		{
+			name: "sector that cannot be fetched recorded without previous state",
+			setup: func() {
+				suite.sectorRepo.On("FetchSectorById", mock.Anything, 4).Return(repo.Sector{}, errors.New("connection reset"))
+				suite.sectorRepo.On("UpdateSectorById", mock.Anything, repo.Sector{ID: 4, Name: "Building"}).Return(repo.Sector{ID: 4, Name: "Building"}, nil)
+				suite.auditService.On("Record", mock.Anything, audit.Update, audit.Sector, 4, nil, Sector{ID: 4, Name: "Building"}).Return()
+			},
+			expectedError: nil,
+		},
+		{
+			name: "failed update not recorded",
+			setup: func() {
+				suite.sectorRepo.On("FetchSectorById", mock.Anything, 4).Return(repo.Sector{ID: 4, Name: "Construction"}, nil)
+				suite.sectorRepo.On("UpdateSectorById", mock.Anything, mock.Anything).Return(repo.Sector{}, errors.New("connection reset"))
+			},
+			expectedError: errors.New("connection reset"),
+		},
+	}
+
+	for _, test := range testCases {
+		suite.SetupTest()
+		suite.Run(test.name, func() {
+			test.setup()
+
+			_, err := suite.service.UpdateSectorById(context.Background(), Sector{ID: 4, Name: "Building"})
+
+			suite.Equal(test.expectedError, err)
+		})
+		suite.TearDownTest()
+	}
+}
+
+func (suite *AuditedServiceTestSuite) TestDeleteSectorById() {
+	suite.sectorRepo.On("FetchSectorById", mock.Anything, 4).Return(repo.Sector{ID: 4, Name: "Construction"}, nil)
+	suite.sectorRepo.On("FetchSectorStats", mock.Anything, repo.Sector{ID: 4, Name: "Construction"}).Return(repo.SectorStats{}, nil)
+	suite.sectorRepo.On("DeleteSectorById", mock.Anything, 4).Return(4, nil)
+	suite.auditService.On("Record", mock.Anything, audit.Delete, audit.Sector, 4, Sector{ID: 4, Name: "Construction"}, nil).Return()
+
+	id, err := suite.service.DeleteSectorById(context.Background(), 4)
+
+	suite.NoError(err)
+	suite.Equal(4, id)
+}
@@ -5,3 +5,13 @@ type Sector struct {
 	Name        string `json:"name"`
 	Description string `json:"description"`
 }
+
+type SectorStats struct {
+	SectorID    int     `json:"sector_id"`
+	Workers     int     `json:"workers"`
+	Employers   int     `json:"employers"`
+	Jobs        int     `json:"jobs"`
+	OpenJobs    int     `json:"open_jobs"`
+	Skills      int     `json:"skills"`
+	AverageWage float64 `json:"average_wage"`
+}
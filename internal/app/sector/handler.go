@@ -99,11 +99,32 @@ func DeleteSectorById(sectorService Service) func(w http.ResponseWriter, r *http
 			return
 		}
 
+		// a sector in use can only be deleted by merging it into another sector
+		mergeInto := r.URL.Query().Get("merge_into")
+		if mergeInto != "" {
+			targetId, err := strconv.Atoi(mergeInto)
+			if err != nil {
+				logger.Errorw(ctx, apperrors.MsgInvalidSectorId, zap.Error(err), zap.String("ID", mergeInto))
+				httpResponseMsg := apperrors.HttpErrorResponseMessage(apperrors.ErrDeleteSector.Error(), apperrors.MsgInvalidSectorId, mergeInto)
+				middleware.HandleErrorResponse(ctx, w, httpResponseMsg, http.StatusBadRequest)
+				return
+			}
+
+			_, err = sectorService.MergeSector(ctx, sectorId, targetId)
+			if err != nil {
+				logger.Errorw(ctx, apperrors.ErrDeleteSector.Error(), zap.Error(err), zap.String("ID", id))
+				middleware.HandleErrorResponse(ctx, w, apperrors.ErrDeleteSector.Error()+", "+err.Error(), sectorErrorStatusCode(err))
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
 		_, err := sectorService.DeleteSectorById(ctx, sectorId)
 		if err != nil {
-			if errors.Is(err, apperrors.ErrNoSectorExists) {
-				logger.Errorw(ctx, apperrors.ErrNoSectorExists.Error(), zap.Error(err), zap.String("ID", id))
-				middleware.HandleErrorResponse(ctx, w, apperrors.ErrDeleteSector.Error()+", "+err.Error(), http.StatusNotFound)
+			if errors.Is(err, apperrors.ErrNoSectorExists) || errors.Is(err, apperrors.ErrSectorInUse) {
+				logger.Errorw(ctx, err.Error(), zap.Error(err), zap.String("ID", id))
+				middleware.HandleErrorResponse(ctx, w, apperrors.ErrDeleteSector.Error()+", "+err.Error(), sectorErrorStatusCode(err))
 				return
 			}
 
@@ -128,6 +149,65 @@ func FetchAllSectors(sectorService Service) func(w http.ResponseWriter, r *http.
 	}
 }
 
+func MergeSector(sectorService Service) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		sectorId, id := isSectorIdValid(ctx, w, r, apperrors.ErrMergeSector)
+		if sectorId == -1 {
+			return
+		}
+
+		otherId := mux.Vars(r)["other_id"]
+		targetId, err := strconv.Atoi(otherId)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.MsgInvalidSectorId, zap.Error(err), zap.String("ID", otherId))
+			httpResponseMsg := apperrors.HttpErrorResponseMessage(apperrors.ErrMergeSector.Error(), apperrors.MsgInvalidSectorId, otherId)
+			middleware.HandleErrorResponse(ctx, w, httpResponseMsg, http.StatusBadRequest)
+			return
+		}
+
+		target, err := sectorService.MergeSector(ctx, sectorId, targetId)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrMergeSector.Error(), zap.Error(err), zap.String("ID", id), zap.String("target_id", otherId))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrMergeSector.Error()+", "+err.Error(), sectorErrorStatusCode(err))
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, "successfully merged sector", http.StatusOK, target)
+	}
+}
+
+func FetchSectorStats(sectorService Service) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		sectorId, id := isSectorIdValid(ctx, w, r, apperrors.ErrFetchSector)
+		if sectorId == -1 {
+			return
+		}
+
+		stats, err := sectorService.FetchSectorStats(ctx, sectorId)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrFetchSector.Error(), zap.Error(err), zap.String("ID", id))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrFetchSector.Error()+", "+err.Error(), sectorErrorStatusCode(err))
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, "sector statistics retrieved successfully", http.StatusOK, stats)
+	}
+}
+
+func sectorErrorStatusCode(err error) int {
+	switch {
+	case errors.Is(err, apperrors.ErrNoSectorExists):
+		return http.StatusNotFound
+	case errors.Is(err, apperrors.ErrSectorInUse):
+		return http.StatusConflict
+	case errors.Is(err, apperrors.ErrInvalidMerge):
+		return http.StatusBadRequest
+	}
+	return http.StatusInternalServerError
+}
+
 func isSectorIdValid(ctx context.Context, w http.ResponseWriter, r *http.Request, errType error) (int, string) {
 	vars := mux.Vars(r)
 	id := vars["sector_id"]
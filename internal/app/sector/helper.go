@@ -17,3 +17,20 @@ func MapSectorServiceToRepo(sector Sector) repo.Sector {
 		Description: sector.Description,
 	}
 }
+
+func MapSectorStatsRepoToService(sectorId int, stats repo.SectorStats) SectorStats {
+	return SectorStats{
+		SectorID:    sectorId,
+		Workers:     stats.Workers,
+		Employers:   stats.Employers,
+		Jobs:        stats.Jobs,
+		OpenJobs:    stats.OpenJobs,
+		Skills:      stats.Skills,
+		AverageWage: stats.AverageWage,
+	}
+}
+
+// a sector is in use as long as any worker, employer, job or skill still refers to it
+func isSectorInUse(stats repo.SectorStats) bool {
+	return stats.Workers+stats.Employers+stats.Jobs+stats.Skills > 0
+}
@@ -5,13 +5,14 @@ package mocks
 import (
 	context "context"
 
-	sector "github.com/harsh-jagtap-josh/RozgarLink/internal/app/sector"
 	mock "github.com/stretchr/testify/mock"
+
+	sector "github.com/harsh-jagtap-josh/RozgarLink/internal/app/sector"
 )
 
 // Service is an autogenerated mock type for the Service type
 type Service struct {
-	*mock.Mock
+	mock.Mock
 }
 
 // CreateNewSector provides a mock function with given fields: ctx, sectorData
@@ -128,6 +129,62 @@ func (_m *Service) FetchSectorById(ctx context.Context, sectorId int) (sector.Se
 	return r0, r1
 }
 
+// FetchSectorStats provides a mock function with given fields: ctx, sectorId
+func (_m *Service) FetchSectorStats(ctx context.Context, sectorId int) (sector.SectorStats, error) {
+	ret := _m.Called(ctx, sectorId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchSectorStats")
+	}
+
+	var r0 sector.SectorStats
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (sector.SectorStats, error)); ok {
+		return rf(ctx, sectorId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) sector.SectorStats); ok {
+		r0 = rf(ctx, sectorId)
+	} else {
+		r0 = ret.Get(0).(sector.SectorStats)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, sectorId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MergeSector provides a mock function with given fields: ctx, sectorId, targetId
+func (_m *Service) MergeSector(ctx context.Context, sectorId int, targetId int) (sector.Sector, error) {
+	ret := _m.Called(ctx, sectorId, targetId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MergeSector")
+	}
+
+	var r0 sector.Sector
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) (sector.Sector, error)); ok {
+		return rf(ctx, sectorId, targetId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) sector.Sector); ok {
+		r0 = rf(ctx, sectorId, targetId)
+	} else {
+		r0 = ret.Get(0).(sector.Sector)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int) error); ok {
+		r1 = rf(ctx, sectorId, targetId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateSectorById provides a mock function with given fields: ctx, sectorData
 func (_m *Service) UpdateSectorById(ctx context.Context, sectorData sector.Sector) (sector.Sector, error) {
 	ret := _m.Called(ctx, sectorData)
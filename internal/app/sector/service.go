@@ -3,6 +3,7 @@ package sector
 import (
 	"context"
 
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
 	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo"
 )
 
@@ -16,6 +17,8 @@ type Service interface {
 	UpdateSectorById(ctx context.Context, sectorData Sector) (Sector, error)
 	DeleteSectorById(ctx context.Context, sectorId int) (int, error)
 	FetchAllSectors(ctx context.Context) ([]Sector, error)
+	MergeSector(ctx context.Context, sectorId int, targetId int) (Sector, error)
+	FetchSectorStats(ctx context.Context, sectorId int) (SectorStats, error)
 }
 
 func NewService(sectorRepo repo.SectoreStorer) Service {
@@ -62,6 +65,20 @@ func (sectorS *sectorService) UpdateSectorById(ctx context.Context, sectorData S
 }
 
 func (sectorS *sectorService) DeleteSectorById(ctx context.Context, sectorId int) (int, error) {
+	sector, err := sectorS.sectorRepo.FetchSectorById(ctx, sectorId)
+	if err != nil {
+		return -1, err
+	}
+
+	stats, err := sectorS.sectorRepo.FetchSectorStats(ctx, sector)
+	if err != nil {
+		return -1, err
+	}
+
+	if isSectorInUse(stats) {
+		return -1, apperrors.ErrSectorInUse
+	}
+
 	id, err := sectorS.sectorRepo.DeleteSectorById(ctx, sectorId)
 	if err != nil {
 		return -1, err
@@ -72,7 +89,7 @@ func (sectorS *sectorService) DeleteSectorById(ctx context.Context, sectorId int
 func (sectorS *sectorService) FetchAllSectors(ctx context.Context) ([]Sector, error) {
 	var sectors []Sector
 	repoSectors, err := sectorS.sectorRepo.FetchAllSectors(ctx)
-	
+
 	if err != nil {
 		return []Sector{}, err
 	}
@@ -83,3 +100,41 @@ func (sectorS *sectorService) FetchAllSectors(ctx context.Context) ([]Sector, er
 
 	return sectors, nil
 }
+
+// MergeSector moves every reference of the sector to the target sector, deletes the sector and returns the target
+func (sectorS *sectorService) MergeSector(ctx context.Context, sectorId int, targetId int) (Sector, error) {
+	if sectorId == targetId {
+		return Sector{}, apperrors.ErrInvalidMerge
+	}
+
+	source, err := sectorS.sectorRepo.FetchSectorById(ctx, sectorId)
+	if err != nil {
+		return Sector{}, err
+	}
+
+	target, err := sectorS.sectorRepo.FetchSectorById(ctx, targetId)
+	if err != nil {
+		return Sector{}, err
+	}
+
+	err = sectorS.sectorRepo.MergeSector(ctx, source, target)
+	if err != nil {
+		return Sector{}, err
+	}
+
+	return MapSectorRepoToService(target), nil
+}
+
+func (sectorS *sectorService) FetchSectorStats(ctx context.Context, sectorId int) (SectorStats, error) {
+	sector, err := sectorS.sectorRepo.FetchSectorById(ctx, sectorId)
+	if err != nil {
+		return SectorStats{}, err
+	}
+
+	stats, err := sectorS.sectorRepo.FetchSectorStats(ctx, sector)
+	if err != nil {
+		return SectorStats{}, err
+	}
+
+	return MapSectorStatsRepoToService(sectorId, stats), nil
+}
@@ -275,6 +275,8 @@ func (suite *ServiceTestSuite) TestDeleteSectorById() {
 			name:     "delete sector success",
 			sectorId: 1,
 			setup: func() {
+				suite.sectorRepo.On("FetchSectorById", mock.Anything, 1).Return(repo.Sector{ID: 1, Name: "IT"}, nil)
+				suite.sectorRepo.On("FetchSectorStats", mock.Anything, repo.Sector{ID: 1, Name: "IT"}).Return(repo.SectorStats{}, nil)
 				suite.sectorRepo.On("DeleteSectorById", mock.Anything, 1).Return(1, nil)
 			},
 			expectedOutput: 1,
@@ -283,6 +285,8 @@ func (suite *ServiceTestSuite) TestDeleteSectorById() {
 			name:     "delete sector error",
 			sectorId: 1,
 			setup: func() {
+				suite.sectorRepo.On("FetchSectorById", mock.Anything, 1).Return(repo.Sector{ID: 1, Name: "IT"}, nil)
+				suite.sectorRepo.On("FetchSectorStats", mock.Anything, repo.Sector{ID: 1, Name: "IT"}).Return(repo.SectorStats{}, nil)
 				suite.sectorRepo.On("DeleteSectorById", mock.Anything, 1).Return(-1, errors.New("db delete error"))
 			},
 			expectedOutput: -1,
@@ -291,10 +295,19 @@ func (suite *ServiceTestSuite) TestDeleteSectorById() {
 			name:     "sector not found",
 			sectorId: 1,
 			setup: func() {
-				suite.sectorRepo.On("DeleteSectorById", mock.Anything, 1).Return(-1, apperrors.ErrNoSectorExists)
+				suite.sectorRepo.On("FetchSectorById", mock.Anything, 1).Return(repo.Sector{}, apperrors.ErrNoSectorExists)
 			},
 			expectedOutput: -1,
 			expectedError:  apperrors.ErrNoSectorExists,
+		}, {
+			name:     "sector in use",
+			sectorId: 1,
+			setup: func() {
+				suite.sectorRepo.On("FetchSectorById", mock.Anything, 1).Return(repo.Sector{ID: 1, Name: "IT"}, nil)
+				suite.sectorRepo.On("FetchSectorStats", mock.Anything, repo.Sector{ID: 1, Name: "IT"}).Return(repo.SectorStats{Jobs: 2}, nil)
+			},
+			expectedOutput: -1,
+			expectedError:  apperrors.ErrSectorInUse,
 		},
 	}
 
@@ -311,6 +324,118 @@ func (suite *ServiceTestSuite) TestDeleteSectorById() {
 	}
 }
 
+func (suite *ServiceTestSuite) TestMergeSector() {
+	type testCase struct {
+		name           string
+		sectorId       int
+		targetId       int
+		setup          func()
+		expectedOutput Sector
+		expectedError  error
+	}
+	testCases := []testCase{
+		{
+			name:     "merge sector success",
+			sectorId: 1,
+			targetId: 2,
+			setup: func() {
+				suite.sectorRepo.On("FetchSectorById", mock.Anything, 1).Return(repo.Sector{ID: 1, Name: "Masonry"}, nil)
+				suite.sectorRepo.On("FetchSectorById", mock.Anything, 2).Return(repo.Sector{ID: 2, Name: "Construction"}, nil)
+				suite.sectorRepo.On("MergeSector", mock.Anything, repo.Sector{ID: 1, Name: "Masonry"}, repo.Sector{ID: 2, Name: "Construction"}).Return(nil)
+			},
+			expectedOutput: Sector{ID: 2, Name: "Construction"},
+			expectedError:  nil,
+		}, {
+			name:           "merge sector into itself",
+			sectorId:       1,
+			targetId:       1,
+			setup:          func() {},
+			expectedOutput: Sector{},
+			expectedError:  apperrors.ErrInvalidMerge,
+		}, {
+			name:     "target sector not found",
+			sectorId: 1,
+			targetId: 2,
+			setup: func() {
+				suite.sectorRepo.On("FetchSectorById", mock.Anything, 1).Return(repo.Sector{ID: 1, Name: "Masonry"}, nil)
+				suite.sectorRepo.On("FetchSectorById", mock.Anything, 2).Return(repo.Sector{}, apperrors.ErrNoSectorExists)
+			},
+			expectedOutput: Sector{},
+			expectedError:  apperrors.ErrNoSectorExists,
+		},
+	}
+
+	for _, test := range testCases {
+		suite.SetupTest()
+		suite.Run(test.name, func() {
+			test.setup()
+
+			sector, err := suite.service.MergeSector(context.Background(), test.sectorId, test.targetId)
+			suite.Equal(test.expectedOutput, sector)
+			suite.Equal(test.expectedError, err)
+		})
+		suite.TearDownTest()
+	}
+}
+
+func (suite *ServiceTestSuite) TestFetchSectorStats() {
+	type testCase struct {
+		name           string
+		sectorId       int
+		setup          func()
+		expectedOutput SectorStats
+		expectedError  error
+	}
+	testCases := []testCase{
+		{
+			name:     "fetch sector stats success",
+			sectorId: 1,
+			setup: func() {
+				suite.sectorRepo.On("FetchSectorById", mock.Anything, 1).Return(repo.Sector{ID: 1, Name: "Construction"}, nil)
+				suite.sectorRepo.On("FetchSectorStats", mock.Anything, repo.Sector{ID: 1, Name: "Construction"}).Return(repo.SectorStats{
+					Workers:     10,
+					Employers:   2,
+					Jobs:        5,
+					OpenJobs:    3,
+					Skills:      4,
+					AverageWage: 650.5,
+				}, nil)
+			},
+			expectedOutput: SectorStats{
+				SectorID:    1,
+				Workers:     10,
+				Employers:   2,
+				Jobs:        5,
+				OpenJobs:    3,
+				Skills:      4,
+				AverageWage: 650.5,
+			},
+			expectedError: nil,
+		}, {
+			name:     "fetch sector stats error",
+			sectorId: 1,
+			setup: func() {
+				suite.sectorRepo.On("FetchSectorById", mock.Anything, 1).Return(repo.Sector{ID: 1, Name: "Construction"}, nil)
+				suite.sectorRepo.On("FetchSectorStats", mock.Anything, repo.Sector{ID: 1, Name: "Construction"}).Return(repo.SectorStats{}, errors.New("db fetch error"))
+			},
+			expectedOutput: SectorStats{},
+			expectedError:  errors.New("db fetch error"),
+		},
+	}
+
+	for _, test := range testCases {
+		suite.SetupTest()
+		suite.Run(test.name, func() {
+			test.setup()
+
+			stats, err := suite.service.FetchSectorStats(context.Background(), test.sectorId)
+			suite.Equal(test.expectedOutput, stats)
+			suite.Equal(test.expectedError, err)
+		})
+		suite.TearDownTest()
+	}
+}
+
 func TestSectorServciceTestSuite(t *testing.T) {
 	suite.Run(t, new(ServiceTestSuite))
 }
@@ -0,0 +1,17 @@
+package skill
+
+type Skill struct {
+	ID          int       `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	ParentID    int       `json:"parent_id,omitempty"`
+	SectorIDs   []int     `json:"sector_ids"`
+	Synonyms    []Synonym `json:"synonyms,omitempty"`
+	Children    []Skill   `json:"children,omitempty"`
+}
+
+type Synonym struct {
+	ID      int    `json:"id"`
+	SkillID int    `json:"skill_id"`
+	Synonym string `json:"synonym"`
+}
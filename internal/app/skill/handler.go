@@ -0,0 +1,209 @@
+package skill
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/logger"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/middleware"
+	"go.uber.org/zap"
+)
+
+func CreateSkill(skillService Service) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		var skillData Skill
+		err := json.NewDecoder(r.Body).Decode(&skillData)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrInvalidRequestBody.Error(), zap.Error(err))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrInvalidRequestBody.Error()+": "+err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		createdSkill, err := skillService.CreateSkill(ctx, skillData)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrCreateSkill.Error(), zap.Error(err))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrCreateSkill.Error()+": "+err.Error(), skillErrorStatusCode(err))
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, "successfully created new skill", http.StatusCreated, createdSkill)
+	}
+}
+
+func FetchSkillById(skillService Service) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		skillId, id := isSkillIdValid(ctx, w, r, apperrors.ErrFetchSkill)
+		if skillId == -1 {
+			return
+		}
+
+		skill, err := skillService.FetchSkillById(ctx, skillId)
+		if err != nil {
+			if errors.Is(err, apperrors.ErrNoSkillExists) {
+				logger.Errorw(ctx, apperrors.ErrNoSkillExists.Error(), zap.Error(err), zap.String("ID", id))
+				httpResponseMsg := apperrors.HttpErrorResponseMessage(apperrors.MsgFailedToFetchSkill, apperrors.ErrNoSkillExists.Error(), id)
+				middleware.HandleErrorResponse(ctx, w, httpResponseMsg, http.StatusNotFound)
+				return
+			}
+
+			logger.Errorw(ctx, apperrors.MsgFetchFromDbErr, zap.Error(err), zap.String("ID", id))
+			middleware.HandleErrorResponse(ctx, w, apperrors.MsgFailedToFetchSkill+", "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, "skill details retrieved successfully", http.StatusOK, skill)
+	}
+}
+
+func UpdateSkillById(skillService Service) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		skillId, _ := isSkillIdValid(ctx, w, r, apperrors.ErrUpdateSkill)
+		if skillId == -1 {
+			return
+		}
+
+		var skillData Skill
+		err := json.NewDecoder(r.Body).Decode(&skillData)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrInvalidRequestBody.Error(), zap.Error(err))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrInvalidRequestBody.Error()+": "+err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		skillData.ID = skillId
+		updatedSkill, err := skillService.UpdateSkillById(ctx, skillData)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrUpdateSkill.Error(), zap.Error(err))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrUpdateSkill.Error()+": "+err.Error(), skillErrorStatusCode(err))
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, "successfully updated skill details", http.StatusOK, updatedSkill)
+	}
+}
+
+func DeleteSkillById(skillService Service) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		skillId, id := isSkillIdValid(ctx, w, r, apperrors.ErrDeleteSkill)
+		if skillId == -1 {
+			return
+		}
+
+		_, err := skillService.DeleteSkillById(ctx, skillId)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrDeleteSkill.Error(), zap.Error(err), zap.String("ID", id))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrDeleteSkill.Error()+", "+err.Error(), skillErrorStatusCode(err))
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func FetchAllSkills(skillService Service) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		skills, err := skillService.FetchAllSkills(ctx)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrFetchSkill.Error(), zap.Error(err))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrFetchSkill.Error()+", "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		middleware.HandleSuccessResponse(ctx, w, "successfully fetched all skills", http.StatusOK, skills)
+	}
+}
+
+func AddSkillSynonym(skillService Service) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		skillId, _ := isSkillIdValid(ctx, w, r, apperrors.ErrCreateSkillSynonym)
+		if skillId == -1 {
+			return
+		}
+
+		var synonymData Synonym
+		err := json.NewDecoder(r.Body).Decode(&synonymData)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrInvalidRequestBody.Error(), zap.Error(err))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrInvalidRequestBody.Error()+": "+err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		synonymData.SkillID = skillId
+		synonym, err := skillService.AddSkillSynonym(ctx, synonymData)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrCreateSkillSynonym.Error(), zap.Error(err))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrCreateSkillSynonym.Error()+": "+err.Error(), skillErrorStatusCode(err))
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, "successfully added skill synonym", http.StatusCreated, synonym)
+	}
+}
+
+func DeleteSkillSynonym(skillService Service) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		skillId, _ := isSkillIdValid(ctx, w, r, apperrors.ErrDeleteSkillSynonym)
+		if skillId == -1 {
+			return
+		}
+
+		id := mux.Vars(r)["synonym_id"]
+		synonymId, err := strconv.Atoi(id)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.MsgInvalidSynonymId, zap.Error(err), zap.String("ID", id))
+			httpResponseMsg := apperrors.HttpErrorResponseMessage(apperrors.ErrDeleteSkillSynonym.Error(), apperrors.MsgInvalidSynonymId, id)
+			middleware.HandleErrorResponse(ctx, w, httpResponseMsg, http.StatusBadRequest)
+			return
+		}
+
+		_, err = skillService.DeleteSkillSynonym(ctx, skillId, synonymId)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrDeleteSkillSynonym.Error(), zap.Error(err), zap.String("ID", id))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrDeleteSkillSynonym.Error()+", "+err.Error(), skillErrorStatusCode(err))
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func isSkillIdValid(ctx context.Context, w http.ResponseWriter, r *http.Request, errType error) (int, string) {
+	vars := mux.Vars(r)
+	id := vars["skill_id"]
+	skillId, err := strconv.Atoi(id)
+	if err != nil {
+		logger.Errorw(ctx, apperrors.MsgInvalidSkillId, zap.Error(err), zap.String("ID", id))
+		httpResponseMsg := apperrors.HttpErrorResponseMessage(errType.Error(), apperrors.MsgInvalidSkillId, id)
+		middleware.HandleErrorResponse(ctx, w, httpResponseMsg, http.StatusBadRequest)
+		return -1, id
+	}
+	return skillId, id
+}
+
+func skillErrorStatusCode(err error) int {
+	switch {
+	case errors.Is(err, apperrors.ErrInvalidSkillDetails), errors.Is(err, apperrors.ErrSkillParentCycle):
+		return http.StatusBadRequest
+	case errors.Is(err, apperrors.ErrNoSkillExists), errors.Is(err, apperrors.ErrNoSkillSynonymExists), errors.Is(err, apperrors.ErrNoSectorExists):
+		return http.StatusNotFound
+	case errors.Is(err, apperrors.ErrSkillSynonymExists):
+		return http.StatusConflict
+	}
+	return http.StatusInternalServerError
+}
@@ -0,0 +1,50 @@
+package skill
+
+import (
+	"strings"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo"
+)
+
+func MapSkillRepoToService(skill repo.Skill) Skill {
+	return Skill{
+		ID:          skill.ID,
+		Name:        skill.Name,
+		Description: skill.Description,
+		ParentID:    skill.ParentID,
+	}
+}
+
+func MapSkillServiceToRepo(skill Skill) repo.Skill {
+	return repo.Skill{
+		ID:          skill.ID,
+		Name:        skill.Name,
+		Description: skill.Description,
+		ParentID:    skill.ParentID,
+	}
+}
+
+func MapSynonymRepoToService(synonym repo.SkillSynonym) Synonym {
+	return Synonym{
+		ID:      synonym.ID,
+		SkillID: synonym.SkillID,
+		Synonym: synonym.Synonym,
+	}
+}
+
+// NormalizeSkillTerm lowercases a skill term and collapses repeated whitespace, so "Raj  Mistri" and "raj mistri" compare equal
+func NormalizeSkillTerm(term string) string {
+	return strings.Join(strings.Fields(strings.ToLower(term)), " ")
+}
+
+// SplitSkills splits a comma separated skills string into normalized, non-empty terms
+func SplitSkills(skills string) []string {
+	terms := make([]string, 0)
+	for _, term := range strings.Split(skills, ",") {
+		term = NormalizeSkillTerm(term)
+		if term != "" {
+			terms = append(terms, term)
+		}
+	}
+	return terms
+}
@@ -0,0 +1,250 @@
+package skill
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo"
+)
+
+type skillService struct {
+	skillRepo repo.SkillStorer
+}
+
+type Service interface {
+	CreateSkill(ctx context.Context, skillData Skill) (Skill, error)
+	FetchSkillById(ctx context.Context, skillId int) (Skill, error)
+	UpdateSkillById(ctx context.Context, skillData Skill) (Skill, error)
+	DeleteSkillById(ctx context.Context, skillId int) (int, error)
+	FetchAllSkills(ctx context.Context) ([]Skill, error)
+	AddSkillSynonym(ctx context.Context, synonymData Synonym) (Synonym, error)
+	DeleteSkillSynonym(ctx context.Context, skillId int, synonymId int) (int, error)
+	NormalizeSkills(ctx context.Context, skills string) (string, error)
+}
+
+func NewService(skillRepo repo.SkillStorer) Service {
+	return &skillService{
+		skillRepo: skillRepo,
+	}
+}
+
+func (skillS *skillService) CreateSkill(ctx context.Context, skillData Skill) (Skill, error) {
+	err := validateSkill(skillData)
+	if err != nil {
+		return Skill{}, err
+	}
+
+	err = skillS.checkTermAvailable(ctx, skillData.Name, 0)
+	if err != nil {
+		return Skill{}, err
+	}
+
+	if skillData.ParentID != 0 {
+		_, err = skillS.skillRepo.FetchSkillById(ctx, skillData.ParentID)
+		if err != nil {
+			return Skill{}, err
+		}
+	}
+
+	createdSkill, err := skillS.skillRepo.CreateSkill(ctx, MapSkillServiceToRepo(skillData), skillData.SectorIDs)
+	if err != nil {
+		return Skill{}, err
+	}
+
+	skill := MapSkillRepoToService(createdSkill)
+	skill.SectorIDs = skillData.SectorIDs
+	return skill, nil
+}
+
+// FetchSkillById returns the skill along with its sectors, synonyms and direct children
+func (skillS *skillService) FetchSkillById(ctx context.Context, skillId int) (Skill, error) {
+	repoSkill, err := skillS.skillRepo.FetchSkillById(ctx, skillId)
+	if err != nil {
+		return Skill{}, err
+	}
+
+	skill := MapSkillRepoToService(repoSkill)
+
+	skill.SectorIDs, err = skillS.skillRepo.FetchSectorIdsBySkillId(ctx, skillId)
+	if err != nil {
+		return Skill{}, err
+	}
+
+	synonyms, err := skillS.skillRepo.FetchSynonymsBySkillId(ctx, skillId)
+	if err != nil {
+		return Skill{}, err
+	}
+	for _, synonym := range synonyms {
+		skill.Synonyms = append(skill.Synonyms, MapSynonymRepoToService(synonym))
+	}
+
+	children, err := skillS.skillRepo.FetchChildSkills(ctx, skillId)
+	if err != nil {
+		return Skill{}, err
+	}
+	for _, child := range children {
+		skill.Children = append(skill.Children, MapSkillRepoToService(child))
+	}
+
+	return skill, nil
+}
+
+func (skillS *skillService) UpdateSkillById(ctx context.Context, skillData Skill) (Skill, error) {
+	err := validateSkill(skillData)
+	if err != nil {
+		return Skill{}, err
+	}
+
+	_, err = skillS.skillRepo.FetchSkillById(ctx, skillData.ID)
+	if err != nil {
+		return Skill{}, err
+	}
+
+	err = skillS.checkTermAvailable(ctx, skillData.Name, skillData.ID)
+	if err != nil {
+		return Skill{}, err
+	}
+
+	err = skillS.checkParentCycle(ctx, skillData.ID, skillData.ParentID)
+	if err != nil {
+		return Skill{}, err
+	}
+
+	updatedSkill, err := skillS.skillRepo.UpdateSkillById(ctx, MapSkillServiceToRepo(skillData), skillData.SectorIDs)
+	if err != nil {
+		return Skill{}, err
+	}
+
+	skill := MapSkillRepoToService(updatedSkill)
+	skill.SectorIDs = skillData.SectorIDs
+	return skill, nil
+}
+
+func (skillS *skillService) DeleteSkillById(ctx context.Context, skillId int) (int, error) {
+	id, err := skillS.skillRepo.DeleteSkillById(ctx, skillId)
+	if err != nil {
+		return -1, err
+	}
+	return id, nil
+}
+
+func (skillS *skillService) FetchAllSkills(ctx context.Context) ([]Skill, error) {
+	repoSkills, err := skillS.skillRepo.FetchAllSkills(ctx)
+	if err != nil {
+		return []Skill{}, err
+	}
+
+	skills := make([]Skill, 0)
+	for _, val := range repoSkills {
+		skills = append(skills, MapSkillRepoToService(val))
+	}
+
+	return skills, nil
+}
+
+func (skillS *skillService) AddSkillSynonym(ctx context.Context, synonymData Synonym) (Synonym, error) {
+	synonymData.Synonym = NormalizeSkillTerm(synonymData.Synonym)
+	if synonymData.Synonym == "" {
+		return Synonym{}, fmt.Errorf("%w: synonym cannot be empty", apperrors.ErrInvalidSkillDetails)
+	}
+
+	_, err := skillS.skillRepo.FetchSkillById(ctx, synonymData.SkillID)
+	if err != nil {
+		return Synonym{}, err
+	}
+
+	err = skillS.checkTermAvailable(ctx, synonymData.Synonym, 0)
+	if err != nil {
+		return Synonym{}, err
+	}
+
+	synonym, err := skillS.skillRepo.AddSkillSynonym(ctx, repo.SkillSynonym{
+		SkillID: synonymData.SkillID,
+		Synonym: synonymData.Synonym,
+	})
+	if err != nil {
+		return Synonym{}, err
+	}
+
+	return MapSynonymRepoToService(synonym), nil
+}
+
+func (skillS *skillService) DeleteSkillSynonym(ctx context.Context, skillId int, synonymId int) (int, error) {
+	id, err := skillS.skillRepo.DeleteSkillSynonym(ctx, skillId, synonymId)
+	if err != nil {
+		return -1, err
+	}
+	return id, nil
+}
+
+// NormalizeSkills maps every comma separated term to the canonical skill name it is a name or synonym of,
+// keeps unknown terms in their normalized form and removes duplicates while preserving order
+func (skillS *skillService) NormalizeSkills(ctx context.Context, skills string) (string, error) {
+	normalized := make([]string, 0)
+	seen := make(map[string]bool)
+
+	for _, term := range SplitSkills(skills) {
+		name := term
+
+		skill, err := skillS.skillRepo.FindSkillByTerm(ctx, term)
+		if err == nil {
+			name = skill.Name
+		} else if !errors.Is(err, apperrors.ErrNoSkillExists) {
+			return "", err
+		}
+
+		key := NormalizeSkillTerm(name)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		normalized = append(normalized, name)
+	}
+
+	return strings.Join(normalized, ", "), nil
+}
+
+func validateSkill(skillData Skill) error {
+	if NormalizeSkillTerm(skillData.Name) == "" {
+		return fmt.Errorf("%w: skill name cannot be empty", apperrors.ErrInvalidSkillDetails)
+	}
+	if len(skillData.SectorIDs) == 0 {
+		return fmt.Errorf("%w: skill must be linked to at least one sector", apperrors.ErrInvalidSkillDetails)
+	}
+	return nil
+}
+
+// checkTermAvailable makes sure no other skill already uses the term as its name or synonym
+func (skillS *skillService) checkTermAvailable(ctx context.Context, term string, skillId int) error {
+	existing, err := skillS.skillRepo.FindSkillByTerm(ctx, NormalizeSkillTerm(term))
+	if err != nil {
+		if errors.Is(err, apperrors.ErrNoSkillExists) {
+			return nil
+		}
+		return err
+	}
+
+	if existing.ID != skillId {
+		return apperrors.ErrSkillSynonymExists
+	}
+	return nil
+}
+
+// checkParentCycle walks up the ancestors of the new parent and rejects the update if the skill itself is found
+func (skillS *skillService) checkParentCycle(ctx context.Context, skillId int, parentId int) error {
+	for parentId != 0 {
+		if parentId == skillId {
+			return apperrors.ErrSkillParentCycle
+		}
+
+		parent, err := skillS.skillRepo.FetchSkillById(ctx, parentId)
+		if err != nil {
+			return err
+		}
+		parentId = parent.ParentID
+	}
+	return nil
+}
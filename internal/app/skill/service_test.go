@@ -0,0 +1,263 @@
+package skill
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type SkillServiceTestSuite struct {
+	suite.Suite
+	service   Service
+	skillRepo mocks.SkillStorer
+}
+
+func (suite *SkillServiceTestSuite) SetupTest() {
+	suite.skillRepo = mocks.SkillStorer{}
+	suite.service = NewService(&suite.skillRepo)
+}
+
+func (suite *SkillServiceTestSuite) TearDownTest() {
+	suite.skillRepo.AssertExpectations(suite.T())
+}
+
+func TestSkillServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(SkillServiceTestSuite))
+}
+
+func (suite *SkillServiceTestSuite) TestNormalizeSkills() {
+	type testCase struct {
+		name           string
+		input          string
+		setup          func()
+		expectedOutput string
+		expectedError  bool
+	}
+
+	testCases := []testCase{
+		{
+			name:  "synonyms mapped to canonical skill",
+			input: "Mason, masonry,  Raj   Mistri",
+			setup: func() {
+				masonry := repo.Skill{ID: 1, Name: "Masonry"}
+				suite.skillRepo.On("FindSkillByTerm", mock.Anything, "mason").Return(masonry, nil)
+				suite.skillRepo.On("FindSkillByTerm", mock.Anything, "masonry").Return(masonry, nil)
+				suite.skillRepo.On("FindSkillByTerm", mock.Anything, "raj mistri").Return(masonry, nil)
+			},
+			expectedOutput: "Masonry",
+			expectedError:  false,
+		},
+		{
+			name:  "unknown skills kept in normalized form",
+			input: "Plumbing, Tile  Fitting,",
+			setup: func() {
+				suite.skillRepo.On("FindSkillByTerm", mock.Anything, "plumbing").Return(repo.Skill{ID: 2, Name: "Plumbing"}, nil)
+				suite.skillRepo.On("FindSkillByTerm", mock.Anything, "tile fitting").Return(repo.Skill{}, apperrors.ErrNoSkillExists)
+			},
+			expectedOutput: "Plumbing, tile fitting",
+			expectedError:  false,
+		},
+		{
+			name:           "empty skills",
+			input:          " , ",
+			setup:          func() {},
+			expectedOutput: "",
+			expectedError:  false,
+		},
+		{
+			name:  "error from db",
+			input: "mason",
+			setup: func() {
+				suite.skillRepo.On("FindSkillByTerm", mock.Anything, "mason").Return(repo.Skill{}, errors.New("some db error"))
+			},
+			expectedOutput: "",
+			expectedError:  true,
+		},
+	}
+
+	for _, test := range testCases {
+		suite.SetupTest()
+		suite.Run(test.name, func() {
+			test.setup()
+
+			skills, err := suite.service.NormalizeSkills(context.Background(), test.input)
+			suite.Equal(test.expectedOutput, skills)
+			suite.Equal(test.expectedError, err != nil)
+		})
+		suite.TearDownTest()
+	}
+}
+
+func (suite *SkillServiceTestSuite) TestCreateSkill() {
+	type testCase struct {
+		name           string
+		input          Skill
+		setup          func()
+		expectedOutput Skill
+		expectedError  error
+	}
+
+	testCases := []testCase{
+		{
+			name:  "success",
+			input: Skill{Name: "Masonry", Description: "Brick and stone work", SectorIDs: []int{1}},
+			setup: func() {
+				suite.skillRepo.On("FindSkillByTerm", mock.Anything, "masonry").Return(repo.Skill{}, apperrors.ErrNoSkillExists)
+				suite.skillRepo.On("CreateSkill", mock.Anything, repo.Skill{Name: "Masonry", Description: "Brick and stone work"}, []int{1}).Return(repo.Skill{ID: 1, Name: "Masonry", Description: "Brick and stone work"}, nil)
+			},
+			expectedOutput: Skill{ID: 1, Name: "Masonry", Description: "Brick and stone work", SectorIDs: []int{1}},
+			expectedError:  nil,
+		},
+		{
+			name:  "sector not found",
+			input: Skill{Name: "Masonry", SectorIDs: []int{1, 99}},
+			setup: func() {
+				suite.skillRepo.On("FindSkillByTerm", mock.Anything, "masonry").Return(repo.Skill{}, apperrors.ErrNoSkillExists)
+				suite.skillRepo.On("CreateSkill", mock.Anything, repo.Skill{Name: "Masonry"}, []int{1, 99}).Return(repo.Skill{}, apperrors.ErrNoSectorExists)
+			},
+			expectedOutput: Skill{},
+			expectedError:  apperrors.ErrNoSectorExists,
+		},
+		{
+			name:           "missing sectors",
+			input:          Skill{Name: "Masonry"},
+			setup:          func() {},
+			expectedOutput: Skill{},
+			expectedError:  apperrors.ErrInvalidSkillDetails,
+		},
+		{
+			name:  "name already used as synonym",
+			input: Skill{Name: "Mason", SectorIDs: []int{1}},
+			setup: func() {
+				suite.skillRepo.On("FindSkillByTerm", mock.Anything, "mason").Return(repo.Skill{ID: 1, Name: "Masonry"}, nil)
+			},
+			expectedOutput: Skill{},
+			expectedError:  apperrors.ErrSkillSynonymExists,
+		},
+		{
+			name:  "parent skill not found",
+			input: Skill{Name: "Tiling", ParentID: 5, SectorIDs: []int{1}},
+			setup: func() {
+				suite.skillRepo.On("FindSkillByTerm", mock.Anything, "tiling").Return(repo.Skill{}, apperrors.ErrNoSkillExists)
+				suite.skillRepo.On("FetchSkillById", mock.Anything, 5).Return(repo.Skill{}, apperrors.ErrNoSkillExists)
+			},
+			expectedOutput: Skill{},
+			expectedError:  apperrors.ErrNoSkillExists,
+		},
+	}
+
+	for _, test := range testCases {
+		suite.SetupTest()
+		suite.Run(test.name, func() {
+			test.setup()
+
+			skill, err := suite.service.CreateSkill(context.Background(), test.input)
+			suite.Equal(test.expectedOutput, skill)
+			suite.ErrorIs(err, test.expectedError)
+		})
+		suite.TearDownTest()
+	}
+}
+
+func (suite *SkillServiceTestSuite) TestUpdateSkillById() {
+	type testCase struct {
+		name          string
+		input         Skill
+		setup         func()
+		expectedError error
+	}
+
+	testCases := []testCase{
+		{
+			name:  "success",
+			input: Skill{ID: 2, Name: "Tiling", ParentID: 1, SectorIDs: []int{1, 2}},
+			setup: func() {
+				suite.skillRepo.On("FetchSkillById", mock.Anything, 2).Return(repo.Skill{ID: 2, Name: "Tiling"}, nil)
+				suite.skillRepo.On("FindSkillByTerm", mock.Anything, "tiling").Return(repo.Skill{ID: 2, Name: "Tiling"}, nil)
+				suite.skillRepo.On("FetchSkillById", mock.Anything, 1).Return(repo.Skill{ID: 1, Name: "Masonry"}, nil)
+				suite.skillRepo.On("UpdateSkillById", mock.Anything, repo.Skill{ID: 2, Name: "Tiling", ParentID: 1}, []int{1, 2}).Return(repo.Skill{ID: 2, Name: "Tiling", ParentID: 1}, nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:  "parent is a descendant",
+			input: Skill{ID: 1, Name: "Masonry", ParentID: 2, SectorIDs: []int{1}},
+			setup: func() {
+				suite.skillRepo.On("FetchSkillById", mock.Anything, 1).Return(repo.Skill{ID: 1, Name: "Masonry"}, nil)
+				suite.skillRepo.On("FindSkillByTerm", mock.Anything, "masonry").Return(repo.Skill{ID: 1, Name: "Masonry"}, nil)
+				suite.skillRepo.On("FetchSkillById", mock.Anything, 2).Return(repo.Skill{ID: 2, Name: "Tiling", ParentID: 1}, nil)
+			},
+			expectedError: apperrors.ErrSkillParentCycle,
+		},
+	}
+
+	for _, test := range testCases {
+		suite.SetupTest()
+		suite.Run(test.name, func() {
+			test.setup()
+
+			_, err := suite.service.UpdateSkillById(context.Background(), test.input)
+			suite.ErrorIs(err, test.expectedError)
+		})
+		suite.TearDownTest()
+	}
+}
+
+func (suite *SkillServiceTestSuite) TestAddSkillSynonym() {
+	type testCase struct {
+		name           string
+		input          Synonym
+		setup          func()
+		expectedOutput Synonym
+		expectedError  error
+	}
+
+	testCases := []testCase{
+		{
+			name:  "success",
+			input: Synonym{SkillID: 1, Synonym: " Raj Mistri "},
+			setup: func() {
+				suite.skillRepo.On("FetchSkillById", mock.Anything, 1).Return(repo.Skill{ID: 1, Name: "Masonry"}, nil)
+				suite.skillRepo.On("FindSkillByTerm", mock.Anything, "raj mistri").Return(repo.Skill{}, apperrors.ErrNoSkillExists)
+				suite.skillRepo.On("AddSkillSynonym", mock.Anything, repo.SkillSynonym{SkillID: 1, Synonym: "raj mistri"}).Return(repo.SkillSynonym{ID: 1, SkillID: 1, Synonym: "raj mistri"}, nil)
+			},
+			expectedOutput: Synonym{ID: 1, SkillID: 1, Synonym: "raj mistri"},
+			expectedError:  nil,
+		},
+		{
+			name:           "empty synonym",
+			input:          Synonym{SkillID: 1, Synonym: "  "},
+			setup:          func() {},
+			expectedOutput: Synonym{},
+			expectedError:  apperrors.ErrInvalidSkillDetails,
+		},
+		{
+			name:  "synonym already used",
+			input: Synonym{SkillID: 1, Synonym: "mason"},
+			setup: func() {
+				suite.skillRepo.On("FetchSkillById", mock.Anything, 1).Return(repo.Skill{ID: 1, Name: "Masonry"}, nil)
+				suite.skillRepo.On("FindSkillByTerm", mock.Anything, "mason").Return(repo.Skill{ID: 1, Name: "Masonry"}, nil)
+			},
+			expectedOutput: Synonym{},
+			expectedError:  apperrors.ErrSkillSynonymExists,
+		},
+	}
+
+	for _, test := range testCases {
+		suite.SetupTest()
+		suite.Run(test.name, func() {
+			test.setup()
+
+			synonym, err := suite.service.AddSkillSynonym(context.Background(), test.input)
+			suite.Equal(test.expectedOutput, synonym)
+			suite.ErrorIs(err, test.expectedError)
+		})
+		suite.TearDownTest()
+	}
+}
@@ -0,0 +1,56 @@
+package stream
+
+import (
+	"time"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/outbox"
+)
+
+const (
+	WorkerRecipient   = "worker"
+	EmployerRecipient = "employer"
+)
+
+// ReplayTruncated is sent after a replay that did not fit in replayLimit, clients should refetch
+// their applications and messages instead of relying on the replayed events
+const ReplayTruncated = "replay_truncated"
+
+// Events are the outbox events pushed to connected clients
+var Events = []outbox.EventType{
+	outbox.ApplicationSubmitted,
+	outbox.ApplicationStatusChanged,
+	outbox.MessageSent,
+}
+
+// recipientEvents lists the events streamed to each role, employers learn about new applications
+// while both sides of an application see its status changes and messages
+var recipientEvents = map[string][]outbox.EventType{
+	WorkerRecipient:   {outbox.ApplicationStatusChanged, outbox.MessageSent},
+	EmployerRecipient: {outbox.ApplicationSubmitted, outbox.ApplicationStatusChanged, outbox.MessageSent},
+}
+
+// Recipient is the worker or employer a connection streams updates to
+type Recipient struct {
+	Role string
+	ID   int
+}
+
+// Event is an update pushed to clients, its id is the id of the outbox event it comes from and
+// is sent as the SSE event id so that clients can resume from it
+type Event struct {
+	ID   int
+	Type outbox.EventType
+	Data EventData
+}
+
+type EventData struct {
+	ApplicationID  int       `json:"application_id,omitempty"`
+	JobID          int       `json:"job_id,omitempty"`
+	WorkerID       int       `json:"worker_id,omitempty"`
+	EmployerID     int       `json:"employer_id,omitempty"`
+	MessageID      int       `json:"message_id,omitempty"`
+	SenderRole     string    `json:"sender_role,omitempty"`
+	Status         string    `json:"status,omitempty"`
+	PreviousStatus string    `json:"previous_status,omitempty"`
+	OccurredAt     time.Time `json:"occurred_at"`
+}
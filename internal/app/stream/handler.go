@@ -0,0 +1,130 @@
+package stream
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/logger"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/middleware"
+	"go.uber.org/zap"
+)
+
+const (
+	// comments are written this often so that proxies keep idle connections open and clients
+	// notice dropped connections
+	heartbeatInterval = 15 * time.Second
+	// reconnection delay suggested to clients, in milliseconds
+	retryInterval = 3000
+)
+
+// StreamUpdates streams the application and message updates of the logged in worker or employer
+// as server-sent events, clients resume from the Last-Event-ID header (or the last_event_id query
+// parameter for clients that cannot set headers) after reconnecting
+func StreamUpdates(streamService Service) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		userId, ok := ctx.Value("user_id").(int)
+		role, _ := ctx.Value("role").(string)
+		if !ok {
+			logger.Errorw(ctx, apperrors.ErrUnauthorizedStream.Error())
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrUnauthorizedStream.Error(), http.StatusUnauthorized)
+			return
+		}
+
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			logger.Errorw(ctx, apperrors.ErrStreamingUnsupported.Error())
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrStreamingUnsupported.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		lastEventId := r.Header.Get("Last-Event-ID")
+		if lastEventId == "" {
+			lastEventId = r.URL.Query().Get("last_event_id")
+		}
+		afterId := 0
+		if lastEventId != "" {
+			id, err := strconv.Atoi(lastEventId)
+			if err != nil || id < 0 {
+				logger.Errorw(ctx, apperrors.MsgInvalidLastEventId, zap.String("ID", lastEventId))
+				httpResponseMsg := apperrors.HttpErrorResponseMessage(apperrors.ErrOpenStream.Error(), apperrors.MsgInvalidLastEventId, lastEventId)
+				middleware.HandleErrorResponse(ctx, w, httpResponseMsg, http.StatusBadRequest)
+				return
+			}
+			afterId = id
+		}
+
+		sub, err := streamService.Subscribe(ctx, Recipient{Role: role, ID: userId}, afterId)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrOpenStream.Error(), zap.Error(err), zap.Int("user_id", userId), zap.String("role", role))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrOpenStream.Error()+", "+err.Error(), streamErrorStatusCode(err))
+			return
+		}
+		defer sub.Close()
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.Header().Set("Connection", "keep-alive")
+		w.Header().Set("X-Accel-Buffering", "no")
+		w.WriteHeader(http.StatusOK)
+
+		_, err = fmt.Fprintf(w, "retry: %d\n\n", retryInterval)
+		if err != nil {
+			return
+		}
+
+		for _, event := range sub.Replay {
+			err = writeEvent(w, event)
+			if err != nil {
+				return
+			}
+		}
+		if sub.ReplayTruncated {
+			_, err = fmt.Fprintf(w, "event: %s\ndata: {}\n\n", ReplayTruncated)
+			if err != nil {
+				return
+			}
+		}
+		flusher.Flush()
+
+		heartbeat := time.NewTicker(heartbeatInterval)
+		defer heartbeat.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-heartbeat.C:
+				_, err = fmt.Fprint(w, ": heartbeat\n\n")
+			case event, ok := <-sub.Events:
+				if !ok {
+					// dropped for falling behind, the client reconnects and replays what it missed
+					return
+				}
+				if sub.IsReplayed(event.ID) {
+					continue
+				}
+				err = writeEvent(w, event)
+			}
+			if err != nil {
+				return
+			}
+			flusher.Flush()
+		}
+	}
+}
+
+func streamErrorStatusCode(err error) int {
+	switch {
+	case errors.Is(err, apperrors.ErrUnauthorizedStream):
+		return http.StatusForbidden
+	case errors.Is(err, apperrors.ErrTooManyStreams):
+		return http.StatusTooManyRequests
+	}
+	return http.StatusInternalServerError
+}
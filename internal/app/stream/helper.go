@@ -0,0 +1,59 @@
+package stream
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/outbox"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo"
+)
+
+func MapOutboxEventToService(event outbox.Event) Event {
+	return Event{
+		ID:   event.ID,
+		Type: event.Type,
+		Data: EventData{
+			ApplicationID:  event.ApplicationID,
+			JobID:          event.JobID,
+			WorkerID:       event.WorkerID,
+			EmployerID:     event.EmployerID,
+			MessageID:      event.MessageID,
+			SenderRole:     event.SenderRole,
+			Status:         event.Status,
+			PreviousStatus: event.PreviousStatus,
+			OccurredAt:     event.OccurredAt,
+		},
+	}
+}
+
+func MapOutboxEventRepoToService(event repo.OutboxEvent) (Event, error) {
+	outboxEvent, err := outbox.MapOutboxEventRepoToService(event)
+	if err != nil {
+		return Event{}, err
+	}
+	return MapOutboxEventToService(outboxEvent), nil
+}
+
+func streams(role string, eventType outbox.EventType) bool {
+	return slices.Contains(recipientEvents[role], eventType)
+}
+
+func eventTypes(role string) []string {
+	types := make([]string, 0)
+	for _, eventType := range recipientEvents[role] {
+		types = append(types, string(eventType))
+	}
+	return types
+}
+
+// writeEvent writes an event in the server-sent events format
+func writeEvent(w io.Writer, event Event) error {
+	data, err := json.Marshal(event.Data)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", event.ID, event.Type, data)
+	return err
+}
@@ -0,0 +1,113 @@
+package stream
+
+import (
+	"sync"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+)
+
+// Subscription is an open stream of a recipient. Replay holds the events missed since the last
+// event id the client reconnected with, Events receives the live events and is closed when the
+// subscription is dropped.
+type Subscription struct {
+	Recipient       Recipient
+	Replay          []Event
+	ReplayTruncated bool
+	Events          <-chan Event
+
+	events   chan Event
+	replayed map[int]bool
+	registry *registry
+}
+
+// Close removes the subscription from the registry, it is safe to call more than once
+func (sub *Subscription) Close() {
+	sub.registry.remove(sub)
+}
+
+// IsReplayed reports whether a live event was already sent in the replay, events published while
+// the replay is fetched arrive on both
+func (sub *Subscription) IsReplayed(eventId int) bool {
+	return sub.replayed[eventId]
+}
+
+// registry tracks the open subscriptions of every recipient. Live events are handed to
+// subscriptions without blocking, a subscription whose buffer is full is dropped and its client
+// catches up by reconnecting with the last event id it received.
+type registry struct {
+	mu            sync.Mutex
+	subscriptions map[Recipient]map[*Subscription]struct{}
+	count         int
+}
+
+func newRegistry() *registry {
+	return &registry{subscriptions: make(map[Recipient]map[*Subscription]struct{})}
+}
+
+func (reg *registry) add(recipient Recipient) (*Subscription, error) {
+	reg.mu.Lock()
+	defer reg.mu.Unlock()
+
+	if reg.count >= maxConnections || len(reg.subscriptions[recipient]) >= maxConnectionsPerRecipient {
+		return nil, apperrors.ErrTooManyStreams
+	}
+
+	events := make(chan Event, bufferSize)
+	sub := &Subscription{
+		Recipient: recipient,
+		Events:    events,
+		events:    events,
+		replayed:  make(map[int]bool),
+		registry:  reg,
+	}
+
+	if reg.subscriptions[recipient] == nil {
+		reg.subscriptions[recipient] = make(map[*Subscription]struct{})
+	}
+	reg.subscriptions[recipient][sub] = struct{}{}
+	reg.count++
+	return sub, nil
+}
+
+func (reg *registry) remove(sub *Subscription) {
+	reg.mu.Lock()
+	defer reg.mu.Unlock()
+	reg.drop(sub)
+}
+
+// drop must be called with the lock held
+func (reg *registry) drop(sub *Subscription) {
+	subscriptions, ok := reg.subscriptions[sub.Recipient]
+	if !ok {
+		return
+	}
+	if _, ok := subscriptions[sub]; !ok {
+		return
+	}
+
+	delete(subscriptions, sub)
+	if len(subscriptions) == 0 {
+		delete(reg.subscriptions, sub.Recipient)
+	}
+	reg.count--
+	close(sub.events)
+}
+
+func (reg *registry) publish(recipient Recipient, event Event) {
+	reg.mu.Lock()
+	defer reg.mu.Unlock()
+
+	for sub := range reg.subscriptions[recipient] {
+		select {
+		case sub.events <- event:
+		default:
+			reg.drop(sub)
+		}
+	}
+}
+
+func (reg *registry) connections() int {
+	reg.mu.Lock()
+	defer reg.mu.Unlock()
+	return reg.count
+}
@@ -0,0 +1,114 @@
+package stream
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/outbox"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo"
+)
+
+const (
+	// events buffered for a connection before it is considered too slow and dropped
+	bufferSize = 64
+	// events replayed to a reconnecting client, older missed events are not replayed
+	replayLimit = 100
+
+	maxConnectionsPerRecipient = 10
+	maxConnections             = 10000
+)
+
+type streamService struct {
+	streamRepo repo.StreamStorer
+	jobRepo    repo.JobStorer
+	registry   *registry
+}
+
+type Service interface {
+	Subscribe(ctx context.Context, recipient Recipient, lastEventId int) (*Subscription, error)
+	Publish(ctx context.Context, event outbox.Event) error
+	Connections() int
+}
+
+func NewService(streamRepo repo.StreamStorer, jobRepo repo.JobStorer) Service {
+	return &streamService{
+		streamRepo: streamRepo,
+		jobRepo:    jobRepo,
+		registry:   newRegistry(),
+	}
+}
+
+// Subscribe opens a stream for the recipient, a lastEventId above zero replays the events the
+// recipient missed after it. The subscription must be closed once the client disconnects.
+func (strS *streamService) Subscribe(ctx context.Context, recipient Recipient, lastEventId int) (*Subscription, error) {
+	if recipient.Role != WorkerRecipient && recipient.Role != EmployerRecipient {
+		return nil, apperrors.ErrUnauthorizedStream
+	}
+
+	// register before fetching the replay so that no event published meanwhile is missed
+	sub, err := strS.registry.add(recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	if lastEventId <= 0 {
+		return sub, nil
+	}
+
+	events, err := strS.streamRepo.FetchStreamEvents(ctx, recipient.Role, recipient.ID, eventTypes(recipient.Role), lastEventId, replayLimit+1)
+	if err != nil {
+		sub.Close()
+		return nil, err
+	}
+
+	if len(events) > replayLimit {
+		events = events[:replayLimit]
+		sub.ReplayTruncated = true
+	}
+
+	sub.Replay = make([]Event, 0)
+	for _, event := range events {
+		mappedEvent, err := MapOutboxEventRepoToService(event)
+		if err != nil {
+			continue
+		}
+		sub.Replay = append(sub.Replay, mappedEvent)
+		sub.replayed[mappedEvent.ID] = true
+	}
+	return sub, nil
+}
+
+// Publish pushes an outbox event to the connected worker and employer it concerns, it is
+// registered as an outbox subscriber
+func (strS *streamService) Publish(ctx context.Context, event outbox.Event) error {
+	if strS.registry.connections() == 0 {
+		return nil
+	}
+
+	streamEvent := MapOutboxEventToService(event)
+
+	if streams(EmployerRecipient, event.Type) && streamEvent.Data.EmployerID == 0 {
+		job, err := strS.jobRepo.FetchJobById(ctx, event.JobID)
+		if err != nil {
+			if errors.Is(err, apperrors.ErrNoJobExists) {
+				return nil
+			}
+			return fmt.Errorf("%w: %w", apperrors.ErrPublishStreamEvent, err)
+		}
+		streamEvent.Data.EmployerID = job.EmployerID
+	}
+
+	if streams(WorkerRecipient, event.Type) && streamEvent.Data.WorkerID != 0 {
+		strS.registry.publish(Recipient{Role: WorkerRecipient, ID: streamEvent.Data.WorkerID}, streamEvent)
+	}
+	if streams(EmployerRecipient, event.Type) {
+		strS.registry.publish(Recipient{Role: EmployerRecipient, ID: streamEvent.Data.EmployerID}, streamEvent)
+	}
+	return nil
+}
+
+func (strS *streamService) Connections() int {
+	return strS.registry.connections()
+}
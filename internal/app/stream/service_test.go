@@ -0,0 +1,283 @@
+package stream
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/outbox"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type StreamServiceTestSuite struct {
+	suite.Suite
+	service    *streamService
+	streamRepo mocks.StreamStorer
+	jobRepo    mocks.JobStorer
+}
+
+var now = time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC)
+
+func (suite *StreamServiceTestSuite) SetupTest() {
+	suite.streamRepo = mocks.StreamStorer{}
+	suite.jobRepo = mocks.JobStorer{}
+	suite.service = NewService(&suite.streamRepo, &suite.jobRepo).(*streamService)
+}
+
+func (suite *StreamServiceTestSuite) TearDownTest() {
+	suite.streamRepo.AssertExpectations(suite.T())
+	suite.jobRepo.AssertExpectations(suite.T())
+}
+
+func TestStreamServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(StreamServiceTestSuite))
+}
+
+func (suite *StreamServiceTestSuite) subscribe(role string, id int) *Subscription {
+	sub, err := suite.service.Subscribe(context.Background(), Recipient{Role: role, ID: id}, 0)
+	suite.Require().NoError(err)
+	return sub
+}
+
+// received drains the events waiting on a subscription
+func received(sub *Subscription) []int {
+	ids := make([]int, 0)
+	for {
+		select {
+		case event, ok := <-sub.Events:
+			if !ok {
+				return ids
+			}
+			ids = append(ids, event.ID)
+		default:
+			return ids
+		}
+	}
+}
+
+func (suite *StreamServiceTestSuite) TestPublish() {
+	type testCase struct {
+		name             string
+		event            outbox.Event
+		setup            func()
+		expectedWorker   []int
+		expectedEmployer []int
+		expectedError    error
+	}
+
+	testCases := []testCase{
+		{
+			name:  "status change reaches the worker and the employer of the job",
+			event: outbox.Event{ID: 7, Type: outbox.ApplicationStatusChanged, ApplicationID: 1, JobID: 3, WorkerID: 12, Status: "confirmed", OccurredAt: now},
+			setup: func() {
+				suite.jobRepo.On("FetchJobById", mock.Anything, 3).Return(repo.Job{ID: 3, EmployerID: 4}, nil)
+			},
+			expectedWorker:   []int{7},
+			expectedEmployer: []int{7},
+			expectedError:    nil,
+		},
+		{
+			name:  "new applications only reach the employer",
+			event: outbox.Event{ID: 8, Type: outbox.ApplicationSubmitted, ApplicationID: 2, JobID: 3, WorkerID: 12, OccurredAt: now},
+			setup: func() {
+				suite.jobRepo.On("FetchJobById", mock.Anything, 3).Return(repo.Job{ID: 3, EmployerID: 4}, nil)
+			},
+			expectedWorker:   []int{},
+			expectedEmployer: []int{8},
+			expectedError:    nil,
+		},
+		{
+			name:             "messages carry both parties",
+			event:            outbox.Event{ID: 9, Type: outbox.MessageSent, ApplicationID: 1, JobID: 3, WorkerID: 12, EmployerID: 4, MessageID: 30, SenderRole: "worker", OccurredAt: now},
+			setup:            func() {},
+			expectedWorker:   []int{9},
+			expectedEmployer: []int{9},
+			expectedError:    nil,
+		},
+		{
+			name:             "events of other recipients are not received",
+			event:            outbox.Event{ID: 10, Type: outbox.MessageSent, ApplicationID: 5, JobID: 6, WorkerID: 13, EmployerID: 5, MessageID: 31, OccurredAt: now},
+			setup:            func() {},
+			expectedWorker:   []int{},
+			expectedEmployer: []int{},
+			expectedError:    nil,
+		},
+		{
+			name:  "job could not be fetched",
+			event: outbox.Event{ID: 11, Type: outbox.ApplicationSubmitted, ApplicationID: 2, JobID: 3, WorkerID: 12, OccurredAt: now},
+			setup: func() {
+				suite.jobRepo.On("FetchJobById", mock.Anything, 3).Return(repo.Job{}, errors.New("connection reset"))
+			},
+			expectedWorker:   []int{},
+			expectedEmployer: []int{},
+			expectedError:    apperrors.ErrPublishStreamEvent,
+		},
+	}
+
+	for _, test := range testCases {
+		suite.SetupTest()
+		suite.Run(test.name, func() {
+			test.setup()
+			workerSub := suite.subscribe(WorkerRecipient, 12)
+			employerSub := suite.subscribe(EmployerRecipient, 4)
+
+			err := suite.service.Publish(context.Background(), test.event)
+
+			suite.ErrorIs(err, test.expectedError)
+			suite.Equal(test.expectedWorker, received(workerSub))
+			suite.Equal(test.expectedEmployer, received(employerSub))
+		})
+		suite.TearDownTest()
+	}
+}
+
+func (suite *StreamServiceTestSuite) TestPublishWithoutConnections() {
+	err := suite.service.Publish(context.Background(), outbox.Event{ID: 7, Type: outbox.ApplicationSubmitted, JobID: 3, WorkerID: 12})
+
+	suite.NoError(err)
+	suite.jobRepo.AssertNotCalled(suite.T(), "FetchJobById", mock.Anything, mock.Anything)
+}
+
+func (suite *StreamServiceTestSuite) TestSubscribe() {
+	type testCase struct {
+		name              string
+		recipient         Recipient
+		lastEventId       int
+		setup             func()
+		expectedReplay    []int
+		expectedTruncated bool
+		expectedError     error
+	}
+
+	statusChanged := repo.OutboxEvent{ID: 7, Type: repo.ApplicationStatusChangedEvent, Payload: []byte(`{"application_id":1,"job_id":3,"worker_id":12,"status":"confirmed"}`), CreatedAt: now}
+	messageSent := repo.OutboxEvent{ID: 9, Type: repo.MessageSentEvent, Payload: []byte(`{"application_id":1,"job_id":3,"worker_id":12,"employer_id":4,"message_id":30}`), CreatedAt: now}
+
+	testCases := []testCase{
+		{
+			name:           "without a last event id nothing is replayed",
+			recipient:      Recipient{Role: WorkerRecipient, ID: 12},
+			lastEventId:    0,
+			setup:          func() {},
+			expectedReplay: nil,
+			expectedError:  nil,
+		},
+		{
+			name:        "missed events are replayed",
+			recipient:   Recipient{Role: WorkerRecipient, ID: 12},
+			lastEventId: 5,
+			setup: func() {
+				suite.streamRepo.On("FetchStreamEvents", mock.Anything, "worker", 12, []string{"application_status_changed", "message_sent"}, 5, replayLimit+1).Return([]repo.OutboxEvent{statusChanged, messageSent}, nil)
+			},
+			expectedReplay: []int{7, 9},
+			expectedError:  nil,
+		},
+		{
+			name:        "replay is cut at the limit",
+			recipient:   Recipient{Role: EmployerRecipient, ID: 4},
+			lastEventId: 1,
+			setup: func() {
+				events := make([]repo.OutboxEvent, 0)
+				for i := 0; i <= replayLimit; i++ {
+					event := messageSent
+					event.ID = 100 + i
+					events = append(events, event)
+				}
+				suite.streamRepo.On("FetchStreamEvents", mock.Anything, "employer", 4, []string{"application_submitted", "application_status_changed", "message_sent"}, 1, replayLimit+1).Return(events, nil)
+			},
+			expectedReplay: func() []int {
+				ids := make([]int, 0)
+				for i := 0; i < replayLimit; i++ {
+					ids = append(ids, 100+i)
+				}
+				return ids
+			}(),
+			expectedTruncated: true,
+			expectedError:     nil,
+		},
+		{
+			name:          "admins cannot subscribe",
+			recipient:     Recipient{Role: "admin", ID: 1},
+			setup:         func() {},
+			expectedError: apperrors.ErrUnauthorizedStream,
+		},
+		{
+			name:        "replay could not be fetched",
+			recipient:   Recipient{Role: WorkerRecipient, ID: 12},
+			lastEventId: 5,
+			setup: func() {
+				suite.streamRepo.On("FetchStreamEvents", mock.Anything, "worker", 12, mock.Anything, 5, replayLimit+1).Return([]repo.OutboxEvent{}, errors.New("connection reset"))
+			},
+			expectedError: errors.New("connection reset"),
+		},
+	}
+
+	for _, test := range testCases {
+		suite.SetupTest()
+		suite.Run(test.name, func() {
+			test.setup()
+
+			sub, err := suite.service.Subscribe(context.Background(), test.recipient, test.lastEventId)
+
+			if test.expectedError != nil {
+				suite.EqualError(err, test.expectedError.Error())
+				suite.Equal(0, suite.service.Connections())
+				return
+			}
+			suite.NoError(err)
+			suite.Equal(1, suite.service.Connections())
+			suite.Equal(test.expectedTruncated, sub.ReplayTruncated)
+
+			var replay []int
+			for _, event := range sub.Replay {
+				replay = append(replay, event.ID)
+				suite.True(sub.IsReplayed(event.ID))
+			}
+			suite.Equal(test.expectedReplay, replay)
+
+			sub.Close()
+			sub.Close()
+			suite.Equal(0, suite.service.Connections())
+		})
+		suite.TearDownTest()
+	}
+}
+
+func (suite *StreamServiceTestSuite) TestSlowSubscriptionIsDropped() {
+	slow := suite.subscribe(WorkerRecipient, 12)
+	other := suite.subscribe(WorkerRecipient, 12)
+
+	for i := 1; i <= bufferSize+1; i++ {
+		err := suite.service.Publish(context.Background(), outbox.Event{ID: i, Type: outbox.MessageSent, WorkerID: 12, EmployerID: 4})
+		suite.NoError(err)
+		if i <= bufferSize {
+			<-other.Events
+		}
+	}
+
+	suite.Len(received(slow), bufferSize)
+	_, open := <-slow.Events
+	suite.False(open)
+
+	event, open := <-other.Events
+	suite.True(open)
+	suite.Equal(bufferSize+1, event.ID)
+	suite.Equal(1, suite.service.Connections())
+}
+
+func (suite *StreamServiceTestSuite) TestConnectionLimit() {
+	for i := 0; i < maxConnectionsPerRecipient; i++ {
+		suite.subscribe(EmployerRecipient, 4)
+	}
+
+	_, err := suite.service.Subscribe(context.Background(), Recipient{Role: EmployerRecipient, ID: 4}, 0)
+	suite.ErrorIs(err, apperrors.ErrTooManyStreams)
+
+	other := suite.subscribe(EmployerRecipient, 5)
+	suite.Equal(maxConnectionsPerRecipient+1, suite.service.Connections())
+	other.Close()
+}
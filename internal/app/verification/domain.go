@@ -0,0 +1,52 @@
+package verification
+
+import "time"
+
+type Status string
+
+const (
+	Pending  Status = "pending"
+	Approved Status = "approved"
+	Rejected Status = "rejected"
+)
+
+type DocumentType string
+
+const (
+	GSTCertificate   DocumentType = "gst_certificate"
+	PANCard          DocumentType = "pan_card"
+	UdyamCertificate DocumentType = "udyam_certificate"
+	OtherDocument    DocumentType = "other"
+)
+
+// Document is the metadata of a document supporting a verification request, the file itself is
+// uploaded separately
+type Document struct {
+	Type        DocumentType `json:"type"`
+	Name        string       `json:"name"`
+	ContentType string       `json:"content_type"`
+	Size        int64        `json:"size"`
+	URL         string       `json:"url"`
+}
+
+// Verification is a request of an employer to be verified, at least one of the GSTIN, PAN and
+// Udyam registration numbers is required
+type Verification struct {
+	ID           int        `json:"id"`
+	EmployerID   int        `json:"employer_id"`
+	EmployerName string     `json:"employer_name,omitempty"`
+	GSTIN        string     `json:"gstin,omitempty"`
+	PAN          string     `json:"pan,omitempty"`
+	UdyamNumber  string     `json:"udyam_number,omitempty"`
+	Documents    []Document `json:"documents"`
+	Status       Status     `json:"status"`
+	Reason       string     `json:"reason,omitempty"`
+	ReviewedBy   int        `json:"reviewed_by,omitempty"`
+	SubmittedAt  time.Time  `json:"submitted_at"`
+	ReviewedAt   *time.Time `json:"reviewed_at,omitempty"`
+}
+
+// Review is the decision of an admin on a verification request, a reason is required to reject it
+type Review struct {
+	Reason string `json:"reason"`
+}
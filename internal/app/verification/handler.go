@@ -0,0 +1,178 @@
+package verification
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/logger"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/middleware"
+	"go.uber.org/zap"
+)
+
+func SubmitVerification(verificationService Service) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		employerId, id := isPathIdValid(ctx, w, r, "employer_id", apperrors.MsgInvalidEmployerId, apperrors.ErrSubmitVerification)
+		if employerId == -1 {
+			return
+		}
+
+		var verification Verification
+		err := json.NewDecoder(r.Body).Decode(&verification)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrInvalidRequestBody.Error(), zap.Error(err))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrInvalidRequestBody.Error()+": "+err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		submittedVerification, err := verificationService.SubmitVerification(ctx, employerId, verification)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrSubmitVerification.Error(), zap.Error(err), zap.String("ID", id))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrSubmitVerification.Error()+": "+err.Error(), verificationErrorStatusCode(err))
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, "verification request submitted for review", http.StatusCreated, submittedVerification)
+	}
+}
+
+func FetchEmployerVerification(verificationService Service) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		employerId, id := isPathIdValid(ctx, w, r, "employer_id", apperrors.MsgInvalidEmployerId, apperrors.ErrFetchVerification)
+		if employerId == -1 {
+			return
+		}
+
+		verification, err := verificationService.FetchEmployerVerification(ctx, employerId)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrFetchVerification.Error(), zap.Error(err), zap.String("ID", id))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrFetchVerification.Error()+", "+err.Error(), verificationErrorStatusCode(err))
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, "verification request retrieved successfully", http.StatusOK, verification)
+	}
+}
+
+func FetchVerifications(verificationService Service) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		status := r.URL.Query().Get("status")
+		verifications, err := verificationService.FetchVerifications(ctx, status)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrFetchVerifications.Error(), zap.Error(err), zap.String("status", status))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrFetchVerifications.Error()+", "+err.Error(), verificationErrorStatusCode(err))
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, "verification requests retrieved successfully", http.StatusOK, verifications)
+	}
+}
+
+func FetchVerificationById(verificationService Service) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		verificationId, id := isPathIdValid(ctx, w, r, "verification_id", apperrors.MsgInvalidVerificationId, apperrors.ErrFetchVerification)
+		if verificationId == -1 {
+			return
+		}
+
+		verification, err := verificationService.FetchVerificationById(ctx, verificationId)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrFetchVerification.Error(), zap.Error(err), zap.String("ID", id))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrFetchVerification.Error()+", "+err.Error(), verificationErrorStatusCode(err))
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, "verification request retrieved successfully", http.StatusOK, verification)
+	}
+}
+
+func ApproveVerification(verificationService Service) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		verificationId, id := isPathIdValid(ctx, w, r, "verification_id", apperrors.MsgInvalidVerificationId, apperrors.ErrReviewVerification)
+		if verificationId == -1 {
+			return
+		}
+
+		verification, err := verificationService.ApproveVerification(ctx, verificationId, reviewerId(ctx))
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrReviewVerification.Error(), zap.Error(err), zap.String("ID", id))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrReviewVerification.Error()+", "+err.Error(), verificationErrorStatusCode(err))
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, "verification request approved, employer is now verified", http.StatusOK, verification)
+	}
+}
+
+func RejectVerification(verificationService Service) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		verificationId, id := isPathIdValid(ctx, w, r, "verification_id", apperrors.MsgInvalidVerificationId, apperrors.ErrReviewVerification)
+		if verificationId == -1 {
+			return
+		}
+
+		var review Review
+		err := json.NewDecoder(r.Body).Decode(&review)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrInvalidRequestBody.Error(), zap.Error(err))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrInvalidRequestBody.Error()+": "+err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		verification, err := verificationService.RejectVerification(ctx, verificationId, reviewerId(ctx), review)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrReviewVerification.Error(), zap.Error(err), zap.String("ID", id))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrReviewVerification.Error()+", "+err.Error(), verificationErrorStatusCode(err))
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, "verification request rejected", http.StatusOK, verification)
+	}
+}
+
+// reviewerId is the admin reviewing a request, taken from the JWT when the route is authenticated
+func reviewerId(ctx context.Context) int {
+	userId, _ := ctx.Value("user_id").(int)
+	return userId
+}
+
+func isPathIdValid(ctx context.Context, w http.ResponseWriter, r *http.Request, key string, invalidMsg string, errType error) (int, string) {
+	vars := mux.Vars(r)
+	id := vars[key]
+	pathId, err := strconv.Atoi(id)
+	if err != nil {
+		logger.Errorw(ctx, invalidMsg, zap.Error(err), zap.String("ID", id))
+		httpResponseMsg := apperrors.HttpErrorResponseMessage(errType.Error(), invalidMsg, id)
+		middleware.HandleErrorResponse(ctx, w, httpResponseMsg, http.StatusBadRequest)
+		return -1, id
+	}
+	return pathId, id
+}
+
+func verificationErrorStatusCode(err error) int {
+	switch {
+	case errors.Is(err, apperrors.ErrInvalidVerification), errors.Is(err, apperrors.ErrInvalidVerificationStatus), errors.Is(err, apperrors.ErrInvalidReview):
+		return http.StatusBadRequest
+	case errors.Is(err, apperrors.ErrNoVerificationExists), errors.Is(err, apperrors.ErrNoEmployerExists):
+		return http.StatusNotFound
+	case errors.Is(err, apperrors.ErrEmployerAlreadyVerified), errors.Is(err, apperrors.ErrVerificationPending), errors.Is(err, apperrors.ErrVerificationAlreadyReviewed):
+		return http.StatusConflict
+	}
+	return http.StatusInternalServerError
+}
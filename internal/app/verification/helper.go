@@ -0,0 +1,149 @@
+package verification
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo"
+)
+
+const (
+	maxDocuments    = 5
+	maxDocumentSize = 5 << 20
+	maxReasonLength = 500
+
+	gstinCharset = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+)
+
+var (
+	// state code, PAN of the business, entity number, Z and a check character
+	gstinPattern = regexp.MustCompile(`^[0-9]{2}[A-Z]{5}[0-9]{4}[A-Z][1-9A-Z]Z[0-9A-Z]$`)
+	panPattern   = regexp.MustCompile(`^[A-Z]{5}[0-9]{4}[A-Z]$`)
+	udyamPattern = regexp.MustCompile(`^UDYAM-[A-Z]{2}-[0-9]{2}-[0-9]{7}$`)
+
+	documentTypes        = []DocumentType{GSTCertificate, PANCard, UdyamCertificate, OtherDocument}
+	documentContentTypes = []string{"application/pdf", "image/jpeg", "image/png"}
+)
+
+func MapVerificationRepoToService(verification repo.EmployerVerification, documents []repo.VerificationDocument) Verification {
+	mappedDocuments := make([]Document, 0)
+	for _, document := range documents {
+		mappedDocuments = append(mappedDocuments, Document{
+			Type:        DocumentType(document.DocumentType),
+			Name:        document.FileName,
+			ContentType: document.ContentType,
+			Size:        document.Size,
+			URL:         document.URL,
+		})
+	}
+
+	return Verification{
+		ID:           verification.ID,
+		EmployerID:   verification.EmployerID,
+		EmployerName: verification.EmployerName,
+		GSTIN:        verification.GSTIN,
+		PAN:          verification.PAN,
+		UdyamNumber:  verification.UdyamNumber,
+		Documents:    mappedDocuments,
+		Status:       Status(verification.Status),
+		Reason:       verification.Reason,
+		ReviewedBy:   verification.ReviewedBy,
+		SubmittedAt:  verification.SubmittedAt,
+		ReviewedAt:   verification.ReviewedAt,
+	}
+}
+
+func MapDocumentsServiceToRepo(documents []Document) []repo.VerificationDocument {
+	mappedDocuments := make([]repo.VerificationDocument, 0)
+	for _, document := range documents {
+		mappedDocuments = append(mappedDocuments, repo.VerificationDocument{
+			DocumentType: string(document.Type),
+			FileName:     document.Name,
+			ContentType:  document.ContentType,
+			Size:         document.Size,
+			URL:          document.URL,
+		})
+	}
+	return mappedDocuments
+}
+
+// normalizeVerification trims the registration numbers and writes them in upper case, the way
+// they are printed on the certificates
+func normalizeVerification(verification Verification) Verification {
+	verification.GSTIN = strings.ToUpper(strings.TrimSpace(verification.GSTIN))
+	verification.PAN = strings.ToUpper(strings.TrimSpace(verification.PAN))
+	verification.UdyamNumber = strings.ToUpper(strings.TrimSpace(verification.UdyamNumber))
+	return verification
+}
+
+// validateVerification checks the format of the registration numbers and the metadata of the
+// documents of a normalized verification request
+func validateVerification(verification Verification) error {
+	if verification.GSTIN == "" && verification.PAN == "" && verification.UdyamNumber == "" {
+		return fmt.Errorf("%w: provide a GSTIN, PAN or Udyam registration number", apperrors.ErrInvalidVerification)
+	}
+
+	if verification.GSTIN != "" && !isValidGSTIN(verification.GSTIN) {
+		return fmt.Errorf("%w: invalid GSTIN", apperrors.ErrInvalidVerification)
+	}
+	if verification.PAN != "" && !panPattern.MatchString(verification.PAN) {
+		return fmt.Errorf("%w: invalid PAN", apperrors.ErrInvalidVerification)
+	}
+	// the PAN of a business is part of its GSTIN
+	if verification.GSTIN != "" && verification.PAN != "" && verification.GSTIN[2:12] != verification.PAN {
+		return fmt.Errorf("%w: PAN does not match the GSTIN", apperrors.ErrInvalidVerification)
+	}
+	if verification.UdyamNumber != "" && !udyamPattern.MatchString(verification.UdyamNumber) {
+		return fmt.Errorf("%w: invalid Udyam registration number", apperrors.ErrInvalidVerification)
+	}
+
+	if len(verification.Documents) == 0 || len(verification.Documents) > maxDocuments {
+		return fmt.Errorf("%w: attach between 1 and %d documents", apperrors.ErrInvalidVerification, maxDocuments)
+	}
+	for _, document := range verification.Documents {
+		if !slices.Contains(documentTypes, document.Type) {
+			return fmt.Errorf("%w: unknown document type %s", apperrors.ErrInvalidVerification, document.Type)
+		}
+		if strings.TrimSpace(document.Name) == "" || strings.TrimSpace(document.URL) == "" {
+			return fmt.Errorf("%w: documents need a name and a url", apperrors.ErrInvalidVerification)
+		}
+		if document.Size <= 0 || document.Size > maxDocumentSize {
+			return fmt.Errorf("%w: documents must be at most 5 MB", apperrors.ErrInvalidVerification)
+		}
+		if !slices.Contains(documentContentTypes, document.ContentType) {
+			return fmt.Errorf("%w: documents must be pdf, jpeg or png files", apperrors.ErrInvalidVerification)
+		}
+	}
+	return nil
+}
+
+// isValidGSTIN checks the format of a GSTIN and its check character, computed over the first 14
+// characters with the base 36 checksum used by the GST network
+func isValidGSTIN(gstin string) bool {
+	if !gstinPattern.MatchString(gstin) {
+		return false
+	}
+
+	sum := 0
+	for i := 0; i < 14; i++ {
+		product := strings.IndexByte(gstinCharset, gstin[i]) * (i%2 + 1)
+		sum += product/36 + product%36
+	}
+	check := gstinCharset[(36-sum%36)%36]
+	return gstin[14] == check
+}
+
+func parseStatus(status string) (repo.VerificationStatus, error) {
+	switch Status(status) {
+	case "", Pending:
+		return repo.VerificationPending, nil
+	case Approved:
+		return repo.VerificationApproved, nil
+	case Rejected:
+		return repo.VerificationRejected, nil
+	}
+	return "", apperrors.ErrInvalidVerificationStatus
+}
@@ -0,0 +1,85 @@
+package verification
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+)
+
+func TestValidateVerification(t *testing.T) {
+	type testCase struct {
+		name          string
+		input         Verification
+		expectedError error
+	}
+
+	certificate := []Document{{Type: GSTCertificate, Name: "gst.pdf", ContentType: "application/pdf", Size: 120000, URL: "https://files.example.com/gst.pdf"}}
+
+	testCases := []testCase{
+		{
+			name:          "gstin with its pan",
+			input:         Verification{GSTIN: " 27aapfu0939f1zv ", PAN: "AAPFU0939F", Documents: certificate},
+			expectedError: nil,
+		},
+		{
+			name:          "udyam number only",
+			input:         Verification{UdyamNumber: "udyam-mh-26-0012345", Documents: certificate},
+			expectedError: nil,
+		},
+		{
+			name:          "no registration number",
+			input:         Verification{Documents: certificate},
+			expectedError: apperrors.ErrInvalidVerification,
+		},
+		{
+			name:          "gstin with a wrong check character",
+			input:         Verification{GSTIN: "27AAPFU0939F1ZW", Documents: certificate},
+			expectedError: apperrors.ErrInvalidVerification,
+		},
+		{
+			name:          "malformed gstin",
+			input:         Verification{GSTIN: "27AAPFU0939F1", Documents: certificate},
+			expectedError: apperrors.ErrInvalidVerification,
+		},
+		{
+			name:          "malformed pan",
+			input:         Verification{PAN: "AAPF0939FU", Documents: certificate},
+			expectedError: apperrors.ErrInvalidVerification,
+		},
+		{
+			name:          "pan of another business than the gstin",
+			input:         Verification{GSTIN: "29AAGCB7383J1Z4", PAN: "AAPFU0939F", Documents: certificate},
+			expectedError: apperrors.ErrInvalidVerification,
+		},
+		{
+			name:          "malformed udyam number",
+			input:         Verification{UdyamNumber: "UDYAM-MH-26-12345", Documents: certificate},
+			expectedError: apperrors.ErrInvalidVerification,
+		},
+		{
+			name:          "no documents",
+			input:         Verification{PAN: "AAPFU0939F"},
+			expectedError: apperrors.ErrInvalidVerification,
+		},
+		{
+			name:          "document too large",
+			input:         Verification{PAN: "AAPFU0939F", Documents: []Document{{Type: PANCard, Name: "pan.png", ContentType: "image/png", Size: maxDocumentSize + 1, URL: "https://files.example.com/pan.png"}}},
+			expectedError: apperrors.ErrInvalidVerification,
+		},
+		{
+			name:          "unsupported document type",
+			input:         Verification{PAN: "AAPFU0939F", Documents: []Document{{Type: PANCard, Name: "pan.docx", ContentType: "application/msword", Size: 1000, URL: "https://files.example.com/pan.docx"}}},
+			expectedError: apperrors.ErrInvalidVerification,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			err := validateVerification(normalizeVerification(test.input))
+			if !errors.Is(err, test.expectedError) {
+				t.Errorf("expected error %v, got %v", test.expectedError, err)
+			}
+		})
+	}
+}
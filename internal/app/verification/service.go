@@ -0,0 +1,141 @@
+package verification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo"
+)
+
+type verificationService struct {
+	verificationRepo repo.VerificationStorer
+	employerRepo     repo.EmployerStorer
+}
+
+type Service interface {
+	SubmitVerification(ctx context.Context, employerId int, verificationDetails Verification) (Verification, error)
+	FetchEmployerVerification(ctx context.Context, employerId int) (Verification, error)
+	FetchVerifications(ctx context.Context, status string) ([]Verification, error)
+	FetchVerificationById(ctx context.Context, verificationId int) (Verification, error)
+	ApproveVerification(ctx context.Context, verificationId int, reviewerId int) (Verification, error)
+	RejectVerification(ctx context.Context, verificationId int, reviewerId int, review Review) (Verification, error)
+}
+
+func NewService(verificationRepo repo.VerificationStorer, employerRepo repo.EmployerStorer) Service {
+	return &verificationService{
+		verificationRepo: verificationRepo,
+		employerRepo:     employerRepo,
+	}
+}
+
+// SubmitVerification queues a verification request of an employer for review, an employer can
+// only have one request waiting at a time and verified employers cannot submit again
+func (verS *verificationService) SubmitVerification(ctx context.Context, employerId int, verificationDetails Verification) (Verification, error) {
+	verificationDetails = normalizeVerification(verificationDetails)
+	err := validateVerification(verificationDetails)
+	if err != nil {
+		return Verification{}, err
+	}
+
+	employer, err := verS.employerRepo.FetchEmployerByID(ctx, employerId)
+	if err != nil {
+		return Verification{}, err
+	}
+	if employer.IsVerified {
+		return Verification{}, apperrors.ErrEmployerAlreadyVerified
+	}
+
+	latest, err := verS.verificationRepo.FetchLatestVerification(ctx, employerId)
+	if err != nil && !errors.Is(err, apperrors.ErrNoVerificationExists) {
+		return Verification{}, err
+	}
+	if err == nil && latest.Status == repo.VerificationPending {
+		return Verification{}, apperrors.ErrVerificationPending
+	}
+
+	documents := MapDocumentsServiceToRepo(verificationDetails.Documents)
+	createdVerification, err := verS.verificationRepo.CreateVerification(ctx, repo.EmployerVerification{
+		EmployerID:  employerId,
+		GSTIN:       verificationDetails.GSTIN,
+		PAN:         verificationDetails.PAN,
+		UdyamNumber: verificationDetails.UdyamNumber,
+	}, documents)
+	if err != nil {
+		return Verification{}, err
+	}
+
+	return MapVerificationRepoToService(createdVerification, documents), nil
+}
+
+// FetchEmployerVerification returns the latest verification request of an employer, with the
+// reason when it was rejected
+func (verS *verificationService) FetchEmployerVerification(ctx context.Context, employerId int) (Verification, error) {
+	verification, err := verS.verificationRepo.FetchLatestVerification(ctx, employerId)
+	if err != nil {
+		return Verification{}, err
+	}
+	return verS.withDocuments(ctx, verification)
+}
+
+// FetchVerifications lists the verification requests with a status, the pending ones by default
+// which makes up the review queue of the admins
+func (verS *verificationService) FetchVerifications(ctx context.Context, status string) ([]Verification, error) {
+	verificationStatus, err := parseStatus(status)
+	if err != nil {
+		return []Verification{}, err
+	}
+
+	verifications, err := verS.verificationRepo.FetchVerificationsByStatus(ctx, verificationStatus)
+	if err != nil {
+		return []Verification{}, err
+	}
+
+	mappedVerifications := make([]Verification, 0)
+	for _, verification := range verifications {
+		mappedVerifications = append(mappedVerifications, MapVerificationRepoToService(verification, nil))
+	}
+	return mappedVerifications, nil
+}
+
+func (verS *verificationService) FetchVerificationById(ctx context.Context, verificationId int) (Verification, error) {
+	verification, err := verS.verificationRepo.FetchVerificationById(ctx, verificationId)
+	if err != nil {
+		return Verification{}, err
+	}
+	return verS.withDocuments(ctx, verification)
+}
+
+// ApproveVerification approves a pending request and marks its employer verified
+func (verS *verificationService) ApproveVerification(ctx context.Context, verificationId int, reviewerId int) (Verification, error) {
+	verification, err := verS.verificationRepo.ReviewVerification(ctx, verificationId, repo.VerificationApproved, "", reviewerId)
+	if err != nil {
+		return Verification{}, err
+	}
+	return verS.withDocuments(ctx, verification)
+}
+
+// RejectVerification rejects a pending request with the reason shown to the employer, who can
+// then submit a new request
+func (verS *verificationService) RejectVerification(ctx context.Context, verificationId int, reviewerId int, review Review) (Verification, error) {
+	reason := strings.TrimSpace(review.Reason)
+	if reason == "" || len(reason) > maxReasonLength {
+		return Verification{}, fmt.Errorf("%w, at most %d characters", apperrors.ErrInvalidReview, maxReasonLength)
+	}
+
+	verification, err := verS.verificationRepo.ReviewVerification(ctx, verificationId, repo.VerificationRejected, reason, reviewerId)
+	if err != nil {
+		return Verification{}, err
+	}
+	return verS.withDocuments(ctx, verification)
+}
+
+func (verS *verificationService) withDocuments(ctx context.Context, verification repo.EmployerVerification) (Verification, error) {
+	documents, err := verS.verificationRepo.FetchVerificationDocuments(ctx, verification.ID)
+	if err != nil {
+		return Verification{}, err
+	}
+	return MapVerificationRepoToService(verification, documents), nil
+}
@@ -0,0 +1,250 @@
+package verification
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type VerificationServiceTestSuite struct {
+	suite.Suite
+	service          Service
+	verificationRepo mocks.VerificationStorer
+	employerRepo     mocks.EmployerStorer
+}
+
+var submittedAt = time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC)
+
+func (suite *VerificationServiceTestSuite) SetupTest() {
+	suite.verificationRepo = mocks.VerificationStorer{}
+	suite.employerRepo = mocks.EmployerStorer{}
+	suite.service = NewService(&suite.verificationRepo, &suite.employerRepo)
+}
+
+func (suite *VerificationServiceTestSuite) TearDownTest() {
+	suite.verificationRepo.AssertExpectations(suite.T())
+	suite.employerRepo.AssertExpectations(suite.T())
+}
+
+func TestVerificationServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(VerificationServiceTestSuite))
+}
+
+func (suite *VerificationServiceTestSuite) TestSubmitVerification() {
+	type testCase struct {
+		name           string
+		input          Verification
+		setup          func()
+		expectedOutput Verification
+		expectedError  error
+	}
+
+	document := Document{Type: GSTCertificate, Name: "gst.pdf", ContentType: "application/pdf", Size: 120000, URL: "https://files.example.com/gst.pdf"}
+	input := Verification{GSTIN: "27aapfu0939f1zv", Documents: []Document{document}}
+	created := repo.EmployerVerification{ID: 1, EmployerID: 4, EmployerName: "Patil Constructions", GSTIN: "27AAPFU0939F1ZV", Status: repo.VerificationPending, SubmittedAt: submittedAt}
+
+	testCases := []testCase{
+		{
+			name:  "first request",
+			input: input,
+			setup: func() {
+				suite.employerRepo.On("FetchEmployerByID", mock.Anything, 4).Return(repo.Employer{ID: 4}, nil)
+				suite.verificationRepo.On("FetchLatestVerification", mock.Anything, 4).Return(repo.EmployerVerification{}, apperrors.ErrNoVerificationExists)
+				suite.verificationRepo.On("CreateVerification", mock.Anything, repo.EmployerVerification{EmployerID: 4, GSTIN: "27AAPFU0939F1ZV"}, []repo.VerificationDocument{{DocumentType: "gst_certificate", FileName: "gst.pdf", ContentType: "application/pdf", Size: 120000, URL: "https://files.example.com/gst.pdf"}}).Return(created, nil)
+			},
+			expectedOutput: Verification{ID: 1, EmployerID: 4, EmployerName: "Patil Constructions", GSTIN: "27AAPFU0939F1ZV", Documents: []Document{document}, Status: Pending, SubmittedAt: submittedAt},
+			expectedError:  nil,
+		},
+		{
+			name:  "resubmitted after a rejection",
+			input: input,
+			setup: func() {
+				suite.employerRepo.On("FetchEmployerByID", mock.Anything, 4).Return(repo.Employer{ID: 4}, nil)
+				suite.verificationRepo.On("FetchLatestVerification", mock.Anything, 4).Return(repo.EmployerVerification{ID: 1, Status: repo.VerificationRejected}, nil)
+				suite.verificationRepo.On("CreateVerification", mock.Anything, mock.Anything, mock.Anything).Return(repo.EmployerVerification{ID: 2, EmployerID: 4, GSTIN: "27AAPFU0939F1ZV", Status: repo.VerificationPending, SubmittedAt: submittedAt}, nil)
+			},
+			expectedOutput: Verification{ID: 2, EmployerID: 4, GSTIN: "27AAPFU0939F1ZV", Documents: []Document{document}, Status: Pending, SubmittedAt: submittedAt},
+			expectedError:  nil,
+		},
+		{
+			name:  "request already waiting for review",
+			input: input,
+			setup: func() {
+				suite.employerRepo.On("FetchEmployerByID", mock.Anything, 4).Return(repo.Employer{ID: 4}, nil)
+				suite.verificationRepo.On("FetchLatestVerification", mock.Anything, 4).Return(repo.EmployerVerification{ID: 1, Status: repo.VerificationPending}, nil)
+			},
+			expectedError: apperrors.ErrVerificationPending,
+		},
+		{
+			name:  "employer already verified",
+			input: input,
+			setup: func() {
+				suite.employerRepo.On("FetchEmployerByID", mock.Anything, 4).Return(repo.Employer{ID: 4, IsVerified: true}, nil)
+			},
+			expectedError: apperrors.ErrEmployerAlreadyVerified,
+		},
+		{
+			name:          "invalid details",
+			input:         Verification{PAN: "AAPFU0939", Documents: []Document{document}},
+			setup:         func() {},
+			expectedError: apperrors.ErrInvalidVerification,
+		},
+	}
+
+	for _, test := range testCases {
+		suite.SetupTest()
+		suite.Run(test.name, func() {
+			test.setup()
+
+			verification, err := suite.service.SubmitVerification(context.Background(), 4, test.input)
+
+			suite.ErrorIs(err, test.expectedError)
+			if test.expectedError == nil {
+				suite.Equal(test.expectedOutput, verification)
+			}
+		})
+		suite.TearDownTest()
+	}
+}
+
+func (suite *VerificationServiceTestSuite) TestFetchVerifications() {
+	type testCase struct {
+		name          string
+		status        string
+		setup         func()
+		expectedCount int
+		expectedError error
+	}
+
+	testCases := []testCase{
+		{
+			name:   "pending queue by default",
+			status: "",
+			setup: func() {
+				suite.verificationRepo.On("FetchVerificationsByStatus", mock.Anything, repo.VerificationPending).Return([]repo.EmployerVerification{{ID: 1, Status: repo.VerificationPending}, {ID: 2, Status: repo.VerificationPending}}, nil)
+			},
+			expectedCount: 2,
+			expectedError: nil,
+		},
+		{
+			name:   "rejected requests",
+			status: "rejected",
+			setup: func() {
+				suite.verificationRepo.On("FetchVerificationsByStatus", mock.Anything, repo.VerificationRejected).Return([]repo.EmployerVerification{}, nil)
+			},
+			expectedCount: 0,
+			expectedError: nil,
+		},
+		{
+			name:          "unknown status",
+			status:        "waiting",
+			setup:         func() {},
+			expectedError: apperrors.ErrInvalidVerificationStatus,
+		},
+	}
+
+	for _, test := range testCases {
+		suite.SetupTest()
+		suite.Run(test.name, func() {
+			test.setup()
+
+			verifications, err := suite.service.FetchVerifications(context.Background(), test.status)
+
+			suite.ErrorIs(err, test.expectedError)
+			suite.Len(verifications, test.expectedCount)
+		})
+		suite.TearDownTest()
+	}
+}
+
+func (suite *VerificationServiceTestSuite) TestReviewVerification() {
+	type testCase struct {
+		name           string
+		review         func() (Verification, error)
+		setup          func()
+		expectedStatus Status
+		expectedError  error
+	}
+
+	reviewedAt := submittedAt.Add(time.Hour)
+
+	testCases := []testCase{
+		{
+			name: "approved",
+			review: func() (Verification, error) {
+				return suite.service.ApproveVerification(context.Background(), 1, 9)
+			},
+			setup: func() {
+				suite.verificationRepo.On("ReviewVerification", mock.Anything, 1, repo.VerificationApproved, "", 9).Return(repo.EmployerVerification{ID: 1, EmployerID: 4, Status: repo.VerificationApproved, ReviewedBy: 9, ReviewedAt: &reviewedAt}, nil)
+				suite.verificationRepo.On("FetchVerificationDocuments", mock.Anything, 1).Return([]repo.VerificationDocument{}, nil)
+			},
+			expectedStatus: Approved,
+			expectedError:  nil,
+		},
+		{
+			name: "rejected with a reason",
+			review: func() (Verification, error) {
+				return suite.service.RejectVerification(context.Background(), 1, 9, Review{Reason: " GST certificate is not readable "})
+			},
+			setup: func() {
+				suite.verificationRepo.On("ReviewVerification", mock.Anything, 1, repo.VerificationRejected, "GST certificate is not readable", 9).Return(repo.EmployerVerification{ID: 1, EmployerID: 4, Status: repo.VerificationRejected, Reason: "GST certificate is not readable", ReviewedBy: 9, ReviewedAt: &reviewedAt}, nil)
+				suite.verificationRepo.On("FetchVerificationDocuments", mock.Anything, 1).Return([]repo.VerificationDocument{}, nil)
+			},
+			expectedStatus: Rejected,
+			expectedError:  nil,
+		},
+		{
+			name: "rejected without a reason",
+			review: func() (Verification, error) {
+				return suite.service.RejectVerification(context.Background(), 1, 9, Review{Reason: "  "})
+			},
+			setup:         func() {},
+			expectedError: apperrors.ErrInvalidReview,
+		},
+		{
+			name: "already reviewed",
+			review: func() (Verification, error) {
+				return suite.service.ApproveVerification(context.Background(), 1, 9)
+			},
+			setup: func() {
+				suite.verificationRepo.On("ReviewVerification", mock.Anything, 1, repo.VerificationApproved, "", 9).Return(repo.EmployerVerification{}, apperrors.ErrVerificationAlreadyReviewed)
+			},
+			expectedError: apperrors.ErrVerificationAlreadyReviewed,
+		},
+		{
+			name: "database error",
+			review: func() (Verification, error) {
+				return suite.service.ApproveVerification(context.Background(), 1, 9)
+			},
+			setup: func() {
+				suite.verificationRepo.On("ReviewVerification", mock.Anything, 1, repo.VerificationApproved, "", 9).Return(repo.EmployerVerification{}, errors.New("connection reset"))
+			},
+			expectedError: errors.New("connection reset"),
+		},
+	}
+
+	for _, test := range testCases {
+		suite.SetupTest()
+		suite.Run(test.name, func() {
+			test.setup()
+
+			verification, err := test.review()
+
+			if test.expectedError != nil {
+				suite.ErrorContains(err, test.expectedError.Error())
+				return
+			}
+			suite.NoError(err)
+			suite.Equal(test.expectedStatus, verification.Status)
+			suite.Equal(9, verification.ReviewedBy)
+		})
+		suite.TearDownTest()
+	}
+}
@@ -0,0 +1,73 @@
+package webhook
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/outbox"
+)
+
+type DeliveryStatus string
+
+const (
+	Pending   DeliveryStatus = "pending"
+	Succeeded DeliveryStatus = "succeeded"
+	Failed    DeliveryStatus = "failed"
+)
+
+// Events are the outbox events employers can subscribe their webhooks to
+var Events = []outbox.EventType{
+	outbox.ApplicationSubmitted,
+	outbox.ApplicationStatusChanged,
+	outbox.JobPosted,
+	outbox.JobUpdated,
+}
+
+// Webhook is an endpoint registered by an employer, the signing secret is only returned when the
+// webhook is created
+type Webhook struct {
+	ID                  int                `json:"id"`
+	EmployerID          int                `json:"employer_id"`
+	URL                 string             `json:"url"`
+	Events              []outbox.EventType `json:"events"`
+	Enabled             bool               `json:"enabled"`
+	Secret              string             `json:"secret,omitempty"`
+	ConsecutiveFailures int                `json:"consecutive_failures"`
+	DisabledAt          *time.Time         `json:"disabled_at,omitempty"`
+	CreatedAt           time.Time          `json:"created_at"`
+	UpdatedAt           time.Time          `json:"updated_at"`
+}
+
+// Delivery is an event sent to a webhook and the result of its latest attempt
+type Delivery struct {
+	ID             int              `json:"id"`
+	WebhookID      int              `json:"webhook_id"`
+	EventID        int              `json:"event_id"`
+	Event          outbox.EventType `json:"event"`
+	Status         DeliveryStatus   `json:"status"`
+	Attempts       int              `json:"attempts"`
+	NextAttemptAt  *time.Time       `json:"next_attempt_at,omitempty"`
+	ResponseStatus int              `json:"response_status,omitempty"`
+	ResponseBody   string           `json:"response_body,omitempty"`
+	Error          string           `json:"error,omitempty"`
+	RedeliveryOf   *int             `json:"redelivery_of,omitempty"`
+	Payload        json.RawMessage  `json:"payload"`
+	CreatedAt      time.Time        `json:"created_at"`
+	DeliveredAt    *time.Time       `json:"delivered_at,omitempty"`
+}
+
+// Payload is the JSON body posted to webhooks
+type Payload struct {
+	ID        string           `json:"id"`
+	Event     outbox.EventType `json:"event"`
+	CreatedAt time.Time        `json:"created_at"`
+	Data      PayloadData      `json:"data"`
+}
+
+type PayloadData struct {
+	ApplicationID  int    `json:"application_id,omitempty"`
+	JobID          int    `json:"job_id,omitempty"`
+	WorkerID       int    `json:"worker_id,omitempty"`
+	Status         string `json:"status,omitempty"`
+	PreviousStatus string `json:"previous_status,omitempty"`
+}
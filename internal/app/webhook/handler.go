@@ -0,0 +1,204 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/logger"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/middleware"
+	"go.uber.org/zap"
+)
+
+func CreateWebhook(webhookService Service) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		employerId, id := isPathIdValid(ctx, w, r, "employer_id", apperrors.MsgInvalidEmployerId, apperrors.ErrCreateWebhook)
+		if employerId == -1 {
+			return
+		}
+
+		var webhook Webhook
+		err := json.NewDecoder(r.Body).Decode(&webhook)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrInvalidRequestBody.Error(), zap.Error(err))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrInvalidRequestBody.Error()+": "+err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		createdWebhook, err := webhookService.CreateWebhook(ctx, employerId, webhook)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrCreateWebhook.Error(), zap.Error(err), zap.String("ID", id))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrCreateWebhook.Error()+": "+err.Error(), webhookErrorStatusCode(err))
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, "webhook created successfully, keep the secret to verify signatures", http.StatusCreated, createdWebhook)
+	}
+}
+
+func FetchWebhooks(webhookService Service) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		employerId, id := isPathIdValid(ctx, w, r, "employer_id", apperrors.MsgInvalidEmployerId, apperrors.ErrFetchWebhooks)
+		if employerId == -1 {
+			return
+		}
+
+		webhooks, err := webhookService.FetchWebhooks(ctx, employerId)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrFetchWebhooks.Error(), zap.Error(err), zap.String("ID", id))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrFetchWebhooks.Error()+", "+err.Error(), webhookErrorStatusCode(err))
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, "webhooks retrieved successfully", http.StatusOK, webhooks)
+	}
+}
+
+func UpdateWebhook(webhookService Service) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		employerId, _ := isPathIdValid(ctx, w, r, "employer_id", apperrors.MsgInvalidEmployerId, apperrors.ErrUpdateWebhook)
+		if employerId == -1 {
+			return
+		}
+
+		webhookId, id := isPathIdValid(ctx, w, r, "webhook_id", apperrors.MsgInvalidWebhookId, apperrors.ErrUpdateWebhook)
+		if webhookId == -1 {
+			return
+		}
+
+		var webhook Webhook
+		err := json.NewDecoder(r.Body).Decode(&webhook)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrInvalidRequestBody.Error(), zap.Error(err))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrInvalidRequestBody.Error()+": "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		webhook.ID = webhookId
+
+		updatedWebhook, err := webhookService.UpdateWebhook(ctx, employerId, webhook)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrUpdateWebhook.Error(), zap.Error(err), zap.String("ID", id))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrUpdateWebhook.Error()+": "+err.Error(), webhookErrorStatusCode(err))
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, "webhook updated successfully", http.StatusOK, updatedWebhook)
+	}
+}
+
+func DeleteWebhook(webhookService Service) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		employerId, _ := isPathIdValid(ctx, w, r, "employer_id", apperrors.MsgInvalidEmployerId, apperrors.ErrDeleteWebhook)
+		if employerId == -1 {
+			return
+		}
+
+		webhookId, id := isPathIdValid(ctx, w, r, "webhook_id", apperrors.MsgInvalidWebhookId, apperrors.ErrDeleteWebhook)
+		if webhookId == -1 {
+			return
+		}
+
+		deletedId, err := webhookService.DeleteWebhook(ctx, employerId, webhookId)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrDeleteWebhook.Error(), zap.Error(err), zap.String("ID", id))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrDeleteWebhook.Error()+", "+err.Error(), webhookErrorStatusCode(err))
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, "webhook deleted successfully", http.StatusOK, deletedId)
+	}
+}
+
+func FetchDeliveries(webhookService Service) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		employerId, _ := isPathIdValid(ctx, w, r, "employer_id", apperrors.MsgInvalidEmployerId, apperrors.ErrFetchWebhookDeliveries)
+		if employerId == -1 {
+			return
+		}
+
+		webhookId, id := isPathIdValid(ctx, w, r, "webhook_id", apperrors.MsgInvalidWebhookId, apperrors.ErrFetchWebhookDeliveries)
+		if webhookId == -1 {
+			return
+		}
+
+		deliveries, err := webhookService.FetchDeliveries(ctx, employerId, webhookId)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrFetchWebhookDeliveries.Error(), zap.Error(err), zap.String("ID", id))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrFetchWebhookDeliveries.Error()+", "+err.Error(), webhookErrorStatusCode(err))
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, "webhook deliveries retrieved successfully", http.StatusOK, deliveries)
+	}
+}
+
+func Redeliver(webhookService Service) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		employerId, _ := isPathIdValid(ctx, w, r, "employer_id", apperrors.MsgInvalidEmployerId, apperrors.ErrRedeliverWebhook)
+		if employerId == -1 {
+			return
+		}
+
+		webhookId, _ := isPathIdValid(ctx, w, r, "webhook_id", apperrors.MsgInvalidWebhookId, apperrors.ErrRedeliverWebhook)
+		if webhookId == -1 {
+			return
+		}
+
+		deliveryId, id := isPathIdValid(ctx, w, r, "delivery_id", apperrors.MsgInvalidDeliveryId, apperrors.ErrRedeliverWebhook)
+		if deliveryId == -1 {
+			return
+		}
+
+		delivery, err := webhookService.Redeliver(ctx, employerId, webhookId, deliveryId)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrRedeliverWebhook.Error(), zap.Error(err), zap.String("ID", id))
+			middleware.HandleErrorResponse(ctx, w, apperrors.ErrRedeliverWebhook.Error()+", "+err.Error(), webhookErrorStatusCode(err))
+			return
+		}
+
+		middleware.HandleSuccessResponse(ctx, w, "webhook redelivered, see the delivery status for the result", http.StatusOK, delivery)
+	}
+}
+
+func isPathIdValid(ctx context.Context, w http.ResponseWriter, r *http.Request, key string, invalidMsg string, errType error) (int, string) {
+	vars := mux.Vars(r)
+	id := vars[key]
+	pathId, err := strconv.Atoi(id)
+	if err != nil {
+		logger.Errorw(ctx, invalidMsg, zap.Error(err), zap.String("ID", id))
+		httpResponseMsg := apperrors.HttpErrorResponseMessage(errType.Error(), invalidMsg, id)
+		middleware.HandleErrorResponse(ctx, w, httpResponseMsg, http.StatusBadRequest)
+		return -1, id
+	}
+	return pathId, id
+}
+
+func webhookErrorStatusCode(err error) int {
+	switch {
+	case errors.Is(err, apperrors.ErrInvalidWebhook):
+		return http.StatusBadRequest
+	case errors.Is(err, apperrors.ErrWebhooksNotAllowed):
+		return http.StatusForbidden
+	case errors.Is(err, apperrors.ErrNoWebhookExists), errors.Is(err, apperrors.ErrNoWebhookDeliveryExists), errors.Is(err, apperrors.ErrNoEmployerExists):
+		return http.StatusNotFound
+	case errors.Is(err, apperrors.ErrWebhookDisabled):
+		return http.StatusConflict
+	}
+	return http.StatusInternalServerError
+}
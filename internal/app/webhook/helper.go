@@ -0,0 +1,101 @@
+package webhook
+
+import (
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/outbox"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo"
+)
+
+func MapWebhookRepoToService(webhook repo.Webhook) Webhook {
+	events := make([]outbox.EventType, 0)
+	for _, event := range strings.Split(webhook.Events, ",") {
+		if event != "" {
+			events = append(events, outbox.EventType(event))
+		}
+	}
+
+	return Webhook{
+		ID:                  webhook.ID,
+		EmployerID:          webhook.EmployerID,
+		URL:                 webhook.URL,
+		Events:              events,
+		Enabled:             webhook.Enabled,
+		ConsecutiveFailures: webhook.ConsecutiveFailures,
+		DisabledAt:          webhook.DisabledAt,
+		CreatedAt:           webhook.CreatedAt,
+		UpdatedAt:           webhook.UpdatedAt,
+	}
+}
+
+func MapDeliveryRepoToService(delivery repo.WebhookDelivery) Delivery {
+	mappedDelivery := Delivery{
+		ID:             delivery.ID,
+		WebhookID:      delivery.WebhookID,
+		EventID:        delivery.EventID,
+		Event:          outbox.EventType(delivery.EventType),
+		Status:         DeliveryStatus(delivery.Status),
+		Attempts:       delivery.Attempts,
+		ResponseStatus: delivery.ResponseStatus,
+		ResponseBody:   delivery.ResponseBody,
+		Error:          delivery.Error,
+		RedeliveryOf:   delivery.RedeliveryOf,
+		Payload:        delivery.Payload,
+		CreatedAt:      delivery.CreatedAt,
+		DeliveredAt:    delivery.DeliveredAt,
+	}
+	if delivery.Status == repo.DeliveryPending {
+		nextAttemptAt := delivery.NextAttemptAt
+		mappedDelivery.NextAttemptAt = &nextAttemptAt
+	}
+	return mappedDelivery
+}
+
+func MapEventToPayload(event outbox.Event) Payload {
+	return Payload{
+		ID:        fmt.Sprintf("evt_%d", event.ID),
+		Event:     event.Type,
+		CreatedAt: event.OccurredAt,
+		Data: PayloadData{
+			ApplicationID:  event.ApplicationID,
+			JobID:          event.JobID,
+			WorkerID:       event.WorkerID,
+			Status:         event.Status,
+			PreviousStatus: event.PreviousStatus,
+		},
+	}
+}
+
+// validateWebhook checks the endpoint and the event filters of a webhook and returns its events
+// without duplicates, comma separated as they are stored
+func validateWebhook(webhook Webhook) (string, error) {
+	endpoint, err := url.Parse(webhook.URL)
+	if err != nil || (endpoint.Scheme != "https" && endpoint.Scheme != "http") || endpoint.Host == "" {
+		return "", fmt.Errorf("%w: url must be an absolute http or https url", apperrors.ErrInvalidWebhook)
+	}
+
+	if len(webhook.Events) == 0 {
+		return "", fmt.Errorf("%w: subscribe to at least one event", apperrors.ErrInvalidWebhook)
+	}
+
+	events := make([]string, 0)
+	for _, event := range webhook.Events {
+		if !slices.Contains(Events, event) {
+			return "", fmt.Errorf("%w: unknown event %s", apperrors.ErrInvalidWebhook, event)
+		}
+		if !slices.Contains(events, string(event)) {
+			events = append(events, string(event))
+		}
+	}
+	return strings.Join(events, ","), nil
+}
+
+// retryDelay doubles the wait after every failed attempt
+func retryDelay(attempts int) time.Duration {
+	return initialRetryDelay << (attempts - 1)
+}
@@ -0,0 +1,353 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/outbox"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/logger"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/webhookclient"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo"
+	"go.uber.org/zap"
+)
+
+const (
+	batchSize    = 20
+	pollInterval = 2 * time.Second
+	// a claimed delivery is retried by another dispatcher if not finished within the lease
+	claimLease = time.Minute
+
+	// a delivery is attempted maxAttempts times over about two hours before it is given up
+	maxAttempts       = 8
+	initialRetryDelay = time.Minute
+	// a webhook is disabled once this many attempts in a row have failed
+	disableAfterFailures = 20
+)
+
+type webhookService struct {
+	webhookRepo  repo.WebhookStorer
+	employerRepo repo.EmployerStorer
+	jobRepo      repo.JobStorer
+	client       webhookclient.Client
+	now          func() time.Time
+}
+
+type Service interface {
+	CreateWebhook(ctx context.Context, employerId int, webhookDetails Webhook) (Webhook, error)
+	FetchWebhooks(ctx context.Context, employerId int) ([]Webhook, error)
+	UpdateWebhook(ctx context.Context, employerId int, webhookDetails Webhook) (Webhook, error)
+	DeleteWebhook(ctx context.Context, employerId int, webhookId int) (int, error)
+	FetchDeliveries(ctx context.Context, employerId int, webhookId int) ([]Delivery, error)
+	Redeliver(ctx context.Context, employerId int, webhookId int, deliveryId int) (Delivery, error)
+	Enqueue(ctx context.Context, event outbox.Event) error
+	DeliverPending(ctx context.Context) (int, error)
+	Run(ctx context.Context)
+}
+
+func NewService(webhookRepo repo.WebhookStorer, employerRepo repo.EmployerStorer, jobRepo repo.JobStorer, client webhookclient.Client) Service {
+	return &webhookService{
+		webhookRepo:  webhookRepo,
+		employerRepo: employerRepo,
+		jobRepo:      jobRepo,
+		client:       client,
+		now:          time.Now,
+	}
+}
+
+func (whS *webhookService) CreateWebhook(ctx context.Context, employerId int, webhook Webhook) (Webhook, error) {
+	events, err := validateWebhook(webhook)
+	if err != nil {
+		return Webhook{}, err
+	}
+
+	employer, err := whS.employerRepo.FetchEmployerByID(ctx, employerId)
+	if err != nil {
+		return Webhook{}, err
+	}
+	if employer.Type != repo.Organization {
+		return Webhook{}, apperrors.ErrWebhooksNotAllowed
+	}
+
+	secret, err := webhookclient.NewSecret()
+	if err != nil {
+		return Webhook{}, err
+	}
+
+	createdWebhook, err := whS.webhookRepo.CreateWebhook(ctx, repo.Webhook{
+		EmployerID: employerId,
+		URL:        webhook.URL,
+		Secret:     secret,
+		Events:     events,
+	})
+	if err != nil {
+		return Webhook{}, err
+	}
+
+	mappedWebhook := MapWebhookRepoToService(createdWebhook)
+	mappedWebhook.Secret = createdWebhook.Secret
+	return mappedWebhook, nil
+}
+
+func (whS *webhookService) FetchWebhooks(ctx context.Context, employerId int) ([]Webhook, error) {
+	webhooks, err := whS.webhookRepo.FetchWebhooksByEmployerId(ctx, employerId)
+	if err != nil {
+		return []Webhook{}, err
+	}
+
+	mappedWebhooks := make([]Webhook, 0)
+	for _, webhook := range webhooks {
+		mappedWebhooks = append(mappedWebhooks, MapWebhookRepoToService(webhook))
+	}
+	return mappedWebhooks, nil
+}
+
+// UpdateWebhook changes the url, events and enabled state of a webhook, enabling a disabled
+// webhook clears its failures
+func (whS *webhookService) UpdateWebhook(ctx context.Context, employerId int, webhook Webhook) (Webhook, error) {
+	events, err := validateWebhook(webhook)
+	if err != nil {
+		return Webhook{}, err
+	}
+
+	existing, err := whS.employerWebhook(ctx, employerId, webhook.ID)
+	if err != nil {
+		return Webhook{}, err
+	}
+
+	existing.URL = webhook.URL
+	existing.Events = events
+	if webhook.Enabled && !existing.Enabled {
+		existing.ConsecutiveFailures = 0
+		existing.DisabledAt = nil
+	}
+	existing.Enabled = webhook.Enabled
+
+	updatedWebhook, err := whS.webhookRepo.UpdateWebhook(ctx, existing)
+	if err != nil {
+		return Webhook{}, err
+	}
+	return MapWebhookRepoToService(updatedWebhook), nil
+}
+
+func (whS *webhookService) DeleteWebhook(ctx context.Context, employerId int, webhookId int) (int, error) {
+	_, err := whS.employerWebhook(ctx, employerId, webhookId)
+	if err != nil {
+		return -1, err
+	}
+	return whS.webhookRepo.DeleteWebhook(ctx, webhookId)
+}
+
+func (whS *webhookService) FetchDeliveries(ctx context.Context, employerId int, webhookId int) ([]Delivery, error) {
+	_, err := whS.employerWebhook(ctx, employerId, webhookId)
+	if err != nil {
+		return []Delivery{}, err
+	}
+
+	deliveries, err := whS.webhookRepo.FetchDeliveriesByWebhookId(ctx, webhookId)
+	if err != nil {
+		return []Delivery{}, err
+	}
+
+	mappedDeliveries := make([]Delivery, 0)
+	for _, delivery := range deliveries {
+		mappedDeliveries = append(mappedDeliveries, MapDeliveryRepoToService(delivery))
+	}
+	return mappedDeliveries, nil
+}
+
+// Redeliver sends the event of a past delivery again right away, as a new delivery that is
+// retried like any other if it fails
+func (whS *webhookService) Redeliver(ctx context.Context, employerId int, webhookId int, deliveryId int) (Delivery, error) {
+	webhook, err := whS.employerWebhook(ctx, employerId, webhookId)
+	if err != nil {
+		return Delivery{}, err
+	}
+	if !webhook.Enabled {
+		return Delivery{}, apperrors.ErrWebhookDisabled
+	}
+
+	original, err := whS.webhookRepo.FetchDeliveryById(ctx, deliveryId)
+	if err != nil {
+		return Delivery{}, err
+	}
+	if original.WebhookID != webhookId {
+		return Delivery{}, apperrors.ErrNoWebhookDeliveryExists
+	}
+
+	delivery, err := whS.webhookRepo.EnqueueDelivery(ctx, repo.WebhookDelivery{
+		WebhookID:    webhookId,
+		EventID:      original.EventID,
+		EventType:    original.EventType,
+		Payload:      original.Payload,
+		RedeliveryOf: &original.ID,
+	})
+	if err != nil {
+		return Delivery{}, err
+	}
+
+	attempted, err := whS.attempt(ctx, webhook, delivery)
+	if err != nil {
+		return Delivery{}, err
+	}
+	return MapDeliveryRepoToService(attempted), nil
+}
+
+// Enqueue queues an outbox event for every enabled webhook of the employer it concerns that
+// subscribed to it, it is registered as an outbox subscriber
+func (whS *webhookService) Enqueue(ctx context.Context, event outbox.Event) error {
+	employerId := event.EmployerID
+	if employerId == 0 {
+		job, err := whS.jobRepo.FetchJobById(ctx, event.JobID)
+		if err != nil {
+			return err
+		}
+		employerId = job.EmployerID
+	}
+
+	webhooks, err := whS.webhookRepo.FetchSubscribedWebhooks(ctx, employerId, string(event.Type))
+	if err != nil {
+		return err
+	}
+	if len(webhooks) == 0 {
+		return nil
+	}
+
+	payload, err := json.Marshal(MapEventToPayload(event))
+	if err != nil {
+		return err
+	}
+
+	for _, webhook := range webhooks {
+		_, err = whS.webhookRepo.EnqueueDelivery(ctx, repo.WebhookDelivery{
+			WebhookID: webhook.ID,
+			EventID:   event.ID,
+			EventType: string(event.Type),
+			Payload:   payload,
+		})
+		if err != nil {
+			return fmt.Errorf("%w: %w", apperrors.ErrEnqueueWebhookDeliveries, err)
+		}
+	}
+	return nil
+}
+
+// Run delivers queued webhooks until the context is cancelled
+func (whS *webhookService) Run(ctx context.Context) {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		delivered, err := whS.DeliverPending(ctx)
+		if err != nil {
+			logger.Errorw(ctx, apperrors.ErrDeliverWebhooks.Error(), zap.Error(err))
+		}
+
+		if delivered == batchSize && ctx.Err() == nil {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// DeliverPending claims the deliveries that are due and attempts each of them, it returns the
+// number of deliveries claimed
+func (whS *webhookService) DeliverPending(ctx context.Context) (int, error) {
+	deliveries, err := whS.webhookRepo.ClaimDueDeliveries(ctx, batchSize, claimLease)
+	if err != nil {
+		return 0, err
+	}
+
+	var deliveryErrors []error
+	for _, delivery := range deliveries {
+		webhook, err := whS.webhookRepo.FetchWebhookById(ctx, delivery.WebhookID)
+		if err != nil {
+			deliveryErrors = append(deliveryErrors, err)
+			continue
+		}
+
+		_, err = whS.attempt(ctx, webhook, delivery)
+		if err != nil {
+			deliveryErrors = append(deliveryErrors, err)
+		}
+	}
+	return len(deliveries), errors.Join(deliveryErrors...)
+}
+
+// attempt posts a delivery to its webhook and records the outcome on the delivery and the
+// webhook, deliveries of disabled webhooks fail without being sent
+func (whS *webhookService) attempt(ctx context.Context, webhook repo.Webhook, delivery repo.WebhookDelivery) (repo.WebhookDelivery, error) {
+	if !webhook.Enabled {
+		delivery.Status = repo.DeliveryFailed
+		delivery.Error = apperrors.ErrWebhookDisabled.Error()
+		return whS.webhookRepo.SaveDeliveryAttempt(ctx, delivery)
+	}
+
+	response, err := whS.client.Post(ctx, webhookclient.Request{
+		URL:        webhook.URL,
+		Event:      delivery.EventType,
+		DeliveryID: delivery.ID,
+		Secret:     webhook.Secret,
+		Payload:    delivery.Payload,
+	})
+
+	delivery.Attempts++
+	delivery.ResponseStatus = response.StatusCode
+	delivery.ResponseBody = response.Body
+	succeeded := err == nil && response.Succeeded()
+
+	switch {
+	case succeeded:
+		deliveredAt := whS.now()
+		delivery.Status = repo.DeliverySucceeded
+		delivery.Error = ""
+		delivery.DeliveredAt = &deliveredAt
+	case err != nil:
+		delivery.Error = err.Error()
+	default:
+		delivery.Error = fmt.Sprintf("endpoint responded with status %d", response.StatusCode)
+	}
+
+	if !succeeded {
+		delivery.Status = repo.DeliveryPending
+		delivery.NextAttemptAt = whS.now().Add(retryDelay(delivery.Attempts))
+		if delivery.Attempts >= maxAttempts {
+			delivery.Status = repo.DeliveryFailed
+		}
+	}
+
+	savedDelivery, err := whS.webhookRepo.SaveDeliveryAttempt(ctx, delivery)
+	if err != nil {
+		return repo.WebhookDelivery{}, err
+	}
+
+	updatedWebhook, err := whS.webhookRepo.RecordWebhookOutcome(ctx, webhook.ID, succeeded, disableAfterFailures)
+	if err != nil {
+		return repo.WebhookDelivery{}, err
+	}
+	if webhook.Enabled && !updatedWebhook.Enabled {
+		logger.Infow(ctx, "webhook disabled after repeated failures", zap.Int("webhook_id", webhook.ID), zap.Int("employer_id", webhook.EmployerID))
+	}
+	return savedDelivery, nil
+}
+
+// employerWebhook fetches a webhook of the employer, webhooks of other employers are reported as
+// not existing
+func (whS *webhookService) employerWebhook(ctx context.Context, employerId int, webhookId int) (repo.Webhook, error) {
+	webhook, err := whS.webhookRepo.FetchWebhookById(ctx, webhookId)
+	if err != nil {
+		return repo.Webhook{}, err
+	}
+	if webhook.EmployerID != employerId {
+		return repo.Webhook{}, apperrors.ErrNoWebhookExists
+	}
+	return webhook, nil
+}
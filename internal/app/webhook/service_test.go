@@ -0,0 +1,369 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/outbox"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/webhookclient"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+// fakeClient records the requests it is asked to post and answers with a fixed response
+type fakeClient struct {
+	response webhookclient.Response
+	err      error
+	requests []webhookclient.Request
+}
+
+func (fc *fakeClient) Post(ctx context.Context, request webhookclient.Request) (webhookclient.Response, error) {
+	fc.requests = append(fc.requests, request)
+	return fc.response, fc.err
+}
+
+type WebhookServiceTestSuite struct {
+	suite.Suite
+	service      *webhookService
+	webhookRepo  mocks.WebhookStorer
+	employerRepo mocks.EmployerStorer
+	jobRepo      mocks.JobStorer
+	client       *fakeClient
+}
+
+var now = time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC)
+
+func (suite *WebhookServiceTestSuite) SetupTest() {
+	suite.webhookRepo = mocks.WebhookStorer{}
+	suite.employerRepo = mocks.EmployerStorer{}
+	suite.jobRepo = mocks.JobStorer{}
+	suite.client = &fakeClient{response: webhookclient.Response{StatusCode: http.StatusOK}}
+
+	suite.service = NewService(&suite.webhookRepo, &suite.employerRepo, &suite.jobRepo, suite.client).(*webhookService)
+	suite.service.now = func() time.Time { return now }
+}
+
+func (suite *WebhookServiceTestSuite) TearDownTest() {
+	suite.webhookRepo.AssertExpectations(suite.T())
+	suite.employerRepo.AssertExpectations(suite.T())
+	suite.jobRepo.AssertExpectations(suite.T())
+}
+
+func TestWebhookServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(WebhookServiceTestSuite))
+}
+
+func (suite *WebhookServiceTestSuite) TestCreateWebhook() {
+	type testCase struct {
+		name          string
+		input         Webhook
+		setup         func()
+		expectedError error
+	}
+
+	input := Webhook{URL: "https://hooks.example.com/rozgarlink", Events: []outbox.EventType{outbox.ApplicationSubmitted, outbox.ApplicationSubmitted, outbox.JobPosted}}
+
+	testCases := []testCase{
+		{
+			name:  "organization creates a webhook",
+			input: input,
+			setup: func() {
+				suite.employerRepo.On("FetchEmployerByID", mock.Anything, 4).Return(repo.Employer{ID: 4, Type: repo.Organization}, nil)
+				suite.webhookRepo.On("CreateWebhook", mock.Anything, mock.MatchedBy(func(webhook repo.Webhook) bool {
+					return webhook.EmployerID == 4 && webhook.Events == "application_submitted,job_posted" && len(webhook.Secret) > 0
+				})).Return(repo.Webhook{ID: 1, EmployerID: 4, URL: input.URL, Secret: "whsec_abc", Events: "application_submitted,job_posted", Enabled: true}, nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:  "individual employers cannot register webhooks",
+			input: input,
+			setup: func() {
+				suite.employerRepo.On("FetchEmployerByID", mock.Anything, 4).Return(repo.Employer{ID: 4, Type: repo.EmployerP}, nil)
+			},
+			expectedError: apperrors.ErrWebhooksNotAllowed,
+		},
+		{
+			name:          "url without a scheme",
+			input:         Webhook{URL: "hooks.example.com", Events: input.Events},
+			setup:         func() {},
+			expectedError: apperrors.ErrInvalidWebhook,
+		},
+		{
+			name:          "unknown event",
+			input:         Webhook{URL: input.URL, Events: []outbox.EventType{"worker_deleted"}},
+			setup:         func() {},
+			expectedError: apperrors.ErrInvalidWebhook,
+		},
+		{
+			name:          "no events",
+			input:         Webhook{URL: input.URL},
+			setup:         func() {},
+			expectedError: apperrors.ErrInvalidWebhook,
+		},
+	}
+
+	for _, test := range testCases {
+		suite.SetupTest()
+		suite.Run(test.name, func() {
+			test.setup()
+
+			webhook, err := suite.service.CreateWebhook(context.Background(), 4, test.input)
+
+			suite.ErrorIs(err, test.expectedError)
+			if test.expectedError == nil {
+				suite.Equal("whsec_abc", webhook.Secret)
+				suite.Equal([]outbox.EventType{outbox.ApplicationSubmitted, outbox.JobPosted}, webhook.Events)
+			}
+		})
+		suite.TearDownTest()
+	}
+}
+
+func (suite *WebhookServiceTestSuite) TestUpdateWebhook() {
+	type testCase struct {
+		name          string
+		input         Webhook
+		setup         func()
+		expectedError error
+	}
+
+	disabledAt := now.Add(-time.Hour)
+	disabled := repo.Webhook{ID: 1, EmployerID: 4, URL: "https://hooks.example.com", Secret: "whsec_abc", Events: "job_posted", Enabled: false, ConsecutiveFailures: 20, DisabledAt: &disabledAt}
+
+	testCases := []testCase{
+		{
+			name:  "enabling a disabled webhook clears its failures",
+			input: Webhook{ID: 1, URL: "https://hooks.example.com/v2", Events: []outbox.EventType{outbox.JobPosted}, Enabled: true},
+			setup: func() {
+				suite.webhookRepo.On("FetchWebhookById", mock.Anything, 1).Return(disabled, nil)
+				suite.webhookRepo.On("UpdateWebhook", mock.Anything, mock.MatchedBy(func(webhook repo.Webhook) bool {
+					return webhook.Enabled && webhook.ConsecutiveFailures == 0 && webhook.DisabledAt == nil && webhook.URL == "https://hooks.example.com/v2"
+				})).Return(repo.Webhook{ID: 1, EmployerID: 4, URL: "https://hooks.example.com/v2", Events: "job_posted", Enabled: true}, nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:  "webhook of another employer",
+			input: Webhook{ID: 1, URL: "https://hooks.example.com", Events: []outbox.EventType{outbox.JobPosted}, Enabled: true},
+			setup: func() {
+				suite.webhookRepo.On("FetchWebhookById", mock.Anything, 1).Return(repo.Webhook{ID: 1, EmployerID: 9}, nil)
+			},
+			expectedError: apperrors.ErrNoWebhookExists,
+		},
+	}
+
+	for _, test := range testCases {
+		suite.SetupTest()
+		suite.Run(test.name, func() {
+			test.setup()
+
+			webhook, err := suite.service.UpdateWebhook(context.Background(), 4, test.input)
+
+			suite.ErrorIs(err, test.expectedError)
+			if test.expectedError == nil {
+				suite.Empty(webhook.Secret)
+				suite.True(webhook.Enabled)
+			}
+		})
+		suite.TearDownTest()
+	}
+}
+
+func (suite *WebhookServiceTestSuite) TestEnqueue() {
+	type testCase struct {
+		name          string
+		event         outbox.Event
+		setup         func()
+		expectedError error
+	}
+
+	webhooks := []repo.Webhook{{ID: 1, EmployerID: 4, Enabled: true}, {ID: 2, EmployerID: 4, Enabled: true}}
+
+	testCases := []testCase{
+		{
+			name:  "queued for every subscribed webhook",
+			event: outbox.Event{ID: 7, Type: outbox.JobPosted, JobID: 3, EmployerID: 4, OccurredAt: now},
+			setup: func() {
+				suite.webhookRepo.On("FetchSubscribedWebhooks", mock.Anything, 4, "job_posted").Return(webhooks, nil)
+				suite.webhookRepo.On("EnqueueDelivery", mock.Anything, mock.MatchedBy(func(delivery repo.WebhookDelivery) bool {
+					return delivery.EventID == 7 && delivery.EventType == "job_posted" && delivery.RedeliveryOf == nil
+				})).Return(repo.WebhookDelivery{}, nil).Twice()
+			},
+			expectedError: nil,
+		},
+		{
+			name:  "employer resolved through the job",
+			event: outbox.Event{ID: 8, Type: outbox.ApplicationSubmitted, ApplicationID: 5, JobID: 3, WorkerID: 12, OccurredAt: now},
+			setup: func() {
+				suite.jobRepo.On("FetchJobById", mock.Anything, 3).Return(repo.Job{ID: 3, EmployerID: 4}, nil)
+				suite.webhookRepo.On("FetchSubscribedWebhooks", mock.Anything, 4, "application_submitted").Return([]repo.Webhook{}, nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:  "delivery could not be queued",
+			event: outbox.Event{ID: 7, Type: outbox.JobPosted, JobID: 3, EmployerID: 4, OccurredAt: now},
+			setup: func() {
+				suite.webhookRepo.On("FetchSubscribedWebhooks", mock.Anything, 4, "job_posted").Return(webhooks, nil)
+				suite.webhookRepo.On("EnqueueDelivery", mock.Anything, mock.Anything).Return(repo.WebhookDelivery{}, errors.New("connection reset")).Once()
+			},
+			expectedError: apperrors.ErrEnqueueWebhookDeliveries,
+		},
+	}
+
+	for _, test := range testCases {
+		suite.SetupTest()
+		suite.Run(test.name, func() {
+			test.setup()
+
+			err := suite.service.Enqueue(context.Background(), test.event)
+
+			suite.ErrorIs(err, test.expectedError)
+		})
+		suite.TearDownTest()
+	}
+}
+
+func (suite *WebhookServiceTestSuite) TestDeliverPending() {
+	type testCase struct {
+		name           string
+		delivery       repo.WebhookDelivery
+		setup          func()
+		expectedStatus repo.WebhookDeliveryStatus
+		expectedPosts  int
+	}
+
+	webhook := repo.Webhook{ID: 1, EmployerID: 4, URL: "https://hooks.example.com", Secret: "whsec_abc", Enabled: true}
+	pending := repo.WebhookDelivery{ID: 11, WebhookID: 1, EventID: 7, EventType: "job_posted", Payload: []byte(`{"id":"evt_7"}`), Status: repo.DeliveryPending}
+
+	testCases := []testCase{
+		{
+			name:     "delivered",
+			delivery: pending,
+			setup: func() {
+				suite.webhookRepo.On("RecordWebhookOutcome", mock.Anything, 1, true, disableAfterFailures).Return(webhook, nil)
+			},
+			expectedStatus: repo.DeliverySucceeded,
+			expectedPosts:  1,
+		},
+		{
+			name:     "endpoint error is retried later",
+			delivery: pending,
+			setup: func() {
+				suite.client.response = webhookclient.Response{StatusCode: http.StatusBadGateway}
+				suite.webhookRepo.On("RecordWebhookOutcome", mock.Anything, 1, false, disableAfterFailures).Return(webhook, nil)
+			},
+			expectedStatus: repo.DeliveryPending,
+			expectedPosts:  1,
+		},
+		{
+			name: "last attempt fails the delivery and disables the webhook",
+			delivery: func() repo.WebhookDelivery {
+				delivery := pending
+				delivery.Attempts = maxAttempts - 1
+				return delivery
+			}(),
+			setup: func() {
+				suite.client.response = webhookclient.Response{}
+				suite.client.err = errors.New("connection refused")
+				suite.webhookRepo.On("RecordWebhookOutcome", mock.Anything, 1, false, disableAfterFailures).Return(repo.Webhook{ID: 1, EmployerID: 4, Enabled: false}, nil)
+			},
+			expectedStatus: repo.DeliveryFailed,
+			expectedPosts:  1,
+		},
+	}
+
+	for _, test := range testCases {
+		suite.SetupTest()
+		suite.Run(test.name, func() {
+			test.setup()
+
+			var saved repo.WebhookDelivery
+			suite.webhookRepo.On("ClaimDueDeliveries", mock.Anything, batchSize, claimLease).Return([]repo.WebhookDelivery{test.delivery}, nil)
+			suite.webhookRepo.On("FetchWebhookById", mock.Anything, 1).Return(webhook, nil)
+			suite.webhookRepo.On("SaveDeliveryAttempt", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+				saved = args.Get(1).(repo.WebhookDelivery)
+			}).Return(repo.WebhookDelivery{}, nil)
+
+			count, err := suite.service.DeliverPending(context.Background())
+
+			suite.NoError(err)
+			suite.Equal(1, count)
+			suite.Equal(test.expectedStatus, saved.Status)
+			suite.Equal(test.delivery.Attempts+1, saved.Attempts)
+			suite.Len(suite.client.requests, test.expectedPosts)
+			if test.expectedStatus == repo.DeliveryPending {
+				suite.Equal(now.Add(retryDelay(saved.Attempts)), saved.NextAttemptAt)
+			}
+		})
+		suite.TearDownTest()
+	}
+}
+
+func (suite *WebhookServiceTestSuite) TestRedeliver() {
+	type testCase struct {
+		name          string
+		setup         func()
+		expectedError error
+	}
+
+	webhook := repo.Webhook{ID: 1, EmployerID: 4, URL: "https://hooks.example.com", Secret: "whsec_abc", Enabled: true}
+	original := repo.WebhookDelivery{ID: 11, WebhookID: 1, EventID: 7, EventType: "job_posted", Payload: []byte(`{"id":"evt_7"}`), Status: repo.DeliveryFailed, Attempts: maxAttempts}
+
+	testCases := []testCase{
+		{
+			name: "sent again as a new delivery",
+			setup: func() {
+				suite.webhookRepo.On("FetchWebhookById", mock.Anything, 1).Return(webhook, nil)
+				suite.webhookRepo.On("FetchDeliveryById", mock.Anything, 11).Return(original, nil)
+				suite.webhookRepo.On("EnqueueDelivery", mock.Anything, mock.MatchedBy(func(delivery repo.WebhookDelivery) bool {
+					return delivery.RedeliveryOf != nil && *delivery.RedeliveryOf == 11 && delivery.EventID == 7
+				})).Return(repo.WebhookDelivery{ID: 12, WebhookID: 1, EventID: 7, EventType: "job_posted", Payload: original.Payload, Status: repo.DeliveryPending}, nil)
+				suite.webhookRepo.On("SaveDeliveryAttempt", mock.Anything, mock.MatchedBy(func(delivery repo.WebhookDelivery) bool {
+					return delivery.ID == 12 && delivery.Status == repo.DeliverySucceeded
+				})).Return(repo.WebhookDelivery{ID: 12, WebhookID: 1, Status: repo.DeliverySucceeded, Attempts: 1}, nil)
+				suite.webhookRepo.On("RecordWebhookOutcome", mock.Anything, 1, true, disableAfterFailures).Return(webhook, nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name: "disabled webhook",
+			setup: func() {
+				suite.webhookRepo.On("FetchWebhookById", mock.Anything, 1).Return(repo.Webhook{ID: 1, EmployerID: 4, Enabled: false}, nil)
+			},
+			expectedError: apperrors.ErrWebhookDisabled,
+		},
+		{
+			name: "delivery of another webhook",
+			setup: func() {
+				suite.webhookRepo.On("FetchWebhookById", mock.Anything, 1).Return(webhook, nil)
+				suite.webhookRepo.On("FetchDeliveryById", mock.Anything, 11).Return(repo.WebhookDelivery{ID: 11, WebhookID: 2}, nil)
+			},
+			expectedError: apperrors.ErrNoWebhookDeliveryExists,
+		},
+	}
+
+	for _, test := range testCases {
+		suite.SetupTest()
+		suite.Run(test.name, func() {
+			test.setup()
+
+			delivery, err := suite.service.Redeliver(context.Background(), 4, 1, 11)
+
+			suite.ErrorIs(err, test.expectedError)
+			if test.expectedError == nil {
+				suite.Equal(Succeeded, delivery.Status)
+				suite.Len(suite.client.requests, 1)
+				suite.Equal(12, suite.client.requests[0].DeliveryID)
+			}
+		})
+		suite.TearDownTest()
+	}
+}
@@ -0,0 +1,43 @@
+package worker
+
+import (
+	"context"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/audit"
+)
+
+// auditedService records every worker that is created, updated or deleted in the audit log
+type auditedService struct {
+	Service
+	recorder audit.Recorder[Worker]
+}
+
+func NewAuditedService(workerService Service, auditService audit.Service) Service {
+	return &auditedService{
+		Service: workerService,
+		recorder: audit.Recorder[Worker]{
+			Service: auditService,
+			Type:    audit.Worker,
+			ID:      func(worker Worker) int { return worker.ID },
+			Fetch:   workerService.FetchWorkerByID,
+		},
+	}
+}
+
+func (auditedS *auditedService) CreateWorker(ctx context.Context, workerData Worker) (Worker, error) {
+	return auditedS.recorder.Create(ctx, func() (Worker, error) {
+		return auditedS.Service.CreateWorker(ctx, workerData)
+	})
+}
+
+func (auditedS *auditedService) UpdateWorkerByID(ctx context.Context, workerData Worker) (Worker, error) {
+	return auditedS.recorder.Update(ctx, workerData.ID, func() (Worker, error) {
+		return auditedS.Service.UpdateWorkerByID(ctx, workerData)
+	})
+}
+
+func (auditedS *auditedService) DeleteWorkerByID(ctx context.Context, workerId int) (int, error) {
+	return auditedS.recorder.Delete(ctx, workerId, func() (int, error) {
+		return auditedS.Service.DeleteWorkerByID(ctx, workerId)
+	})
+}
@@ -12,6 +12,12 @@ const (
 	Unknown Gender = "unknown"
 )
 
+// Badges shown on worker profiles, earned through the KYC documents approved by admins
+const (
+	IdentityVerifiedBadge = "identity_verified"
+	ITICertifiedBadge     = "iti_certified"
+)
+
 type Address struct {
 	ID      int    `json:"id,omitempty"`
 	Details string `json:"details"`
@@ -37,4 +43,5 @@ type Worker struct {
 	CreatedAt       time.Time `json:"created_at"`
 	UpdatedAt       time.Time `json:"updated_at"`
 	Language        string    `json:"language"`
+	Badges          []string  `json:"badges,omitempty"`
 }
@@ -1,6 +1,11 @@
 package worker
 
-import "github.com/harsh-jagtap-josh/RozgarLink/internal/repo"
+import (
+	"slices"
+	"strings"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo"
+)
 
 func MapRepoDomainToService(repoWorker repo.Worker) Worker {
 	return Worker{
@@ -24,6 +29,7 @@ func MapRepoDomainToService(repoWorker repo.Worker) Worker {
 		TotalJobsWorked: repoWorker.TotalJobsWorked,
 		CreatedAt:       repoWorker.CreatedAt,
 		UpdatedAt:       repoWorker.UpdatedAt,
+		Badges:          workerBadges(repoWorker.VerifiedDocuments),
 	}
 }
 
@@ -50,3 +56,17 @@ func MapServiceDomainToRepo(Worker Worker) repo.Worker {
 		Pincode:         Worker.Location.Pincode,
 	}
 }
+
+// workerBadges turns the types of the approved KYC documents of a worker into profile badges, an
+// Aadhaar or e-Shram card verifies the identity of the worker
+func workerBadges(verifiedDocuments string) []string {
+	var badges []string
+	documents := strings.Split(verifiedDocuments, ",")
+	if slices.Contains(documents, string(repo.AadhaarDocument)) || slices.Contains(documents, string(repo.EShramDocument)) {
+		badges = append(badges, IdentityVerifiedBadge)
+	}
+	if slices.Contains(documents, string(repo.ITICertificateDocument)) {
+		badges = append(badges, ITICertifiedBadge)
+	}
+	return badges
+}
@@ -5,13 +5,15 @@ import (
 	"fmt"
 
 	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/application"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/skill"
 	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
 	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/utils"
 	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo"
 )
 
 type service struct {
-	workerRepo repo.WorkerStorer
+	workerRepo   repo.WorkerStorer
+	skillService skill.Service
 }
 
 type Service interface {
@@ -23,9 +25,10 @@ type Service interface {
 	FetchAllWorkers(ctx context.Context) ([]Worker, error)
 }
 
-func NewService(workerRepo repo.WorkerStorer) Service {
+func NewService(workerRepo repo.WorkerStorer, skillService skill.Service) Service {
 	return &service{
-		workerRepo: workerRepo,
+		workerRepo:   workerRepo,
+		skillService: skillService,
 	}
 }
 
@@ -59,6 +62,12 @@ func (ws *service) CreateWorker(ctx context.Context, workerData Worker) (Worker,
 	}
 
 	workerData.Password = hashed_password
+
+	workerData.Skills, err = ws.skillService.NormalizeSkills(ctx, workerData.Skills)
+	if err != nil {
+		return Worker{}, fmt.Errorf("%w: %w", apperrors.ErrNormalizeSkills, err)
+	}
+
 	repoWorkerObj := MapServiceDomainToRepo(workerData)
 
 	newWorkerData, err := ws.workerRepo.CreateWorker(ctx, repoWorkerObj)
@@ -78,6 +87,11 @@ func (ws *service) UpdateWorkerByID(ctx context.Context, workerData Worker) (Wor
 		return Worker{}, fmt.Errorf("%w: %w", apperrors.ErrInvalidUserDetails, err)
 	}
 
+	workerData.Skills, err = ws.skillService.NormalizeSkills(ctx, workerData.Skills)
+	if err != nil {
+		return Worker{}, fmt.Errorf("%w: %w", apperrors.ErrNormalizeSkills, err)
+	}
+
 	repoWorkerObj := MapServiceDomainToRepo(workerData)
 
 	newWorkerData, err := ws.workerRepo.UpdateWorkerByID(ctx, repoWorkerObj)
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/harsh-jagtap-josh/RozgarLink/internal/app/application"
+	skillMocks "github.com/harsh-jagtap-josh/RozgarLink/internal/app/skill/mocks"
 	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo"
 	"github.com/harsh-jagtap-josh/RozgarLink/internal/repo/mocks"
 	"github.com/stretchr/testify/mock"
@@ -15,13 +16,18 @@ import (
 
 type WorkerServiceTestSuite struct {
 	suite.Suite
-	service    Service
-	workerRepo mocks.WorkerStorer
+	service      Service
+	workerRepo   mocks.WorkerStorer
+	skillService skillMocks.Service
 }
 
 func (suite *WorkerServiceTestSuite) SetupTest() {
 	suite.workerRepo = mocks.WorkerStorer{}
-	suite.service = NewService(&suite.workerRepo)
+	suite.skillService = skillMocks.Service{}
+	suite.skillService.On("NormalizeSkills", mock.Anything, mock.Anything).Return(func(ctx context.Context, skills string) (string, error) {
+		return skills, nil
+	}).Maybe()
+	suite.service = NewService(&suite.workerRepo, &suite.skillService)
 }
 
 func (suite *WorkerServiceTestSuite) TearDownTest() {
@@ -144,6 +150,25 @@ func (suite *WorkerServiceTestSuite) TestFetchWorkerById() {
 			},
 			expectedError: false,
 		},
+		{
+			name:     "worker with verified documents",
+			workerId: 1,
+			setup: func() {
+				suite.workerRepo.On("FetchWorkerByID", mock.Anything, 1).Return(repo.Worker{
+					ID:                1,
+					Name:              "John",
+					Location:          1,
+					VerifiedDocuments: "eshram,iti_certificate",
+				}, nil)
+			},
+			expectedOutput: Worker{
+				ID:       1,
+				Name:     "John",
+				Location: Address{ID: 1},
+				Badges:   []string{IdentityVerifiedBadge, ITICertifiedBadge},
+			},
+			expectedError: false,
+		},
 		{
 			name:     "db error",
 			workerId: 1,
@@ -527,7 +552,7 @@ func (suite *WorkerServiceTestSuite) TestApplicationsByWorkerId() {
 					Vacancy:        5,
 					JobDate:        "2025-02-02",
 					EmployerName:   "John Doe",
-					ContactNumber:  "9067691363",
+					ContactNumber:  "XXXXXXXX63",
 					EmployerEmail:  "emp@gmail.com",
 					EmployerType:   "Organization",
 				},
@@ -555,7 +580,7 @@ func (suite *WorkerServiceTestSuite) TestApplicationsByWorkerId() {
 					Vacancy:        5,
 					JobDate:        "2025-02-02",
 					EmployerName:   "John Doe",
-					ContactNumber:  "9067691363",
+					ContactNumber:  "XXXXXXXX63",
 					EmployerEmail:  "emp@gmail.com",
 					EmployerType:   "Organization",
 				},
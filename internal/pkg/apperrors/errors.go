@@ -54,6 +54,182 @@ var (
 	ErrDeleteSector   = errors.New("failed to delete sector data")
 	ErrFetchSector    = errors.New("failed to fetch sector data")
 	ErrNoSectorExists = errors.New("no sector found with id")
+	ErrSectorInUse    = errors.New("sector is referenced by workers, employers, jobs or skills, provide a sector to merge into")
+	ErrMergeSector    = errors.New("failed to merge sector")
+	ErrInvalidMerge   = errors.New("sector cannot be merged into itself")
+
+	// Skill Errors
+	ErrCreateSkill          = errors.New("failed to create skill")
+	ErrUpdateSkill          = errors.New("failed to update skill data")
+	ErrDeleteSkill          = errors.New("failed to delete skill data")
+	ErrFetchSkill           = errors.New("failed to fetch skill data")
+	ErrNoSkillExists        = errors.New("no skill found with id")
+	ErrInvalidSkillDetails  = errors.New("invalid skill details")
+	ErrSkillParentCycle     = errors.New("skill cannot be a parent of itself or of its ancestors")
+	ErrCreateSkillSynonym   = errors.New("failed to add skill synonym")
+	ErrDeleteSkillSynonym   = errors.New("failed to delete skill synonym")
+	ErrNoSkillSynonymExists = errors.New("no skill synonym found with id")
+	ErrSkillSynonymExists   = errors.New("skill or synonym with same name already exists")
+	ErrNormalizeSkills      = errors.New("failed to normalize skills")
+
+	// Date and Time Errors
+	ErrInvalidDateTime   = errors.New("invalid date or time")
+	ErrInvalidJobTimings = errors.New("invalid job timings")
+
+	// Job Shift Errors
+	ErrInvalidRecurrence     = errors.New("invalid job date range or recurrence")
+	ErrInvalidShiftSelection = errors.New("selected shifts do not belong to the job")
+	ErrFetchShifts           = errors.New("failed to fetch job shifts")
+
+	// Schedule Errors
+	ErrInvalidSchedule      = errors.New("invalid schedule details")
+	ErrScheduleConflict     = errors.New("job overlaps another confirmed job of the worker")
+	ErrWorkerBlackout       = errors.New("worker is unavailable on the job date")
+	ErrFetchSchedule        = errors.New("failed to fetch worker schedule")
+	ErrCreateAvailability   = errors.New("failed to create availability window")
+	ErrDeleteAvailability   = errors.New("failed to delete availability window")
+	ErrNoAvailabilityExists = errors.New("no availability window found with id")
+	ErrCreateBlackoutDate   = errors.New("failed to create blackout date")
+	ErrDeleteBlackoutDate   = errors.New("failed to delete blackout date")
+	ErrNoBlackoutDateExists = errors.New("no blackout date found with id")
+
+	// Payment Errors
+	ErrInvalidPayment        = errors.New("invalid payment details")
+	ErrPaymentExceedsDues    = errors.New("payment exceeds the outstanding wage of the application")
+	ErrApplicationNotPayable = errors.New("wages can only be recorded for confirmed applications")
+	ErrRecordPayment         = errors.New("failed to record payment")
+	ErrAcknowledgePayment    = errors.New("failed to acknowledge payment")
+	ErrFetchPayments         = errors.New("failed to fetch payments")
+	ErrFetchDues             = errors.New("failed to fetch outstanding dues")
+	ErrNoPaymentExists       = errors.New("no payment found with id")
+	ErrNotPaymentEmployer    = errors.New("payments can only be recorded by the employer of the application")
+	ErrNotPaymentWorker      = errors.New("payments can only be acknowledged by the worker of the application")
+
+	// Payment Gateway Errors
+	ErrPaymentProvider         = errors.New("payment provider rejected the request")
+	ErrPaymentProviderTimeout  = errors.New("payment provider did not respond in time")
+	ErrInvalidWebhookSignature = errors.New("invalid webhook signature")
+	ErrInvalidWebhookPayload   = errors.New("invalid webhook payload")
+	ErrMissingIdempotencyKey   = errors.New("idempotency key is required")
+	ErrIdempotencyKeyReused    = errors.New("idempotency key was already used for another payment")
+	ErrInitiatePayment         = errors.New("failed to initiate payment")
+	ErrRefundPayment           = errors.New("failed to refund payment")
+	ErrHandleWebhook           = errors.New("failed to process payment webhook")
+	ErrNoPaymentOrderExists    = errors.New("no payment order found with id")
+	ErrPaymentOrderSettled     = errors.New("payment order is already settled")
+	ErrPaymentNotRefundable    = errors.New("only paid gateway orders can be refunded")
+	ErrPaymentOrderExists      = errors.New("a payment order with the idempotency key already exists")
+
+	// Attendance Errors
+	ErrInvalidAttendance       = errors.New("invalid attendance details")
+	ErrInvalidAttendanceCode   = errors.New("attendance code is invalid, expired or already used")
+	ErrApplicationNotConfirmed = errors.New("attendance can only be marked for confirmed applications")
+	ErrNoShiftToday            = errors.New("application has no shift today")
+	ErrAttendanceAlreadyMarked = errors.New("attendance is already marked for the day")
+	ErrNotCheckedIn            = errors.New("worker is not checked in")
+	ErrNotAttendanceEmployer   = errors.New("attendance codes and no-shows can only be issued by the employer of the job")
+	ErrIssueAttendanceCode     = errors.New("failed to issue attendance code")
+	ErrCheckIn                 = errors.New("failed to check in")
+	ErrCheckOut                = errors.New("failed to check out")
+	ErrMarkNoShow              = errors.New("failed to mark no-show")
+	ErrFetchAttendance         = errors.New("failed to fetch attendance")
+
+	// Pickup Errors
+	ErrInvalidPickupAssignment = errors.New("invalid pickup assignment")
+	ErrNoPickupStop            = errors.New("job has no pickup stop at the given pincode")
+	ErrPickupNotArranged       = errors.New("pickup is only arranged for confirmed applications that chose pickup")
+	ErrFetchPickupPlan         = errors.New("failed to fetch pickup plan")
+	ErrAssignPickup            = errors.New("failed to assign pickup vehicle")
+	ErrFetchPickup             = errors.New("failed to fetch pickup details")
+
+	// Message Errors
+	ErrInvalidMessage       = errors.New("invalid message details")
+	ErrInvalidAttachment    = errors.New("invalid attachment details")
+	ErrInvalidParticipant   = errors.New("participant role must be worker or employer")
+	ErrNotThreadParticipant = errors.New("only the employer and the worker of the application can access its messages")
+	ErrSendMessage          = errors.New("failed to send message")
+	ErrFetchMessages        = errors.New("failed to fetch messages")
+	ErrMarkMessagesRead     = errors.New("failed to mark messages as read")
+
+	// Notification Errors
+	ErrInvalidRecipient           = errors.New("notification recipient must be a worker or an employer")
+	ErrInvalidPreference          = errors.New("invalid notification preference")
+	ErrNoNotificationExists       = errors.New("notification does not exist")
+	ErrFetchNotifications         = errors.New("failed to fetch notifications")
+	ErrMarkNotificationRead       = errors.New("failed to mark notification as read")
+	ErrFetchNotificationPrefs     = errors.New("failed to fetch notification preferences")
+	ErrUpdateNotificationPrefs    = errors.New("failed to update notification preferences")
+	ErrNotificationDeliveryFailed = errors.New("failed to deliver notification")
+
+	// Outbox Errors
+	ErrInvalidEventPayload = errors.New("invalid outbox event payload")
+	ErrEventDeliveryFailed = errors.New("failed to deliver event to subscribers")
+	ErrDispatchEvents      = errors.New("failed to dispatch outbox events")
+
+	// Webhook Errors
+	ErrInvalidWebhook           = errors.New("invalid webhook details")
+	ErrWebhooksNotAllowed       = errors.New("webhooks are only available to organization employers")
+	ErrNoWebhookExists          = errors.New("no webhook found with id")
+	ErrNoWebhookDeliveryExists  = errors.New("no webhook delivery found with id")
+	ErrWebhookDisabled          = errors.New("webhook is disabled")
+	ErrCreateWebhook            = errors.New("failed to create webhook")
+	ErrUpdateWebhook            = errors.New("failed to update webhook")
+	ErrDeleteWebhook            = errors.New("failed to delete webhook")
+	ErrFetchWebhooks            = errors.New("failed to fetch webhooks")
+	ErrFetchWebhookDeliveries   = errors.New("failed to fetch webhook deliveries")
+	ErrRedeliverWebhook         = errors.New("failed to redeliver webhook")
+	ErrEnqueueWebhookDeliveries = errors.New("failed to queue webhook deliveries")
+	ErrDeliverWebhooks          = errors.New("failed to deliver webhooks")
+
+	// Stream Errors
+	ErrUnauthorizedStream   = errors.New("updates can only be streamed to a logged in worker or employer")
+	ErrStreamingUnsupported = errors.New("streaming is not supported on this connection")
+	ErrTooManyStreams       = errors.New("too many open update streams")
+	ErrOpenStream           = errors.New("failed to open update stream")
+	ErrPublishStreamEvent   = errors.New("failed to publish update to streams")
+
+	// Verification Errors
+	ErrInvalidVerification         = errors.New("invalid verification details")
+	ErrInvalidVerificationStatus   = errors.New("verification status must be pending, approved or rejected")
+	ErrInvalidReview               = errors.New("a reason is required to reject a verification request")
+	ErrEmployerAlreadyVerified     = errors.New("employer is already verified")
+	ErrVerificationPending         = errors.New("a verification request of the employer is already waiting for review")
+	ErrNoVerificationExists        = errors.New("no verification request found")
+	ErrVerificationAlreadyReviewed = errors.New("verification request was already reviewed")
+	ErrSubmitVerification          = errors.New("failed to submit verification request")
+	ErrFetchVerification           = errors.New("failed to fetch verification request")
+	ErrFetchVerifications          = errors.New("failed to fetch verification requests")
+	ErrReviewVerification          = errors.New("failed to review verification request")
+
+	// Blob Storage Errors
+	ErrBlobNotFound   = errors.New("no file stored with key")
+	ErrInvalidBlobKey = errors.New("invalid file key")
+
+	// Worker Document Errors
+	ErrInvalidWorkerDocument   = errors.New("invalid worker document")
+	ErrDocumentTooLarge        = errors.New("document is larger than 5 MB")
+	ErrUnsupportedDocumentType = errors.New("documents must be pdf, jpeg or png files")
+	ErrNoWorkerDocumentExists  = errors.New("no worker document found with id")
+	ErrWorkerDocumentPending   = errors.New("a document of this type is already waiting for review")
+	ErrWorkerDocumentVerified  = errors.New("a document of this type is already verified")
+	ErrDocumentAlreadyReviewed = errors.New("document was already reviewed")
+	ErrUploadWorkerDocument    = errors.New("failed to upload worker document")
+	ErrFetchWorkerDocuments    = errors.New("failed to fetch worker documents")
+	ErrReviewWorkerDocument    = errors.New("failed to review worker document")
+	ErrFetchWorkerDocumentFile = errors.New("failed to fetch worker document file")
+
+	// Media Errors
+	ErrInvalidMedia          = errors.New("invalid image")
+	ErrMediaTooLarge         = errors.New("image is larger than 5 MB")
+	ErrUnsupportedMediaType  = errors.New("images must be jpeg or png files")
+	ErrMediaLimitReached     = errors.New("the maximum number of images is already uploaded")
+	ErrNoMediaExists         = errors.New("no image found with id")
+	ErrInvalidMediaSignature = errors.New("invalid image link")
+	ErrMediaURLExpired       = errors.New("image link has expired")
+	ErrUploadMedia           = errors.New("failed to upload image")
+	ErrFetchMedia            = errors.New("failed to fetch images")
+	ErrDeleteMedia           = errors.New("failed to delete image")
+	ErrFetchMediaFile        = errors.New("failed to fetch image file")
 
 	// Admin Errors
 	ErrCreateAdmin   = errors.New("failed to create admin")
@@ -62,6 +238,80 @@ var (
 	ErrAdminExists   = errors.New("admin with same email already exists")
 	ErrNoAdminExists = errors.New("no admin found with id")
 
+	// Admin Console Errors
+	ErrInvalidUserSearch       = errors.New("invalid search, status must be active or suspended and limit and offset cannot be negative")
+	ErrInvalidSuspension       = errors.New("a reason is required to suspend an account")
+	ErrAccountSuspended        = errors.New("account is suspended")
+	ErrAccountAlreadySuspended = errors.New("account is already suspended")
+	ErrAccountNotSuspended     = errors.New("account is not suspended")
+	ErrInvalidModeration       = errors.New("a reason is required to hide a job")
+	ErrJobAlreadyHidden        = errors.New("job is already hidden")
+	ErrJobNotHidden            = errors.New("job is not hidden")
+	ErrInvalidAdminRole        = errors.New("admin role must be an existing role other than worker or employer")
+	ErrLastSuperAdmin          = errors.New("the last super admin cannot be removed or demoted")
+	ErrInvalidDashboardRange   = errors.New("dashboard range must be between 1 and 366 days")
+	ErrFetchUsers              = errors.New("failed to fetch accounts")
+	ErrSuspendUser             = errors.New("failed to suspend account")
+	ErrReactivateUser          = errors.New("failed to reactivate account")
+	ErrModerateJob             = errors.New("failed to moderate job")
+	ErrFetchAdmins             = errors.New("failed to fetch admins")
+	ErrFetchDashboard          = errors.New("failed to fetch dashboard")
+
+	// Role Errors
+	ErrInvalidRole     = errors.New("invalid role, the name must be 3 to 30 lowercase letters, digits or dashes and every permission must exist")
+	ErrNoRoleExists    = errors.New("no role found with name")
+	ErrRoleExists      = errors.New("role already exists")
+	ErrSystemRole      = errors.New("built-in roles cannot be deleted and the worker, employer and super-admin roles cannot be created or changed")
+	ErrRoleInUse       = errors.New("role is assigned to admins")
+	ErrFetchRole       = errors.New("failed to fetch role")
+	ErrCreateRole      = errors.New("failed to create role")
+	ErrUpdateRole      = errors.New("failed to update role")
+	ErrDeleteRole      = errors.New("failed to delete role")
+	ErrCheckPermission = errors.New("failed to check permissions")
+
+	// Audit Errors
+	ErrInvalidAuditFilter = errors.New("invalid audit log filters, ids, limit and offset must be positive numbers and dates in YYYY-MM-DD format")
+	ErrFetchAuditLogs     = errors.New("failed to fetch audit logs")
+
+	// Report Errors
+	ErrInvalidReport           = errors.New("invalid report, target type must be job, employer, worker or message and reason one of below_minimum_wage, suspicious_contact_request, fake_job, harassment, fraud, spam, inappropriate_content or other, with details for other")
+	ErrInvalidReporter         = errors.New("only workers and employers can report content")
+	ErrNoReportTarget          = errors.New("reported content does not exist")
+	ErrSelfReport              = errors.New("you cannot report yourself or your own content")
+	ErrAlreadyReported         = errors.New("you have already reported this content")
+	ErrInvalidReportQueue      = errors.New("invalid report queue filters, target type must be job, employer, worker or message and limit and offset positive numbers")
+	ErrInvalidReportResolution = errors.New("invalid report resolution, action must be dismiss, hide or suspend, and suspend is only for workers and employers")
+	ErrNoOpenReports           = errors.New("content has no open reports")
+	ErrCreateReport            = errors.New("failed to report content")
+	ErrFetchReports            = errors.New("failed to fetch reports")
+	ErrResolveReports          = errors.New("failed to resolve reports")
+
+	// Minimum Wage Errors
+	ErrInvalidMinimumWage     = errors.New("invalid minimum wage, daily wage must be a positive number and skill level one of unskilled, semi_skilled, skilled or highly_skilled")
+	ErrInvalidSkillLevel      = errors.New("skill level must be one of unskilled, semi_skilled, skilled or highly_skilled")
+	ErrInvalidComplianceQuery = errors.New("invalid compliance report filters, status must be compliant, below_minimum or no_minimum and limit and offset positive numbers")
+	ErrNoMinimumWageExists    = errors.New("no minimum wage found with id")
+	ErrBelowMinimumWage       = errors.New("wage is below the minimum wage")
+	ErrFetchMinimumWages      = errors.New("failed to fetch minimum wages")
+	ErrSaveMinimumWage        = errors.New("failed to save minimum wage")
+	ErrDeleteMinimumWage      = errors.New("failed to delete minimum wage")
+	ErrRecordWageCheck        = errors.New("failed to record job wage check")
+	ErrFetchComplianceReport  = errors.New("failed to fetch wage compliance report")
+
+	// Analytics Errors
+	ErrInvalidWageFilter      = errors.New("invalid wage filters, season must be summer, monsoon or winter")
+	ErrNotEnoughWageData      = errors.New("not enough jobs like this one to suggest a wage")
+	ErrFetchWageAnalytics     = errors.New("failed to fetch wage analytics")
+	ErrSuggestWage            = errors.New("failed to suggest a wage")
+	ErrInvalidAnalyticsFilter = errors.New("invalid analytics filters, from and to must be dates in YYYY-MM-DD format with from not after to and interval week or month")
+	ErrFetchEmployerAnalytics = errors.New("failed to fetch employer analytics")
+
+	// Work History Errors
+	ErrInvalidHistoryFilter   = errors.New("invalid work history filters, from and to must be dates in YYYY-MM-DD format with from not after to")
+	ErrInvalidStatementFormat = errors.New("statement format must be pdf or csv")
+	ErrFetchWorkHistory       = errors.New("failed to fetch work history")
+	ErrGenerateStatement      = errors.New("failed to generate earnings statement")
+
 	// Login Errors
 	ErrInvalidLoginCredentials = errors.New("invalid email or password")
 )
@@ -89,6 +339,54 @@ const MsgFailedToFetchApplication = "failed to fetch application"
 const MsgInvalidSectorId = "invalid sector id provided"
 const MsgFailedToFetchSector = "failed to fetch sector"
 
+// Skills Error Messages
+const MsgInvalidSkillId = "invalid skill id provided"
+const MsgInvalidSynonymId = "invalid synonym id provided"
+const MsgFailedToFetchSkill = "failed to fetch skill"
+
+// Schedule Error Messages
+const MsgInvalidAvailabilityId = "invalid availability id provided"
+const MsgInvalidBlackoutId = "invalid blackout date id provided"
+const MsgInvalidDateRange = "invalid date range provided"
+
+// Payment Error Messages
+const MsgInvalidPaymentId = "invalid payment id provided"
+const MsgInvalidOrderId = "invalid payment order id provided"
+
+// Pickup Error Messages
+const MsgInvalidPincode = "invalid pincode provided"
+
+// Message Error Messages
+const MsgInvalidUserId = "invalid user id provided"
+
+// Notification Error Messages
+const MsgInvalidNotificationId = "invalid notification id provided"
+
+// Webhook Error Messages
+const MsgInvalidWebhookId = "invalid webhook id provided"
+const MsgInvalidDeliveryId = "invalid webhook delivery id provided"
+
+// Stream Error Messages
+const MsgInvalidLastEventId = "invalid last event id provided"
+
+// Verification Error Messages
+const MsgInvalidVerificationId = "invalid verification id provided"
+
+// Worker Document Error Messages
+const MsgInvalidDocumentId = "invalid document id provided"
+
+// Admin Error Messages
+const MsgInvalidAdminId = "invalid admin id provided"
+
+// Media Error Messages
+const MsgInvalidMediaId = "invalid image id provided"
+
+// Report Error Messages
+const MsgInvalidReportTargetId = "invalid reported content id provided"
+
+// Minimum Wage Error Messages
+const MsgInvalidMinimumWageId = "invalid minimum wage id provided"
+
 func HttpErrorResponseMessage(warning, message, id string) string {
 	return fmt.Sprintf("%s: %s, id: %v", warning, message, id)
 }
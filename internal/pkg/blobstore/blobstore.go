@@ -0,0 +1,34 @@
+package blobstore
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+)
+
+// BlobStore keeps uploaded files. Keys are slash separated relative paths chosen by the caller
+// (letters, digits, dots, dashes and underscores in each part),
+// the metadata of a blob (name, content type, size) is kept by the caller with its key.
+type BlobStore interface {
+	Put(ctx context.Context, key string, contentType string, body io.Reader) error
+	// Get returns apperrors.ErrBlobNotFound when no blob is stored under the key
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete removes a blob, deleting a missing blob is not an error
+	Delete(ctx context.Context, key string) error
+}
+
+var keyPartPattern = regexp.MustCompile(`^[A-Za-z0-9_-][A-Za-z0-9._-]*$`)
+
+// validateKey rejects keys that could escape the store or would need escaping in a URL
+func validateKey(key string) error {
+	for _, part := range strings.Split(key, "/") {
+		if !keyPartPattern.MatchString(part) {
+			return fmt.Errorf("%w: %q", apperrors.ErrInvalidBlobKey, key)
+		}
+	}
+	return nil
+}
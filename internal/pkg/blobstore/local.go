@@ -0,0 +1,91 @@
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+)
+
+// localStore keeps blobs as files under a root directory
+type localStore struct {
+	root string
+}
+
+// NewLocalStore stores blobs under root, directories are created on the first write
+func NewLocalStore(root string) BlobStore {
+	return &localStore{root: root}
+}
+
+func (ls *localStore) Put(ctx context.Context, key string, contentType string, body io.Reader) error {
+	path, err := ls.path(key)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(path), 0o750)
+	if err != nil {
+		return err
+	}
+
+	// write to a temporary file first so that readers never see a partially written blob
+	file, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+
+	_, err = io.Copy(file, body)
+	if err != nil {
+		file.Close()
+		return err
+	}
+
+	err = file.Close()
+	if err != nil {
+		return err
+	}
+	return os.Rename(file.Name(), path)
+}
+
+func (ls *localStore) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	path, err := ls.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, apperrors.ErrBlobNotFound
+		}
+		return nil, err
+	}
+	return file, nil
+}
+
+func (ls *localStore) Delete(ctx context.Context, key string) error {
+	path, err := ls.path(key)
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(path)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// path resolves a key inside the root, keys escaping the root are rejected
+func (ls *localStore) path(key string) (string, error) {
+	err := validateKey(key)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(ls.root, filepath.FromSlash(key)), nil
+}
@@ -0,0 +1,56 @@
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+)
+
+func TestLocalStore(t *testing.T) {
+	ctx := context.Background()
+	store := NewLocalStore(t.TempDir())
+
+	err := store.Put(ctx, "worker-documents/12/card.pdf", "application/pdf", strings.NewReader("%PDF-1.4"))
+	if err != nil {
+		t.Fatalf("failed to put blob: %v", err)
+	}
+
+	blob, err := store.Get(ctx, "worker-documents/12/card.pdf")
+	if err != nil {
+		t.Fatalf("failed to get blob: %v", err)
+	}
+	content, _ := io.ReadAll(blob)
+	blob.Close()
+	if string(content) != "%PDF-1.4" {
+		t.Errorf("expected the stored content, got %q", content)
+	}
+
+	err = store.Delete(ctx, "worker-documents/12/card.pdf")
+	if err != nil {
+		t.Fatalf("failed to delete blob: %v", err)
+	}
+	err = store.Delete(ctx, "worker-documents/12/card.pdf")
+	if err != nil {
+		t.Errorf("deleting a missing blob should succeed, got %v", err)
+	}
+
+	_, err = store.Get(ctx, "worker-documents/12/card.pdf")
+	if !errors.Is(err, apperrors.ErrBlobNotFound) {
+		t.Errorf("expected ErrBlobNotFound, got %v", err)
+	}
+}
+
+func TestLocalStoreRejectsKeysOutsideRoot(t *testing.T) {
+	store := NewLocalStore(t.TempDir())
+
+	for _, key := range []string{"", "/etc/passwd", "../secrets", "documents/../../secrets", "documents//card.pdf", `documents\card.pdf`} {
+		err := store.Put(context.Background(), key, "text/plain", strings.NewReader("x"))
+		if !errors.Is(err, apperrors.ErrInvalidBlobKey) {
+			t.Errorf("expected ErrInvalidBlobKey for %q, got %v", key, err)
+		}
+	}
+}
@@ -0,0 +1,203 @@
+package blobstore
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+)
+
+const (
+	signingAlgorithm = "AWS4-HMAC-SHA256"
+	amzDateFormat    = "20060102T150405Z"
+	// larger error bodies of the storage service are cut in error messages
+	maxErrorBodyLength = 512
+)
+
+// S3Config points an S3 compatible store at a bucket. Objects are addressed path style
+// (endpoint/bucket/key), which AWS S3, MinIO and most other services accept.
+type S3Config struct {
+	Endpoint  string
+	Region    string
+	Bucket    string
+	AccessKey string
+	SecretKey string
+}
+
+// s3Store keeps blobs as objects of an S3 compatible bucket, requests are signed with AWS
+// signature version 4
+type s3Store struct {
+	config S3Config
+	client *http.Client
+	now    func() time.Time
+}
+
+func NewS3Store(config S3Config, client *http.Client) BlobStore {
+	config.Endpoint = strings.TrimRight(config.Endpoint, "/")
+	return &s3Store{
+		config: config,
+		client: client,
+		now:    time.Now,
+	}
+}
+
+// Put uploads a blob in a single request, the body is read in memory first as the payload has to
+// be hashed for the signature. Callers limit the size of what they store.
+func (s3S *s3Store) Put(ctx context.Context, key string, contentType string, body io.Reader) error {
+	content, err := io.ReadAll(body)
+	if err != nil {
+		return err
+	}
+
+	req, err := s3S.newRequest(ctx, http.MethodPut, key, content)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", contentType)
+	s3S.sign(req, content)
+
+	resp, err := s3S.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return responseError(resp)
+	}
+	return nil
+}
+
+func (s3S *s3Store) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	req, err := s3S.newRequest(ctx, http.MethodGet, key, nil)
+	if err != nil {
+		return nil, err
+	}
+	s3S.sign(req, nil)
+
+	resp, err := s3S.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return resp.Body, nil
+	case http.StatusNotFound:
+		resp.Body.Close()
+		return nil, apperrors.ErrBlobNotFound
+	}
+	defer resp.Body.Close()
+	return nil, responseError(resp)
+}
+
+func (s3S *s3Store) Delete(ctx context.Context, key string) error {
+	req, err := s3S.newRequest(ctx, http.MethodDelete, key, nil)
+	if err != nil {
+		return err
+	}
+	s3S.sign(req, nil)
+
+	resp, err := s3S.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNotFound {
+		return responseError(resp)
+	}
+	return nil
+}
+
+func (s3S *s3Store) newRequest(ctx context.Context, method string, key string, content []byte) (*http.Request, error) {
+	err := validateKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	var body io.Reader
+	if content != nil {
+		body = bytes.NewReader(content)
+	}
+	return http.NewRequestWithContext(ctx, method, s3S.config.Endpoint+"/"+s3S.config.Bucket+"/"+key, body)
+}
+
+// sign adds the signature version 4 headers to a request, the signature covers the host, the
+// payload hash and every x-amz, content-type and range header set on the request
+func (s3S *s3Store) sign(req *http.Request, payload []byte) {
+	now := s3S.now().UTC()
+	payloadHash := sha256.Sum256(payload)
+
+	req.Header.Set("X-Amz-Date", now.Format(amzDateFormat))
+	req.Header.Set("X-Amz-Content-Sha256", hex.EncodeToString(payloadHash[:]))
+
+	canonicalHeaders, signedHeaders := canonicalHeaders(req)
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.Query().Encode(),
+		canonicalHeaders,
+		signedHeaders,
+		hex.EncodeToString(payloadHash[:]),
+	}, "\n")
+
+	date := now.Format("20060102")
+	scope := date + "/" + s3S.config.Region + "/s3/aws4_request"
+	requestHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := signingAlgorithm + "\n" + now.Format(amzDateFormat) + "\n" + scope + "\n" + hex.EncodeToString(requestHash[:])
+
+	signingKey := hmacSHA256([]byte("AWS4"+s3S.config.SecretKey), date)
+	signingKey = hmacSHA256(signingKey, s3S.config.Region)
+	signingKey = hmacSHA256(signingKey, "s3")
+	signingKey = hmacSHA256(signingKey, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s", signingAlgorithm, s3S.config.AccessKey, scope, signedHeaders, signature))
+}
+
+func canonicalHeaders(req *http.Request) (string, string) {
+	host := req.Host
+	if host == "" {
+		host = req.URL.Host
+	}
+	headers := map[string]string{"host": host}
+	for name, values := range req.Header {
+		name = strings.ToLower(name)
+		if strings.HasPrefix(name, "x-amz-") || name == "content-type" || name == "range" {
+			headers[name] = strings.TrimSpace(strings.Join(values, ","))
+		}
+	}
+
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var canonical strings.Builder
+	for _, name := range names {
+		canonical.WriteString(name + ":" + headers[name] + "\n")
+	}
+	return canonical.String(), strings.Join(names, ";")
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+func responseError(resp *http.Response) error {
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodyLength))
+	return fmt.Errorf("storage service responded with %s: %s", resp.Status, strings.TrimSpace(string(body)))
+}
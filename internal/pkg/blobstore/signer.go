@@ -0,0 +1,59 @@
+package blobstore
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+)
+
+// URLSigner issues time limited download links of blobs served by the application, a link is
+// only valid for the key and expiry it was signed with
+type URLSigner struct {
+	baseURL string
+	secret  []byte
+}
+
+func NewURLSigner(baseURL string, secret string) URLSigner {
+	return URLSigner{
+		baseURL: strings.TrimRight(baseURL, "/"),
+		secret:  []byte(secret),
+	}
+}
+
+// Sign returns the link of a key valid until expiresAt
+func (us URLSigner) Sign(key string, expiresAt time.Time) string {
+	expires := strconv.FormatInt(expiresAt.Unix(), 10)
+	query := url.Values{}
+	query.Set("expires", expires)
+	query.Set("signature", us.signature(key, expires))
+	return us.baseURL + "/" + key + "?" + query.Encode()
+}
+
+// Verify checks the expiry and signature taken from the query of a link
+func (us URLSigner) Verify(key string, expires string, signature string, now time.Time) error {
+	expiresAt, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil {
+		return apperrors.ErrInvalidMediaSignature
+	}
+
+	expected := us.signature(key, expires)
+	if !hmac.Equal([]byte(expected), []byte(signature)) {
+		return apperrors.ErrInvalidMediaSignature
+	}
+	if now.Unix() > expiresAt {
+		return apperrors.ErrMediaURLExpired
+	}
+	return nil
+}
+
+func (us URLSigner) signature(key string, expires string) string {
+	mac := hmac.New(sha256.New, us.secret)
+	mac.Write([]byte(key + "\n" + expires))
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,49 @@
+package blobstore
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+)
+
+func TestURLSigner(t *testing.T) {
+	signer := NewURLSigner("http://localhost:8080/media/files/", "secret")
+	now := time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC)
+
+	link := signer.Sign("media/job/7/front.jpg", now.Add(15*time.Minute))
+	if !strings.HasPrefix(link, "http://localhost:8080/media/files/media/job/7/front.jpg?") {
+		t.Fatalf("unexpected link %q", link)
+	}
+
+	parsed, _ := url.Parse(link)
+	expires := parsed.Query().Get("expires")
+	signature := parsed.Query().Get("signature")
+
+	type testCase struct {
+		name          string
+		key           string
+		expires       string
+		signature     string
+		now           time.Time
+		expectedError error
+	}
+
+	testCases := []testCase{
+		{name: "valid link", key: "media/job/7/front.jpg", expires: expires, signature: signature, now: now},
+		{name: "expired link", key: "media/job/7/front.jpg", expires: expires, signature: signature, now: now.Add(time.Hour), expectedError: apperrors.ErrMediaURLExpired},
+		{name: "other key", key: "media/job/8/front.jpg", expires: expires, signature: signature, now: now, expectedError: apperrors.ErrInvalidMediaSignature},
+		{name: "extended expiry", key: "media/job/7/front.jpg", expires: "99999999999", signature: signature, now: now, expectedError: apperrors.ErrInvalidMediaSignature},
+		{name: "missing expiry", key: "media/job/7/front.jpg", signature: signature, now: now, expectedError: apperrors.ErrInvalidMediaSignature},
+	}
+
+	for _, test := range testCases {
+		err := signer.Verify(test.key, test.expires, test.signature, test.now)
+		if !errors.Is(err, test.expectedError) {
+			t.Errorf("%s: expected error %v, got %v", test.name, test.expectedError, err)
+		}
+	}
+}
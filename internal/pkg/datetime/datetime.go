@@ -0,0 +1,189 @@
+package datetime
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+)
+
+const (
+	DateLayout  = "2006-01-02"
+	ClockLayout = "15:04"
+)
+
+// Location is the default timezone all dates and hours are interpreted in,
+// India does not observe daylight saving so the fixed offset is exact
+var Location = time.FixedZone("Asia/Kolkata", 5*60*60+30*60)
+
+// Date is a calendar day stored in a DATE column and exchanged as "YYYY-MM-DD", empty when not set
+type Date string
+
+// Clock is a time of day stored in a TIME column and exchanged as "HH:MM", empty when not set
+type Clock string
+
+// ParseDate strictly parses a "YYYY-MM-DD" date
+func ParseDate(value string) (Date, error) {
+	parsed, err := time.ParseInLocation(DateLayout, value, Location)
+	if err != nil {
+		return "", fmt.Errorf("%w: date %q must be in YYYY-MM-DD format", apperrors.ErrInvalidDateTime, value)
+	}
+	return Date(parsed.Format(DateLayout)), nil
+}
+
+// ParseClock strictly parses an "HH:MM" (or "HH:MM:SS" as returned by postgres) time of day
+func ParseClock(value string) (Clock, error) {
+	for _, layout := range []string{ClockLayout, "15:04:05"} {
+		parsed, err := time.Parse(layout, value)
+		if err == nil {
+			return Clock(parsed.Format(ClockLayout)), nil
+		}
+	}
+	return "", fmt.Errorf("%w: time %q must be in HH:MM format", apperrors.ErrInvalidDateTime, value)
+}
+
+func Today() Date {
+	return Date(time.Now().In(Location).Format(DateLayout))
+}
+
+func (d Date) IsZero() bool {
+	return d == ""
+}
+
+// Time returns the start of the day in the default location
+func (d Date) Time() (time.Time, error) {
+	parsed, err := time.ParseInLocation(DateLayout, string(d), Location)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: date %q must be in YYYY-MM-DD format", apperrors.ErrInvalidDateTime, string(d))
+	}
+	return parsed, nil
+}
+
+func (d Date) AddDays(days int) (Date, error) {
+	day, err := d.Time()
+	if err != nil {
+		return "", err
+	}
+	return Date(day.AddDate(0, 0, days).Format(DateLayout)), nil
+}
+
+func (d *Date) UnmarshalJSON(data []byte) error {
+	var value string
+	err := json.Unmarshal(data, &value)
+	if err != nil {
+		return fmt.Errorf("%w: date must be a string in YYYY-MM-DD format", apperrors.ErrInvalidDateTime)
+	}
+
+	if value == "" {
+		*d = ""
+		return nil
+	}
+
+	*d, err = ParseDate(value)
+	return err
+}
+
+func (d Date) Value() (driver.Value, error) {
+	if d.IsZero() {
+		return nil, nil
+	}
+	return string(d), nil
+}
+
+func (d *Date) Scan(src interface{}) error {
+	switch value := src.(type) {
+	case nil:
+		*d = ""
+		return nil
+	case time.Time:
+		*d = Date(value.Format(DateLayout))
+		return nil
+	case []byte:
+		return d.scanString(string(value))
+	case string:
+		return d.scanString(value)
+	}
+	return fmt.Errorf("%w: cannot scan %T into date", apperrors.ErrInvalidDateTime, src)
+}
+
+func (d *Date) scanString(value string) error {
+	// postgres may render dates with a time part when selected through text casts
+	if len(value) > len(DateLayout) {
+		value = value[:len(DateLayout)]
+	}
+
+	parsed, err := ParseDate(value)
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+func (c Clock) IsZero() bool {
+	return c == ""
+}
+
+// Duration returns the offset of the time of day from midnight
+func (c Clock) Duration() (time.Duration, error) {
+	parsed, err := time.Parse(ClockLayout, string(c))
+	if err != nil {
+		return 0, fmt.Errorf("%w: time %q must be in HH:MM format", apperrors.ErrInvalidDateTime, string(c))
+	}
+	return time.Duration(parsed.Hour())*time.Hour + time.Duration(parsed.Minute())*time.Minute, nil
+}
+
+func (c *Clock) UnmarshalJSON(data []byte) error {
+	var value string
+	err := json.Unmarshal(data, &value)
+	if err != nil {
+		return fmt.Errorf("%w: time must be a string in HH:MM format", apperrors.ErrInvalidDateTime)
+	}
+
+	if value == "" {
+		*c = ""
+		return nil
+	}
+
+	*c, err = ParseClock(value)
+	return err
+}
+
+func (c Clock) Value() (driver.Value, error) {
+	if c.IsZero() {
+		return nil, nil
+	}
+	return string(c), nil
+}
+
+func (c *Clock) Scan(src interface{}) error {
+	switch value := src.(type) {
+	case nil:
+		*c = ""
+		return nil
+	case time.Time:
+		*c = Clock(value.Format(ClockLayout))
+		return nil
+	case []byte:
+		return c.scanString(string(value))
+	case string:
+		return c.scanString(value)
+	}
+	return fmt.Errorf("%w: cannot scan %T into time", apperrors.ErrInvalidDateTime, src)
+}
+
+func (c *Clock) scanString(value string) error {
+	if value == "" {
+		*c = ""
+		return nil
+	}
+
+	parsed, err := ParseClock(value)
+	if err != nil {
+		return err
+	}
+	*c = parsed
+	return nil
+}
@@ -0,0 +1,104 @@
+package datetime
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+)
+
+func TestParseDate(t *testing.T) {
+	testCases := []struct {
+		name           string
+		input          string
+		expectedOutput Date
+		expectedError  error
+	}{
+		{name: "valid date", input: "2025-03-10", expectedOutput: "2025-03-10"},
+		{name: "day first format", input: "10-03-2025", expectedError: apperrors.ErrInvalidDateTime},
+		{name: "timestamp", input: "2025-03-10T09:00:00Z", expectedError: apperrors.ErrInvalidDateTime},
+		{name: "impossible date", input: "2025-02-30", expectedError: apperrors.ErrInvalidDateTime},
+	}
+
+	for _, test := range testCases {
+		output, err := ParseDate(test.input)
+		if output != test.expectedOutput || !errors.Is(err, test.expectedError) {
+			t.Errorf("%s: expected %q, %v got %q, %v", test.name, test.expectedOutput, test.expectedError, output, err)
+		}
+	}
+}
+
+func TestParseClock(t *testing.T) {
+	testCases := []struct {
+		name           string
+		input          string
+		expectedOutput Clock
+		expectedError  error
+	}{
+		{name: "valid time", input: "09:30", expectedOutput: "09:30"},
+		{name: "time with seconds", input: "17:00:00", expectedOutput: "17:00"},
+		{name: "twelve hour format", input: "9:30 AM", expectedError: apperrors.ErrInvalidDateTime},
+		{name: "out of range", input: "25:00", expectedError: apperrors.ErrInvalidDateTime},
+	}
+
+	for _, test := range testCases {
+		output, err := ParseClock(test.input)
+		if output != test.expectedOutput || !errors.Is(err, test.expectedError) {
+			t.Errorf("%s: expected %q, %v got %q, %v", test.name, test.expectedOutput, test.expectedError, output, err)
+		}
+	}
+}
+
+func TestDateTimeInLocation(t *testing.T) {
+	day, err := Date("2025-03-10").Time()
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if !day.Equal(time.Date(2025, 3, 9, 18, 30, 0, 0, time.UTC)) {
+		t.Errorf("expected midnight in Asia/Kolkata, got %v", day.UTC())
+	}
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	var payload struct {
+		Date  Date  `json:"date"`
+		Start Clock `json:"start_hour"`
+	}
+
+	err := json.Unmarshal([]byte(`{"date": "2025-03-10", "start_hour": "09:00"}`), &payload)
+	if err != nil || payload.Date != "2025-03-10" || payload.Start != "09:00" {
+		t.Errorf("expected valid payload to be parsed, got %+v, %v", payload, err)
+	}
+
+	err = json.Unmarshal([]byte(`{"date": "10/03/2025"}`), &payload)
+	if !errors.Is(err, apperrors.ErrInvalidDateTime) {
+		t.Errorf("expected invalid date to be rejected, got %v", err)
+	}
+
+	err = json.Unmarshal([]byte(`{"start_hour": "9 o'clock"}`), &payload)
+	if !errors.Is(err, apperrors.ErrInvalidDateTime) {
+		t.Errorf("expected invalid time to be rejected, got %v", err)
+	}
+}
+
+func TestScan(t *testing.T) {
+	var date Date
+	err := date.Scan(time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC))
+	if err != nil || date != "2025-03-10" {
+		t.Errorf("expected date scanned from time, got %q, %v", date, err)
+	}
+
+	var clock Clock
+	err = clock.Scan([]byte("09:00:00"))
+	if err != nil || clock != "09:00" {
+		t.Errorf("expected clock scanned from bytes, got %q, %v", clock, err)
+	}
+
+	err = clock.Scan(nil)
+	if err != nil || clock != "" {
+		t.Errorf("expected null to scan as empty clock, got %q, %v", clock, err)
+	}
+}
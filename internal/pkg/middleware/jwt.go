@@ -2,6 +2,7 @@ package middleware
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"os"
 	"strconv"
@@ -86,49 +87,64 @@ func ValidateJWT(next http.Handler) http.Handler {
 	})
 }
 
-func RequireWorkerRole(next http.Handler) http.Handler {
+// IdentifyJWT puts the user id and role of a valid JWT into the request context like ValidateJWT but
+// never rejects the request, routes that are still open use it so that the changes made through them
+// are recorded with the user that made them
+func IdentifyJWT(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		userRole := r.Context().Value("role")
-		if userRole != "worker" {
-			logger.Errorw(r.Context(), "unauthorized access", zap.String("required_role", "worker"))
-			HandleErrorResponse(r.Context(), w, "unauthorized access to api", http.StatusForbidden)
+		userId, role, ok := tokenIdentity(r)
+		if !ok {
+			next.ServeHTTP(w, r)
 			return
 		}
-		next.ServeHTTP(w, r)
-	})
-}
 
-func RequireEmployerRole(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		userRole := r.Context().Value("role")
-		if userRole != "employer" {
-			logger.Errorw(r.Context(), "unauthorized access", zap.String("required_role", "employer"))
-			HandleErrorResponse(r.Context(), w, "unauthorized access to api", http.StatusForbidden)
-			return
-		}
-		next.ServeHTTP(w, r)
+		ctx := context.WithValue(r.Context(), "user_id", userId)
+		ctx = context.WithValue(ctx, "role", role)
+		next.ServeHTTP(w, r.WithContext(ctx))
 	})
 }
 
-func RequireAdminRole(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		userRole := r.Context().Value("role")
-		if userRole != "admin" {
-			logger.Errorw(r.Context(), "unauthorized access", zap.String("required_role", "admin"))
-			HandleErrorResponse(r.Context(), w, "unauthorized access to api", http.StatusForbidden)
-			return
+// tokenIdentity reads the user id and role of the bearer token of a request, it is not ok when the
+// token is missing, is not signed with the JWT_PRIVATE_KEY or does not carry both claims
+func tokenIdentity(r *http.Request) (int, string, bool) {
+	tokenStr, found := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	secret := os.Getenv("JWT_PRIVATE_KEY")
+	if !found || tokenStr == "" || secret == "" {
+		return 0, "", false
+	}
+
+	token, err := jwt.Parse(tokenStr, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method %v", token.Header["alg"])
 		}
-		next.ServeHTTP(w, r)
+		return []byte(secret), nil
 	})
+	if err != nil || !token.Valid {
+		return 0, "", false
+	}
+
+	data, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return 0, "", false
+	}
+	userId, ok := data["user_id"].(float64)
+	if !ok {
+		return 0, "", false
+	}
+	role, ok := data["role"].(string)
+	if !ok || role == "" {
+		return 0, "", false
+	}
+	return int(userId), role, true
 }
 
-func RequireSuperAdminRole(next http.Handler) http.Handler {
+// TokenFromQuery lets clients that cannot set headers, like the browser EventSource, send the
+// jwt in the access_token query parameter, it must be placed before ValidateJWT
+func TokenFromQuery(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		userRole := r.Context().Value("role")
-		if userRole != "super-admin" {
-			logger.Errorw(r.Context(), "unauthorized access", zap.String("required_role", "worker"))
-			HandleErrorResponse(r.Context(), w, "unauthorized access to api", http.StatusForbidden)
-			return
+		token := r.URL.Query().Get("access_token")
+		if r.Header.Get("Authorization") == "" && token != "" {
+			r.Header.Set("Authorization", "Bearer "+token)
 		}
 		next.ServeHTTP(w, r)
 	})
@@ -0,0 +1,100 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang-jwt/jwt"
+)
+
+func signedToken(t *testing.T, secret string, claims jwt.MapClaims) string {
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(secret))
+	if err != nil {
+		t.Fatalf("failed to sign token: %v", err)
+	}
+	return token
+}
+
+func TestIdentifyJWT(t *testing.T) {
+	t.Setenv("JWT_PRIVATE_KEY", "test-secret")
+
+	type testCase struct {
+		name          string
+		authorization string
+		expectedId    interface{}
+		expectedRole  interface{}
+	}
+
+	testCases := []testCase{
+		{
+			name:          "valid token",
+			authorization: "Bearer " + signedToken(t, "test-secret", jwt.MapClaims{"user_id": 7, "role": "employer"}),
+			expectedId:    7,
+			expectedRole:  "employer",
+		},
+		{
+			name:          "no token",
+			authorization: "",
+		},
+		{
+			name:          "not a bearer token",
+			authorization: signedToken(t, "test-secret", jwt.MapClaims{"user_id": 7, "role": "employer"}),
+		},
+		{
+			name:          "signed with another key",
+			authorization: "Bearer " + signedToken(t, "other-secret", jwt.MapClaims{"user_id": 7, "role": "employer"}),
+		},
+		{
+			name:          "malformed token",
+			authorization: "Bearer not-a-token",
+		},
+		{
+			name:          "claims of the wrong type",
+			authorization: "Bearer " + signedToken(t, "test-secret", jwt.MapClaims{"user_id": "7", "role": 3}),
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			var userId, role interface{}
+			called := false
+			handler := IdentifyJWT(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				called = true
+				userId = r.Context().Value("user_id")
+				role = r.Context().Value("role")
+			}))
+
+			request := httptest.NewRequest(http.MethodPut, "/worker/7", nil)
+			if test.authorization != "" {
+				request.Header.Set("Authorization", test.authorization)
+			}
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, request)
+
+			if !called || recorder.Code != http.StatusOK {
+				t.Fatalf("expected the request to be let through, got status %d", recorder.Code)
+			}
+			if userId != test.expectedId || role != test.expectedRole {
+				t.Errorf("expected user %v with role %v in the context, got %v with %v", test.expectedId, test.expectedRole, userId, role)
+			}
+		})
+	}
+}
+
+func TestIdentifyJWTWithoutKey(t *testing.T) {
+	t.Setenv("JWT_PRIVATE_KEY", "")
+
+	var userId interface{}
+	handler := IdentifyJWT(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userId = r.Context().Value("user_id")
+	}))
+
+	request := httptest.NewRequest(http.MethodPut, "/worker/7", nil)
+	request.Header.Set("Authorization", "Bearer "+signedToken(t, "", jwt.MapClaims{"user_id": 7, "role": "worker"}))
+	handler.ServeHTTP(httptest.NewRecorder(), request)
+
+	if userId != nil {
+		t.Errorf("expected no user without a signing key, got %v", userId)
+	}
+}
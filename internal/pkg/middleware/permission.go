@@ -0,0 +1,97 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// Permission is an action on the platform that a role can be granted, written as resource:action
+type Permission string
+
+const (
+	DashboardRead       Permission = "dashboard:read"
+	UsersRead           Permission = "users:read"
+	UsersSuspend        Permission = "users:suspend"
+	JobsModerate        Permission = "jobs:moderate"
+	VerificationsReview Permission = "verifications:review"
+	DocumentsReview     Permission = "documents:review"
+	SectorsWrite        Permission = "sectors:write"
+	SkillsWrite         Permission = "skills:write"
+	AdminsManage        Permission = "admins:manage"
+	RolesManage         Permission = "roles:manage"
+	AuditRead           Permission = "audit:read"
+	ReportsReview       Permission = "reports:review"
+	WagesManage         Permission = "wages:manage"
+)
+
+// Permissions is every permission a role can be granted
+var Permissions = []Permission{
+	DashboardRead,
+	UsersRead,
+	UsersSuspend,
+	JobsModerate,
+	VerificationsReview,
+	DocumentsReview,
+	SectorsWrite,
+	SkillsWrite,
+	AdminsManage,
+	RolesManage,
+	AuditRead,
+	ReportsReview,
+	WagesManage,
+}
+
+func IsPermission(value string) bool {
+	for _, permission := range Permissions {
+		if string(permission) == value {
+			return true
+		}
+	}
+	return false
+}
+
+// PermissionResolver looks up the permissions granted to a role
+type PermissionResolver interface {
+	RolePermissions(ctx context.Context, role string) ([]Permission, error)
+}
+
+// RequirePermission lets a request through when the role in its JWT is granted all of the
+// permissions, it must be placed after ValidateJWT
+func RequirePermission(resolver PermissionResolver, permissions ...Permission) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+
+			role, _ := ctx.Value("role").(string)
+			granted, err := resolver.RolePermissions(ctx, role)
+			if err != nil {
+				logger.Errorw(ctx, apperrors.ErrCheckPermission.Error(), zap.Error(err), zap.String("role", role))
+				HandleErrorResponse(ctx, w, apperrors.ErrCheckPermission.Error(), http.StatusInternalServerError)
+				return
+			}
+
+			for _, permission := range permissions {
+				if !hasPermission(granted, permission) {
+					logger.Errorw(ctx, "unauthorized access", zap.String("role", role), zap.String("required_permission", string(permission)))
+					HandleErrorResponse(ctx, w, "unauthorized access to api", http.StatusForbidden)
+					return
+				}
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func hasPermission(granted []Permission, permission Permission) bool {
+	for _, grantedPermission := range granted {
+		if grantedPermission == permission {
+			return true
+		}
+	}
+	return false
+}
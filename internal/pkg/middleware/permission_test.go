@@ -0,0 +1,84 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type staticResolver map[string][]Permission
+
+func (resolver staticResolver) RolePermissions(ctx context.Context, role string) ([]Permission, error) {
+	if role == "broken" {
+		return nil, errors.New("connection reset")
+	}
+	return resolver[role], nil
+}
+
+func TestRequirePermission(t *testing.T) {
+	type testCase struct {
+		name           string
+		role           string
+		required       []Permission
+		expectedStatus int
+	}
+
+	resolver := staticResolver{
+		"admin":     {DashboardRead, JobsModerate},
+		"moderator": {JobsModerate},
+	}
+
+	testCases := []testCase{
+		{
+			name:           "granted",
+			role:           "admin",
+			required:       []Permission{JobsModerate},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "every permission needed",
+			role:           "moderator",
+			required:       []Permission{JobsModerate, DashboardRead},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "role without permissions",
+			role:           "worker",
+			required:       []Permission{DashboardRead},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "no role",
+			role:           "",
+			required:       []Permission{DashboardRead},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "permissions cannot be read",
+			role:           "broken",
+			required:       []Permission{DashboardRead},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			handler := RequirePermission(resolver, test.required...)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			}))
+
+			request := httptest.NewRequest(http.MethodGet, "/admin/dashboard", nil)
+			if test.role != "" {
+				request = request.WithContext(context.WithValue(request.Context(), "role", test.role))
+			}
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, request)
+
+			if recorder.Code != test.expectedStatus {
+				t.Errorf("expected status %d, got %d", test.expectedStatus, recorder.Code)
+			}
+		})
+	}
+}
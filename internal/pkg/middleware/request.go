@@ -0,0 +1,72 @@
+package middleware
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+const (
+	RequestIDHeader = "X-Request-ID"
+	requestIDKey    = "request_id"
+	clientIPKey     = "client_ip"
+)
+
+var requestIDPattern = regexp.MustCompile(`^[A-Za-z0-9._-]{1,64}$`)
+
+// RequestMetadata puts the request id and the client IP into the request context. The X-Request-ID
+// sent by the client is kept when it is well formed, otherwise one is generated, and it is echoed
+// back in the response. X-Forwarded-For is only trusted when the server runs behind a proxy.
+func RequestMetadata(trustProxy bool) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requestID := r.Header.Get(RequestIDHeader)
+			if !requestIDPattern.MatchString(requestID) {
+				requestID = newRequestID()
+			}
+			w.Header().Set(RequestIDHeader, requestID)
+
+			ctx := context.WithValue(r.Context(), requestIDKey, requestID)
+			ctx = context.WithValue(ctx, clientIPKey, clientIP(r, trustProxy))
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// RequestID returns the id RequestMetadata gave the request, empty outside of a request
+func RequestID(ctx context.Context) string {
+	requestID, _ := ctx.Value(requestIDKey).(string)
+	return requestID
+}
+
+// ClientIP returns the IP RequestMetadata read for the request, empty outside of a request
+func ClientIP(ctx context.Context) string {
+	ip, _ := ctx.Value(clientIPKey).(string)
+	return ip
+}
+
+func clientIP(r *http.Request, trustProxy bool) string {
+	if trustProxy {
+		// the first address is the client, the rest are the proxies it went through
+		forwardedFor := strings.Split(r.Header.Get("X-Forwarded-For"), ",")[0]
+		if ip := net.ParseIP(strings.TrimSpace(forwardedFor)); ip != nil {
+			return ip.String()
+		}
+	}
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+func newRequestID() string {
+	random := make([]byte, 16)
+	rand.Read(random)
+	return hex.EncodeToString(random)
+}
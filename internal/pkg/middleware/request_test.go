@@ -0,0 +1,87 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRequestMetadata(t *testing.T) {
+	type testCase struct {
+		name              string
+		trustProxy        bool
+		requestID         string
+		forwardedFor      string
+		expectedRequestID string
+		expectedIP        string
+	}
+
+	testCases := []testCase{
+		{
+			name:              "request id and remote address kept",
+			requestID:         "req-42.a_b",
+			expectedRequestID: "req-42.a_b",
+			expectedIP:        "10.0.0.7",
+		},
+		{
+			name:              "forwarded for ignored without a proxy",
+			requestID:         "req-1",
+			forwardedFor:      "203.0.113.9",
+			expectedRequestID: "req-1",
+			expectedIP:        "10.0.0.7",
+		},
+		{
+			name:              "forwarded for trusted behind a proxy",
+			trustProxy:        true,
+			requestID:         "req-1",
+			forwardedFor:      "203.0.113.9, 10.0.0.1",
+			expectedRequestID: "req-1",
+			expectedIP:        "203.0.113.9",
+		},
+		{
+			name:              "malformed forwarded for",
+			trustProxy:        true,
+			requestID:         "req-1",
+			forwardedFor:      "unknown",
+			expectedRequestID: "req-1",
+			expectedIP:        "10.0.0.7",
+		},
+		{
+			name:       "malformed request id replaced",
+			requestID:  "<script>",
+			expectedIP: "10.0.0.7",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			var requestID, ip string
+			handler := RequestMetadata(test.trustProxy)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requestID = RequestID(r.Context())
+				ip = ClientIP(r.Context())
+			}))
+
+			request := httptest.NewRequest(http.MethodPost, "/job", nil)
+			request.RemoteAddr = "10.0.0.7:51234"
+			request.Header.Set(RequestIDHeader, test.requestID)
+			if test.forwardedFor != "" {
+				request.Header.Set("X-Forwarded-For", test.forwardedFor)
+			}
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, request)
+
+			if test.expectedRequestID != "" && requestID != test.expectedRequestID {
+				t.Errorf("expected request id %q, got %q", test.expectedRequestID, requestID)
+			}
+			if test.expectedRequestID == "" && (requestID == "" || requestID == test.requestID) {
+				t.Errorf("expected a generated request id, got %q", requestID)
+			}
+			if recorder.Header().Get(RequestIDHeader) != requestID {
+				t.Errorf("expected response header %q, got %q", requestID, recorder.Header().Get(RequestIDHeader))
+			}
+			if ip != test.expectedIP {
+				t.Errorf("expected ip %q, got %q", test.expectedIP, ip)
+			}
+		})
+	}
+}
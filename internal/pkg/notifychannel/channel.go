@@ -0,0 +1,32 @@
+package notifychannel
+
+import "context"
+
+type Channel string
+
+const (
+	SMS   Channel = "sms"
+	Push  Channel = "push"
+	Email Channel = "email"
+	InApp Channel = "in_app"
+)
+
+// Channels lists every channel a notification can be delivered through
+var Channels = []Channel{InApp, SMS, Push, Email}
+
+// Message is a rendered notification addressed to a single recipient on a single channel. Address
+// is the phone number for SMS, the email address for email and empty for push and in-app messages
+type Message struct {
+	Channel       Channel `json:"channel"`
+	Event         string  `json:"event"`
+	RecipientRole string  `json:"recipient_role"`
+	RecipientID   int     `json:"recipient_id"`
+	Address       string  `json:"address,omitempty"`
+	Title         string  `json:"title"`
+	Body          string  `json:"body"`
+}
+
+// Sender is implemented by every delivery channel (SMS gateway, push service, mail server, inbox)
+type Sender interface {
+	Send(ctx context.Context, message Message) error
+}
@@ -0,0 +1,70 @@
+package notifychannel
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// FileSender is a development Sender appending every message as a JSON line to a file, so that
+// notifications can be inspected without any SMS, push or mail provider
+type FileSender struct {
+	path string
+	mu   sync.Mutex
+}
+
+func NewFileSender(path string) *FileSender {
+	return &FileSender{path: path}
+}
+
+type fileEntry struct {
+	Message
+	SentAt time.Time `json:"sent_at"`
+}
+
+func (fs *FileSender) Send(ctx context.Context, message Message) error {
+	line, err := json.Marshal(fileEntry{Message: message, SentAt: time.Now()})
+	if err != nil {
+		return err
+	}
+
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	file, err := os.OpenFile(fs.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = file.Write(append(line, '\n'))
+	return err
+}
+
+// LogSender is a development Sender writing every message to the application log
+type LogSender struct{}
+
+func (LogSender) Send(ctx context.Context, message Message) error {
+	logger.Infow(ctx, "notification sent",
+		zap.String("channel", string(message.Channel)),
+		zap.String("event", message.Event),
+		zap.String("recipient_role", message.RecipientRole),
+		zap.Int("recipient_id", message.RecipientID),
+		zap.String("title", message.Title),
+		zap.String("body", message.Body),
+	)
+	return nil
+}
+
+// NewDevSender returns a FileSender writing to path, or a LogSender when no path is configured
+func NewDevSender(path string) Sender {
+	if path == "" {
+		return LogSender{}
+	}
+	return NewFileSender(path)
+}
@@ -0,0 +1,61 @@
+package notifychannel
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestFileSender(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "notifications.log")
+	sender := NewFileSender(path)
+
+	messages := []Message{
+		{Channel: SMS, Event: "application_confirmed", RecipientRole: "worker", RecipientID: 5, Address: "9876543210", Title: "Confirmed", Body: "You are confirmed"},
+		{Channel: Email, Event: "application_submitted", RecipientRole: "employer", RecipientID: 9, Address: "employer@example.com", Title: "New application", Body: "Someone applied"},
+	}
+	for _, message := range messages {
+		err := sender.Send(context.Background(), message)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("expected notifications file, got %v", err)
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	lines := 0
+	for scanner.Scan() {
+		var entry fileEntry
+		err = json.Unmarshal(scanner.Bytes(), &entry)
+		if err != nil {
+			t.Fatalf("expected JSON line, got %v", err)
+		}
+		if entry.Message != messages[lines] {
+			t.Errorf("expected %+v, got %+v", messages[lines], entry.Message)
+		}
+		if entry.SentAt.IsZero() {
+			t.Errorf("expected sent_at to be set")
+		}
+		lines++
+	}
+	if lines != len(messages) {
+		t.Errorf("expected %d lines, got %d", len(messages), lines)
+	}
+}
+
+func TestNewDevSender(t *testing.T) {
+	if _, ok := NewDevSender("").(LogSender); !ok {
+		t.Errorf("expected a LogSender without a path")
+	}
+	if _, ok := NewDevSender("notifications.log").(*FileSender); !ok {
+		t.Errorf("expected a FileSender with a path")
+	}
+}
@@ -0,0 +1,141 @@
+package paymentgateway
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+)
+
+// UPI handles understood by the fake provider, any other handle pays successfully
+const (
+	FakeFailureVPA = "failure@fake"
+	FakeTimeoutVPA = "timeout@fake"
+)
+
+// FakeProvider is an in memory PaymentProvider for local development and tests. Orders are paid
+// (or declined for FakeFailureVPA) as soon as they are created. For FakeTimeoutVPA the order is
+// created but the first request reports a timeout, a retry with the same receipt returns it.
+type FakeProvider struct {
+	secret    string
+	mu        sync.Mutex
+	orders    map[string]*Order
+	receipts  map[string]string
+	timedOut  map[string]bool
+	refunds   map[string]Refund
+	idCounter int
+}
+
+func NewFakeProvider(secret string) *FakeProvider {
+	return &FakeProvider{
+		secret:   secret,
+		orders:   map[string]*Order{},
+		receipts: map[string]string{},
+		timedOut: map[string]bool{},
+		refunds:  map[string]Refund{},
+	}
+}
+
+func (fp *FakeProvider) CreateOrder(ctx context.Context, request OrderRequest) (Order, error) {
+	fp.mu.Lock()
+	defer fp.mu.Unlock()
+
+	if request.Amount <= 0 {
+		return Order{}, fmt.Errorf("%w: amount must be greater than zero", apperrors.ErrPaymentProvider)
+	}
+
+	if orderId, ok := fp.receipts[request.Receipt]; ok && request.Receipt != "" {
+		return *fp.orders[orderId], nil
+	}
+
+	order := &Order{ID: fp.nextId("order"), Receipt: request.Receipt, Amount: request.Amount, Status: Paid}
+	switch request.VPA {
+	case FakeFailureVPA:
+		order.Status = Failed
+	default:
+		order.PaymentID = fp.nextId("pay")
+	}
+
+	fp.orders[order.ID] = order
+	fp.receipts[request.Receipt] = order.ID
+
+	if request.VPA == FakeTimeoutVPA && !fp.timedOut[request.Receipt] {
+		fp.timedOut[request.Receipt] = true
+		return Order{}, fmt.Errorf("%w: no response for receipt %s", apperrors.ErrPaymentProviderTimeout, request.Receipt)
+	}
+	return *order, nil
+}
+
+func (fp *FakeProvider) VerifyCallback(payload []byte, signature string) (Event, error) {
+	if !validSignature(fp.secret, payload, signature) {
+		return Event{}, apperrors.ErrInvalidWebhookSignature
+	}
+
+	var event Event
+	err := json.Unmarshal(payload, &event)
+	if err != nil {
+		return Event{}, fmt.Errorf("%w: %s", apperrors.ErrInvalidWebhookPayload, err.Error())
+	}
+	return event, nil
+}
+
+func (fp *FakeProvider) Refund(ctx context.Context, paymentId string, amount int, refundKey string) (Refund, error) {
+	fp.mu.Lock()
+	defer fp.mu.Unlock()
+
+	if refund, ok := fp.refunds[refundKey]; ok && refundKey != "" && refund.PaymentID == paymentId {
+		return refund, nil
+	}
+
+	for _, order := range fp.orders {
+		if order.PaymentID != paymentId {
+			continue
+		}
+		if order.Status != Paid {
+			return Refund{}, fmt.Errorf("%w: payment %s cannot be refunded", apperrors.ErrPaymentProvider, paymentId)
+		}
+
+		refund := Refund{ID: fp.nextId("rfnd"), PaymentID: paymentId, Amount: amount}
+		fp.refunds[refundKey] = refund
+		order.Status = Refunded
+		return refund, nil
+	}
+	return Refund{}, fmt.Errorf("%w: unknown payment %s", apperrors.ErrPaymentProvider, paymentId)
+}
+
+func (fp *FakeProvider) FetchStatus(ctx context.Context, orderId string) (Order, error) {
+	fp.mu.Lock()
+	defer fp.mu.Unlock()
+
+	order, ok := fp.orders[orderId]
+	if !ok {
+		return Order{}, fmt.Errorf("%w: unknown order %s", apperrors.ErrPaymentProvider, orderId)
+	}
+	return *order, nil
+}
+
+// SignedEvent builds the callback the provider would send for the current status of an order,
+// it lets local clients and tests exercise the webhook without a real gateway
+func (fp *FakeProvider) SignedEvent(orderId string) ([]byte, string, error) {
+	order, err := fp.FetchStatus(context.Background(), orderId)
+	if err != nil {
+		return nil, "", err
+	}
+
+	fp.mu.Lock()
+	eventId := fp.nextId("evt")
+	fp.mu.Unlock()
+
+	payload, err := json.Marshal(Event{ID: eventId, OrderID: order.ID, PaymentID: order.PaymentID, Status: order.Status})
+	if err != nil {
+		return nil, "", err
+	}
+	return payload, Sign(fp.secret, payload), nil
+}
+
+func (fp *FakeProvider) nextId(prefix string) string {
+	fp.idCounter++
+	return fmt.Sprintf("%s_fake_%d", prefix, fp.idCounter)
+}
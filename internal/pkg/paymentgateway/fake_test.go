@@ -0,0 +1,93 @@
+package paymentgateway
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+)
+
+func TestFakeProviderOrders(t *testing.T) {
+	testCases := []struct {
+		name           string
+		vpa            string
+		expectedStatus OrderStatus
+	}{
+		{name: "success", vpa: "employer@upi", expectedStatus: Paid},
+		{name: "failure", vpa: FakeFailureVPA, expectedStatus: Failed},
+	}
+
+	for _, test := range testCases {
+		provider := NewFakeProvider("secret")
+		order, err := provider.CreateOrder(context.Background(), OrderRequest{Amount: 500, Receipt: "receipt", VPA: test.vpa})
+		if err != nil || order.Status != test.expectedStatus {
+			t.Errorf("%s: expected %s order, got %+v, %v", test.name, test.expectedStatus, order, err)
+		}
+	}
+}
+
+func TestFakeProviderTimeoutIsRecoveredByRetry(t *testing.T) {
+	provider := NewFakeProvider("secret")
+	request := OrderRequest{Amount: 500, Receipt: "receipt", VPA: FakeTimeoutVPA}
+
+	_, err := provider.CreateOrder(context.Background(), request)
+	if !errors.Is(err, apperrors.ErrPaymentProviderTimeout) {
+		t.Fatalf("expected first attempt to time out, got %v", err)
+	}
+
+	order, err := provider.CreateOrder(context.Background(), request)
+	if err != nil || order.Status != Paid {
+		t.Fatalf("expected retry to return the paid order, got %+v, %v", order, err)
+	}
+
+	again, _ := provider.CreateOrder(context.Background(), request)
+	if again.ID != order.ID {
+		t.Errorf("expected the same receipt to return order %s, got %s", order.ID, again.ID)
+	}
+}
+
+func TestFakeProviderCallbacks(t *testing.T) {
+	provider := NewFakeProvider("secret")
+	order, _ := provider.CreateOrder(context.Background(), OrderRequest{Amount: 500, Receipt: "receipt", VPA: "employer@upi"})
+
+	payload, signature, err := provider.SignedEvent(order.ID)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	event, err := provider.VerifyCallback(payload, signature)
+	if err != nil || event.OrderID != order.ID || event.Status != Paid {
+		t.Errorf("expected a verified paid event for %s, got %+v, %v", order.ID, event, err)
+	}
+
+	_, err = provider.VerifyCallback(payload, Sign("other secret", payload))
+	if !errors.Is(err, apperrors.ErrInvalidWebhookSignature) {
+		t.Errorf("expected signature from another secret to be rejected, got %v", err)
+	}
+}
+
+func TestFakeProviderRefund(t *testing.T) {
+	provider := NewFakeProvider("secret")
+	order, _ := provider.CreateOrder(context.Background(), OrderRequest{Amount: 500, Receipt: "receipt", VPA: "employer@upi"})
+
+	refund, err := provider.Refund(context.Background(), order.PaymentID, order.Amount, "refund-1")
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	status, _ := provider.FetchStatus(context.Background(), order.ID)
+	if status.Status != Refunded {
+		t.Errorf("expected order to be refunded, got %s", status.Status)
+	}
+
+	retried, err := provider.Refund(context.Background(), order.PaymentID, order.Amount, "refund-1")
+	if err != nil || retried != refund {
+		t.Errorf("expected retried refund to return %+v, got %+v, %v", refund, retried, err)
+	}
+
+	_, err = provider.Refund(context.Background(), order.PaymentID, order.Amount, "refund-2")
+	if !errors.Is(err, apperrors.ErrPaymentProvider) {
+		t.Errorf("expected second refund to be rejected, got %v", err)
+	}
+}
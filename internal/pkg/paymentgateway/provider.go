@@ -0,0 +1,52 @@
+package paymentgateway
+
+import "context"
+
+type OrderStatus string
+
+const (
+	Created  OrderStatus = "created"
+	Paid     OrderStatus = "paid"
+	Failed   OrderStatus = "failed"
+	Refunded OrderStatus = "refunded"
+)
+
+// OrderRequest asks the provider to collect an amount (in rupees) from a UPI handle, the receipt
+// is our idempotency key so that providers can deduplicate retried requests
+type OrderRequest struct {
+	Amount   int
+	Currency string
+	Receipt  string
+	VPA      string
+}
+
+type Order struct {
+	ID        string
+	Receipt   string
+	Amount    int
+	Status    OrderStatus
+	PaymentID string
+}
+
+// Event is the body of a provider callback announcing a change in the status of an order
+type Event struct {
+	ID        string      `json:"event_id"`
+	OrderID   string      `json:"order_id"`
+	PaymentID string      `json:"payment_id"`
+	Status    OrderStatus `json:"status"`
+}
+
+type Refund struct {
+	ID        string
+	PaymentID string
+	Amount    int
+}
+
+// PaymentProvider is implemented by every payment gateway the platform can collect wages through.
+// Refunds are idempotent on the refund key, a retried refund returns the refund already made.
+type PaymentProvider interface {
+	CreateOrder(ctx context.Context, request OrderRequest) (Order, error)
+	VerifyCallback(payload []byte, signature string) (Event, error)
+	Refund(ctx context.Context, paymentId string, amount int, refundKey string) (Refund, error)
+	FetchStatus(ctx context.Context, orderId string) (Order, error)
+}
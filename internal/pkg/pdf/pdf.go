@@ -0,0 +1,143 @@
+// Package pdf writes plain text documents as PDF files with the standard Courier fonts, so reports
+// can be generated without any external service or library
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+const (
+	// A4 page in points
+	pageWidth  = 595
+	pageHeight = 842
+	margin     = 50
+	fontSize   = 9
+	leading    = 12
+	// LineWidth is the number of characters that fit on a line, Courier is 0.6 of the font size wide
+	LineWidth    = (pageWidth - 2*margin) * 10 / (6 * fontSize)
+	linesPerPage = (pageHeight - 2*margin) / leading
+)
+
+type line struct {
+	text string
+	bold bool
+}
+
+// Document is a monospaced text document, lines longer than LineWidth are cut and pages are
+// added as lines are written
+type Document struct {
+	lines []line
+}
+
+func New() *Document {
+	return &Document{}
+}
+
+func (d *Document) Line(text string) {
+	d.lines = append(d.lines, line{text: text})
+}
+
+func (d *Document) Bold(text string) {
+	d.lines = append(d.lines, line{text: text, bold: true})
+}
+
+func (d *Document) Blank() {
+	d.lines = append(d.lines, line{})
+}
+
+// Bytes renders the document, every page ends with its page number
+func (d *Document) Bytes() []byte {
+	pages := paginate(d.lines)
+
+	var out bytes.Buffer
+	offsets := []int{}
+	object := func(body string) {
+		offsets = append(offsets, out.Len())
+		fmt.Fprintf(&out, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	out.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+
+	// objects 1 to 4 are the catalog, the page tree and the two fonts, each page is followed by
+	// its content stream
+	kids := make([]string, 0, len(pages))
+	for i := range pages {
+		kids = append(kids, fmt.Sprintf("%d 0 R", 5+2*i))
+	}
+	object("<< /Type /Catalog /Pages 2 0 R >>")
+	object(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(pages)))
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Courier /Encoding /WinAnsiEncoding >>")
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Courier-Bold /Encoding /WinAnsiEncoding >>")
+
+	for i, page := range pages {
+		content := pageContent(page, fmt.Sprintf("Page %d of %d", i+1, len(pages)))
+		object(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %d %d] /Resources << /Font << /F1 3 0 R /F2 4 0 R >> >> /Contents %d 0 R >>", pageWidth, pageHeight, 6+2*i))
+		object(fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", len(content), content))
+	}
+
+	xref := out.Len()
+	fmt.Fprintf(&out, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&out, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&out, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+	return out.Bytes()
+}
+
+// paginate splits the lines into pages, leaving the last line of each page for its number
+func paginate(lines []line) [][]line {
+	perPage := linesPerPage - 2
+	pages := [][]line{}
+	for len(lines) > perPage {
+		pages = append(pages, lines[:perPage])
+		lines = lines[perPage:]
+	}
+	return append(pages, lines)
+}
+
+func pageContent(lines []line, footer string) string {
+	var content strings.Builder
+	fmt.Fprintf(&content, "BT\n%d TL\n%d %d Td\n", leading, margin, pageHeight-margin)
+
+	font := ""
+	for _, l := range lines {
+		lineFont := "/F1"
+		if l.bold {
+			lineFont = "/F2"
+		}
+		if lineFont != font {
+			fmt.Fprintf(&content, "%s %d Tf\n", lineFont, fontSize)
+			font = lineFont
+		}
+		fmt.Fprintf(&content, "(%s) Tj T*\n", escape(l.text))
+	}
+	fmt.Fprintf(&content, "ET\nBT\n/F1 %d Tf\n%d %d Td\n(%s) Tj\nET", fontSize, margin, margin-leading, escape(footer))
+	return content.String()
+}
+
+// escape makes text safe inside a PDF string, characters the Courier fonts cannot show become '?'
+func escape(text string) string {
+	var escaped strings.Builder
+	count := 0
+	for _, r := range text {
+		if count == LineWidth {
+			break
+		}
+		count++
+
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			escaped.WriteByte('\\')
+			escaped.WriteRune(r)
+		case r == '\t':
+			escaped.WriteByte(' ')
+		case r < 0x20 || r > 0x7e:
+			escaped.WriteByte('?')
+		default:
+			escaped.WriteRune(r)
+		}
+	}
+	return escaped.String()
+}
@@ -0,0 +1,58 @@
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestBytes(t *testing.T) {
+	doc := New()
+	doc.Bold("Earnings Statement")
+	doc.Line("Paid (in full) \\ रोज़गार")
+	for i := 0; i < 70; i++ {
+		doc.Line(fmt.Sprintf("row %d", i))
+	}
+
+	out := doc.Bytes()
+
+	if !bytes.HasPrefix(out, []byte("%PDF-1.4\n")) || !bytes.HasSuffix(out, []byte("%%EOF\n")) {
+		t.Fatalf("expected a PDF header and end of file marker")
+	}
+	for _, expected := range []string{"/Count 2", "(Paid \\(in full\\) \\\\ ???????) Tj", "(Page 2 of 2) Tj"} {
+		if !bytes.Contains(out, []byte(expected)) {
+			t.Errorf("expected output to contain %q", expected)
+		}
+	}
+
+	// every object starts at the offset listed for it in the cross-reference table
+	startxref := regexp.MustCompile(`startxref\n(\d+)\n`).FindSubmatch(out)
+	if startxref == nil {
+		t.Fatalf("expected a startxref")
+	}
+	xref, _ := strconv.Atoi(string(startxref[1]))
+	entries := strings.Split(string(out[xref:]), "\n")[3:]
+	objects := 0
+	for ; !strings.HasPrefix(entries[objects], "trailer"); objects++ {
+		offset, err := strconv.Atoi(entries[objects][:10])
+		if err != nil {
+			t.Fatalf("invalid cross-reference entry %q", entries[objects])
+		}
+		if !bytes.HasPrefix(out[offset:], []byte(fmt.Sprintf("%d 0 obj", objects+1))) {
+			t.Errorf("expected object %d at offset %d", objects+1, offset)
+		}
+	}
+	if objects != 8 {
+		t.Errorf("expected 8 objects, got %d", objects)
+	}
+}
+
+func TestEscapeCutsLongLines(t *testing.T) {
+	escaped := escape(strings.Repeat("a", LineWidth+10))
+	if len(escaped) != LineWidth {
+		t.Errorf("expected %d characters, got %d", LineWidth, len(escaped))
+	}
+}
@@ -0,0 +1,73 @@
+package webhookclient
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// at most this much of a response body is kept in the delivery log
+const maxResponseBody = 2048
+
+type Request struct {
+	URL        string
+	Event      string
+	DeliveryID int
+	Secret     string
+	Payload    []byte
+}
+
+type Response struct {
+	StatusCode int
+	Body       string
+}
+
+// Client posts signed webhook requests to employer endpoints
+type Client interface {
+	Post(ctx context.Context, request Request) (Response, error)
+}
+
+type httpClient struct {
+	client *http.Client
+	now    func() time.Time
+}
+
+func NewHTTPClient(timeout time.Duration) Client {
+	return &httpClient{
+		client: &http.Client{Timeout: timeout},
+		now:    time.Now,
+	}
+}
+
+func (hc *httpClient) Post(ctx context.Context, request Request) (Response, error) {
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodPost, request.URL, bytes.NewReader(request.Payload))
+	if err != nil {
+		return Response{}, err
+	}
+
+	httpRequest.Header.Set("Content-Type", "application/json")
+	httpRequest.Header.Set("User-Agent", "RozgarLink-Webhooks/1.0")
+	httpRequest.Header.Set(EventHeader, request.Event)
+	httpRequest.Header.Set(DeliveryHeader, strconv.Itoa(request.DeliveryID))
+	httpRequest.Header.Set(SignatureHeader, Sign(request.Secret, hc.now(), request.Payload))
+
+	httpResponse, err := hc.client.Do(httpRequest)
+	if err != nil {
+		return Response{}, err
+	}
+	defer httpResponse.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(httpResponse.Body, maxResponseBody))
+	if err != nil {
+		return Response{StatusCode: httpResponse.StatusCode}, err
+	}
+	return Response{StatusCode: httpResponse.StatusCode, Body: string(body)}, nil
+}
+
+// Succeeded reports whether the endpoint accepted the delivery
+func (response Response) Succeeded() bool {
+	return response.StatusCode >= 200 && response.StatusCode < 300
+}
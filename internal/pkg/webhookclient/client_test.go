@@ -0,0 +1,52 @@
+package webhookclient
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestHTTPClientPost(t *testing.T) {
+	payload := []byte(`{"event":"application_submitted"}`)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if r.Header.Get(EventHeader) != "application_submitted" || r.Header.Get(DeliveryHeader) != "42" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if !Verify("secret", r.Header.Get(SignatureHeader), body, time.Minute, time.Now()) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusAccepted)
+		w.Write([]byte("received"))
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(time.Second)
+
+	response, err := client.Post(context.Background(), Request{URL: server.URL, Event: "application_submitted", DeliveryID: 42, Secret: "secret", Payload: payload})
+	if err != nil || !response.Succeeded() || response.Body != "received" {
+		t.Errorf("expected accepted delivery, got %+v, %v", response, err)
+	}
+
+	response, err = client.Post(context.Background(), Request{URL: server.URL, Event: "application_submitted", DeliveryID: 42, Secret: "wrong", Payload: payload})
+	if err != nil || response.Succeeded() || response.StatusCode != http.StatusUnauthorized {
+		t.Errorf("expected rejected delivery, got %+v, %v", response, err)
+	}
+}
+
+func TestHTTPClientPostUnreachable(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	url := server.URL
+	server.Close()
+
+	_, err := NewHTTPClient(time.Second).Post(context.Background(), Request{URL: url, Event: "job_posted", Secret: "secret", Payload: []byte(`{}`)})
+	if err == nil {
+		t.Error("expected an error for an unreachable endpoint")
+	}
+}
@@ -0,0 +1,68 @@
+package webhookclient
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// headers sent with every webhook request
+const (
+	SignatureHeader = "X-RozgarLink-Signature"
+	EventHeader     = "X-RozgarLink-Event"
+	DeliveryHeader  = "X-RozgarLink-Delivery"
+)
+
+// Sign returns the signature header value for a payload sent at the given time, it is
+// "t=<unix seconds>,v1=<hex HMAC-SHA256 of "<unix seconds>.<payload>">". Signing the timestamp
+// lets receivers reject replayed requests.
+func Sign(secret string, timestamp time.Time, payload []byte) string {
+	unix := strconv.FormatInt(timestamp.Unix(), 10)
+	return fmt.Sprintf("t=%s,v1=%s", unix, digest(secret, unix, payload))
+}
+
+// Verify checks a signature header against the payload, signatures older than tolerance are
+// rejected, a zero tolerance accepts any age
+func Verify(secret string, header string, payload []byte, tolerance time.Duration, now time.Time) bool {
+	var unix, signature string
+	for _, part := range strings.Split(header, ",") {
+		key, value, _ := strings.Cut(part, "=")
+		switch key {
+		case "t":
+			unix = value
+		case "v1":
+			signature = value
+		}
+	}
+
+	seconds, err := strconv.ParseInt(unix, 10, 64)
+	if err != nil || signature == "" {
+		return false
+	}
+	if tolerance > 0 && now.Sub(time.Unix(seconds, 0)) > tolerance {
+		return false
+	}
+	return hmac.Equal([]byte(digest(secret, unix, payload)), []byte(signature))
+}
+
+// NewSecret returns a random signing secret for a webhook endpoint
+func NewSecret() (string, error) {
+	secret := make([]byte, 24)
+	_, err := rand.Read(secret)
+	if err != nil {
+		return "", err
+	}
+	return "whsec_" + hex.EncodeToString(secret), nil
+}
+
+func digest(secret string, unix string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(unix + "."))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
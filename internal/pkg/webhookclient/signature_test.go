@@ -0,0 +1,46 @@
+package webhookclient
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSignAndVerify(t *testing.T) {
+	sentAt := time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC)
+	payload := []byte(`{"event":"application_submitted"}`)
+	header := Sign("secret", sentAt, payload)
+
+	testCases := []struct {
+		name     string
+		secret   string
+		header   string
+		payload  []byte
+		now      time.Time
+		expected bool
+	}{
+		{name: "valid", secret: "secret", header: header, payload: payload, now: sentAt.Add(time.Minute), expected: true},
+		{name: "wrong secret", secret: "other", header: header, payload: payload, now: sentAt, expected: false},
+		{name: "tampered payload", secret: "secret", header: header, payload: []byte(`{"event":"job_posted"}`), now: sentAt, expected: false},
+		{name: "too old", secret: "secret", header: header, payload: payload, now: sentAt.Add(10 * time.Minute), expected: false},
+		{name: "malformed header", secret: "secret", header: "v1=abc", payload: payload, now: sentAt, expected: false},
+	}
+
+	for _, test := range testCases {
+		if got := Verify(test.secret, test.header, test.payload, 5*time.Minute, test.now); got != test.expected {
+			t.Errorf("%s: expected %v, got %v", test.name, test.expected, got)
+		}
+	}
+}
+
+func TestNewSecret(t *testing.T) {
+	first, err := NewSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, _ := NewSecret()
+
+	if !strings.HasPrefix(first, "whsec_") || len(first) != 54 || first == second {
+		t.Errorf("expected distinct random secrets, got %q and %q", first, second)
+	}
+}
@@ -17,10 +17,11 @@ const (
 	deleteAddressByIdQuery        = "DELETE FROM address WHERE id=$1;"
 )
 
-// create a new address and return newly created address object, and error
-func CreateAddress(ctx context.Context, sqlxDb *sqlx.DB, addressData Address) (Address, error) {
+// create a new address and return newly created address object, and error, the address is written
+// through sqlxDb which is either the database or a transaction
+func CreateAddress(ctx context.Context, sqlxDb sqlx.Ext, addressData Address) (Address, error) {
 	var newAddress Address
-	rows, err := sqlxDb.NamedQuery(createAddressQuery, addressData)
+	rows, err := sqlx.NamedQuery(sqlxDb, createAddressQuery, addressData)
 	if err != nil {
 		return Address{}, err
 	}
@@ -36,10 +37,10 @@ func CreateAddress(ctx context.Context, sqlxDb *sqlx.DB, addressData Address) (A
 }
 
 // update address based on ID, and return updated address, and error
-func UpdateAddress(ctx context.Context, sqlxDb *sqlx.DB, addressData Address) (Address, error) {
+func UpdateAddress(ctx context.Context, sqlxDb sqlx.Ext, addressData Address) (Address, error) {
 
 	var address Address
-	rows, err := sqlxDb.NamedQuery(updateAddressQuery, addressData)
+	rows, err := sqlx.NamedQuery(sqlxDb, updateAddressQuery, addressData)
 	if err != nil {
 		return Address{}, err
 	}
@@ -57,7 +58,7 @@ func UpdateAddress(ctx context.Context, sqlxDb *sqlx.DB, addressData Address) (A
 }
 
 // delete address and return ID of address obj deleted, and error
-func DeleteAddress(ctx context.Context, sqlxDb *sqlx.DB, addressId int) error {
+func DeleteAddress(ctx context.Context, sqlxDb sqlx.Execer, addressId int) error {
 	_, err := sqlxDb.Exec(deleteAddressByIdQuery, addressId)
 	if err != nil {
 		return err
@@ -67,11 +68,11 @@ func DeleteAddress(ctx context.Context, sqlxDb *sqlx.DB, addressId int) error {
 }
 
 // fetch address by id
-func GetAddressById(ctx context.Context, sqlxDb *sqlx.DB, addressId int) (Address, error) {
+func GetAddressById(ctx context.Context, sqlxDb sqlx.Queryer, addressId int) (Address, error) {
 
 	var address Address
 
-	err := sqlxDb.Get(&address, fetchAddressByIdQuery, addressId)
+	err := sqlx.Get(sqlxDb, &address, fetchAddressByIdQuery, addressId)
 
 	if err != nil {
 		return Address{}, err
@@ -2,7 +2,10 @@ package repo
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
 	"github.com/jmoiron/sqlx"
 )
 
@@ -15,6 +18,8 @@ type AdminStorer interface {
 	DeleteAdmin(ctx context.Context, adminId int) error
 	FindAdminByEmail(ctx context.Context, email string) bool
 	FindAdminById(ctx context.Context, adminId int) bool
+	FetchAdmins(ctx context.Context) ([]Admin, error)
+	UpdateAdminRole(ctx context.Context, adminId int, role string) (Admin, error)
 }
 
 func NewAdminRepo(db *sqlx.DB) AdminStorer {
@@ -25,10 +30,15 @@ func NewAdminRepo(db *sqlx.DB) AdminStorer {
 
 // PostgreSQL Queries
 const (
-	registerAdminQuery    = `INSERT INTO admins (name, contact_no, email, password, created_at, updated_at) VALUES (:name, :contact_no, :email, :password, NOW(), NOW()) RETURNING *;`
+	registerAdminQuery    = `INSERT INTO admins (name, contact_no, email, password, role, created_at, updated_at) VALUES (:name, :contact_no, :email, :password, :role, NOW(), NOW()) RETURNING *;`
 	deleteAdminQuery      = `DELETE FROM admins WHERE id=$1 RETURNING id;`
 	findAdminByEmailQuery = `SELECT id from admins where email = $1;`
 	findAdminByIdQuery    = `SELECT id from admins where id = $1;`
+	fetchAdminsQuery      = `SELECT id, name, contact_no, email, role, created_at, updated_at FROM admins ORDER BY id;`
+	fetchAdminByIdQuery   = `SELECT id, name, contact_no, email, role, created_at, updated_at FROM admins WHERE id = $1;`
+	lockAdminQuery        = `SELECT role FROM admins WHERE id = $1 FOR UPDATE;`
+	lockSuperAdminsQuery  = `SELECT id FROM admins WHERE role = 'super-admin' FOR UPDATE;`
+	updateAdminRoleQuery  = `UPDATE admins SET role = $2, updated_at = NOW() WHERE id = $1;`
 )
 
 func (admR *adminRepo) RegisterAdmin(ctx context.Context, adminData Admin) (Admin, error) {
@@ -52,19 +62,104 @@ func (admR *adminRepo) RegisterAdmin(ctx context.Context, adminData Admin) (Admi
 	return createdAdmin, nil
 }
 
+// Delete an admin, the last super admin cannot be deleted so that someone can always manage the
+// other admins
 func (adminR *adminRepo) DeleteAdmin(ctx context.Context, adminId int) error {
+	tx, err := adminR.DB.Beginx()
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback()
+
+	err = lockAdminRole(tx, adminId, "")
+	if err != nil {
+		return err
+	}
+
 	var ID int
-	err := adminR.DB.Get(&ID, deleteAdminQuery, adminId)
+	err = tx.Get(&ID, deleteAdminQuery, adminId)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// Change the role of an admin, the last super admin cannot be demoted
+func (adminR *adminRepo) UpdateAdminRole(ctx context.Context, adminId int, role string) (Admin, error) {
+	tx, err := adminR.DB.Beginx()
+	if err != nil {
+		return Admin{}, err
+	}
+
+	defer tx.Rollback()
+
+	err = lockAdminRole(tx, adminId, role)
+	if err != nil {
+		return Admin{}, err
+	}
+
+	_, err = tx.Exec(updateAdminRoleQuery, adminId, role)
+	if err != nil {
+		return Admin{}, err
+	}
 
+	var admin Admin
+	err = tx.Get(&admin, fetchAdminByIdQuery, adminId)
 	if err != nil {
+		return Admin{}, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return Admin{}, err
+	}
+
+	return admin, nil
+}
+
+// lockAdminRole locks an admin that is removed or given a new role, a super admin is only let go
+// when another super admin remains. All super admins are locked so that two of them cannot be
+// removed at the same time.
+func lockAdminRole(tx *sqlx.Tx, adminId int, newRole string) error {
+	var currentRole string
+	err := tx.Get(&currentRole, lockAdminQuery, adminId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return apperrors.ErrNoAdminExists
+		}
 		return err
 	}
+
+	if currentRole != "super-admin" || newRole == "super-admin" {
+		return nil
+	}
+
+	var superAdmins []int
+	err = tx.Select(&superAdmins, lockSuperAdminsQuery)
+	if err != nil {
+		return err
+	}
+	if len(superAdmins) <= 1 {
+		return apperrors.ErrLastSuperAdmin
+	}
 	return nil
 }
 
+func (adminR *adminRepo) FetchAdmins(ctx context.Context) ([]Admin, error) {
+	admins := make([]Admin, 0)
+
+	err := adminR.DB.Select(&admins, fetchAdminsQuery)
+	if err != nil {
+		return []Admin{}, err
+	}
+	return admins, nil
+}
+
 func (adminR *adminRepo) FindAdminById(ctx context.Context, adminId int) bool {
 	var ID int
-	err := adminR.DB.QueryRow(findAdminByIdQuery, ID).Scan(&ID)
+	err := adminR.DB.QueryRow(findAdminByIdQuery, adminId).Scan(&ID)
 
 	return err == nil
 }
@@ -0,0 +1,269 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/datetime"
+	"github.com/jmoiron/sqlx"
+)
+
+type adminConsoleStore struct {
+	BaseRepository
+}
+
+type AdminConsoleStorer interface {
+	SearchUsers(ctx context.Context, userType UserType, search UserSearch) ([]UserSummary, error)
+	SuspendUser(ctx context.Context, userType UserType, userId int, reason string, suspendedBy int) (UserSummary, error)
+	ReactivateUser(ctx context.Context, userType UserType, userId int) (UserSummary, error)
+	HideJob(ctx context.Context, jobId int, reason string, hiddenBy int) (HiddenJob, error)
+	UnhideJob(ctx context.Context, jobId int) error
+	FetchHiddenJobs(ctx context.Context) ([]HiddenJob, error)
+	FetchDashboardStats(ctx context.Context, from, to time.Time, today datetime.Date) (DashboardStats, error)
+}
+
+func NewAdminConsoleRepo(db *sqlx.DB) AdminConsoleStorer {
+	return &adminConsoleStore{
+		BaseRepository: BaseRepository{DB: db},
+	}
+}
+
+// the tables of the accounts an admin can suspend, user types are never read from a request
+// directly so only these names end up in the queries
+var userTables = map[UserType]string{
+	WorkerUser:   "workers",
+	EmployerUser: "employers",
+}
+
+// PostgreSQL Queries
+const (
+	userSummaryColumns      = `%[1]s.id, %[1]s.name, %[1]s.contact_number, %[1]s.email, address.city, address.state, %[1]s.created_at, COALESCE(account_suspensions.reason, '') AS suspension_reason, COALESCE(account_suspensions.suspended_by, 0) AS suspended_by, account_suspensions.suspended_at`
+	userSummarySource       = `%[1]s INNER JOIN address ON %[1]s.location = address.id LEFT JOIN account_suspensions ON account_suspensions.user_type = '%[2]s' AND account_suspensions.user_id = %[1]s.id`
+	fetchUserSummaryQuery   = `SELECT ` + userSummaryColumns + ` FROM ` + userSummarySource + ` WHERE %[1]s.id = $1;`
+	lockUserQuery           = `SELECT id FROM %s WHERE id = $1 FOR UPDATE;`
+	suspendUserQuery        = `INSERT INTO account_suspensions (user_type, user_id, reason, suspended_by, suspended_at) VALUES ($1, $2, $3, $4, NOW()) ON CONFLICT (user_type, user_id) DO NOTHING RETURNING user_id;`
+	reactivateUserQuery     = `DELETE FROM account_suspensions WHERE user_type = $1 AND user_id = $2 RETURNING user_id;`
+	hiddenJobColumns        = `hidden_content.target_id AS job_id, jobs.title, jobs.employer_id, hidden_content.reason, COALESCE(hidden_content.hidden_by, 0) AS hidden_by, hidden_content.hidden_at`
+	hiddenJobSource         = `hidden_content INNER JOIN jobs ON hidden_content.target_type = 'job' AND hidden_content.target_id = jobs.id`
+	lockJobQuery            = `SELECT id FROM jobs WHERE id = $1 FOR UPDATE;`
+	hideJobQuery            = `INSERT INTO hidden_content (target_type, target_id, reason, hidden_by, hidden_at) VALUES ('job', $1, $2, $3, NOW()) ON CONFLICT (target_type, target_id) DO NOTHING RETURNING target_id;`
+	fetchHiddenJobByIdQuery = `SELECT ` + hiddenJobColumns + ` FROM ` + hiddenJobSource + ` WHERE hidden_content.target_id = $1;`
+	unhideJobQuery          = `DELETE FROM hidden_content WHERE target_type = 'job' AND target_id = $1 RETURNING target_id;`
+	fetchHiddenJobsQuery    = `SELECT ` + hiddenJobColumns + ` FROM ` + hiddenJobSource + ` ORDER BY hidden_content.hidden_at DESC;`
+	fetchRegistrationsQuery = `SELECT (SELECT COUNT(*) FROM workers WHERE created_at >= $1 AND created_at < $2) AS worker_registrations, (SELECT COUNT(*) FROM employers WHERE created_at >= $1 AND created_at < $2) AS employer_registrations;`
+	// a vacancy is filled by a confirmed application, extra confirmations over the vacancy are not counted
+	fetchJobsPostedQuery = `SELECT COUNT(*) AS jobs_posted, COALESCE(SUM(vacancy), 0) AS vacancies, COALESCE(SUM(filled), 0) AS filled_vacancies FROM (SELECT jobs.vacancy, LEAST(COUNT(applications.id) FILTER (WHERE applications.status = 'confirmed'), jobs.vacancy) AS filled FROM jobs LEFT JOIN applications ON applications.job_id = jobs.id WHERE jobs.created_at >= $1 AND jobs.created_at < $2 GROUP BY jobs.id) AS posted;`
+	// open jobs are counted as of today, whatever the range: not over, not hidden and not yet filled
+	fetchOpenJobsQuery             = `SELECT COUNT(*) FROM jobs WHERE COALESCE(jobs.end_date, jobs.date) >= $1 AND NOT EXISTS (SELECT 1 FROM hidden_content WHERE hidden_content.target_type = 'job' AND hidden_content.target_id = jobs.id) AND jobs.vacancy > (SELECT COUNT(*) FROM applications WHERE applications.job_id = jobs.id AND applications.status = 'confirmed');`
+	fetchApplicationsByStatusQuery = `SELECT status, COUNT(*) AS count FROM applications WHERE applied_at >= $1 AND applied_at < $2 GROUP BY status ORDER BY status;`
+)
+
+func userNotFoundError(userType UserType) error {
+	if userType == EmployerUser {
+		return apperrors.ErrNoEmployerExists
+	}
+	return apperrors.ErrNoWorkerExists
+}
+
+// Search the workers or employers of the platform, newest first
+func (adminS *adminConsoleStore) SearchUsers(ctx context.Context, userType UserType, search UserSearch) ([]UserSummary, error) {
+	users := make([]UserSummary, 0)
+	table := userTables[userType]
+
+	query := fmt.Sprintf(`SELECT `+userSummaryColumns+` FROM `+userSummarySource+` WHERE 1=1`, table, userType)
+	args := []interface{}{}
+	argIndex := 1
+
+	if len(search.Query) > 0 {
+		query += fmt.Sprintf(" AND (%[1]s.name ILIKE $%[2]d OR %[1]s.email ILIKE $%[2]d OR %[1]s.contact_number ILIKE $%[2]d)", table, argIndex)
+		args = append(args, "%"+search.Query+"%")
+		argIndex++
+	}
+	if len(search.City) > 0 {
+		query += fmt.Sprintf(" AND address.city ILIKE $%d", argIndex)
+		args = append(args, "%"+search.City+"%")
+		argIndex++
+	}
+	switch search.Status {
+	case "active":
+		query += " AND account_suspensions.user_id IS NULL"
+	case "suspended":
+		query += " AND account_suspensions.user_id IS NOT NULL"
+	}
+
+	query += fmt.Sprintf(" ORDER BY %[1]s.created_at DESC, %[1]s.id DESC LIMIT $%[2]d OFFSET $%[3]d", table, argIndex, argIndex+1)
+	args = append(args, search.Limit, search.Offset)
+
+	err := adminS.DB.Select(&users, query, args...)
+	if err != nil {
+		return []UserSummary{}, err
+	}
+	return users, nil
+}
+
+func (adminS *adminConsoleStore) FetchUserSummary(ctx context.Context, userType UserType, userId int) (UserSummary, error) {
+	var user UserSummary
+
+	err := adminS.DB.Get(&user, fmt.Sprintf(fetchUserSummaryQuery, userTables[userType], userType), userId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return UserSummary{}, userNotFoundError(userType)
+		}
+		return UserSummary{}, err
+	}
+	return user, nil
+}
+
+// Suspend a worker or employer, the account row is locked so that it cannot be deleted while the
+// suspension is written
+func (adminS *adminConsoleStore) SuspendUser(ctx context.Context, userType UserType, userId int, reason string, suspendedBy int) (UserSummary, error) {
+	tx, err := adminS.DB.Beginx()
+	if err != nil {
+		return UserSummary{}, err
+	}
+
+	defer tx.Rollback()
+
+	var lockedId int
+	err = tx.Get(&lockedId, fmt.Sprintf(lockUserQuery, userTables[userType]), userId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return UserSummary{}, userNotFoundError(userType)
+		}
+		return UserSummary{}, err
+	}
+
+	var suspendedId int
+	err = tx.Get(&suspendedId, suspendUserQuery, userType, userId, reason, suspendedBy)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return UserSummary{}, apperrors.ErrAccountAlreadySuspended
+		}
+		return UserSummary{}, err
+	}
+
+	var user UserSummary
+	err = tx.Get(&user, fmt.Sprintf(fetchUserSummaryQuery, userTables[userType], userType), userId)
+	if err != nil {
+		return UserSummary{}, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return UserSummary{}, err
+	}
+
+	return user, nil
+}
+
+func (adminS *adminConsoleStore) ReactivateUser(ctx context.Context, userType UserType, userId int) (UserSummary, error) {
+	var reactivatedId int
+
+	err := adminS.DB.Get(&reactivatedId, reactivateUserQuery, userType, userId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return UserSummary{}, apperrors.ErrAccountNotSuspended
+		}
+		return UserSummary{}, err
+	}
+
+	return adminS.FetchUserSummary(ctx, userType, userId)
+}
+
+// Hide a job from the job listings, the job itself and its applications are kept
+func (adminS *adminConsoleStore) HideJob(ctx context.Context, jobId int, reason string, hiddenBy int) (HiddenJob, error) {
+	tx, err := adminS.DB.Beginx()
+	if err != nil {
+		return HiddenJob{}, err
+	}
+
+	defer tx.Rollback()
+
+	var lockedId int
+	err = tx.Get(&lockedId, lockJobQuery, jobId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return HiddenJob{}, apperrors.ErrNoJobExists
+		}
+		return HiddenJob{}, err
+	}
+
+	var hiddenId int
+	err = tx.Get(&hiddenId, hideJobQuery, jobId, reason, hiddenBy)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return HiddenJob{}, apperrors.ErrJobAlreadyHidden
+		}
+		return HiddenJob{}, err
+	}
+
+	var hiddenJob HiddenJob
+	err = tx.Get(&hiddenJob, fetchHiddenJobByIdQuery, jobId)
+	if err != nil {
+		return HiddenJob{}, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return HiddenJob{}, err
+	}
+
+	return hiddenJob, nil
+}
+
+func (adminS *adminConsoleStore) UnhideJob(ctx context.Context, jobId int) error {
+	var unhiddenId int
+
+	err := adminS.DB.Get(&unhiddenId, unhideJobQuery, jobId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return apperrors.ErrJobNotHidden
+		}
+		return err
+	}
+	return nil
+}
+
+// Fetch the jobs hidden by admins and the ones hidden once reported by enough users, which have no hidden_by
+func (adminS *adminConsoleStore) FetchHiddenJobs(ctx context.Context) ([]HiddenJob, error) {
+	hiddenJobs := make([]HiddenJob, 0)
+
+	err := adminS.DB.Select(&hiddenJobs, fetchHiddenJobsQuery)
+	if err != nil {
+		return []HiddenJob{}, err
+	}
+	return hiddenJobs, nil
+}
+
+// Fetch the platform counts between from (inclusive) and to (exclusive), open jobs are counted
+// as of today
+func (adminS *adminConsoleStore) FetchDashboardStats(ctx context.Context, from, to time.Time, today datetime.Date) (DashboardStats, error) {
+	var stats DashboardStats
+
+	err := adminS.DB.Get(&stats, fetchRegistrationsQuery, from, to)
+	if err != nil {
+		return DashboardStats{}, err
+	}
+
+	err = adminS.DB.Get(&stats, fetchJobsPostedQuery, from, to)
+	if err != nil {
+		return DashboardStats{}, err
+	}
+
+	err = adminS.DB.Get(&stats.OpenJobs, fetchOpenJobsQuery, today)
+	if err != nil {
+		return DashboardStats{}, err
+	}
+
+	stats.ApplicationsByStatus = make([]ApplicationStatusCount, 0)
+	err = adminS.DB.Select(&stats.ApplicationsByStatus, fetchApplicationsByStatusQuery, from, to)
+	if err != nil {
+		return DashboardStats{}, err
+	}
+
+	return stats, nil
+}
@@ -14,8 +14,8 @@ type applicationStore struct {
 }
 
 type ApplicationStorer interface {
-	CreateNewApplication(ctx context.Context, applicationData Application) (Application, error)
-	UpdateApplicationByID(ctx context.Context, applicationData Application) (Application, error)
+	CreateNewApplication(ctx context.Context, applicationData Application, shiftIds []int) (Application, error)
+	UpdateApplicationByID(ctx context.Context, applicationData Application, checkEngagements EngagementCheck) (Application, error)
 	FetchApplicationByID(ctx context.Context, applicationId int) (Application, error)
 	DeleteApplicationByID(ctx context.Context, applicationId int) (int, error)
 	FindApplicationById(ctx context.Context, applicationId int) bool
@@ -30,15 +30,18 @@ func NewApplicationRepo(db *sqlx.DB) ApplicationStorer {
 
 // PostgreSQL Queries
 const (
-	createApplicationQuery     = `INSERT INTO applications (job_id, worker_id, status, expected_wage, mode_of_arrival, pick_up_location, worker_comments, applied_at, updated_at) VALUES (:job_id, :worker_id, :status, :expected_wage, :mode_of_arrival, :pick_up_location, :worker_comments, NOW(), NOW()) RETURNING *;`
-	updateApplicationByIdQuery = `UPDATE applications SET status=:status, expected_wage=:expected_wage, mode_of_arrival=:mode_of_arrival, pick_up_location=:pick_up_location, worker_comments=:worker_comments, updated_at=NOW() where id=:id RETURNING *;`
+	createApplicationQuery     = `INSERT INTO applications (job_id, worker_id, status, expected_wage, mode_of_arrival, pick_up_location, worker_comments, whole_series, applied_at, updated_at) VALUES (:job_id, :worker_id, :status, :expected_wage, :mode_of_arrival, :pick_up_location, :worker_comments, :whole_series, NOW(), NOW()) RETURNING *;`
+	updateApplicationByIdQuery = `UPDATE applications SET status=:status, expected_wage=:expected_wage, mode_of_arrival=:mode_of_arrival, pick_up_location=:pick_up_location, worker_comments=:worker_comments, confirmed_at=CASE WHEN :status = 'confirmed' AND status <> 'confirmed' THEN NOW() ELSE confirmed_at END, updated_at=NOW() where id=:id RETURNING *;`
 	fethcApplicationByIdQuery  = `SELECT applications.*, address.details, address.street, address.city, address.state, address.pincode from applications inner join address on applications.pick_up_location = address.id where applications.id = $1;`
 	deleteApplicationByIdQuery = `DELETE FROM applications WHERE id=$1 RETURNING pick_up_location;`
 	findApplicationByIdQuery   = `SELECT id FROM applications WHERE id = $1;`
+	lockApplicationStatusQuery = `SELECT status FROM applications WHERE id = $1 FOR UPDATE;`
+	lockApplicationWorkerQuery = `SELECT workers.id FROM workers INNER JOIN applications ON applications.worker_id = workers.id WHERE applications.id = $1 FOR UPDATE OF workers;`
 	fetchAllApplicationsQuery  = `select applications.*, address.details, address.street, address.state, address.city, address.pincode, jobs.title, jobs.description, jobs.skills_required, jobs.sectors, jobs.wage, jobs.vacancy, jobs.date, employers.name, employers.contact_number, employers.email, employers.type from applications inner join address on applications.pick_up_location = address.id inner join jobs on applications.job_id = jobs.id inner join employers on jobs.employer_id = employers.id;`
 )
 
-func (appS *applicationStore) CreateNewApplication(ctx context.Context, applicationData Application) (Application, error) {
+// Create an application along with the shifts it picked, applications for the whole series pick none
+func (appS *applicationStore) CreateNewApplication(ctx context.Context, applicationData Application, shiftIds []int) (Application, error) {
 
 	var createdApplication Application
 
@@ -50,14 +53,21 @@ func (appS *applicationStore) CreateNewApplication(ctx context.Context, applicat
 		Pincode: applicationData.Pincode,
 	}
 
-	address, err := CreateAddress(ctx, appS.DB, addressData)
+	tx, err := appS.DB.Beginx()
+	if err != nil {
+		return Application{}, err
+	}
+
+	defer tx.Rollback()
+
+	address, err := CreateAddress(ctx, tx, addressData)
 	if err != nil {
 		return Application{}, err
 	}
 
 	applicationData.PickUpLocation = address.ID
 
-	rows, err := appS.DB.NamedQuery(createApplicationQuery, applicationData)
+	rows, err := tx.NamedQuery(createApplicationQuery, applicationData)
 	if err != nil {
 		return Application{}, err
 	}
@@ -70,23 +80,72 @@ func (appS *applicationStore) CreateNewApplication(ctx context.Context, applicat
 			return Application{}, err
 		}
 	}
+	rows.Close()
+
+	for _, shiftId := range shiftIds {
+		_, err = tx.Exec(createApplicationShiftQuery, createdApplication.ID, shiftId)
+		if err != nil {
+			return Application{}, err
+		}
+	}
+
+	err = writeOutboxEvent(ctx, tx, ApplicationSubmittedEvent, EventPayload{
+		ApplicationID: createdApplication.ID,
+		JobID:         createdApplication.JobID,
+		WorkerID:      createdApplication.WorkerID,
+		Status:        string(createdApplication.Status),
+	})
+	if err != nil {
+		return Application{}, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return Application{}, err
+	}
 
 	return createdApplication, nil
 }
 
-func (appS *applicationStore) UpdateApplicationByID(ctx context.Context, applicationData Application) (Application, error) {
+// Update an application, confirming it books the worker once checkEngagements accepts the job next to
+// their calendar and records when it was confirmed in confirmed_at
+func (appS *applicationStore) UpdateApplicationByID(ctx context.Context, applicationData Application, checkEngagements EngagementCheck) (Application, error) {
 
 	var updatedApplication Application
 	var updatedAddress Address
 
-	address, err := GetAddressById(ctx, appS.DB, applicationData.PickUpLocation)
+	tx, err := appS.DB.Beginx()
+	if err != nil {
+		return Application{}, err
+	}
+
+	defer tx.Rollback()
+
+	// the row is locked so that concurrent updates report the status they actually changed from
+	var previousStatus Status
+	err = tx.Get(&previousStatus, lockApplicationStatusQuery, applicationData.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return Application{}, apperrors.ErrNoApplicationExists
+		}
+		return Application{}, err
+	}
+
+	if applicationData.Status == Confirmed && previousStatus != Confirmed {
+		err = checkConfirmation(tx, applicationData.ID, checkEngagements)
+		if err != nil {
+			return Application{}, err
+		}
+	}
+
+	address, err := GetAddressById(ctx, tx, applicationData.PickUpLocation)
 	if err != nil {
 		return Application{}, err
 	}
 
 	isAddressChanged := !MatchAddressApplication(address, applicationData)
 	if isAddressChanged {
-		updatedAddress, err = UpdateAddress(ctx, appS.DB, Address{
+		updatedAddress, err = UpdateAddress(ctx, tx, Address{
 			ID:      address.ID,
 			Details: applicationData.Details,
 			Street:  applicationData.Street,
@@ -99,7 +158,7 @@ func (appS *applicationStore) UpdateApplicationByID(ctx context.Context, applica
 		}
 	}
 
-	rows, err := appS.DB.NamedQuery(updateApplicationByIdQuery, applicationData)
+	rows, err := tx.NamedQuery(updateApplicationByIdQuery, applicationData)
 	if err != nil {
 		return Application{}, err
 	}
@@ -111,6 +170,25 @@ func (appS *applicationStore) UpdateApplicationByID(ctx context.Context, applica
 			return Application{}, err
 		}
 	}
+	rows.Close()
+
+	if updatedApplication.Status != previousStatus {
+		err = writeOutboxEvent(ctx, tx, ApplicationStatusChangedEvent, EventPayload{
+			ApplicationID:  updatedApplication.ID,
+			JobID:          updatedApplication.JobID,
+			WorkerID:       updatedApplication.WorkerID,
+			Status:         string(updatedApplication.Status),
+			PreviousStatus: string(previousStatus),
+		})
+		if err != nil {
+			return Application{}, err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return Application{}, err
+	}
 
 	if isAddressChanged {
 		updatedApplication = MapAddressToApplication(updatedApplication, updatedAddress)
@@ -121,6 +199,39 @@ func (appS *applicationStore) UpdateApplicationByID(ctx context.Context, applica
 	return updatedApplication, nil
 }
 
+// checkConfirmation locks the worker of an application so that confirmations of clashing jobs are
+// checked one after the other against the engagements already confirmed
+func checkConfirmation(tx *sqlx.Tx, applicationId int, checkEngagements EngagementCheck) error {
+	var workerId int
+	err := tx.Get(&workerId, lockApplicationWorkerQuery, applicationId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return apperrors.ErrNoWorkerExists
+		}
+		return err
+	}
+
+	candidates := make([]Engagement, 0)
+	err = tx.Select(&candidates, fetchEngagementsByApplicationIdQuery, applicationId)
+	if err != nil {
+		return err
+	}
+
+	engagements := make([]Engagement, 0)
+	err = tx.Select(&engagements, fetchEngagementsByWorkerIdQuery, workerId)
+	if err != nil {
+		return err
+	}
+
+	blackouts := make([]BlackoutDate, 0)
+	err = tx.Select(&blackouts, fetchBlackoutDatesByWorkerIdQuery, workerId)
+	if err != nil {
+		return err
+	}
+
+	return checkEngagements(candidates, engagements, blackouts)
+}
+
 func (appS *applicationStore) FetchApplicationByID(ctx context.Context, applicationId int) (Application, error) {
 
 	var application Application
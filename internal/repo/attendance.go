@@ -0,0 +1,231 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/jmoiron/sqlx"
+)
+
+type attendanceStore struct {
+	BaseRepository
+}
+
+type AttendanceStorer interface {
+	CreateAttendanceCode(ctx context.Context, code AttendanceCode) (AttendanceCode, error)
+	CreateAttendanceRecord(ctx context.Context, record AttendanceRecord) (AttendanceRecord, error)
+	CheckInAttendanceRecord(ctx context.Context, record AttendanceRecord, codeHash string) (AttendanceRecord, error)
+	FetchOpenAttendanceRecord(ctx context.Context, applicationId int) (AttendanceRecord, error)
+	CheckOutAttendanceRecord(ctx context.Context, record AttendanceRecord, codeHash string) (AttendanceRecord, error)
+	FetchAttendanceByApplicationId(ctx context.Context, applicationId int) ([]AttendanceRecord, error)
+	FetchAttendanceByJobId(ctx context.Context, jobId int) ([]AttendanceRecord, error)
+	FetchConfirmedEngagementsByJobId(ctx context.Context, jobId int) ([]Engagement, error)
+}
+
+func NewAttendanceRepo(db *sqlx.DB) AttendanceStorer {
+	return &attendanceStore{
+		BaseRepository: BaseRepository{DB: db},
+	}
+}
+
+// PostgreSQL Queries
+const (
+	attendanceColumns                     = `attendance.id, attendance.application_id, attendance.shift_id, attendance.worker_id, attendance.date, attendance.status, attendance.check_in_at, attendance.check_out_at, attendance.check_in_latitude, attendance.check_in_longitude, attendance.check_out_latitude, attendance.check_out_longitude, attendance.minutes_worked`
+	createAttendanceCodeQuery             = `INSERT INTO attendance_codes (application_id, code_hash, expires_at) VALUES (:application_id, :code_hash, :expires_at) RETURNING id, application_id, code_hash, expires_at, used_at;`
+	consumeAttendanceCodeQuery            = `UPDATE attendance_codes SET used_at=NOW() WHERE id = (SELECT id FROM attendance_codes WHERE application_id=$1 AND code_hash=$2 AND used_at IS NULL AND expires_at > NOW() LIMIT 1) RETURNING id;`
+	createAttendanceRecordQuery           = `INSERT INTO attendance (application_id, shift_id, worker_id, date, status, check_in_at, check_in_latitude, check_in_longitude, minutes_worked) VALUES (:application_id, :shift_id, :worker_id, :date, :status, :check_in_at, :check_in_latitude, :check_in_longitude, 0) ON CONFLICT (application_id, date) DO NOTHING RETURNING ` + attendanceColumns + `;`
+	fetchOpenAttendanceRecordQuery        = `SELECT ` + attendanceColumns + ` FROM attendance WHERE application_id=$1 AND status='checked_in' ORDER BY check_in_at DESC LIMIT 1;`
+	checkOutAttendanceRecordQuery         = `UPDATE attendance SET status='checked_out', check_out_at=:check_out_at, check_out_latitude=:check_out_latitude, check_out_longitude=:check_out_longitude, minutes_worked=:minutes_worked WHERE id=:id AND status='checked_in' RETURNING ` + attendanceColumns + `;`
+	countCheckedOutRecordsQuery           = `SELECT COUNT(*) FROM attendance WHERE application_id=$1 AND status='checked_out';`
+	incrementJobsWorkedQuery              = `UPDATE workers SET total_jobs_worked = total_jobs_worked + 1 WHERE id=$1;`
+	fetchAttendanceByApplicationIdQuery   = `SELECT ` + attendanceColumns + ` FROM attendance WHERE application_id=$1 ORDER BY date;`
+	fetchAttendanceByJobIdQuery           = `SELECT ` + attendanceColumns + ` FROM attendance INNER JOIN applications ON attendance.application_id = applications.id WHERE applications.job_id=$1 ORDER BY attendance.date, attendance.worker_id;`
+	fetchConfirmedEngagementsByJobIdQuery = `SELECT ` + engagementColumns + ` FROM ` + engagementSource + ` WHERE applications.job_id = $1 AND applications.status = 'confirmed' AND ` + engagementShiftCondition + ` ORDER BY date, applications.worker_id;`
+)
+
+func (attS *attendanceStore) CreateAttendanceCode(ctx context.Context, code AttendanceCode) (AttendanceCode, error) {
+	var createdCode AttendanceCode
+
+	rows, err := attS.DB.NamedQuery(createAttendanceCodeQuery, code)
+	if err != nil {
+		return AttendanceCode{}, err
+	}
+
+	defer rows.Close()
+
+	if rows.Next() {
+		err = rows.StructScan(&createdCode)
+		if err != nil {
+			return AttendanceCode{}, err
+		}
+	}
+	return createdCode, nil
+}
+
+// Mark an unexpired code of the application as used, every code can verify a single check-in or check-out
+// Create the attendance of an application for a day, a day can only be marked once
+func (attS *attendanceStore) CreateAttendanceRecord(ctx context.Context, record AttendanceRecord) (AttendanceRecord, error) {
+	var createdRecord AttendanceRecord
+
+	rows, err := attS.DB.NamedQuery(createAttendanceRecordQuery, record)
+	if err != nil {
+		return AttendanceRecord{}, err
+	}
+
+	defer rows.Close()
+
+	if !rows.Next() {
+		return AttendanceRecord{}, apperrors.ErrAttendanceAlreadyMarked
+	}
+
+	err = rows.StructScan(&createdRecord)
+	if err != nil {
+		return AttendanceRecord{}, err
+	}
+	return createdRecord, nil
+}
+
+// CheckInAttendanceRecord uses up the check-in code and records the check-in together, so a code is
+// not spent on a day that is already marked
+func (attS *attendanceStore) CheckInAttendanceRecord(ctx context.Context, record AttendanceRecord, codeHash string) (AttendanceRecord, error) {
+	tx, err := attS.DB.Beginx()
+	if err != nil {
+		return AttendanceRecord{}, err
+	}
+
+	defer tx.Rollback()
+
+	var codeId int
+	err = tx.Get(&codeId, consumeAttendanceCodeQuery, record.ApplicationID, codeHash)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return AttendanceRecord{}, apperrors.ErrInvalidAttendanceCode
+		}
+		return AttendanceRecord{}, err
+	}
+
+	rows, err := tx.NamedQuery(createAttendanceRecordQuery, record)
+	if err != nil {
+		return AttendanceRecord{}, err
+	}
+
+	var createdRecord AttendanceRecord
+	if !rows.Next() {
+		rows.Close()
+		return AttendanceRecord{}, apperrors.ErrAttendanceAlreadyMarked
+	}
+
+	err = rows.StructScan(&createdRecord)
+	rows.Close()
+	if err != nil {
+		return AttendanceRecord{}, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return AttendanceRecord{}, err
+	}
+	return createdRecord, nil
+}
+
+func (attS *attendanceStore) FetchOpenAttendanceRecord(ctx context.Context, applicationId int) (AttendanceRecord, error) {
+	var record AttendanceRecord
+
+	err := attS.DB.Get(&record, fetchOpenAttendanceRecordQuery, applicationId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return AttendanceRecord{}, apperrors.ErrNotCheckedIn
+		}
+		return AttendanceRecord{}, err
+	}
+	return record, nil
+}
+
+// Close an open attendance record with a code issued by the employer, the code is only used up if the
+// record is closed. The first completed day of an application counts towards the jobs worked by the worker
+func (attS *attendanceStore) CheckOutAttendanceRecord(ctx context.Context, record AttendanceRecord, codeHash string) (AttendanceRecord, error) {
+	tx, err := attS.DB.Beginx()
+	if err != nil {
+		return AttendanceRecord{}, err
+	}
+
+	defer tx.Rollback()
+
+	var codeId int
+	err = tx.Get(&codeId, consumeAttendanceCodeQuery, record.ApplicationID, codeHash)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return AttendanceRecord{}, apperrors.ErrInvalidAttendanceCode
+		}
+		return AttendanceRecord{}, err
+	}
+
+	rows, err := tx.NamedQuery(checkOutAttendanceRecordQuery, record)
+	if err != nil {
+		return AttendanceRecord{}, err
+	}
+
+	var updatedRecord AttendanceRecord
+	if !rows.Next() {
+		rows.Close()
+		return AttendanceRecord{}, apperrors.ErrNotCheckedIn
+	}
+
+	err = rows.StructScan(&updatedRecord)
+	rows.Close()
+	if err != nil {
+		return AttendanceRecord{}, err
+	}
+
+	var completedDays int
+	err = tx.Get(&completedDays, countCheckedOutRecordsQuery, updatedRecord.ApplicationID)
+	if err != nil {
+		return AttendanceRecord{}, err
+	}
+
+	if completedDays == 1 {
+		_, err = tx.Exec(incrementJobsWorkedQuery, updatedRecord.WorkerID)
+		if err != nil {
+			return AttendanceRecord{}, err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return AttendanceRecord{}, err
+	}
+	return updatedRecord, nil
+}
+
+func (attS *attendanceStore) FetchAttendanceByApplicationId(ctx context.Context, applicationId int) ([]AttendanceRecord, error) {
+	records := make([]AttendanceRecord, 0)
+
+	err := attS.DB.Select(&records, fetchAttendanceByApplicationIdQuery, applicationId)
+	if err != nil {
+		return []AttendanceRecord{}, err
+	}
+	return records, nil
+}
+
+func (attS *attendanceStore) FetchAttendanceByJobId(ctx context.Context, jobId int) ([]AttendanceRecord, error) {
+	records := make([]AttendanceRecord, 0)
+
+	err := attS.DB.Select(&records, fetchAttendanceByJobIdQuery, jobId)
+	if err != nil {
+		return []AttendanceRecord{}, err
+	}
+	return records, nil
+}
+
+// Fetch the job slots of every confirmed application to a job, one per covered shift
+func (attS *attendanceStore) FetchConfirmedEngagementsByJobId(ctx context.Context, jobId int) ([]Engagement, error) {
+	engagements := make([]Engagement, 0)
+
+	err := attS.DB.Select(&engagements, fetchConfirmedEngagementsByJobIdQuery, jobId)
+	if err != nil {
+		return []Engagement{}, err
+	}
+	return engagements, nil
+}
@@ -0,0 +1,82 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type auditStore struct {
+	BaseRepository
+}
+
+type AuditStorer interface {
+	CreateAuditLog(ctx context.Context, auditLog AuditLog) error
+	FetchAuditLogs(ctx context.Context, filter AuditLogFilter) ([]AuditLog, error)
+}
+
+func NewAuditRepo(db *sqlx.DB) AuditStorer {
+	return &auditStore{
+		BaseRepository: BaseRepository{DB: db},
+	}
+}
+
+// PostgreSQL Queries
+const (
+	createAuditLogQuery = `INSERT INTO audit_logs (actor_id, actor_role, action, entity_type, entity_id, changes, request_id, ip, created_at) VALUES (NULLIF(:actor_id, 0), :actor_role, :action, :entity_type, :entity_id, :changes, :request_id, :ip, NOW());`
+	auditLogColumns     = `id, COALESCE(actor_id, 0) AS actor_id, actor_role, action, entity_type, entity_id, changes, request_id, ip, created_at`
+)
+
+func (auditS *auditStore) CreateAuditLog(ctx context.Context, auditLog AuditLog) error {
+	_, err := auditS.DB.NamedExec(createAuditLogQuery, auditLog)
+	return err
+}
+
+// Fetch the audit logs matching a filter, newest first
+func (auditS *auditStore) FetchAuditLogs(ctx context.Context, filter AuditLogFilter) ([]AuditLog, error) {
+	auditLogs := make([]AuditLog, 0)
+	query := `SELECT ` + auditLogColumns + ` FROM audit_logs WHERE 1=1`
+	args := []interface{}{}
+	argIndex := 1
+
+	if len(filter.EntityType) > 0 {
+		query += fmt.Sprintf(" AND entity_type = $%d", argIndex)
+		args = append(args, filter.EntityType)
+		argIndex++
+	}
+	if filter.EntityID > 0 {
+		query += fmt.Sprintf(" AND entity_id = $%d", argIndex)
+		args = append(args, filter.EntityID)
+		argIndex++
+	}
+	if filter.ActorID > 0 {
+		query += fmt.Sprintf(" AND actor_id = $%d", argIndex)
+		args = append(args, filter.ActorID)
+		argIndex++
+	}
+	if len(filter.ActorRole) > 0 {
+		query += fmt.Sprintf(" AND actor_role = $%d", argIndex)
+		args = append(args, filter.ActorRole)
+		argIndex++
+	}
+	if !filter.From.IsZero() {
+		query += fmt.Sprintf(" AND created_at >= $%d", argIndex)
+		args = append(args, filter.From)
+		argIndex++
+	}
+	if !filter.To.IsZero() {
+		query += fmt.Sprintf(" AND created_at < $%d", argIndex)
+		args = append(args, filter.To)
+		argIndex++
+	}
+
+	query += fmt.Sprintf(" ORDER BY created_at DESC, id DESC LIMIT $%d OFFSET $%d", argIndex, argIndex+1)
+	args = append(args, filter.Limit, filter.Offset)
+
+	err := auditS.DB.Select(&auditLogs, query, args...)
+	if err != nil {
+		return []AuditLog{}, err
+	}
+	return auditLogs, nil
+}
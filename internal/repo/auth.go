@@ -21,9 +21,9 @@ func NewAuthRepo(db *sqlx.DB) AuthStorer {
 	}
 }
 
-const fetchWorkerByEmailQuery = "SELECT id, name, email, password FROM workers WHERE email=:email;"
-const fetchEmployerByEmailQuery = "SELECT id, name, email, password FROM employers WHERE email=:email;"
-const fetchAdminByEmailQuery = "SELECT id, name, email, password, role FROM admins where email=:email;"
+const fetchWorkerByEmailQuery = "SELECT id, name, email, password, EXISTS (SELECT 1 FROM account_suspensions WHERE user_type = 'worker' AND user_id = workers.id) AS is_suspended FROM workers WHERE email=:email;"
+const fetchEmployerByEmailQuery = "SELECT id, name, email, password, EXISTS (SELECT 1 FROM account_suspensions WHERE user_type = 'employer' AND user_id = employers.id) AS is_suspended FROM employers WHERE email=:email;"
+const fetchAdminByEmailQuery = "SELECT id, name, email, password, role, FALSE AS is_suspended FROM admins where email=:email;"
 
 func (authS *authStore) Login(ctx context.Context, loginData LoginRequest) (LoginUserData, error) {
 	var user LoginUserData
@@ -5,3 +5,9 @@ import "github.com/jmoiron/sqlx"
 type BaseRepository struct {
 	DB *sqlx.DB
 }
+
+// PostgreSQL error codes the repos turn into app errors
+const (
+	foreignKeyViolation = "23503"
+	uniqueViolation     = "23505"
+)
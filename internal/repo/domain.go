@@ -2,6 +2,9 @@ package repo
 
 import (
 	"time"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/datetime"
+	"github.com/lib/pq"
 )
 
 type Gender string
@@ -33,6 +36,8 @@ type Worker struct {
 	City            string    `db:"city"`
 	State           string    `db:"state"`
 	Pincode         int       `db:"pincode"`
+	// only set by the queries fetching worker profiles
+	VerifiedDocuments string `db:"verified_documents"`
 }
 
 type EmployerType string
@@ -84,6 +89,8 @@ type LoginUserData struct {
 	Email    string `db:"email"`
 	Password string `db:"password"`
 	Role     string `db:"role"`
+	// workers and employers suspended by an admin cannot log in
+	IsSuspended bool `db:"is_suspended"`
 }
 
 type LoginResponse struct {
@@ -94,27 +101,43 @@ type LoginResponse struct {
 // Job Structs
 
 type Job struct {
-	ID              int       `db:"id"`
-	EmployerID      int       `db:"employer_id"`
-	Title           string    `db:"title" `
-	RequiredGender  string    `db:"required_gender"`
-	Description     string    `db:"description"`
-	DurationInHours int       `db:"duration_in_hours"`
-	SkillsRequired  string    `db:"skills_required"`
-	Sectors         string    `db:"sectors"`
-	Wage            int       `db:"wage"`
-	Vacancy         int       `db:"vacancy"`
-	Location        int       `db:"location"`
-	Date            string    `db:"date"`
-	StartHour       string    `db:"start_hour"`
-	EndHour         string    `db:"end_hour"`
-	CreatedAt       time.Time `db:"created_at"`
-	UpdatedAt       time.Time `db:"updated_at"`
-	Details         string    `db:"details"`
-	Street          string    `db:"street"`
-	City            string    `db:"city"`
-	State           string    `db:"state"`
-	Pincode         int       `db:"pincode"`
+	ID              int            `db:"id"`
+	EmployerID      int            `db:"employer_id"`
+	Title           string         `db:"title" `
+	RequiredGender  string         `db:"required_gender"`
+	Description     string         `db:"description"`
+	DurationInHours int            `db:"duration_in_hours"`
+	SkillsRequired  string         `db:"skills_required"`
+	Sectors         string         `db:"sectors"`
+	SkillLevel      string         `db:"skill_level"`
+	Wage            int            `db:"wage"`
+	Vacancy         int            `db:"vacancy"`
+	Location        int            `db:"location"`
+	Date            datetime.Date  `db:"date"`
+	EndDate         datetime.Date  `db:"end_date"`
+	Recurrence      string         `db:"recurrence"`
+	RecurrenceDays  string         `db:"recurrence_days"`
+	StartHour       datetime.Clock `db:"start_hour"`
+	EndHour         datetime.Clock `db:"end_hour"`
+	CreatedAt       time.Time      `db:"created_at"`
+	UpdatedAt       time.Time      `db:"updated_at"`
+	Details         string         `db:"details"`
+	Street          string         `db:"street"`
+	City            string         `db:"city"`
+	State           string         `db:"state"`
+	Pincode         int            `db:"pincode"`
+	// only set by the queries joining the employer of the job
+	EmployerVerified bool `db:"employer_verified"`
+}
+
+// JobShift is a single day of work generated from the date range and recurrence of a job
+type JobShift struct {
+	ID        int            `db:"id"`
+	JobID     int            `db:"job_id"`
+	Date      datetime.Date  `db:"date"`
+	StartHour datetime.Clock `db:"start_hour"`
+	EndHour   datetime.Clock `db:"end_hour"`
+	Wage      int            `db:"wage"`
 }
 
 type Status string
@@ -137,8 +160,10 @@ type Application struct {
 	ModeOfArrival  ModeOfArrival `db:"mode_of_arrival"`
 	PickUpLocation int           `db:"pick_up_location"`
 	WorkerComment  string        `db:"worker_comments"`
+	WholeSeries    bool          `db:"whole_series"`
 	AppliedAt      time.Time     `db:"applied_at"`
 	UpdatedAt      time.Time     `db:"updated_at"`
+	ConfirmedAt    *time.Time    `db:"confirmed_at"`
 	Details        string        `db:"details"`
 	Street         string        `db:"street"`
 	City           string        `db:"city"`
@@ -155,8 +180,10 @@ type ApplicationComplete struct {
 	ModeOfArrival  ModeOfArrival `db:"mode_of_arrival"`
 	PickUpLocation int           `db:"pick_up_location"`
 	WorkerComment  string        `db:"worker_comments"`
+	WholeSeries    bool          `db:"whole_series"`
 	AppliedAt      time.Time     `db:"applied_at"`
 	UpdatedAt      time.Time     `db:"updated_at"`
+	ConfirmedAt    *time.Time    `db:"confirmed_at"`
 	Details        string        `db:"details"`
 	Street         string        `db:"street"`
 	City           string        `db:"city"`
@@ -168,7 +195,7 @@ type ApplicationComplete struct {
 	JobSectors     string        `db:"sectors"`
 	JobWage        int           `db:"wage"`
 	Vacancy        int           `db:"vacancy"`
-	JobDate        string        `db:"date"`
+	JobDate        datetime.Date `db:"date"`
 	EmployerName   string        `db:"name"`
 	ContactNumber  string        `db:"contact_number"`
 	EmployerEmail  string        `db:"email"`
@@ -184,8 +211,10 @@ type ApplicationCompleteEmp struct {
 	ModeOfArrival  ModeOfArrival `db:"mode_of_arrival"`
 	PickUpLocation int           `db:"pick_up_location"`
 	WorkerComment  string        `db:"worker_comments"`
+	WholeSeries    bool          `db:"whole_series"`
 	AppliedAt      time.Time     `db:"applied_at"`
 	UpdatedAt      time.Time     `db:"updated_at"`
+	ConfirmedAt    *time.Time    `db:"confirmed_at"`
 	Details        string        `db:"details"`
 	Street         string        `db:"street"`
 	City           string        `db:"city"`
@@ -197,7 +226,7 @@ type ApplicationCompleteEmp struct {
 	JobSectors     string        `db:"sectors"`
 	JobWage        int           `db:"wage"`
 	Vacancy        int           `db:"vacancy"`
-	JobDate        string        `db:"date"`
+	JobDate        datetime.Date `db:"date"`
 	WorkerName     string        `db:"name"`
 	ContactNumber  string        `db:"contact_number"`
 	WorkerEmail    string        `db:"email"`
@@ -210,18 +239,145 @@ type Sector struct {
 	Description string `db:"description"`
 }
 
+type SectorStats struct {
+	Workers     int     `db:"workers"`
+	Employers   int     `db:"employers"`
+	Jobs        int     `db:"jobs"`
+	OpenJobs    int     `db:"open_jobs"`
+	Skills      int     `db:"skills"`
+	AverageWage float64 `db:"average_wage"`
+}
+
+type Skill struct {
+	ID          int    `db:"id"`
+	Name        string `db:"name"`
+	Description string `db:"description"`
+	ParentID    int    `db:"parent_id"`
+}
+
+type SkillSynonym struct {
+	ID      int    `db:"id"`
+	SkillID int    `db:"skill_id"`
+	Synonym string `db:"synonym"`
+}
+
+type AvailabilityWindow struct {
+	ID        int            `db:"id"`
+	WorkerID  int            `db:"worker_id"`
+	DayOfWeek int            `db:"day_of_week"`
+	StartHour datetime.Clock `db:"start_hour"`
+	EndHour   datetime.Clock `db:"end_hour"`
+}
+
+type BlackoutDate struct {
+	ID       int           `db:"id"`
+	WorkerID int           `db:"worker_id"`
+	Date     datetime.Date `db:"date"`
+	Reason   string        `db:"reason"`
+}
+
+// Engagement is a job a worker is (or is about to be) confirmed for, used as a block on the worker calendar
+type Engagement struct {
+	ApplicationID   int            `db:"application_id"`
+	JobID           int            `db:"job_id"`
+	ShiftID         int            `db:"shift_id"`
+	WorkerID        int            `db:"worker_id"`
+	JobTitle        string         `db:"title"`
+	Date            datetime.Date  `db:"date"`
+	StartHour       datetime.Clock `db:"start_hour"`
+	EndHour         datetime.Clock `db:"end_hour"`
+	DurationInHours int            `db:"duration_in_hours"`
+}
+
+// EngagementCheck refuses the candidate engagements of an application that clash with the confirmed
+// engagements or the blackout dates of its worker
+type EngagementCheck func(candidates []Engagement, engagements []Engagement, blackouts []BlackoutDate) error
+
+// PaymentEntry is a single movement in the wage ledger of an application, deductions reduce the
+// amount owed while advance and final payments settle it
+type PaymentEntry struct {
+	ID             int        `db:"id"`
+	ApplicationID  int        `db:"application_id"`
+	Type           string     `db:"type"`
+	Amount         int        `db:"amount"`
+	Method         string     `db:"method"`
+	Reference      string     `db:"reference"`
+	Note           string     `db:"note"`
+	RecordedAt     time.Time  `db:"recorded_at"`
+	AcknowledgedAt *time.Time `db:"acknowledged_at"`
+}
+
+// PaymentOrder tracks a wage payment collected through a payment gateway, once paid it is linked to
+// the ledger entry recording it
+type PaymentOrder struct {
+	ID                int       `db:"id"`
+	ApplicationID     int       `db:"application_id"`
+	Type              string    `db:"type"`
+	Amount            int       `db:"amount"`
+	IdempotencyKey    string    `db:"idempotency_key"`
+	ProviderOrderID   string    `db:"provider_order_id"`
+	ProviderPaymentID string    `db:"provider_payment_id"`
+	Status            string    `db:"status"`
+	PaymentID         *int      `db:"payment_id"`
+	CreatedAt         time.Time `db:"created_at"`
+	UpdatedAt         time.Time `db:"updated_at"`
+}
+
+// Dues is the wage balance of an application, owed is the total wage of the shifts it covers
+type Dues struct {
+	ApplicationID int    `db:"application_id"`
+	JobID         int    `db:"job_id"`
+	JobTitle      string `db:"title"`
+	WorkerID      int    `db:"worker_id"`
+	EmployerID    int    `db:"employer_id"`
+	Status        Status `db:"status"`
+	Owed          int    `db:"owed"`
+	Paid          int    `db:"paid"`
+	Deducted      int    `db:"deducted"`
+}
+
+// AttendanceRecord is the check-in/check-out (or no-show) of a confirmed worker for one day of a job
+type AttendanceRecord struct {
+	ID                int           `db:"id"`
+	ApplicationID     int           `db:"application_id"`
+	ShiftID           int           `db:"shift_id"`
+	WorkerID          int           `db:"worker_id"`
+	Date              datetime.Date `db:"date"`
+	Status            string        `db:"status"`
+	CheckInAt         *time.Time    `db:"check_in_at"`
+	CheckOutAt        *time.Time    `db:"check_out_at"`
+	CheckInLatitude   *float64      `db:"check_in_latitude"`
+	CheckInLongitude  *float64      `db:"check_in_longitude"`
+	CheckOutLatitude  *float64      `db:"check_out_latitude"`
+	CheckOutLongitude *float64      `db:"check_out_longitude"`
+	MinutesWorked     int           `db:"minutes_worked"`
+}
+
+// AttendanceCode is a one-time code issued by the employer to verify a check-in or check-out,
+// only a hash of the code is stored
+type AttendanceCode struct {
+	ID            int        `db:"id"`
+	ApplicationID int        `db:"application_id"`
+	CodeHash      string     `db:"code_hash"`
+	ExpiresAt     time.Time  `db:"expires_at"`
+	UsedAt        *time.Time `db:"used_at"`
+}
+
 type JobFilters struct {
 	Title     string
 	Sector    string
 	WageMin   int
 	WageMax   int
-	StartDate time.Time
-	EndDate   time.Time
+	StartDate datetime.Date
+	EndDate   datetime.Date
 	City      string
 	Gender    string
+	// VerifiedOnly keeps the jobs of verified employers
+	VerifiedOnly bool
 }
 
 type Admin struct {
+	ID        int       `db:"id"`
 	Name      string    `db:"name"`
 	ContactNo string    `db:"contact_no"`
 	Email     string    `db:"email"`
@@ -230,3 +386,502 @@ type Admin struct {
 	CreatedAt time.Time `db:"created_at"`
 	UpdatedAt time.Time `db:"updated_at"`
 }
+
+// PickupPassenger is a confirmed worker of a job who asked to be picked up, with their pickup address
+type PickupPassenger struct {
+	ApplicationID int    `db:"application_id"`
+	JobID         int    `db:"job_id"`
+	WorkerID      int    `db:"worker_id"`
+	WorkerName    string `db:"name"`
+	ContactNumber string `db:"contact_number"`
+	AddressID     int    `db:"address_id"`
+	Details       string `db:"details"`
+	Street        string `db:"street"`
+	City          string `db:"city"`
+	State         string `db:"state"`
+	Pincode       int    `db:"pincode"`
+}
+
+// PickupAssignment is the vehicle and pickup time the employer assigned to the stop of a pincode
+type PickupAssignment struct {
+	ID         int            `db:"id"`
+	JobID      int            `db:"job_id"`
+	Pincode    int            `db:"pincode"`
+	Vehicle    string         `db:"vehicle"`
+	PickupTime datetime.Clock `db:"pickup_time"`
+	UpdatedAt  time.Time      `db:"updated_at"`
+}
+
+// Message is a message of the thread between the employer and the worker of an application
+type Message struct {
+	ID             int        `db:"id"`
+	ApplicationID  int        `db:"application_id"`
+	SenderRole     string     `db:"sender_role"`
+	SenderID       int        `db:"sender_id"`
+	Body           string     `db:"body"`
+	AttachmentName string     `db:"attachment_name"`
+	AttachmentType string     `db:"attachment_type"`
+	AttachmentSize int64      `db:"attachment_size"`
+	AttachmentURL  string     `db:"attachment_url"`
+	CreatedAt      time.Time  `db:"created_at"`
+	ReadAt         *time.Time `db:"read_at"`
+}
+
+// MessageThread is the thread of an application with its participants, unread counts the messages
+// the reader has not read yet
+type MessageThread struct {
+	ApplicationID int        `db:"application_id"`
+	JobID         int        `db:"job_id"`
+	JobTitle      string     `db:"title"`
+	WorkerID      int        `db:"worker_id"`
+	EmployerID    int        `db:"employer_id"`
+	Status        Status     `db:"status"`
+	LastMessageAt *time.Time `db:"last_message_at"`
+	Unread        int        `db:"unread"`
+}
+
+// NotificationRecipient is the contact details and language of a worker or employer to notify
+type NotificationRecipient struct {
+	ID            int    `db:"id"`
+	Name          string `db:"name"`
+	ContactNumber string `db:"contact_number"`
+	Email         string `db:"email"`
+	Language      string `db:"language"`
+}
+
+// Notification is an entry of the in-app inbox of a worker or employer
+type Notification struct {
+	ID            int        `db:"id"`
+	RecipientRole string     `db:"recipient_role"`
+	RecipientID   int        `db:"recipient_id"`
+	Event         string     `db:"event"`
+	Title         string     `db:"title"`
+	Body          string     `db:"body"`
+	CreatedAt     time.Time  `db:"created_at"`
+	ReadAt        *time.Time `db:"read_at"`
+}
+
+// NotificationPreference enables or disables a delivery channel for a worker or employer
+type NotificationPreference struct {
+	RecipientRole string `db:"recipient_role"`
+	RecipientID   int    `db:"recipient_id"`
+	Channel       string `db:"channel"`
+	Enabled       bool   `db:"enabled"`
+}
+
+type EventType string
+
+// domain events written to the outbox together with the change they announce
+const (
+	JobPostedEvent                EventType = "job_posted"
+	JobUpdatedEvent               EventType = "job_updated"
+	ApplicationSubmittedEvent     EventType = "application_submitted"
+	ApplicationStatusChangedEvent EventType = "application_status_changed"
+	WorkerRegisteredEvent         EventType = "worker_registered"
+	EmployerRegisteredEvent       EventType = "employer_registered"
+	MessageSentEvent              EventType = "message_sent"
+	WorkerDeletedEvent            EventType = "worker_deleted"
+	EmployerDeletedEvent          EventType = "employer_deleted"
+	JobDeletedEvent               EventType = "job_deleted"
+	ReportResolvedEvent           EventType = "report_resolved"
+)
+
+// EventPayload is the JSON body of an outbox event, only the ids relevant to the event are set
+type EventPayload struct {
+	ApplicationID  int    `json:"application_id,omitempty"`
+	JobID          int    `json:"job_id,omitempty"`
+	WorkerID       int    `json:"worker_id,omitempty"`
+	EmployerID     int    `json:"employer_id,omitempty"`
+	MessageID      int    `json:"message_id,omitempty"`
+	SenderRole     string `json:"sender_role,omitempty"`
+	Status         string `json:"status,omitempty"`
+	PreviousStatus string `json:"previous_status,omitempty"`
+}
+
+// OutboxEvent is a domain event waiting in the outbox to be delivered to its subscribers
+type OutboxEvent struct {
+	ID        int       `db:"id"`
+	Type      EventType `db:"type"`
+	Payload   []byte    `db:"payload"`
+	Attempts  int       `db:"attempts"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+type WebhookDeliveryStatus string
+
+const (
+	DeliveryPending   WebhookDeliveryStatus = "pending"
+	DeliverySucceeded WebhookDeliveryStatus = "succeeded"
+	DeliveryFailed    WebhookDeliveryStatus = "failed"
+)
+
+// Webhook is an endpoint of an employer that is sent the events it subscribed to, events are
+// stored comma separated
+type Webhook struct {
+	ID                  int        `db:"id"`
+	EmployerID          int        `db:"employer_id"`
+	URL                 string     `db:"url"`
+	Secret              string     `db:"secret"`
+	Events              string     `db:"events"`
+	Enabled             bool       `db:"enabled"`
+	ConsecutiveFailures int        `db:"consecutive_failures"`
+	DisabledAt          *time.Time `db:"disabled_at"`
+	CreatedAt           time.Time  `db:"created_at"`
+	UpdatedAt           time.Time  `db:"updated_at"`
+}
+
+// WebhookDelivery is an event sent (or waiting to be sent) to a webhook, with the result of its
+// last attempt
+type WebhookDelivery struct {
+	ID             int                   `db:"id"`
+	WebhookID      int                   `db:"webhook_id"`
+	EventID        int                   `db:"event_id"`
+	EventType      string                `db:"event_type"`
+	Payload        []byte                `db:"payload"`
+	Status         WebhookDeliveryStatus `db:"status"`
+	Attempts       int                   `db:"attempts"`
+	NextAttemptAt  time.Time             `db:"next_attempt_at"`
+	ResponseStatus int                   `db:"response_status"`
+	ResponseBody   string                `db:"response_body"`
+	Error          string                `db:"error"`
+	RedeliveryOf   *int                  `db:"redelivery_of"`
+	CreatedAt      time.Time             `db:"created_at"`
+	DeliveredAt    *time.Time            `db:"delivered_at"`
+}
+
+type VerificationStatus string
+
+const (
+	VerificationPending  VerificationStatus = "pending"
+	VerificationApproved VerificationStatus = "approved"
+	VerificationRejected VerificationStatus = "rejected"
+)
+
+// EmployerVerification is a request of an employer to be verified with its registration numbers,
+// reviewed by an admin
+type EmployerVerification struct {
+	ID           int                `db:"id"`
+	EmployerID   int                `db:"employer_id"`
+	EmployerName string             `db:"employer_name"`
+	GSTIN        string             `db:"gstin"`
+	PAN          string             `db:"pan"`
+	UdyamNumber  string             `db:"udyam_number"`
+	Status       VerificationStatus `db:"status"`
+	Reason       string             `db:"reason"`
+	ReviewedBy   int                `db:"reviewed_by"`
+	SubmittedAt  time.Time          `db:"submitted_at"`
+	ReviewedAt   *time.Time         `db:"reviewed_at"`
+}
+
+// VerificationDocument is the metadata of a document attached to a verification request, the
+// file itself is uploaded separately
+type VerificationDocument struct {
+	ID             int    `db:"id"`
+	VerificationID int    `db:"verification_id"`
+	DocumentType   string `db:"document_type"`
+	FileName       string `db:"file_name"`
+	ContentType    string `db:"content_type"`
+	Size           int64  `db:"size"`
+	URL            string `db:"url"`
+}
+
+type WorkerDocumentType string
+
+const (
+	AadhaarDocument        WorkerDocumentType = "aadhaar"
+	EShramDocument         WorkerDocumentType = "eshram"
+	ITICertificateDocument WorkerDocumentType = "iti_certificate"
+)
+
+// WorkerDocument is an identity document or skill certificate uploaded by a worker for KYC, the
+// file is kept in the blob store under BlobKey and only the last digits of its number are stored
+type WorkerDocument struct {
+	ID           int                `db:"id"`
+	WorkerID     int                `db:"worker_id"`
+	WorkerName   string             `db:"worker_name"`
+	DocumentType WorkerDocumentType `db:"document_type"`
+	MaskedNumber string             `db:"masked_number"`
+	BlobKey      string             `db:"blob_key"`
+	FileName     string             `db:"file_name"`
+	ContentType  string             `db:"content_type"`
+	Size         int64              `db:"size"`
+	Status       VerificationStatus `db:"status"`
+	Reason       string             `db:"reason"`
+	ReviewedBy   int                `db:"reviewed_by"`
+	UploadedAt   time.Time          `db:"uploaded_at"`
+	ReviewedAt   *time.Time         `db:"reviewed_at"`
+}
+
+type MediaOwnerType string
+
+const (
+	WorkerMedia   MediaOwnerType = "worker"
+	EmployerMedia MediaOwnerType = "employer"
+	JobMedia      MediaOwnerType = "job"
+)
+
+// Media is an image of a worker, employer or job kept in the blob store with a jpeg thumbnail,
+// owners are not foreign keys so the rows of deleted owners are removed by the media cleanup
+type Media struct {
+	ID           int            `db:"id"`
+	OwnerType    MediaOwnerType `db:"owner_type"`
+	OwnerID      int            `db:"owner_id"`
+	BlobKey      string         `db:"blob_key"`
+	ThumbnailKey string         `db:"thumbnail_key"`
+	ContentType  string         `db:"content_type"`
+	Size         int64          `db:"size"`
+	Width        int            `db:"width"`
+	Height       int            `db:"height"`
+	CreatedAt    time.Time      `db:"created_at"`
+}
+
+type UserType string
+
+const (
+	WorkerUser   UserType = "worker"
+	EmployerUser UserType = "employer"
+)
+
+// UserSearch filters the workers or employers listed in the admin console, Query matches the
+// name, email or contact number and Status is empty, active or suspended
+type UserSearch struct {
+	Query  string
+	City   string
+	Status string
+	Limit  int
+	Offset int
+}
+
+// UserSummary is a worker or employer as listed in the admin console, with its suspension when
+// it is suspended
+type UserSummary struct {
+	ID               int        `db:"id"`
+	Name             string     `db:"name"`
+	ContactNumber    string     `db:"contact_number"`
+	Email            string     `db:"email"`
+	City             string     `db:"city"`
+	State            string     `db:"state"`
+	CreatedAt        time.Time  `db:"created_at"`
+	SuspensionReason string     `db:"suspension_reason"`
+	SuspendedBy      int        `db:"suspended_by"`
+	SuspendedAt      *time.Time `db:"suspended_at"`
+}
+
+// HiddenJob is a job hidden from the job listings by an admin
+type HiddenJob struct {
+	JobID      int       `db:"job_id"`
+	Title      string    `db:"title"`
+	EmployerID int       `db:"employer_id"`
+	Reason     string    `db:"reason"`
+	HiddenBy   int       `db:"hidden_by"`
+	HiddenAt   time.Time `db:"hidden_at"`
+}
+
+type ApplicationStatusCount struct {
+	Status string `db:"status"`
+	Count  int    `db:"count"`
+}
+
+// DashboardStats are the platform counts of a time range, jobs are counted when they were posted
+// in the range and applications when they were submitted in it
+type DashboardStats struct {
+	WorkerRegistrations   int `db:"worker_registrations"`
+	EmployerRegistrations int `db:"employer_registrations"`
+	JobsPosted            int `db:"jobs_posted"`
+	OpenJobs              int `db:"open_jobs"`
+	Vacancies             int `db:"vacancies"`
+	FilledVacancies       int `db:"filled_vacancies"`
+	ApplicationsByStatus  []ApplicationStatusCount
+}
+
+// Role is a named set of permissions, the built-in worker, employer, admin and super-admin roles
+// are created with the database and cannot be deleted
+type Role struct {
+	Name        string         `db:"name"`
+	Description string         `db:"description"`
+	IsSystem    bool           `db:"is_system"`
+	Permissions pq.StringArray `db:"permissions"`
+	CreatedAt   time.Time      `db:"created_at"`
+	UpdatedAt   time.Time      `db:"updated_at"`
+}
+
+// AuditLog is a change made to an entity, Changes holds the before and after value of each field
+// that changed as JSON
+type AuditLog struct {
+	ID         int       `db:"id"`
+	ActorID    int       `db:"actor_id"`
+	ActorRole  string    `db:"actor_role"`
+	Action     string    `db:"action"`
+	EntityType string    `db:"entity_type"`
+	EntityID   int       `db:"entity_id"`
+	Changes    []byte    `db:"changes"`
+	RequestID  string    `db:"request_id"`
+	IP         string    `db:"ip"`
+	CreatedAt  time.Time `db:"created_at"`
+}
+
+// AuditLogFilter selects audit logs, zero values match everything and From and To bound the time
+// the change was made at
+type AuditLogFilter struct {
+	EntityType string
+	EntityID   int
+	ActorID    int
+	ActorRole  string
+	From       time.Time
+	To         time.Time
+	Limit      int
+	Offset     int
+}
+
+// Report is a job, employer, worker or message reported by a worker or an employer, it stays open
+// until an admin resolves the reports of its target
+type Report struct {
+	ID             int        `db:"id"`
+	TargetType     string     `db:"target_type"`
+	TargetID       int        `db:"target_id"`
+	ReporterRole   string     `db:"reporter_role"`
+	ReporterID     int        `db:"reporter_id"`
+	Reason         string     `db:"reason"`
+	Details        string     `db:"details"`
+	Status         string     `db:"status"`
+	Resolution     string     `db:"resolution"`
+	ResolutionNote string     `db:"resolution_note"`
+	ResolvedBy     int        `db:"resolved_by"`
+	ResolvedAt     *time.Time `db:"resolved_at"`
+	CreatedAt      time.Time  `db:"created_at"`
+}
+
+// ReportTarget is who owns reported content, for a message WorkerID and EmployerID are the two
+// sides of the application it was sent in
+type ReportTarget struct {
+	OwnerRole  string `db:"owner_role"`
+	OwnerID    int    `db:"owner_id"`
+	WorkerID   int    `db:"worker_id"`
+	EmployerID int    `db:"employer_id"`
+}
+
+// ReportedContent is content with open reports waiting in the moderation queue
+type ReportedContent struct {
+	TargetType      string         `db:"target_type"`
+	TargetID        int            `db:"target_id"`
+	OpenReports     int            `db:"open_reports"`
+	Reasons         pq.StringArray `db:"reasons"`
+	IsHidden        bool           `db:"is_hidden"`
+	FirstReportedAt time.Time      `db:"first_reported_at"`
+	LastReportedAt  time.Time      `db:"last_reported_at"`
+}
+
+type ReportQueueFilter struct {
+	TargetType string
+	Limit      int
+	Offset     int
+}
+
+// ReportResolution closes the open reports of a target, Status is dismissed or actioned and Action
+// what the admin did about the content. The content is shown again unless HideContent is set, and
+// SuspendAccount also suspends the reported worker or employer.
+type ReportResolution struct {
+	TargetType     string
+	TargetID       int
+	Status         string
+	Action         string
+	Note           string
+	ResolvedBy     int
+	HideContent    bool
+	SuspendAccount bool
+}
+
+// MinimumWage is the lowest daily wage for 8 hours of work at a skill level, an empty State or
+// Sector applies to every state or sector without a wage of its own
+type MinimumWage struct {
+	ID         int       `db:"id"`
+	State      string    `db:"state"`
+	Sector     string    `db:"sector"`
+	SkillLevel string    `db:"skill_level"`
+	DailyWage  int       `db:"daily_wage"`
+	UpdatedBy  int       `db:"updated_by"`
+	UpdatedAt  time.Time `db:"updated_at"`
+}
+
+// JobWageCheck is the result of the last minimum wage check of a job
+type JobWageCheck struct {
+	JobID            int       `db:"job_id"`
+	Title            string    `db:"title"`
+	EmployerID       int       `db:"employer_id"`
+	State            string    `db:"state"`
+	Sector           string    `db:"sector"`
+	SkillLevel       string    `db:"skill_level"`
+	Wage             int       `db:"wage"`
+	DurationInHours  int       `db:"duration_in_hours"`
+	MinimumDailyWage int       `db:"minimum_daily_wage"`
+	RequiredWage     int       `db:"required_wage"`
+	Status           string    `db:"status"`
+	CheckedAt        time.Time `db:"checked_at"`
+}
+
+type JobWageCheckFilter struct {
+	State  string
+	Status string
+	Limit  int
+	Offset int
+}
+
+type WageCheckCount struct {
+	Status string `db:"status"`
+	Count  int    `db:"count"`
+}
+
+// WageFilter narrows the jobs wage distributions are computed over, empty fields match every job
+type WageFilter struct {
+	Sector string
+	Skill  string
+	City   string
+	Season string
+	Since  datetime.Date
+}
+
+// WageDistribution is the spread of the wages of an 8 hour day, Value is the sector, skill, city or
+// season the wages are grouped by
+type WageDistribution struct {
+	Value   string  `db:"value"`
+	Samples int     `db:"samples"`
+	P25     float64 `db:"p25"`
+	Median  float64 `db:"median"`
+	P75     float64 `db:"p75"`
+}
+
+// HiringSummary counts the hiring of an employer for the jobs they posted in a period
+type HiringSummary struct {
+	EmployerExists      bool    `db:"employer_exists"`
+	Jobs                int     `db:"jobs"`
+	Applications        int     `db:"applications"`
+	Shortlisted         int     `db:"shortlisted"`
+	Confirmed           int     `db:"confirmed"`
+	FilledJobs          int     `db:"filled_jobs"`
+	AverageHoursToFill  float64 `db:"average_hours_to_fill"`
+	AttendanceRecords   int     `db:"attendance_records"`
+	NoShows             int     `db:"no_shows"`
+	WorkersHired        int     `db:"workers_hired"`
+	RepeatWorkers       int     `db:"repeat_workers"`
+	AverageWorkerRating float64 `db:"average_worker_rating"`
+	Spend               int     `db:"spend"`
+}
+
+// ApplicationActivity is the jobs an employer posted and the applications they got in a week or month
+type ApplicationActivity struct {
+	Period       time.Time `db:"period"`
+	Jobs         int       `db:"jobs"`
+	Applications int       `db:"applications"`
+}
+
+// WorkHistoryEntry is the work a worker completed for a confirmed application in a period, with the
+// wages of the days worked and the payments recorded in the period
+type WorkHistoryEntry struct {
+	Dues
+	EmployerName   string        `db:"employer_name"`
+	EmployerRating float64       `db:"employer_rating"`
+	FirstDay       datetime.Date `db:"first_day"`
+	LastDay        datetime.Date `db:"last_day"`
+	DaysWorked     int           `db:"days_worked"`
+	MinutesWorked  int           `db:"minutes_worked"`
+}
@@ -22,14 +22,14 @@ type EmployerStorer interface {
 
 // PostgreSQL Queries
 const (
-	registerWorkerQuery        = `INSERT INTO employers (name, contact_number, email, type, password, sectors, location, is_verified, rating, workers_hired, created_at, updated_at, language) VALUES (:name, :contact_number, :email, :type, :password, :sectors, :location, :is_verified, :rating, :workers_hired, NOW(), NOW(), :language) RETURNING *;`
+	registerWorkerQuery        = `INSERT INTO employers (name, contact_number, email, type, password, sectors, location, rating, workers_hired, created_at, updated_at, language) VALUES (:name, :contact_number, :email, :type, :password, :sectors, :location, :rating, :workers_hired, NOW(), NOW(), :language) RETURNING *;`
 	fetchEmployerByIDQuery     = `SELECT employers.*, address.details, address.street, address.city, address.state, address.pincode from employers inner join address on employers.location = address.id where employers.id = $1;`
-	updateEmployerByIdQuery    = `UPDATE employers SET name=:name, contact_number=:contact_number, email=:email, type=:type, sectors=:sectors, rating=:rating, workers_hired=:workers_hired, is_verified=:is_verified, updated_at=NOW(), language=:language WHERE id=:id RETURNING *;`
+	updateEmployerByIdQuery    = `UPDATE employers SET name=:name, contact_number=:contact_number, email=:email, type=:type, sectors=:sectors, rating=:rating, workers_hired=:workers_hired, updated_at=NOW(), language=:language WHERE id=:id RETURNING *;`
 	deleteEmployerByIdQuery    = `DELETE from employers where id=$1 RETURNING location;`
 	findEmployerByEmailQuery   = `SELECT id from employers where email=$1;`
 	findEmployerByIDQuery      = `SELECT id from employers where id=$1;`
 	fetchJobsByIdEmployerQuery = `SELECT jobs.*, address.details, address.street, address.city, address.state, address.pincode from jobs inner join address on jobs.location = address.id where jobs.employer_id = $1;`
-	fetchAllEmployersQuery     = `SELECT * FROM employers;`
+	fetchAllEmployersQuery     = `SELECT * FROM employers WHERE NOT EXISTS (SELECT 1 FROM hidden_content WHERE hidden_content.target_type = 'employer' AND hidden_content.target_id = employers.id);`
 )
 
 type employerStore struct {
@@ -54,14 +54,21 @@ func (es *employerStore) RegisterEmployer(ctx context.Context, employerData Empl
 		Pincode: employerData.Pincode,
 	}
 
-	address, err := CreateAddress(ctx, es.DB, addressData)
+	tx, err := es.DB.Beginx()
+	if err != nil {
+		return Employer{}, err
+	}
+
+	defer tx.Rollback()
+
+	address, err := CreateAddress(ctx, tx, addressData)
 	if err != nil {
 		return Employer{}, err
 	}
 
 	employerData.Location = address.ID
 
-	rows, err := es.DB.NamedQuery(registerWorkerQuery, employerData)
+	rows, err := tx.NamedQuery(registerWorkerQuery, employerData)
 	if err != nil {
 		return Employer{}, err
 	}
@@ -74,6 +81,17 @@ func (es *employerStore) RegisterEmployer(ctx context.Context, employerData Empl
 			return Employer{}, err
 		}
 	}
+	rows.Close()
+
+	err = writeOutboxEvent(ctx, tx, EmployerRegisteredEvent, EventPayload{EmployerID: newEmployer.ID})
+	if err != nil {
+		return Employer{}, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return Employer{}, err
+	}
 	newEmployer = MapAddressToEmployer(newEmployer, address)
 	return newEmployer, nil
 }
@@ -138,19 +156,34 @@ func (es *employerStore) UpdateEmployerById(ctx context.Context, employerData Em
 }
 
 func (es *employerStore) DeleteEmployerByID(ctx context.Context, employerId int) (int, error) {
+	tx, err := es.DB.Beginx()
+	if err != nil {
+		return -1, err
+	}
+
+	defer tx.Rollback()
 
 	var addressId int
+	err = tx.Get(&addressId, deleteEmployerByIdQuery, employerId)
+	if err != nil {
+		return -1, err
+	}
 
-	err := es.DB.Get(&addressId, deleteEmployerByIdQuery, employerId)
+	err = DeleteAddress(ctx, tx, addressId)
+	if err != nil {
+		return -1, err
+	}
 
+	err = writeOutboxEvent(ctx, tx, EmployerDeletedEvent, EventPayload{EmployerID: employerId})
 	if err != nil {
 		return -1, err
 	}
 
-	err = DeleteAddress(ctx, es.DB, addressId)
+	err = tx.Commit()
 	if err != nil {
 		return -1, err
 	}
+
 	return employerId, nil
 }
 
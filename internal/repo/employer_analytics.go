@@ -0,0 +1,82 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/jmoiron/sqlx"
+)
+
+type employerAnalyticsStore struct {
+	BaseRepository
+}
+
+type EmployerAnalyticsStorer interface {
+	FetchHiringSummary(ctx context.Context, employerId int, from time.Time, to time.Time) (HiringSummary, error)
+	FetchApplicationActivity(ctx context.Context, employerId int, from time.Time, to time.Time, interval string) ([]ApplicationActivity, error)
+}
+
+func NewEmployerAnalyticsRepo(db *sqlx.DB) EmployerAnalyticsStorer {
+	return &employerAnalyticsStore{
+		BaseRepository: BaseRepository{DB: db},
+	}
+}
+
+// PostgreSQL Queries
+const (
+	// the jobs an employer posted from $2 until before $3, and their applications
+	employerJobsSource = `WITH employer_jobs AS (SELECT id, vacancy, created_at FROM jobs WHERE employer_id = $1 AND created_at >= $2 AND created_at < $3),
+		employer_applications AS (SELECT applications.id, applications.job_id, applications.worker_id, applications.status, applications.applied_at, applications.confirmed_at FROM applications INNER JOIN employer_jobs ON applications.job_id = employer_jobs.id),
+		confirmed_applications AS (SELECT * FROM employer_applications WHERE status = 'confirmed')`
+	// a job is filled when the worker for its last vacancy is confirmed, the time it took is counted
+	// from the job being posted until that confirmation
+	fetchHiringSummaryQuery = employerJobsSource + `,
+		filled_jobs AS (SELECT employer_jobs.id, EXTRACT(EPOCH FROM (ranked.confirmed_at - employer_jobs.created_at)) / 3600 AS hours_to_fill FROM employer_jobs
+			INNER JOIN (SELECT job_id, confirmed_at, ROW_NUMBER() OVER (PARTITION BY job_id ORDER BY confirmed_at) AS position FROM confirmed_applications) AS ranked
+			ON ranked.job_id = employer_jobs.id AND ranked.position = employer_jobs.vacancy)
+		SELECT
+		EXISTS (SELECT 1 FROM employers WHERE id = $1) AS employer_exists,
+		(SELECT COUNT(*) FROM employer_jobs) AS jobs,
+		(SELECT COUNT(*) FROM employer_applications) AS applications,
+		(SELECT COUNT(*) FROM employer_applications WHERE status IN ('shortlisted', 'confirmed')) AS shortlisted,
+		(SELECT COUNT(*) FROM confirmed_applications) AS confirmed,
+		(SELECT COUNT(*) FROM filled_jobs) AS filled_jobs,
+		(SELECT COALESCE(AVG(hours_to_fill), 0) FROM filled_jobs) AS average_hours_to_fill,
+		(SELECT COUNT(*) FROM attendance INNER JOIN confirmed_applications ON attendance.application_id = confirmed_applications.id) AS attendance_records,
+		(SELECT COUNT(*) FROM attendance INNER JOIN confirmed_applications ON attendance.application_id = confirmed_applications.id WHERE attendance.status = 'no_show') AS no_shows,
+		(SELECT COUNT(DISTINCT worker_id) FROM confirmed_applications) AS workers_hired,
+		(SELECT COUNT(*) FROM (SELECT worker_id FROM confirmed_applications GROUP BY worker_id HAVING COUNT(DISTINCT job_id) > 1) AS repeat_hires) AS repeat_workers,
+		(SELECT COALESCE(AVG(workers.rating), 0) FROM workers WHERE workers.id IN (SELECT worker_id FROM confirmed_applications)) AS average_worker_rating,
+		(SELECT COALESCE(SUM(CASE WHEN payments.type = 'refund' THEN -payments.amount ELSE payments.amount END), 0) FROM payments INNER JOIN confirmed_applications ON payments.application_id = confirmed_applications.id WHERE payments.type IN ('advance', 'final', 'refund')) AS spend;`
+	fetchApplicationActivityQuery = employerJobsSource + `
+		SELECT period, SUM(jobs) AS jobs, SUM(applications) AS applications FROM (
+			SELECT date_trunc($4, created_at) AS period, 1 AS jobs, 0 AS applications FROM employer_jobs
+			UNION ALL
+			SELECT date_trunc($4, applied_at) AS period, 0 AS jobs, 1 AS applications FROM employer_applications
+		) AS activity GROUP BY period ORDER BY period;`
+)
+
+func (empAnalyticsS *employerAnalyticsStore) FetchHiringSummary(ctx context.Context, employerId int, from time.Time, to time.Time) (HiringSummary, error) {
+	var summary HiringSummary
+
+	err := empAnalyticsS.DB.Get(&summary, fetchHiringSummaryQuery, employerId, from, to)
+	if err != nil {
+		return HiringSummary{}, err
+	}
+	if !summary.EmployerExists {
+		return HiringSummary{}, apperrors.ErrNoEmployerExists
+	}
+	return summary, nil
+}
+
+// Fetch the jobs posted and applications received in each week or month, periods without any are left out
+func (empAnalyticsS *employerAnalyticsStore) FetchApplicationActivity(ctx context.Context, employerId int, from time.Time, to time.Time, interval string) ([]ApplicationActivity, error) {
+	activity := make([]ApplicationActivity, 0)
+
+	err := empAnalyticsS.DB.Select(&activity, fetchApplicationActivityQuery, employerId, from, to, interval)
+	if err != nil {
+		return []ApplicationActivity{}, err
+	}
+	return activity, nil
+}
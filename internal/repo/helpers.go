@@ -1,5 +1,7 @@
 package repo
 
+import "strings"
+
 func MapAddressToWorker(workerWithAddress Worker, address Address) Worker {
 	// Map all address fields to output worker object
 	workerWithAddress.Location = address.ID
@@ -75,3 +77,27 @@ func MatchAddressApplication(address Address, application Application) bool {
 	}
 	return false
 }
+
+// ReplaceSector swaps every occurrence of the sector name in a comma separated sectors string with the target name,
+// matching case-insensitively and dropping duplicates created by the swap
+func ReplaceSector(sectors, from, to string) string {
+	replaced := make([]string, 0)
+	seen := make(map[string]bool)
+
+	for _, sector := range strings.Split(sectors, ",") {
+		sector = strings.TrimSpace(sector)
+		if sector == "" {
+			continue
+		}
+		if strings.EqualFold(sector, from) {
+			sector = to
+		}
+		if seen[strings.ToLower(sector)] {
+			continue
+		}
+		seen[strings.ToLower(sector)] = true
+		replaced = append(replaced, sector)
+	}
+
+	return strings.Join(replaced, ", ")
+}
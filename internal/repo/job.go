@@ -23,8 +23,8 @@ func NewJobRepo(db *sqlx.DB) JobStorer {
 }
 
 type JobStorer interface {
-	CreateJob(ctx context.Context, jobData Job) (Job, error)
-	UpdateJobById(ctx context.Context, jobData Job) (Job, error)
+	CreateJob(ctx context.Context, jobData Job, shifts []JobShift) (Job, []JobShift, error)
+	UpdateJobById(ctx context.Context, jobData Job, shifts []JobShift) (Job, []JobShift, error)
 	FetchJobById(ctx context.Context, jobId int) (Job, error)
 	DeleteJobById(ctx context.Context, jobId int) (int, error)
 	FindJobById(ctx context.Context, jobId int) bool
@@ -34,16 +34,16 @@ type JobStorer interface {
 
 // PostgreSQL Queries
 const (
-	createJobQuery                = `INSERT INTO jobs (employer_id, title, required_gender, location, description, duration_in_hours, skills_required, sectors, wage, vacancy, date, start_hour, end_hour, created_at, updated_at) VALUES (:employer_id, :title, :required_gender, :location, :description, :duration_in_hours, :skills_required, :sectors, :wage, :vacancy, :date, :start_hour, :end_hour, NOW(), NOW()) RETURNING *;`
-	updateJobByIdQuery            = `UPDATE jobs SET title=:title, required_gender=:required_gender, description=:description, duration_in_hours=:duration_in_hours, skills_required=:skills_required, sectors=:sectors, wage=:wage, vacancy=:vacancy, date=:date, start_hour=:start_hour, end_hour=:end_hour, updated_at=NOW() where id=:id RETURNING *;`
-	fetchJobByIdQuery             = `SELECT jobs.*, address.details, address.street, address.city, address.state, address.pincode from jobs inner join address on jobs.location = address.id where jobs.id = $1;`
+	createJobQuery                = `INSERT INTO jobs (employer_id, title, required_gender, location, description, duration_in_hours, skills_required, sectors, skill_level, wage, vacancy, date, end_date, recurrence, recurrence_days, start_hour, end_hour, created_at, updated_at) VALUES (:employer_id, :title, :required_gender, :location, :description, :duration_in_hours, :skills_required, :sectors, :skill_level, :wage, :vacancy, :date, :end_date, :recurrence, :recurrence_days, :start_hour, :end_hour, NOW(), NOW()) RETURNING *;`
+	updateJobByIdQuery            = `UPDATE jobs SET title=:title, required_gender=:required_gender, description=:description, duration_in_hours=:duration_in_hours, skills_required=:skills_required, sectors=:sectors, skill_level=:skill_level, wage=:wage, vacancy=:vacancy, date=:date, end_date=:end_date, recurrence=:recurrence, recurrence_days=:recurrence_days, start_hour=:start_hour, end_hour=:end_hour, updated_at=NOW() where id=:id RETURNING *;`
+	fetchJobByIdQuery             = `SELECT jobs.*, address.details, address.street, address.city, address.state, address.pincode, employers.is_verified AS employer_verified from jobs inner join address on jobs.location = address.id inner join employers on jobs.employer_id = employers.id where jobs.id = $1;`
 	deleteJobByIdQuery            = `DELETE FROM jobs WHERE id=$1 RETURNING location;`
 	findJobByIdQuery              = `SELECT id FROM jobs WHERE id = $1;`
 	fetchApplicationsByJobIdQuery = `select applications.*, address.details, address.street, address.state, address.city, address.pincode, jobs.title, jobs.description, jobs.skills_required, jobs.sectors, jobs.wage, jobs.vacancy, jobs.date, workers.name, workers.contact_number, workers.email, workers.gender from applications inner join address on applications.pick_up_location = address.id inner join jobs on applications.job_id = jobs.id inner join workers on applications.worker_id = workers.id where applications.job_id = $1;`
 )
 
-// Create New Job
-func (jobS *jobStore) CreateJob(ctx context.Context, jobData Job) (Job, error) {
+// Create New Job along with its shifts
+func (jobS *jobStore) CreateJob(ctx context.Context, jobData Job, shifts []JobShift) (Job, []JobShift, error) {
 	var createdJob Job
 
 	addressData := Address{
@@ -54,16 +54,23 @@ func (jobS *jobStore) CreateJob(ctx context.Context, jobData Job) (Job, error) {
 		Pincode: jobData.Pincode,
 	}
 
-	address, err := CreateAddress(ctx, jobS.DB, addressData)
+	tx, err := jobS.DB.Beginx()
 	if err != nil {
-		return Job{}, err
+		return Job{}, []JobShift{}, err
+	}
+
+	defer tx.Rollback()
+
+	address, err := CreateAddress(ctx, tx, addressData)
+	if err != nil {
+		return Job{}, []JobShift{}, err
 	}
 
 	jobData.Location = address.ID
 
-	rows, err := jobS.DB.NamedQuery(createJobQuery, jobData)
+	rows, err := tx.NamedQuery(createJobQuery, jobData)
 	if err != nil {
-		return Job{}, err
+		return Job{}, []JobShift{}, err
 	}
 
 	defer rows.Close()
@@ -71,26 +78,50 @@ func (jobS *jobStore) CreateJob(ctx context.Context, jobData Job) (Job, error) {
 	if rows.Next() {
 		err = rows.StructScan(&createdJob)
 		if err != nil {
-			return Job{}, err
+			return Job{}, []JobShift{}, err
 		}
 	}
+	rows.Close()
+
+	syncedShifts, err := syncJobShifts(tx, createdJob.ID, shifts)
+	if err != nil {
+		return Job{}, []JobShift{}, err
+	}
+
+	err = writeOutboxEvent(ctx, tx, JobPostedEvent, EventPayload{JobID: createdJob.ID, EmployerID: createdJob.EmployerID})
+	if err != nil {
+		return Job{}, []JobShift{}, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return Job{}, []JobShift{}, err
+	}
+
 	createdJob = MapAddressToJob(createdJob, address)
-	return createdJob, nil
+	return createdJob, syncedShifts, nil
 }
 
-// Update Job
-func (jobS *jobStore) UpdateJobById(ctx context.Context, jobData Job) (Job, error) {
+// Update Job and replace its shifts
+func (jobS *jobStore) UpdateJobById(ctx context.Context, jobData Job, shifts []JobShift) (Job, []JobShift, error) {
 	var updatedJob Job
 	var updatedAddress Address
 
-	address, err := GetAddressById(ctx, jobS.DB, jobData.Location)
+	tx, err := jobS.DB.Beginx()
 	if err != nil {
-		return Job{}, err
+		return Job{}, []JobShift{}, err
+	}
+
+	defer tx.Rollback()
+
+	address, err := GetAddressById(ctx, tx, jobData.Location)
+	if err != nil {
+		return Job{}, []JobShift{}, err
 	}
 
 	isAddressChanged := !MatchAddressJob(address, jobData)
 	if isAddressChanged {
-		updatedAddress, err = UpdateAddress(ctx, jobS.DB, Address{
+		updatedAddress, err = UpdateAddress(ctx, tx, Address{
 			ID:      address.ID,
 			Details: jobData.Details,
 			Street:  jobData.Street,
@@ -99,22 +130,38 @@ func (jobS *jobStore) UpdateJobById(ctx context.Context, jobData Job) (Job, erro
 			Pincode: jobData.Pincode,
 		})
 		if err != nil {
-			return Job{}, err
+			return Job{}, []JobShift{}, err
 		}
 	}
 
-	rows, err := jobS.DB.NamedQuery(updateJobByIdQuery, jobData)
+	rows, err := tx.NamedQuery(updateJobByIdQuery, jobData)
 	if err != nil {
-		return Job{}, err
+		return Job{}, []JobShift{}, err
 	}
 
 	defer rows.Close()
 	if rows.Next() {
 		err = rows.StructScan(&updatedJob)
 		if err != nil {
-			return Job{}, err
+			return Job{}, []JobShift{}, err
 		}
 	}
+	rows.Close()
+
+	syncedShifts, err := syncJobShifts(tx, updatedJob.ID, shifts)
+	if err != nil {
+		return Job{}, []JobShift{}, err
+	}
+
+	err = writeOutboxEvent(ctx, tx, JobUpdatedEvent, EventPayload{JobID: updatedJob.ID, EmployerID: updatedJob.EmployerID})
+	if err != nil {
+		return Job{}, []JobShift{}, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return Job{}, []JobShift{}, err
+	}
 
 	if isAddressChanged {
 		updatedJob = MapAddressToJob(updatedJob, updatedAddress)
@@ -122,7 +169,7 @@ func (jobS *jobStore) UpdateJobById(ctx context.Context, jobData Job) (Job, erro
 		updatedJob = MapAddressToJob(updatedJob, address)
 	}
 
-	return updatedJob, nil
+	return updatedJob, syncedShifts, nil
 }
 
 // Fetch Job Data by ID
@@ -143,15 +190,30 @@ func (jobS *jobStore) FetchJobById(ctx context.Context, jobId int) (Job, error)
 
 // Delete Job by ID
 func (jobS *jobStore) DeleteJobById(ctx context.Context, jobId int) (int, error) {
+	tx, err := jobS.DB.Beginx()
+	if err != nil {
+		return -1, err
+	}
+
+	defer tx.Rollback()
+
 	var addressId int
+	err = tx.Get(&addressId, deleteJobByIdQuery, jobId)
+	if err != nil {
+		return -1, err
+	}
 
-	err := jobS.DB.Get(&addressId, deleteJobByIdQuery, jobId)
+	err = DeleteAddress(ctx, tx, addressId)
+	if err != nil {
+		return -1, err
+	}
 
+	err = writeOutboxEvent(ctx, tx, JobDeletedEvent, EventPayload{JobID: jobId})
 	if err != nil {
 		return -1, err
 	}
 
-	err = DeleteAddress(ctx, jobS.DB, addressId)
+	err = tx.Commit()
 	if err != nil {
 		return -1, err
 	}
@@ -182,7 +244,9 @@ func (jobS *jobStore) FetchApplicationsByJobId(ctx context.Context, jobId int) (
 
 func (jobS *jobStore) FetchAllJobs(ctx context.Context, filters JobFilters) ([]Job, error) {
 	var jobs []Job
-	query := `SELECT jobs.*, address.details, address.street, address.city, address.state, address.pincode FROM jobs INNER JOIN address ON jobs.location = address.id WHERE 1=1`
+	// jobs hidden by an admin or after being reported and the jobs of suspended employers are left
+	// out of the listings
+	query := `SELECT jobs.*, address.details, address.street, address.city, address.state, address.pincode, employers.is_verified AS employer_verified FROM jobs INNER JOIN address ON jobs.location = address.id INNER JOIN employers ON jobs.employer_id = employers.id WHERE NOT EXISTS (SELECT 1 FROM hidden_content WHERE hidden_content.target_type = 'job' AND hidden_content.target_id = jobs.id) AND NOT EXISTS (SELECT 1 FROM account_suspensions WHERE account_suspensions.user_type = 'employer' AND account_suspensions.user_id = jobs.employer_id)`
 	args := []interface{}{}
 	argIndex := 1
 
@@ -207,8 +271,9 @@ func (jobS *jobStore) FetchAllJobs(ctx context.Context, filters JobFilters) ([]J
 		args = append(args, filters.WageMax)
 		argIndex++
 	}
+	// multi day jobs match when any of their days falls in the requested range
 	if !filters.StartDate.IsZero() {
-		query += fmt.Sprintf(" AND jobs.date >= $%d", argIndex)
+		query += fmt.Sprintf(" AND COALESCE(jobs.end_date, jobs.date) >= $%d", argIndex)
 		args = append(args, filters.StartDate)
 		argIndex++
 	}
@@ -227,6 +292,9 @@ func (jobS *jobStore) FetchAllJobs(ctx context.Context, filters JobFilters) ([]J
 		args = append(args, filters.Gender)
 		argIndex++
 	}
+	if filters.VerifiedOnly {
+		query += " AND employers.is_verified"
+	}
 
 	err := jobS.DB.Select(&jobs, query, args...)
 	if err != nil {
@@ -0,0 +1,194 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/jmoiron/sqlx"
+)
+
+type mediaStore struct {
+	BaseRepository
+}
+
+type MediaStorer interface {
+	CreateMedia(ctx context.Context, media Media, limit int) (Media, error)
+	ReplaceMedia(ctx context.Context, media Media) (Media, []Media, error)
+	FetchMedia(ctx context.Context, ownerType MediaOwnerType, ownerId int) ([]Media, error)
+	FetchMediaById(ctx context.Context, mediaId int) (Media, error)
+	FetchMediaByBlobKey(ctx context.Context, key string) (Media, error)
+	DeleteMedia(ctx context.Context, mediaId int) (Media, error)
+	DeleteOwnerMedia(ctx context.Context, ownerType MediaOwnerType, ownerId int) ([]Media, error)
+}
+
+func NewMediaRepo(db *sqlx.DB) MediaStorer {
+	return &mediaStore{
+		BaseRepository: BaseRepository{DB: db},
+	}
+}
+
+// PostgreSQL Queries
+const (
+	mediaColumns               = `id, owner_type, owner_id, blob_key, thumbnail_key, content_type, size, width, height, created_at`
+	lockMediaOwnerQuery        = `SELECT pg_advisory_xact_lock(hashtext($1::text || ':' || $2::text));`
+	countMediaQuery            = `SELECT COUNT(*) FROM media WHERE owner_type = $1 AND owner_id = $2;`
+	createMediaQuery           = `INSERT INTO media (owner_type, owner_id, blob_key, thumbnail_key, content_type, size, width, height, created_at) VALUES (:owner_type, :owner_id, :blob_key, :thumbnail_key, :content_type, :size, :width, :height, NOW()) RETURNING ` + mediaColumns + `;`
+	fetchMediaQuery            = `SELECT ` + mediaColumns + ` FROM media WHERE owner_type = $1 AND owner_id = $2 ORDER BY id;`
+	fetchMediaByIdQuery        = `SELECT ` + mediaColumns + ` FROM media WHERE id = $1;`
+	fetchMediaByBlobKeyQuery   = `SELECT ` + mediaColumns + ` FROM media WHERE blob_key = $1 OR thumbnail_key = $1;`
+	deleteMediaQuery           = `DELETE FROM media WHERE id = $1 RETURNING ` + mediaColumns + `;`
+	deleteOwnerMediaQuery      = `DELETE FROM media WHERE owner_type = $1 AND owner_id = $2 RETURNING ` + mediaColumns + `;`
+	deleteOtherOwnerMediaQuery = `DELETE FROM media WHERE owner_type = $1 AND owner_id = $2 AND id <> $3 RETURNING ` + mediaColumns + `;`
+)
+
+// Create an image of an owner unless it already has limit images, the owner is locked so that
+// concurrent uploads cannot go over the limit
+func (ms *mediaStore) CreateMedia(ctx context.Context, media Media, limit int) (Media, error) {
+	tx, err := ms.DB.Beginx()
+	if err != nil {
+		return Media{}, err
+	}
+
+	defer tx.Rollback()
+
+	_, err = tx.Exec(lockMediaOwnerQuery, media.OwnerType, media.OwnerID)
+	if err != nil {
+		return Media{}, err
+	}
+
+	var count int
+	err = tx.Get(&count, countMediaQuery, media.OwnerType, media.OwnerID)
+	if err != nil {
+		return Media{}, err
+	}
+	if count >= limit {
+		return Media{}, apperrors.ErrMediaLimitReached
+	}
+
+	createdMedia, err := insertMedia(tx, media)
+	if err != nil {
+		return Media{}, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return Media{}, err
+	}
+
+	return createdMedia, nil
+}
+
+// Replace the images of an owner with a new one, the replaced rows are returned so that their
+// files can be deleted
+func (ms *mediaStore) ReplaceMedia(ctx context.Context, media Media) (Media, []Media, error) {
+	tx, err := ms.DB.Beginx()
+	if err != nil {
+		return Media{}, []Media{}, err
+	}
+
+	defer tx.Rollback()
+
+	_, err = tx.Exec(lockMediaOwnerQuery, media.OwnerType, media.OwnerID)
+	if err != nil {
+		return Media{}, []Media{}, err
+	}
+
+	createdMedia, err := insertMedia(tx, media)
+	if err != nil {
+		return Media{}, []Media{}, err
+	}
+
+	replacedMedia := make([]Media, 0)
+	err = tx.Select(&replacedMedia, deleteOtherOwnerMediaQuery, media.OwnerType, media.OwnerID, createdMedia.ID)
+	if err != nil {
+		return Media{}, []Media{}, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return Media{}, []Media{}, err
+	}
+
+	return createdMedia, replacedMedia, nil
+}
+
+func (ms *mediaStore) FetchMedia(ctx context.Context, ownerType MediaOwnerType, ownerId int) ([]Media, error) {
+	media := make([]Media, 0)
+
+	err := ms.DB.Select(&media, fetchMediaQuery, ownerType, ownerId)
+	if err != nil {
+		return []Media{}, err
+	}
+	return media, nil
+}
+
+func (ms *mediaStore) FetchMediaById(ctx context.Context, mediaId int) (Media, error) {
+	var media Media
+
+	err := ms.DB.Get(&media, fetchMediaByIdQuery, mediaId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return Media{}, apperrors.ErrNoMediaExists
+		}
+		return Media{}, err
+	}
+	return media, nil
+}
+
+// Fetch the image stored under a key, either as the image itself or as its thumbnail
+func (ms *mediaStore) FetchMediaByBlobKey(ctx context.Context, key string) (Media, error) {
+	var media Media
+
+	err := ms.DB.Get(&media, fetchMediaByBlobKeyQuery, key)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return Media{}, apperrors.ErrNoMediaExists
+		}
+		return Media{}, err
+	}
+	return media, nil
+}
+
+func (ms *mediaStore) DeleteMedia(ctx context.Context, mediaId int) (Media, error) {
+	var media Media
+
+	err := ms.DB.Get(&media, deleteMediaQuery, mediaId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return Media{}, apperrors.ErrNoMediaExists
+		}
+		return Media{}, err
+	}
+	return media, nil
+}
+
+// Delete every image of an owner, the deleted rows are returned so that their files can be deleted
+func (ms *mediaStore) DeleteOwnerMedia(ctx context.Context, ownerType MediaOwnerType, ownerId int) ([]Media, error) {
+	media := make([]Media, 0)
+
+	err := ms.DB.Select(&media, deleteOwnerMediaQuery, ownerType, ownerId)
+	if err != nil {
+		return []Media{}, err
+	}
+	return media, nil
+}
+
+func insertMedia(tx *sqlx.Tx, media Media) (Media, error) {
+	rows, err := tx.NamedQuery(createMediaQuery, media)
+	if err != nil {
+		return Media{}, err
+	}
+
+	defer rows.Close()
+
+	var createdMedia Media
+	if rows.Next() {
+		err = rows.StructScan(&createdMedia)
+		if err != nil {
+			return Media{}, err
+		}
+	}
+	return createdMedia, nil
+}
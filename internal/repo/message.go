@@ -0,0 +1,149 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/jmoiron/sqlx"
+)
+
+type messageStore struct {
+	BaseRepository
+}
+
+type MessageStorer interface {
+	FetchMessageThread(ctx context.Context, applicationId int, readerRole string) (MessageThread, error)
+	CreateMessage(ctx context.Context, message Message) (Message, error)
+	FetchMessagesByApplicationId(ctx context.Context, applicationId int) ([]Message, error)
+	MarkMessagesRead(ctx context.Context, applicationId int, readerRole string) (int, error)
+	FetchThreadsByWorkerId(ctx context.Context, workerId int) ([]MessageThread, error)
+	FetchThreadsByEmployerId(ctx context.Context, employerId int) ([]MessageThread, error)
+}
+
+func NewMessageRepo(db *sqlx.DB) MessageStorer {
+	return &messageStore{
+		BaseRepository: BaseRepository{DB: db},
+	}
+}
+
+// PostgreSQL Queries
+const (
+	messageColumns                    = `id, application_id, sender_role, sender_id, body, attachment_name, attachment_type, attachment_size, attachment_url, created_at, read_at`
+	threadColumns                     = `applications.id AS application_id, applications.job_id, jobs.title, applications.worker_id, jobs.employer_id, applications.status, MAX(messages.created_at) AS last_message_at, COUNT(messages.id) FILTER (WHERE messages.sender_role <> $2 AND messages.read_at IS NULL) AS unread`
+	threadSource                      = `applications INNER JOIN jobs ON applications.job_id = jobs.id LEFT JOIN messages ON messages.application_id = applications.id`
+	threadGrouping                    = `GROUP BY applications.id, jobs.title, jobs.employer_id`
+	fetchMessageThreadQuery           = `SELECT ` + threadColumns + ` FROM ` + threadSource + ` WHERE applications.id = $1 ` + threadGrouping + `;`
+	createMessageQuery                = `INSERT INTO messages (application_id, sender_role, sender_id, body, attachment_name, attachment_type, attachment_size, attachment_url) VALUES (:application_id, :sender_role, :sender_id, :body, :attachment_name, :attachment_type, :attachment_size, :attachment_url) RETURNING ` + messageColumns + `;`
+	fetchMessagesByApplicationIdQuery = `SELECT ` + messageColumns + ` FROM messages WHERE application_id = $1 AND NOT EXISTS (SELECT 1 FROM hidden_content WHERE hidden_content.target_type = 'message' AND hidden_content.target_id = messages.id) ORDER BY created_at, id;`
+	markMessagesReadQuery             = `UPDATE messages SET read_at = NOW() WHERE application_id = $1 AND sender_role <> $2 AND read_at IS NULL;`
+	fetchThreadsByWorkerIdQuery       = `SELECT ` + threadColumns + ` FROM ` + threadSource + ` WHERE applications.worker_id = $1 AND messages.id IS NOT NULL ` + threadGrouping + ` ORDER BY last_message_at DESC;`
+	fetchThreadsByEmployerIdQuery     = `SELECT ` + threadColumns + ` FROM ` + threadSource + ` WHERE jobs.employer_id = $1 AND messages.id IS NOT NULL ` + threadGrouping + ` ORDER BY last_message_at DESC;`
+	fetchMessagePartiesQuery          = `SELECT applications.job_id, applications.worker_id, jobs.employer_id FROM applications INNER JOIN jobs ON applications.job_id = jobs.id WHERE applications.id = $1;`
+)
+
+// Fetch the thread of an application, unread counts the messages not sent by the reader
+func (msgS *messageStore) FetchMessageThread(ctx context.Context, applicationId int, readerRole string) (MessageThread, error) {
+	var thread MessageThread
+
+	err := msgS.DB.Get(&thread, fetchMessageThreadQuery, applicationId, readerRole)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return MessageThread{}, apperrors.ErrNoApplicationExists
+		}
+		return MessageThread{}, err
+	}
+	return thread, nil
+}
+
+func (msgS *messageStore) CreateMessage(ctx context.Context, message Message) (Message, error) {
+	var createdMessage Message
+
+	tx, err := msgS.DB.Beginx()
+	if err != nil {
+		return Message{}, err
+	}
+
+	defer tx.Rollback()
+
+	rows, err := tx.NamedQuery(createMessageQuery, message)
+	if err != nil {
+		return Message{}, err
+	}
+
+	defer rows.Close()
+
+	if rows.Next() {
+		err = rows.StructScan(&createdMessage)
+		if err != nil {
+			return Message{}, err
+		}
+	}
+	rows.Close()
+
+	payload := EventPayload{ApplicationID: createdMessage.ApplicationID, MessageID: createdMessage.ID, SenderRole: createdMessage.SenderRole}
+	err = tx.QueryRowx(fetchMessagePartiesQuery, createdMessage.ApplicationID).Scan(&payload.JobID, &payload.WorkerID, &payload.EmployerID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return Message{}, apperrors.ErrNoApplicationExists
+		}
+		return Message{}, err
+	}
+
+	err = writeOutboxEvent(ctx, tx, MessageSentEvent, payload)
+	if err != nil {
+		return Message{}, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return Message{}, err
+	}
+
+	return createdMessage, nil
+}
+
+func (msgS *messageStore) FetchMessagesByApplicationId(ctx context.Context, applicationId int) ([]Message, error) {
+	messages := make([]Message, 0)
+
+	err := msgS.DB.Select(&messages, fetchMessagesByApplicationIdQuery, applicationId)
+	if err != nil {
+		return []Message{}, err
+	}
+	return messages, nil
+}
+
+// Mark the messages sent to the reader as read and return how many were marked
+func (msgS *messageStore) MarkMessagesRead(ctx context.Context, applicationId int, readerRole string) (int, error) {
+	result, err := msgS.DB.Exec(markMessagesReadQuery, applicationId, readerRole)
+	if err != nil {
+		return 0, err
+	}
+
+	marked, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	return int(marked), nil
+}
+
+func (msgS *messageStore) FetchThreadsByWorkerId(ctx context.Context, workerId int) ([]MessageThread, error) {
+	threads := make([]MessageThread, 0)
+
+	err := msgS.DB.Select(&threads, fetchThreadsByWorkerIdQuery, workerId, "worker")
+	if err != nil {
+		return []MessageThread{}, err
+	}
+	return threads, nil
+}
+
+func (msgS *messageStore) FetchThreadsByEmployerId(ctx context.Context, employerId int) ([]MessageThread, error) {
+	threads := make([]MessageThread, 0)
+
+	err := msgS.DB.Select(&threads, fetchThreadsByEmployerIdQuery, employerId, "employer")
+	if err != nil {
+		return []MessageThread{}, err
+	}
+	return threads, nil
+}
@@ -0,0 +1,143 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/jmoiron/sqlx"
+)
+
+type minimumWageStore struct {
+	BaseRepository
+}
+
+type MinimumWageStorer interface {
+	FetchMinimumWages(ctx context.Context, state string) ([]MinimumWage, error)
+	FetchApplicableMinimumWages(ctx context.Context, state string, skillLevel string) ([]MinimumWage, error)
+	SaveMinimumWage(ctx context.Context, minimumWage MinimumWage) (MinimumWage, error)
+	DeleteMinimumWage(ctx context.Context, minimumWageId int) error
+	SaveJobWageCheck(ctx context.Context, check JobWageCheck) error
+	FetchJobWageChecks(ctx context.Context, filter JobWageCheckFilter) ([]JobWageCheck, error)
+	CountJobWageChecks(ctx context.Context, state string) ([]WageCheckCount, error)
+}
+
+func NewMinimumWageRepo(db *sqlx.DB) MinimumWageStorer {
+	return &minimumWageStore{
+		BaseRepository: BaseRepository{DB: db},
+	}
+}
+
+// PostgreSQL Queries
+const (
+	minimumWageColumns               = `id, state, sector, skill_level, daily_wage, COALESCE(updated_by, 0) AS updated_by, updated_at`
+	fetchMinimumWagesQuery           = `SELECT ` + minimumWageColumns + ` FROM minimum_wages WHERE $1 = '' OR state = $1 ORDER BY state, sector, skill_level;`
+	fetchApplicableMinimumWagesQuery = `SELECT ` + minimumWageColumns + ` FROM minimum_wages WHERE state IN ($1, '') AND skill_level = $2;`
+	saveMinimumWageQuery             = `INSERT INTO minimum_wages (state, sector, skill_level, daily_wage, updated_by, updated_at) VALUES (:state, :sector, :skill_level, :daily_wage, NULLIF(:updated_by, 0), NOW()) ON CONFLICT (state, sector, skill_level) DO UPDATE SET daily_wage = EXCLUDED.daily_wage, updated_by = EXCLUDED.updated_by, updated_at = NOW() RETURNING ` + minimumWageColumns + `;`
+	deleteMinimumWageQuery           = `DELETE FROM minimum_wages WHERE id = $1 RETURNING id;`
+	saveJobWageCheckQuery            = `INSERT INTO job_wage_checks (job_id, state, sector, skill_level, wage, duration_in_hours, minimum_daily_wage, required_wage, status, checked_at) VALUES (:job_id, :state, :sector, :skill_level, :wage, :duration_in_hours, :minimum_daily_wage, :required_wage, :status, NOW()) ON CONFLICT (job_id) DO UPDATE SET state = EXCLUDED.state, sector = EXCLUDED.sector, skill_level = EXCLUDED.skill_level, wage = EXCLUDED.wage, duration_in_hours = EXCLUDED.duration_in_hours, minimum_daily_wage = EXCLUDED.minimum_daily_wage, required_wage = EXCLUDED.required_wage, status = EXCLUDED.status, checked_at = NOW();`
+	jobWageCheckColumns              = `job_wage_checks.job_id, jobs.title, jobs.employer_id, job_wage_checks.state, job_wage_checks.sector, job_wage_checks.skill_level, job_wage_checks.wage, job_wage_checks.duration_in_hours, job_wage_checks.minimum_daily_wage, job_wage_checks.required_wage, job_wage_checks.status, job_wage_checks.checked_at`
+	countJobWageChecksQuery          = `SELECT status, COUNT(*) AS count FROM job_wage_checks WHERE $1 = '' OR state = $1 GROUP BY status;`
+)
+
+// Fetch the minimum wages of a state and the ones for every state, all of them when state is empty
+func (minWageS *minimumWageStore) FetchMinimumWages(ctx context.Context, state string) ([]MinimumWage, error) {
+	minimumWages := make([]MinimumWage, 0)
+
+	err := minWageS.DB.Select(&minimumWages, fetchMinimumWagesQuery, state)
+	if err != nil {
+		return []MinimumWage{}, err
+	}
+	return minimumWages, nil
+}
+
+// Fetch the minimum wages that can apply to a job of a state and skill level, the most specific
+// one for its sectors is chosen by the caller
+func (minWageS *minimumWageStore) FetchApplicableMinimumWages(ctx context.Context, state string, skillLevel string) ([]MinimumWage, error) {
+	minimumWages := make([]MinimumWage, 0)
+
+	err := minWageS.DB.Select(&minimumWages, fetchApplicableMinimumWagesQuery, state, skillLevel)
+	if err != nil {
+		return []MinimumWage{}, err
+	}
+	return minimumWages, nil
+}
+
+// Save the minimum wage of a state, sector and skill level, replacing the previous one
+func (minWageS *minimumWageStore) SaveMinimumWage(ctx context.Context, minimumWage MinimumWage) (MinimumWage, error) {
+	var savedMinimumWage MinimumWage
+
+	rows, err := minWageS.DB.NamedQuery(saveMinimumWageQuery, minimumWage)
+	if err != nil {
+		return MinimumWage{}, err
+	}
+	defer rows.Close()
+
+	if rows.Next() {
+		err = rows.StructScan(&savedMinimumWage)
+		if err != nil {
+			return MinimumWage{}, err
+		}
+	}
+	return savedMinimumWage, rows.Err()
+}
+
+func (minWageS *minimumWageStore) DeleteMinimumWage(ctx context.Context, minimumWageId int) error {
+	var deletedId int
+
+	err := minWageS.DB.Get(&deletedId, deleteMinimumWageQuery, minimumWageId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return apperrors.ErrNoMinimumWageExists
+		}
+		return err
+	}
+	return nil
+}
+
+// Save the result of the minimum wage check of a job, replacing the result of its previous check
+func (minWageS *minimumWageStore) SaveJobWageCheck(ctx context.Context, check JobWageCheck) error {
+	_, err := minWageS.DB.NamedExec(saveJobWageCheckQuery, check)
+	return err
+}
+
+// Fetch the wage checks of jobs, the jobs paying the least compared to their minimum first
+func (minWageS *minimumWageStore) FetchJobWageChecks(ctx context.Context, filter JobWageCheckFilter) ([]JobWageCheck, error) {
+	checks := make([]JobWageCheck, 0)
+	query := `SELECT ` + jobWageCheckColumns + ` FROM job_wage_checks INNER JOIN jobs ON job_wage_checks.job_id = jobs.id WHERE 1=1`
+	args := []interface{}{}
+	argIndex := 1
+
+	if len(filter.State) > 0 {
+		query += fmt.Sprintf(" AND job_wage_checks.state = $%d", argIndex)
+		args = append(args, filter.State)
+		argIndex++
+	}
+	if len(filter.Status) > 0 {
+		query += fmt.Sprintf(" AND job_wage_checks.status = $%d", argIndex)
+		args = append(args, filter.Status)
+		argIndex++
+	}
+
+	query += fmt.Sprintf(" ORDER BY job_wage_checks.wage - job_wage_checks.required_wage, job_wage_checks.checked_at DESC LIMIT $%d OFFSET $%d", argIndex, argIndex+1)
+	args = append(args, filter.Limit, filter.Offset)
+
+	err := minWageS.DB.Select(&checks, query, args...)
+	if err != nil {
+		return []JobWageCheck{}, err
+	}
+	return checks, nil
+}
+
+// Count the checked jobs of each status, of every state when state is empty
+func (minWageS *minimumWageStore) CountJobWageChecks(ctx context.Context, state string) ([]WageCheckCount, error) {
+	counts := make([]WageCheckCount, 0)
+
+	err := minWageS.DB.Select(&counts, countJobWageChecksQuery, state)
+	if err != nil {
+		return []WageCheckCount{}, err
+	}
+	return counts, nil
+}
@@ -32,6 +32,36 @@ func (_m *AdminStorer) DeleteAdmin(ctx context.Context, adminId int) error {
 	return r0
 }
 
+// FetchAdmins provides a mock function with given fields: ctx
+func (_m *AdminStorer) FetchAdmins(ctx context.Context) ([]repo.Admin, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchAdmins")
+	}
+
+	var r0 []repo.Admin
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]repo.Admin, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []repo.Admin); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repo.Admin)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // FindAdminByEmail provides a mock function with given fields: ctx, email
 func (_m *AdminStorer) FindAdminByEmail(ctx context.Context, email string) bool {
 	ret := _m.Called(ctx, email)
@@ -96,6 +126,34 @@ func (_m *AdminStorer) RegisterAdmin(ctx context.Context, adminData repo.Admin)
 	return r0, r1
 }
 
+// UpdateAdminRole provides a mock function with given fields: ctx, adminId, role
+func (_m *AdminStorer) UpdateAdminRole(ctx context.Context, adminId int, role string) (repo.Admin, error) {
+	ret := _m.Called(ctx, adminId, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateAdminRole")
+	}
+
+	var r0 repo.Admin
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) (repo.Admin, error)); ok {
+		return rf(ctx, adminId, role)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) repo.Admin); ok {
+		r0 = rf(ctx, adminId, role)
+	} else {
+		r0 = ret.Get(0).(repo.Admin)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, string) error); ok {
+		r1 = rf(ctx, adminId, role)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewAdminStorer creates a new instance of AdminStorer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewAdminStorer(t interface {
@@ -14,9 +14,9 @@ type ApplicationStorer struct {
 	mock.Mock
 }
 
-// CreateNewApplication provides a mock function with given fields: ctx, applicationData
-func (_m *ApplicationStorer) CreateNewApplication(ctx context.Context, applicationData repo.Application) (repo.Application, error) {
-	ret := _m.Called(ctx, applicationData)
+// CreateNewApplication provides a mock function with given fields: ctx, applicationData, shiftIds
+func (_m *ApplicationStorer) CreateNewApplication(ctx context.Context, applicationData repo.Application, shiftIds []int) (repo.Application, error) {
+	ret := _m.Called(ctx, applicationData, shiftIds)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CreateNewApplication")
@@ -24,17 +24,17 @@ func (_m *ApplicationStorer) CreateNewApplication(ctx context.Context, applicati
 
 	var r0 repo.Application
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, repo.Application) (repo.Application, error)); ok {
-		return rf(ctx, applicationData)
+	if rf, ok := ret.Get(0).(func(context.Context, repo.Application, []int) (repo.Application, error)); ok {
+		return rf(ctx, applicationData, shiftIds)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, repo.Application) repo.Application); ok {
-		r0 = rf(ctx, applicationData)
+	if rf, ok := ret.Get(0).(func(context.Context, repo.Application, []int) repo.Application); ok {
+		r0 = rf(ctx, applicationData, shiftIds)
 	} else {
 		r0 = ret.Get(0).(repo.Application)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, repo.Application) error); ok {
-		r1 = rf(ctx, applicationData)
+	if rf, ok := ret.Get(1).(func(context.Context, repo.Application, []int) error); ok {
+		r1 = rf(ctx, applicationData, shiftIds)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -146,9 +146,9 @@ func (_m *ApplicationStorer) FindApplicationById(ctx context.Context, applicatio
 	return r0
 }
 
-// UpdateApplicationByID provides a mock function with given fields: ctx, applicationData
-func (_m *ApplicationStorer) UpdateApplicationByID(ctx context.Context, applicationData repo.Application) (repo.Application, error) {
-	ret := _m.Called(ctx, applicationData)
+// UpdateApplicationByID provides a mock function with given fields: ctx, applicationData, checkEngagements
+func (_m *ApplicationStorer) UpdateApplicationByID(ctx context.Context, applicationData repo.Application, checkEngagements repo.EngagementCheck) (repo.Application, error) {
+	ret := _m.Called(ctx, applicationData, checkEngagements)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UpdateApplicationByID")
@@ -156,17 +156,17 @@ func (_m *ApplicationStorer) UpdateApplicationByID(ctx context.Context, applicat
 
 	var r0 repo.Application
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, repo.Application) (repo.Application, error)); ok {
-		return rf(ctx, applicationData)
+	if rf, ok := ret.Get(0).(func(context.Context, repo.Application, repo.EngagementCheck) (repo.Application, error)); ok {
+		return rf(ctx, applicationData, checkEngagements)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, repo.Application) repo.Application); ok {
-		r0 = rf(ctx, applicationData)
+	if rf, ok := ret.Get(0).(func(context.Context, repo.Application, repo.EngagementCheck) repo.Application); ok {
+		r0 = rf(ctx, applicationData, checkEngagements)
 	} else {
 		r0 = ret.Get(0).(repo.Application)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, repo.Application) error); ok {
-		r1 = rf(ctx, applicationData)
+	if rf, ok := ret.Get(1).(func(context.Context, repo.Application, repo.EngagementCheck) error); ok {
+		r1 = rf(ctx, applicationData, checkEngagements)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -14,32 +14,41 @@ type JobStorer struct {
 	mock.Mock
 }
 
-// CreateJob provides a mock function with given fields: ctx, jobData
-func (_m *JobStorer) CreateJob(ctx context.Context, jobData repo.Job) (repo.Job, error) {
-	ret := _m.Called(ctx, jobData)
+// CreateJob provides a mock function with given fields: ctx, jobData, shifts
+func (_m *JobStorer) CreateJob(ctx context.Context, jobData repo.Job, shifts []repo.JobShift) (repo.Job, []repo.JobShift, error) {
+	ret := _m.Called(ctx, jobData, shifts)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CreateJob")
 	}
 
 	var r0 repo.Job
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, repo.Job) (repo.Job, error)); ok {
-		return rf(ctx, jobData)
+	var r1 []repo.JobShift
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, repo.Job, []repo.JobShift) (repo.Job, []repo.JobShift, error)); ok {
+		return rf(ctx, jobData, shifts)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, repo.Job) repo.Job); ok {
-		r0 = rf(ctx, jobData)
+	if rf, ok := ret.Get(0).(func(context.Context, repo.Job, []repo.JobShift) repo.Job); ok {
+		r0 = rf(ctx, jobData, shifts)
 	} else {
 		r0 = ret.Get(0).(repo.Job)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, repo.Job) error); ok {
-		r1 = rf(ctx, jobData)
+	if rf, ok := ret.Get(1).(func(context.Context, repo.Job, []repo.JobShift) []repo.JobShift); ok {
+		r1 = rf(ctx, jobData, shifts)
 	} else {
-		r1 = ret.Error(1)
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]repo.JobShift)
+		}
 	}
 
-	return r0, r1
+	if rf, ok := ret.Get(2).(func(context.Context, repo.Job, []repo.JobShift) error); ok {
+		r2 = rf(ctx, jobData, shifts)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
 }
 
 // DeleteJobById provides a mock function with given fields: ctx, jobId
@@ -176,32 +185,41 @@ func (_m *JobStorer) FindJobById(ctx context.Context, jobId int) bool {
 	return r0
 }
 
-// UpdateJobById provides a mock function with given fields: ctx, jobData
-func (_m *JobStorer) UpdateJobById(ctx context.Context, jobData repo.Job) (repo.Job, error) {
-	ret := _m.Called(ctx, jobData)
+// UpdateJobById provides a mock function with given fields: ctx, jobData, shifts
+func (_m *JobStorer) UpdateJobById(ctx context.Context, jobData repo.Job, shifts []repo.JobShift) (repo.Job, []repo.JobShift, error) {
+	ret := _m.Called(ctx, jobData, shifts)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UpdateJobById")
 	}
 
 	var r0 repo.Job
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, repo.Job) (repo.Job, error)); ok {
-		return rf(ctx, jobData)
+	var r1 []repo.JobShift
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, repo.Job, []repo.JobShift) (repo.Job, []repo.JobShift, error)); ok {
+		return rf(ctx, jobData, shifts)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, repo.Job) repo.Job); ok {
-		r0 = rf(ctx, jobData)
+	if rf, ok := ret.Get(0).(func(context.Context, repo.Job, []repo.JobShift) repo.Job); ok {
+		r0 = rf(ctx, jobData, shifts)
 	} else {
 		r0 = ret.Get(0).(repo.Job)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, repo.Job) error); ok {
-		r1 = rf(ctx, jobData)
+	if rf, ok := ret.Get(1).(func(context.Context, repo.Job, []repo.JobShift) []repo.JobShift); ok {
+		r1 = rf(ctx, jobData, shifts)
 	} else {
-		r1 = ret.Error(1)
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]repo.JobShift)
+		}
 	}
 
-	return r0, r1
+	if rf, ok := ret.Get(2).(func(context.Context, repo.Job, []repo.JobShift) error); ok {
+		r2 = rf(ctx, jobData, shifts)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
 }
 
 // NewJobStorer creates a new instance of JobStorer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
@@ -128,6 +128,52 @@ func (_m *SectoreStorer) FetchSectorById(ctx context.Context, sectorId int) (rep
 	return r0, r1
 }
 
+// FetchSectorStats provides a mock function with given fields: ctx, sector
+func (_m *SectoreStorer) FetchSectorStats(ctx context.Context, sector repo.Sector) (repo.SectorStats, error) {
+	ret := _m.Called(ctx, sector)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchSectorStats")
+	}
+
+	var r0 repo.SectorStats
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, repo.Sector) (repo.SectorStats, error)); ok {
+		return rf(ctx, sector)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repo.Sector) repo.SectorStats); ok {
+		r0 = rf(ctx, sector)
+	} else {
+		r0 = ret.Get(0).(repo.SectorStats)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repo.Sector) error); ok {
+		r1 = rf(ctx, sector)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MergeSector provides a mock function with given fields: ctx, source, target
+func (_m *SectoreStorer) MergeSector(ctx context.Context, source repo.Sector, target repo.Sector) error {
+	ret := _m.Called(ctx, source, target)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MergeSector")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, repo.Sector, repo.Sector) error); ok {
+		r0 = rf(ctx, source, target)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateSectorById provides a mock function with given fields: ctx, sectorData
 func (_m *SectoreStorer) UpdateSectorById(ctx context.Context, sectorData repo.Sector) (repo.Sector, error) {
 	ret := _m.Called(ctx, sectorData)
@@ -0,0 +1,135 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/jmoiron/sqlx"
+)
+
+type notificationStore struct {
+	BaseRepository
+}
+
+type NotificationStorer interface {
+	FetchNotificationRecipient(ctx context.Context, role string, recipientId int) (NotificationRecipient, error)
+	CreateNotification(ctx context.Context, notification Notification) (Notification, error)
+	FetchNotifications(ctx context.Context, role string, recipientId int) ([]Notification, error)
+	MarkNotificationRead(ctx context.Context, role string, recipientId int, notificationId int) (Notification, error)
+	FetchNotificationPreferences(ctx context.Context, role string, recipientId int) ([]NotificationPreference, error)
+	SaveNotificationPreferences(ctx context.Context, preferences []NotificationPreference) error
+}
+
+func NewNotificationRepo(db *sqlx.DB) NotificationStorer {
+	return &notificationStore{
+		BaseRepository: BaseRepository{DB: db},
+	}
+}
+
+// PostgreSQL Queries
+const (
+	notificationColumns               = `id, recipient_role, recipient_id, event, title, body, created_at, read_at`
+	fetchWorkerRecipientQuery         = `SELECT id, name, contact_number, email, language FROM workers WHERE id = $1;`
+	fetchEmployerRecipientQuery       = `SELECT id, name, contact_number, email, language FROM employers WHERE id = $1;`
+	createNotificationQuery           = `INSERT INTO notifications (recipient_role, recipient_id, event, title, body, created_at) VALUES (:recipient_role, :recipient_id, :event, :title, :body, NOW()) RETURNING ` + notificationColumns + `;`
+	fetchNotificationsQuery           = `SELECT ` + notificationColumns + ` FROM notifications WHERE recipient_role = $1 AND recipient_id = $2 ORDER BY created_at DESC, id DESC;`
+	markNotificationReadQuery         = `UPDATE notifications SET read_at = COALESCE(read_at, NOW()) WHERE id = $3 AND recipient_role = $1 AND recipient_id = $2 RETURNING ` + notificationColumns + `;`
+	fetchNotificationPreferencesQuery = `SELECT recipient_role, recipient_id, channel, enabled FROM notification_preferences WHERE recipient_role = $1 AND recipient_id = $2 ORDER BY channel;`
+	saveNotificationPreferenceQuery   = `INSERT INTO notification_preferences (recipient_role, recipient_id, channel, enabled) VALUES (:recipient_role, :recipient_id, :channel, :enabled) ON CONFLICT (recipient_role, recipient_id, channel) DO UPDATE SET enabled = EXCLUDED.enabled;`
+)
+
+// Fetch the contact details of the worker or employer a notification is addressed to
+func (notS *notificationStore) FetchNotificationRecipient(ctx context.Context, role string, recipientId int) (NotificationRecipient, error) {
+	var recipient NotificationRecipient
+
+	query, notFound := fetchWorkerRecipientQuery, apperrors.ErrNoWorkerExists
+	switch role {
+	case "worker":
+	case "employer":
+		query, notFound = fetchEmployerRecipientQuery, apperrors.ErrNoEmployerExists
+	default:
+		return NotificationRecipient{}, apperrors.ErrInvalidRecipient
+	}
+
+	err := notS.DB.Get(&recipient, query, recipientId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NotificationRecipient{}, notFound
+		}
+		return NotificationRecipient{}, err
+	}
+	return recipient, nil
+}
+
+func (notS *notificationStore) CreateNotification(ctx context.Context, notification Notification) (Notification, error) {
+	var createdNotification Notification
+
+	rows, err := notS.DB.NamedQuery(createNotificationQuery, notification)
+	if err != nil {
+		return Notification{}, err
+	}
+
+	defer rows.Close()
+
+	if rows.Next() {
+		err = rows.StructScan(&createdNotification)
+		if err != nil {
+			return Notification{}, err
+		}
+	}
+	return createdNotification, nil
+}
+
+func (notS *notificationStore) FetchNotifications(ctx context.Context, role string, recipientId int) ([]Notification, error) {
+	notifications := make([]Notification, 0)
+
+	err := notS.DB.Select(&notifications, fetchNotificationsQuery, role, recipientId)
+	if err != nil {
+		return []Notification{}, err
+	}
+	return notifications, nil
+}
+
+// Mark a notification of the recipient as read, notifications already read keep their first read time
+func (notS *notificationStore) MarkNotificationRead(ctx context.Context, role string, recipientId int, notificationId int) (Notification, error) {
+	var notification Notification
+
+	err := notS.DB.Get(&notification, markNotificationReadQuery, role, recipientId, notificationId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return Notification{}, apperrors.ErrNoNotificationExists
+		}
+		return Notification{}, err
+	}
+	return notification, nil
+}
+
+func (notS *notificationStore) FetchNotificationPreferences(ctx context.Context, role string, recipientId int) ([]NotificationPreference, error) {
+	preferences := make([]NotificationPreference, 0)
+
+	err := notS.DB.Select(&preferences, fetchNotificationPreferencesQuery, role, recipientId)
+	if err != nil {
+		return []NotificationPreference{}, err
+	}
+	return preferences, nil
+}
+
+// Save the channel preferences of a recipient in a single transaction
+func (notS *notificationStore) SaveNotificationPreferences(ctx context.Context, preferences []NotificationPreference) error {
+	tx, err := notS.DB.Beginx()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, preference := range preferences {
+		_, err = tx.NamedExec(saveNotificationPreferenceQuery, preference)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,93 @@
+package repo
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type outboxStore struct {
+	BaseRepository
+}
+
+// OutboxStorer reads the outbox, events are written to it by the other stores in the transaction
+// of the change they announce (see writeOutboxEvent)
+type OutboxStorer interface {
+	ClaimPendingEvents(ctx context.Context, limit int, lease time.Duration) ([]OutboxEvent, error)
+	FetchDeliveredSubscribers(ctx context.Context, eventId int) ([]string, error)
+	SaveDelivery(ctx context.Context, eventId int, subscriber string) error
+	MarkEventDispatched(ctx context.Context, eventId int) error
+	MarkEventFailed(ctx context.Context, eventId int, lastError string, retryAt time.Time) error
+	AbandonEvent(ctx context.Context, eventId int, lastError string) error
+}
+
+func NewOutboxRepo(db *sqlx.DB) OutboxStorer {
+	return &outboxStore{
+		BaseRepository: BaseRepository{DB: db},
+	}
+}
+
+// PostgreSQL Queries
+const (
+	createOutboxEventQuery = `INSERT INTO outbox_events (type, payload, attempts, next_attempt_at, created_at) VALUES ($1, $2, 0, NOW(), NOW());`
+	// claimed events are hidden from other dispatchers for the lease, an event whose dispatcher
+	// stops before finishing it is claimed again once the lease runs out
+	claimPendingEventsQuery        = `WITH claimed AS (UPDATE outbox_events SET next_attempt_at = NOW() + make_interval(secs => $2) WHERE id IN (SELECT id FROM outbox_events WHERE dispatched_at IS NULL AND abandoned_at IS NULL AND next_attempt_at <= NOW() ORDER BY id LIMIT $1 FOR UPDATE SKIP LOCKED) RETURNING id, type, payload, attempts, created_at) SELECT * FROM claimed ORDER BY id;`
+	fetchDeliveredSubscribersQuery = `SELECT subscriber FROM outbox_deliveries WHERE event_id = $1;`
+	saveDeliveryQuery              = `INSERT INTO outbox_deliveries (event_id, subscriber, delivered_at) VALUES ($1, $2, NOW()) ON CONFLICT (event_id, subscriber) DO NOTHING;`
+	markEventDispatchedQuery       = `UPDATE outbox_events SET dispatched_at = NOW(), last_error = NULL WHERE id = $1;`
+	markEventFailedQuery           = `UPDATE outbox_events SET attempts = attempts + 1, last_error = $2, next_attempt_at = $3 WHERE id = $1;`
+	abandonEventQuery              = `UPDATE outbox_events SET attempts = attempts + 1, last_error = $2, abandoned_at = NOW() WHERE id = $1;`
+)
+
+// writeOutboxEvent adds an event to the outbox, tx must be the transaction of the change the
+// event announces so that the event is stored if and only if the change is
+func writeOutboxEvent(ctx context.Context, tx sqlx.Execer, eventType EventType, payload EventPayload) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(createOutboxEventQuery, eventType, body)
+	return err
+}
+
+func (outS *outboxStore) ClaimPendingEvents(ctx context.Context, limit int, lease time.Duration) ([]OutboxEvent, error) {
+	events := make([]OutboxEvent, 0)
+	err := outS.DB.Select(&events, claimPendingEventsQuery, limit, lease.Seconds())
+	if err != nil {
+		return []OutboxEvent{}, err
+	}
+	return events, nil
+}
+
+func (outS *outboxStore) FetchDeliveredSubscribers(ctx context.Context, eventId int) ([]string, error) {
+	subscribers := make([]string, 0)
+	err := outS.DB.Select(&subscribers, fetchDeliveredSubscribersQuery, eventId)
+	if err != nil {
+		return []string{}, err
+	}
+	return subscribers, nil
+}
+
+func (outS *outboxStore) SaveDelivery(ctx context.Context, eventId int, subscriber string) error {
+	_, err := outS.DB.Exec(saveDeliveryQuery, eventId, subscriber)
+	return err
+}
+
+func (outS *outboxStore) MarkEventDispatched(ctx context.Context, eventId int) error {
+	_, err := outS.DB.Exec(markEventDispatchedQuery, eventId)
+	return err
+}
+
+func (outS *outboxStore) MarkEventFailed(ctx context.Context, eventId int, lastError string, retryAt time.Time) error {
+	_, err := outS.DB.Exec(markEventFailedQuery, eventId, lastError, retryAt)
+	return err
+}
+
+func (outS *outboxStore) AbandonEvent(ctx context.Context, eventId int, lastError string) error {
+	_, err := outS.DB.Exec(abandonEventQuery, eventId, lastError)
+	return err
+}
@@ -0,0 +1,371 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+type paymentStore struct {
+	BaseRepository
+}
+
+type PaymentStorer interface {
+	CreatePaymentEntry(ctx context.Context, entry PaymentEntry) (PaymentEntry, error)
+	AcknowledgePaymentEntry(ctx context.Context, applicationId int, entryId int) (PaymentEntry, error)
+	FetchPaymentEntriesByApplicationId(ctx context.Context, applicationId int) ([]PaymentEntry, error)
+	FetchDuesByApplicationId(ctx context.Context, applicationId int) (Dues, error)
+	FetchDuesByWorkerId(ctx context.Context, workerId int) ([]Dues, error)
+	FetchDuesByEmployerId(ctx context.Context, employerId int) ([]Dues, error)
+	CreatePaymentOrder(ctx context.Context, order PaymentOrder) (PaymentOrder, error)
+	FetchPaymentOrderById(ctx context.Context, applicationId int, orderId int) (PaymentOrder, error)
+	FetchPaymentOrderByIdempotencyKey(ctx context.Context, idempotencyKey string) (PaymentOrder, error)
+	FetchPaymentOrderByProviderOrderId(ctx context.Context, providerOrderId string) (PaymentOrder, error)
+	FetchPendingOrderAmount(ctx context.Context, applicationId int) (int, error)
+	SettlePaymentOrder(ctx context.Context, orderId int, status string, providerPaymentId string) (PaymentOrder, error)
+	StartPaymentOrderRefund(ctx context.Context, orderId int) (PaymentOrder, error)
+	RefundPaymentOrder(ctx context.Context, orderId int, refundReference string) (PaymentOrder, error)
+}
+
+func NewPaymentRepo(db *sqlx.DB) PaymentStorer {
+	return &paymentStore{
+		BaseRepository: BaseRepository{DB: db},
+	}
+}
+
+// PostgreSQL Queries
+const (
+	paymentEntryColumns = `id, application_id, type, amount, method, reference, note, recorded_at, acknowledged_at`
+	duesColumns         = `applications.id AS application_id, applications.job_id, jobs.title, applications.worker_id, jobs.employer_id, applications.status,
+		CASE WHEN EXISTS (SELECT 1 FROM job_shifts WHERE job_shifts.job_id = jobs.id)
+			THEN COALESCE((SELECT SUM(job_shifts.wage) FROM job_shifts WHERE job_shifts.job_id = jobs.id AND (` + applicationCoversShiftCondition + `)), 0)
+			ELSE jobs.wage END AS owed,
+		COALESCE((SELECT SUM(CASE WHEN payments.type = 'refund' THEN -amount ELSE amount END) FROM payments WHERE payments.application_id = applications.id AND payments.type IN ('advance', 'final', 'refund')), 0) AS paid,
+		COALESCE((SELECT SUM(amount) FROM payments WHERE payments.application_id = applications.id AND payments.type = 'deduction'), 0) AS deducted`
+	duesSource                              = `applications INNER JOIN jobs ON applications.job_id = jobs.id`
+	createPaymentEntryQuery                 = `INSERT INTO payments (application_id, type, amount, method, reference, note, recorded_at) VALUES (:application_id, :type, :amount, :method, :reference, :note, NOW()) RETURNING ` + paymentEntryColumns + `;`
+	acknowledgePaymentEntryQuery            = `UPDATE payments SET acknowledged_at=COALESCE(acknowledged_at, NOW()) WHERE id=$1 AND application_id=$2 RETURNING ` + paymentEntryColumns + `;`
+	fetchPaymentEntriesByApplicationIdQuery = `SELECT ` + paymentEntryColumns + ` FROM payments WHERE application_id=$1 ORDER BY recorded_at, id;`
+	fetchDuesByApplicationIdQuery           = `SELECT ` + duesColumns + ` FROM ` + duesSource + ` WHERE applications.id=$1;`
+	fetchDuesByWorkerIdQuery                = `SELECT ` + duesColumns + ` FROM ` + duesSource + ` WHERE applications.worker_id=$1 AND applications.status='confirmed' ORDER BY applications.id;`
+	fetchDuesByEmployerIdQuery              = `SELECT ` + duesColumns + ` FROM ` + duesSource + ` WHERE jobs.employer_id=$1 AND applications.status='confirmed' ORDER BY applications.id;`
+	paymentOrderColumns                     = `id, application_id, type, amount, idempotency_key, provider_order_id, provider_payment_id, status, payment_id, created_at, updated_at`
+	createPaymentOrderQuery                 = `INSERT INTO payment_orders (application_id, type, amount, idempotency_key, provider_order_id, provider_payment_id, status, created_at, updated_at) VALUES (:application_id, :type, :amount, :idempotency_key, :provider_order_id, :provider_payment_id, :status, NOW(), NOW()) RETURNING ` + paymentOrderColumns + `;`
+	fetchPaymentOrderByIdQuery              = `SELECT ` + paymentOrderColumns + ` FROM payment_orders WHERE id=$1 AND application_id=$2;`
+	fetchPaymentOrderByIdempotencyKeyQuery  = `SELECT ` + paymentOrderColumns + ` FROM payment_orders WHERE idempotency_key=$1;`
+	fetchPaymentOrderByProviderOrderIdQuery = `SELECT ` + paymentOrderColumns + ` FROM payment_orders WHERE provider_order_id=$1;`
+	fetchPendingOrderAmountQuery            = `SELECT COALESCE(SUM(amount), 0) FROM payment_orders WHERE application_id=$1 AND status='created';`
+	settlePaymentOrderQuery                 = `UPDATE payment_orders SET status=$2, provider_payment_id=$3, updated_at=NOW() WHERE id=$1 AND status='created' RETURNING ` + paymentOrderColumns + `;`
+	startPaymentOrderRefundQuery            = `UPDATE payment_orders SET status='refund_pending', updated_at=NOW() WHERE id=$1 AND status='paid' RETURNING ` + paymentOrderColumns + `;`
+	refundPaymentOrderQuery                 = `UPDATE payment_orders SET status='refunded', updated_at=NOW() WHERE id=$1 AND status='refund_pending' RETURNING ` + paymentOrderColumns + `;`
+	linkPaymentOrderEntryQuery              = `UPDATE payment_orders SET payment_id=$2 WHERE id=$1;`
+	createGatewayPaymentEntryQuery          = `INSERT INTO payments (application_id, type, amount, method, reference, note, recorded_at, acknowledged_at) VALUES ($1, $2, $3, 'upi', $4, $5, NOW(), NOW()) RETURNING id;`
+)
+
+// Add an entry to the ledger of a confirmed application. The application is locked while its dues are
+// checked so that concurrent entries cannot together take the outstanding wage below zero.
+func (payS *paymentStore) CreatePaymentEntry(ctx context.Context, entry PaymentEntry) (PaymentEntry, error) {
+	var createdEntry PaymentEntry
+
+	tx, err := payS.DB.Beginx()
+	if err != nil {
+		return PaymentEntry{}, err
+	}
+
+	defer tx.Rollback()
+
+	var status Status
+	err = tx.Get(&status, lockApplicationStatusQuery, entry.ApplicationID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return PaymentEntry{}, apperrors.ErrNoApplicationExists
+		}
+		return PaymentEntry{}, err
+	}
+	if status != Confirmed {
+		return PaymentEntry{}, apperrors.ErrApplicationNotPayable
+	}
+
+	var dues Dues
+	err = tx.Get(&dues, fetchDuesByApplicationIdQuery, entry.ApplicationID)
+	if err != nil {
+		return PaymentEntry{}, err
+	}
+
+	outstanding := dues.Owed - dues.Paid - dues.Deducted
+	if entry.Amount > outstanding {
+		return PaymentEntry{}, fmt.Errorf("%w: %d outstanding", apperrors.ErrPaymentExceedsDues, outstanding)
+	}
+
+	rows, err := tx.NamedQuery(createPaymentEntryQuery, entry)
+	if err != nil {
+		return PaymentEntry{}, err
+	}
+
+	defer rows.Close()
+
+	if rows.Next() {
+		err = rows.StructScan(&createdEntry)
+		if err != nil {
+			return PaymentEntry{}, err
+		}
+	}
+	rows.Close()
+
+	err = tx.Commit()
+	if err != nil {
+		return PaymentEntry{}, err
+	}
+	return createdEntry, nil
+}
+
+// Mark a payment as received by the worker, acknowledging twice keeps the first acknowledgement time
+func (payS *paymentStore) AcknowledgePaymentEntry(ctx context.Context, applicationId int, entryId int) (PaymentEntry, error) {
+	var entry PaymentEntry
+
+	err := payS.DB.Get(&entry, acknowledgePaymentEntryQuery, entryId, applicationId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return PaymentEntry{}, apperrors.ErrNoPaymentExists
+		}
+		return PaymentEntry{}, err
+	}
+	return entry, nil
+}
+
+func (payS *paymentStore) FetchPaymentEntriesByApplicationId(ctx context.Context, applicationId int) ([]PaymentEntry, error) {
+	entries := make([]PaymentEntry, 0)
+
+	err := payS.DB.Select(&entries, fetchPaymentEntriesByApplicationIdQuery, applicationId)
+	if err != nil {
+		return []PaymentEntry{}, err
+	}
+	return entries, nil
+}
+
+func (payS *paymentStore) FetchDuesByApplicationId(ctx context.Context, applicationId int) (Dues, error) {
+	var dues Dues
+
+	err := payS.DB.Get(&dues, fetchDuesByApplicationIdQuery, applicationId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return Dues{}, apperrors.ErrNoApplicationExists
+		}
+		return Dues{}, err
+	}
+	return dues, nil
+}
+
+// Fetch the wage balance of every confirmed application of a worker
+func (payS *paymentStore) FetchDuesByWorkerId(ctx context.Context, workerId int) ([]Dues, error) {
+	dues := make([]Dues, 0)
+
+	err := payS.DB.Select(&dues, fetchDuesByWorkerIdQuery, workerId)
+	if err != nil {
+		return []Dues{}, err
+	}
+	return dues, nil
+}
+
+// Fetch the wage balance of every confirmed application to the jobs of an employer
+func (payS *paymentStore) FetchDuesByEmployerId(ctx context.Context, employerId int) ([]Dues, error) {
+	dues := make([]Dues, 0)
+
+	err := payS.DB.Select(&dues, fetchDuesByEmployerIdQuery, employerId)
+	if err != nil {
+		return []Dues{}, err
+	}
+	return dues, nil
+}
+
+// Create a gateway order, an order already stored under the same idempotency key is reported as
+// ErrPaymentOrderExists
+func (payS *paymentStore) CreatePaymentOrder(ctx context.Context, order PaymentOrder) (PaymentOrder, error) {
+	var createdOrder PaymentOrder
+
+	rows, err := payS.DB.NamedQuery(createPaymentOrderQuery, order)
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == uniqueViolation {
+			return PaymentOrder{}, apperrors.ErrPaymentOrderExists
+		}
+		return PaymentOrder{}, err
+	}
+
+	defer rows.Close()
+
+	if rows.Next() {
+		err = rows.StructScan(&createdOrder)
+		if err != nil {
+			return PaymentOrder{}, err
+		}
+	}
+	return createdOrder, nil
+}
+
+func (payS *paymentStore) FetchPaymentOrderById(ctx context.Context, applicationId int, orderId int) (PaymentOrder, error) {
+	return payS.fetchPaymentOrder(fetchPaymentOrderByIdQuery, orderId, applicationId)
+}
+
+// Fetch the amount of the gateway orders of an application that are still waiting for the provider
+func (payS *paymentStore) FetchPendingOrderAmount(ctx context.Context, applicationId int) (int, error) {
+	var amount int
+
+	err := payS.DB.Get(&amount, fetchPendingOrderAmountQuery, applicationId)
+	if err != nil {
+		return 0, err
+	}
+	return amount, nil
+}
+
+func (payS *paymentStore) FetchPaymentOrderByIdempotencyKey(ctx context.Context, idempotencyKey string) (PaymentOrder, error) {
+	return payS.fetchPaymentOrder(fetchPaymentOrderByIdempotencyKeyQuery, idempotencyKey)
+}
+
+func (payS *paymentStore) FetchPaymentOrderByProviderOrderId(ctx context.Context, providerOrderId string) (PaymentOrder, error) {
+	return payS.fetchPaymentOrder(fetchPaymentOrderByProviderOrderIdQuery, providerOrderId)
+}
+
+func (payS *paymentStore) fetchPaymentOrder(query string, args ...interface{}) (PaymentOrder, error) {
+	var order PaymentOrder
+
+	err := payS.DB.Get(&order, query, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return PaymentOrder{}, apperrors.ErrNoPaymentOrderExists
+		}
+		return PaymentOrder{}, err
+	}
+	return order, nil
+}
+
+// Move a pending gateway order to its final status, a paid order is recorded in the ledger within the
+// same transaction. Orders that are no longer pending are left untouched so that repeated webhooks
+// and status checks cannot record a payment twice. The dues are checked again with the application
+// locked, a paid order that would take the outstanding wage below zero is left pending.
+func (payS *paymentStore) SettlePaymentOrder(ctx context.Context, orderId int, status string, providerPaymentId string) (PaymentOrder, error) {
+	tx, err := payS.DB.Beginx()
+	if err != nil {
+		return PaymentOrder{}, err
+	}
+
+	defer tx.Rollback()
+
+	var order PaymentOrder
+	err = tx.Get(&order, settlePaymentOrderQuery, orderId, status, providerPaymentId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return PaymentOrder{}, apperrors.ErrPaymentOrderSettled
+		}
+		return PaymentOrder{}, err
+	}
+
+	if status == "paid" {
+		err = checkOutstandingDues(tx, order.ApplicationID, order.Amount)
+		if err != nil {
+			return PaymentOrder{}, err
+		}
+
+		entryId, err := createGatewayEntry(tx, order, order.Type, providerPaymentId, "paid through payment gateway")
+		if err != nil {
+			return PaymentOrder{}, err
+		}
+
+		_, err = tx.Exec(linkPaymentOrderEntryQuery, order.ID, entryId)
+		if err != nil {
+			return PaymentOrder{}, err
+		}
+		order.PaymentID = &entryId
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return PaymentOrder{}, err
+	}
+	return order, nil
+}
+
+// Mark a paid gateway order as being refunded before the provider is asked for the refund, so that a
+// refund the provider made is never lost when recording it fails
+func (payS *paymentStore) StartPaymentOrderRefund(ctx context.Context, orderId int) (PaymentOrder, error) {
+	var order PaymentOrder
+
+	err := payS.DB.Get(&order, startPaymentOrderRefundQuery, orderId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return PaymentOrder{}, apperrors.ErrPaymentNotRefundable
+		}
+		return PaymentOrder{}, err
+	}
+	return order, nil
+}
+
+// Mark a gateway order being refunded as refunded and reverse it in the ledger
+func (payS *paymentStore) RefundPaymentOrder(ctx context.Context, orderId int, refundReference string) (PaymentOrder, error) {
+	tx, err := payS.DB.Beginx()
+	if err != nil {
+		return PaymentOrder{}, err
+	}
+
+	defer tx.Rollback()
+
+	var order PaymentOrder
+	err = tx.Get(&order, refundPaymentOrderQuery, orderId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return PaymentOrder{}, apperrors.ErrPaymentNotRefundable
+		}
+		return PaymentOrder{}, err
+	}
+
+	_, err = createGatewayEntry(tx, order, "refund", refundReference, "refund of payment "+order.ProviderPaymentID)
+	if err != nil {
+		return PaymentOrder{}, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return PaymentOrder{}, err
+	}
+	return order, nil
+}
+
+// checkOutstandingDues locks an application and refuses an amount larger than the wage still owed for it
+func checkOutstandingDues(tx *sqlx.Tx, applicationId int, amount int) error {
+	var status Status
+	err := tx.Get(&status, lockApplicationStatusQuery, applicationId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return apperrors.ErrNoApplicationExists
+		}
+		return err
+	}
+
+	var dues Dues
+	err = tx.Get(&dues, fetchDuesByApplicationIdQuery, applicationId)
+	if err != nil {
+		return err
+	}
+
+	outstanding := dues.Owed - dues.Paid - dues.Deducted
+	if amount > outstanding {
+		return fmt.Errorf("%w: %d outstanding", apperrors.ErrPaymentExceedsDues, outstanding)
+	}
+	return nil
+}
+
+// createGatewayEntry records a gateway payment or refund in the ledger, the gateway confirmed the
+// transfer so there is nothing left for the worker to acknowledge
+func createGatewayEntry(tx *sqlx.Tx, order PaymentOrder, entryType string, reference string, note string) (int, error) {
+	var entryId int
+	err := tx.Get(&entryId, createGatewayPaymentEntryQuery, order.ApplicationID, entryType, order.Amount, reference, note)
+	if err != nil {
+		return -1, err
+	}
+	return entryId, nil
+}
@@ -0,0 +1,70 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type pickupStore struct {
+	BaseRepository
+}
+
+type PickupStorer interface {
+	FetchPickupPassengersByJobId(ctx context.Context, jobId int) ([]PickupPassenger, error)
+	FetchPickupAssignmentsByJobId(ctx context.Context, jobId int) ([]PickupAssignment, error)
+	SavePickupAssignment(ctx context.Context, assignment PickupAssignment) (PickupAssignment, error)
+}
+
+func NewPickupRepo(db *sqlx.DB) PickupStorer {
+	return &pickupStore{
+		BaseRepository: BaseRepository{DB: db},
+	}
+}
+
+// PostgreSQL Queries
+const (
+	fetchPickupPassengersByJobIdQuery  = `SELECT applications.id AS application_id, applications.job_id, applications.worker_id, workers.name, workers.contact_number, address.id AS address_id, address.details, address.street, address.city, address.state, address.pincode FROM applications INNER JOIN workers ON applications.worker_id = workers.id INNER JOIN address ON applications.pick_up_location = address.id WHERE applications.job_id = $1 AND applications.status = 'confirmed' AND applications.mode_of_arrival = 'pickup' ORDER BY address.pincode, address.street, workers.name;`
+	fetchPickupAssignmentsByJobIdQuery = `SELECT id, job_id, pincode, vehicle, pickup_time, updated_at FROM pickup_assignments WHERE job_id = $1 ORDER BY pincode;`
+	savePickupAssignmentQuery          = `INSERT INTO pickup_assignments (job_id, pincode, vehicle, pickup_time, updated_at) VALUES (:job_id, :pincode, :vehicle, :pickup_time, NOW()) ON CONFLICT (job_id, pincode) DO UPDATE SET vehicle = EXCLUDED.vehicle, pickup_time = EXCLUDED.pickup_time, updated_at = NOW() RETURNING id, job_id, pincode, vehicle, pickup_time, updated_at;`
+)
+
+func (pickS *pickupStore) FetchPickupPassengersByJobId(ctx context.Context, jobId int) ([]PickupPassenger, error) {
+	passengers := make([]PickupPassenger, 0)
+
+	err := pickS.DB.Select(&passengers, fetchPickupPassengersByJobIdQuery, jobId)
+	if err != nil {
+		return []PickupPassenger{}, err
+	}
+	return passengers, nil
+}
+
+func (pickS *pickupStore) FetchPickupAssignmentsByJobId(ctx context.Context, jobId int) ([]PickupAssignment, error) {
+	assignments := make([]PickupAssignment, 0)
+
+	err := pickS.DB.Select(&assignments, fetchPickupAssignmentsByJobIdQuery, jobId)
+	if err != nil {
+		return []PickupAssignment{}, err
+	}
+	return assignments, nil
+}
+
+// Create or replace the vehicle and pickup time of a stop, a job has a single assignment per pincode
+func (pickS *pickupStore) SavePickupAssignment(ctx context.Context, assignment PickupAssignment) (PickupAssignment, error) {
+	var savedAssignment PickupAssignment
+
+	rows, err := pickS.DB.NamedQuery(savePickupAssignmentQuery, assignment)
+	if err != nil {
+		return PickupAssignment{}, err
+	}
+
+	defer rows.Close()
+
+	if rows.Next() {
+		err = rows.StructScan(&savedAssignment)
+		if err != nil {
+			return PickupAssignment{}, err
+		}
+	}
+	return savedAssignment, nil
+}
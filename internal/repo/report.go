@@ -0,0 +1,210 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/jmoiron/sqlx"
+)
+
+type reportStore struct {
+	BaseRepository
+}
+
+type ReportStorer interface {
+	FetchReportTarget(ctx context.Context, targetType string, targetId int) (ReportTarget, error)
+	CreateReport(ctx context.Context, report Report, hideThreshold int) (Report, error)
+	FetchReportQueue(ctx context.Context, filter ReportQueueFilter) ([]ReportedContent, error)
+	FetchReportsByTarget(ctx context.Context, targetType string, targetId int) ([]Report, error)
+	ResolveReports(ctx context.Context, resolution ReportResolution) ([]Report, error)
+}
+
+func NewReportRepo(db *sqlx.DB) ReportStorer {
+	return &reportStore{
+		BaseRepository: BaseRepository{DB: db},
+	}
+}
+
+// reportTargetQueries find the owner of each kind of content that can be reported
+var reportTargetQueries = map[string]string{
+	"job":      `SELECT 'employer' AS owner_role, employer_id AS owner_id, 0 AS worker_id, 0 AS employer_id FROM jobs WHERE id = $1;`,
+	"employer": `SELECT 'employer' AS owner_role, id AS owner_id, 0 AS worker_id, 0 AS employer_id FROM employers WHERE id = $1;`,
+	"worker":   `SELECT 'worker' AS owner_role, id AS owner_id, 0 AS worker_id, 0 AS employer_id FROM workers WHERE id = $1;`,
+	"message":  `SELECT messages.sender_role AS owner_role, messages.sender_id AS owner_id, applications.worker_id, jobs.employer_id FROM messages INNER JOIN applications ON messages.application_id = applications.id INNER JOIN jobs ON applications.job_id = jobs.id WHERE messages.id = $1;`,
+}
+
+// PostgreSQL Queries
+const (
+	reportColumns             = `id, target_type, target_id, reporter_role, reporter_id, reason, details, status, COALESCE(resolution, '') AS resolution, COALESCE(resolution_note, '') AS resolution_note, COALESCE(resolved_by, 0) AS resolved_by, resolved_at, created_at`
+	lockReportTargetQuery     = `SELECT pg_advisory_xact_lock(hashtext($1), $2);`
+	createReportQuery         = `INSERT INTO reports (target_type, target_id, reporter_role, reporter_id, reason, details, status, created_at) VALUES ($1, $2, $3, $4, $5, $6, 'open', NOW()) ON CONFLICT (target_type, target_id, reporter_role, reporter_id) WHERE status = 'open' DO NOTHING RETURNING ` + reportColumns + `;`
+	countOpenReportsQuery     = `SELECT COUNT(*) FROM reports WHERE target_type = $1 AND target_id = $2 AND status = 'open';`
+	hideContentQuery          = `INSERT INTO hidden_content (target_type, target_id, reason, hidden_at) VALUES ($1, $2, 'reported', NOW()) ON CONFLICT (target_type, target_id) DO NOTHING;`
+	showContentQuery          = `DELETE FROM hidden_content WHERE target_type = $1 AND target_id = $2 AND hidden_by IS NULL;`
+	fetchReportsByTargetQuery = `SELECT ` + reportColumns + ` FROM reports WHERE target_type = $1 AND target_id = $2 ORDER BY created_at DESC, id DESC;`
+	resolveReportsQuery       = `UPDATE reports SET status = $3, resolution = $4, resolution_note = $5, resolved_by = $6, resolved_at = NOW() WHERE target_type = $1 AND target_id = $2 AND status = 'open' RETURNING ` + reportColumns + `;`
+	reportedContentColumns    = `reports.target_type, reports.target_id, COUNT(*) AS open_reports, array_agg(DISTINCT reports.reason ORDER BY reports.reason) AS reasons, EXISTS (SELECT 1 FROM hidden_content WHERE hidden_content.target_type = reports.target_type AND hidden_content.target_id = reports.target_id) AS is_hidden, MIN(reports.created_at) AS first_reported_at, MAX(reports.created_at) AS last_reported_at`
+)
+
+func (reportS *reportStore) FetchReportTarget(ctx context.Context, targetType string, targetId int) (ReportTarget, error) {
+	query, ok := reportTargetQueries[targetType]
+	if !ok {
+		return ReportTarget{}, apperrors.ErrInvalidReport
+	}
+
+	var target ReportTarget
+	err := reportS.DB.Get(&target, query, targetId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ReportTarget{}, apperrors.ErrNoReportTarget
+		}
+		return ReportTarget{}, err
+	}
+	return target, nil
+}
+
+// Create a report and hide its target once it has open reports from hideThreshold reporters, the
+// target is locked so that reports arriving together are counted one after the other
+func (reportS *reportStore) CreateReport(ctx context.Context, report Report, hideThreshold int) (Report, error) {
+	tx, err := reportS.DB.Beginx()
+	if err != nil {
+		return Report{}, err
+	}
+
+	defer tx.Rollback()
+
+	_, err = tx.Exec(lockReportTargetQuery, report.TargetType, report.TargetID)
+	if err != nil {
+		return Report{}, err
+	}
+
+	var createdReport Report
+	err = tx.Get(&createdReport, createReportQuery, report.TargetType, report.TargetID, report.ReporterRole, report.ReporterID, report.Reason, report.Details)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return Report{}, apperrors.ErrAlreadyReported
+		}
+		return Report{}, err
+	}
+
+	// a reporter has at most one open report of a target, so the open reports are the unique reporters
+	var openReports int
+	err = tx.Get(&openReports, countOpenReportsQuery, report.TargetType, report.TargetID)
+	if err != nil {
+		return Report{}, err
+	}
+
+	if openReports >= hideThreshold {
+		_, err = tx.Exec(hideContentQuery, report.TargetType, report.TargetID)
+		if err != nil {
+			return Report{}, err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return Report{}, err
+	}
+
+	return createdReport, nil
+}
+
+// Fetch the content with open reports, hidden content first and then the most reported
+func (reportS *reportStore) FetchReportQueue(ctx context.Context, filter ReportQueueFilter) ([]ReportedContent, error) {
+	queue := make([]ReportedContent, 0)
+	query := `SELECT ` + reportedContentColumns + ` FROM reports WHERE reports.status = 'open'`
+	args := []interface{}{}
+	argIndex := 1
+
+	if len(filter.TargetType) > 0 {
+		query += fmt.Sprintf(" AND reports.target_type = $%d", argIndex)
+		args = append(args, filter.TargetType)
+		argIndex++
+	}
+
+	query += fmt.Sprintf(" GROUP BY reports.target_type, reports.target_id ORDER BY is_hidden DESC, open_reports DESC, last_reported_at DESC LIMIT $%d OFFSET $%d", argIndex, argIndex+1)
+	args = append(args, filter.Limit, filter.Offset)
+
+	err := reportS.DB.Select(&queue, query, args...)
+	if err != nil {
+		return []ReportedContent{}, err
+	}
+	return queue, nil
+}
+
+func (reportS *reportStore) FetchReportsByTarget(ctx context.Context, targetType string, targetId int) ([]Report, error) {
+	reports := make([]Report, 0)
+
+	err := reportS.DB.Select(&reports, fetchReportsByTargetQuery, targetType, targetId)
+	if err != nil {
+		return []Report{}, err
+	}
+	return reports, nil
+}
+
+// Close the open reports of a target and hide, show or suspend the reported content in the same
+// transaction, with a report_resolved event for every reporter. Showing the content again only
+// undoes a hide caused by reports, content an admin hid from the admin console has a hidden_by.
+func (reportS *reportStore) ResolveReports(ctx context.Context, resolution ReportResolution) ([]Report, error) {
+	tx, err := reportS.DB.Beginx()
+	if err != nil {
+		return []Report{}, err
+	}
+
+	defer tx.Rollback()
+
+	_, err = tx.Exec(lockReportTargetQuery, resolution.TargetType, resolution.TargetID)
+	if err != nil {
+		return []Report{}, err
+	}
+
+	reports := make([]Report, 0)
+	err = tx.Select(&reports, resolveReportsQuery, resolution.TargetType, resolution.TargetID, resolution.Status, resolution.Action, resolution.Note, resolution.ResolvedBy)
+	if err != nil {
+		return []Report{}, err
+	}
+	if len(reports) == 0 {
+		return []Report{}, apperrors.ErrNoOpenReports
+	}
+
+	if resolution.HideContent {
+		_, err = tx.Exec(hideContentQuery, resolution.TargetType, resolution.TargetID)
+	} else {
+		_, err = tx.Exec(showContentQuery, resolution.TargetType, resolution.TargetID)
+	}
+	if err != nil {
+		return []Report{}, err
+	}
+
+	if resolution.SuspendAccount {
+		// an account that is already suspended stays suspended for its original reason
+		_, err = tx.Exec(suspendUserQuery, resolution.TargetType, resolution.TargetID, "reported: "+resolution.Note, resolution.ResolvedBy)
+		if err != nil {
+			return []Report{}, err
+		}
+	}
+
+	for _, report := range reports {
+		payload := EventPayload{Status: resolution.Status}
+		if report.ReporterRole == "worker" {
+			payload.WorkerID = report.ReporterID
+		} else {
+			payload.EmployerID = report.ReporterID
+		}
+
+		err = writeOutboxEvent(ctx, tx, ReportResolvedEvent, payload)
+		if err != nil {
+			return []Report{}, err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return []Report{}, err
+	}
+
+	return reports, nil
+}
@@ -0,0 +1,202 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+type roleStore struct {
+	BaseRepository
+}
+
+type RoleStorer interface {
+	FetchRoles(ctx context.Context) ([]Role, error)
+	FetchRole(ctx context.Context, name string) (Role, error)
+	FetchRolePermissions(ctx context.Context, name string) ([]string, error)
+	CreateRole(ctx context.Context, role Role) (Role, error)
+	UpdateRole(ctx context.Context, role Role) (Role, error)
+	DeleteRole(ctx context.Context, name string) error
+}
+
+func NewRoleRepo(db *sqlx.DB) RoleStorer {
+	return &roleStore{
+		BaseRepository: BaseRepository{DB: db},
+	}
+}
+
+// PostgreSQL Queries
+const (
+	roleColumns                = `roles.name, roles.description, roles.is_system, roles.created_at, roles.updated_at, COALESCE(array_agg(role_permissions.permission ORDER BY role_permissions.permission) FILTER (WHERE role_permissions.permission IS NOT NULL), '{}') AS permissions`
+	roleSource                 = `roles LEFT JOIN role_permissions ON role_permissions.role_name = roles.name`
+	fetchRolesQuery            = `SELECT ` + roleColumns + ` FROM ` + roleSource + ` GROUP BY roles.name ORDER BY roles.is_system DESC, roles.name;`
+	fetchRoleQuery             = `SELECT ` + roleColumns + ` FROM ` + roleSource + ` WHERE roles.name = $1 GROUP BY roles.name;`
+	fetchRolePermissionsQuery  = `SELECT permission FROM role_permissions WHERE role_name = $1 ORDER BY permission;`
+	createRoleQuery            = `INSERT INTO roles (name, description, is_system, created_at, updated_at) VALUES ($1, $2, FALSE, NOW(), NOW()) ON CONFLICT (name) DO NOTHING RETURNING name;`
+	lockRoleQuery              = `SELECT is_system FROM roles WHERE name = $1 FOR UPDATE;`
+	updateRoleQuery            = `UPDATE roles SET description = $2, updated_at = NOW() WHERE name = $1;`
+	insertRolePermissionsQuery = `INSERT INTO role_permissions (role_name, permission) SELECT $1, unnest($2::text[]);`
+	deleteRolePermissionsQuery = `DELETE FROM role_permissions WHERE role_name = $1;`
+	countRoleAdminsQuery       = `SELECT COUNT(*) FROM admins WHERE role = $1;`
+	deleteRoleQuery            = `DELETE FROM roles WHERE name = $1;`
+)
+
+func (roleS *roleStore) FetchRoles(ctx context.Context) ([]Role, error) {
+	roles := make([]Role, 0)
+
+	err := roleS.DB.Select(&roles, fetchRolesQuery)
+	if err != nil {
+		return []Role{}, err
+	}
+	return roles, nil
+}
+
+func (roleS *roleStore) FetchRole(ctx context.Context, name string) (Role, error) {
+	var role Role
+
+	err := roleS.DB.Get(&role, fetchRoleQuery, name)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return Role{}, apperrors.ErrNoRoleExists
+		}
+		return Role{}, err
+	}
+	return role, nil
+}
+
+// Fetch the permissions granted to a role, a role that does not exist has none
+func (roleS *roleStore) FetchRolePermissions(ctx context.Context, name string) ([]string, error) {
+	permissions := make([]string, 0)
+
+	err := roleS.DB.Select(&permissions, fetchRolePermissionsQuery, name)
+	if err != nil {
+		return []string{}, err
+	}
+	return permissions, nil
+}
+
+// Create a role with its permissions in a single transaction
+func (roleS *roleStore) CreateRole(ctx context.Context, role Role) (Role, error) {
+	tx, err := roleS.DB.Beginx()
+	if err != nil {
+		return Role{}, err
+	}
+
+	defer tx.Rollback()
+
+	var createdName string
+	err = tx.Get(&createdName, createRoleQuery, role.Name, role.Description)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return Role{}, apperrors.ErrRoleExists
+		}
+		return Role{}, err
+	}
+
+	_, err = tx.Exec(insertRolePermissionsQuery, role.Name, pq.Array(role.Permissions))
+	if err != nil {
+		return Role{}, err
+	}
+
+	var createdRole Role
+	err = tx.Get(&createdRole, fetchRoleQuery, role.Name)
+	if err != nil {
+		return Role{}, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return Role{}, err
+	}
+
+	return createdRole, nil
+}
+
+// Replace the description and permissions of a role
+func (roleS *roleStore) UpdateRole(ctx context.Context, role Role) (Role, error) {
+	tx, err := roleS.DB.Beginx()
+	if err != nil {
+		return Role{}, err
+	}
+
+	defer tx.Rollback()
+
+	var isSystem bool
+	err = tx.Get(&isSystem, lockRoleQuery, role.Name)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return Role{}, apperrors.ErrNoRoleExists
+		}
+		return Role{}, err
+	}
+
+	_, err = tx.Exec(updateRoleQuery, role.Name, role.Description)
+	if err != nil {
+		return Role{}, err
+	}
+
+	_, err = tx.Exec(deleteRolePermissionsQuery, role.Name)
+	if err != nil {
+		return Role{}, err
+	}
+
+	_, err = tx.Exec(insertRolePermissionsQuery, role.Name, pq.Array(role.Permissions))
+	if err != nil {
+		return Role{}, err
+	}
+
+	var updatedRole Role
+	err = tx.Get(&updatedRole, fetchRoleQuery, role.Name)
+	if err != nil {
+		return Role{}, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return Role{}, err
+	}
+
+	return updatedRole, nil
+}
+
+// Delete a role that no admin has, built-in roles are never deleted
+func (roleS *roleStore) DeleteRole(ctx context.Context, name string) error {
+	tx, err := roleS.DB.Beginx()
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback()
+
+	var isSystem bool
+	err = tx.Get(&isSystem, lockRoleQuery, name)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return apperrors.ErrNoRoleExists
+		}
+		return err
+	}
+	if isSystem {
+		return apperrors.ErrSystemRole
+	}
+
+	var adminCount int
+	err = tx.Get(&adminCount, countRoleAdminsQuery, name)
+	if err != nil {
+		return err
+	}
+	if adminCount > 0 {
+		return apperrors.ErrRoleInUse
+	}
+
+	_, err = tx.Exec(deleteRoleQuery, name)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
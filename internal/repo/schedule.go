@@ -0,0 +1,158 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/jmoiron/sqlx"
+)
+
+type scheduleStore struct {
+	BaseRepository
+}
+
+type ScheduleStorer interface {
+	CreateAvailability(ctx context.Context, window AvailabilityWindow) (AvailabilityWindow, error)
+	DeleteAvailability(ctx context.Context, workerId int, availabilityId int) (int, error)
+	FetchAvailabilityByWorkerId(ctx context.Context, workerId int) ([]AvailabilityWindow, error)
+	CreateBlackoutDate(ctx context.Context, blackout BlackoutDate) (BlackoutDate, error)
+	DeleteBlackoutDate(ctx context.Context, workerId int, blackoutId int) (int, error)
+	FetchBlackoutDatesByWorkerId(ctx context.Context, workerId int) ([]BlackoutDate, error)
+	FetchEngagementsByApplicationId(ctx context.Context, applicationId int) ([]Engagement, error)
+	FetchEngagementsByWorkerId(ctx context.Context, workerId int) ([]Engagement, error)
+}
+
+func NewScheduleRepo(db *sqlx.DB) ScheduleStorer {
+	return &scheduleStore{
+		BaseRepository: BaseRepository{DB: db},
+	}
+}
+
+// PostgreSQL Queries
+const (
+	availabilityColumns                  = `id, worker_id, day_of_week, start_hour, end_hour`
+	blackoutDateColumns                  = `id, worker_id, date, reason`
+	engagementColumns                    = `applications.id AS application_id, applications.job_id, COALESCE(job_shifts.id, 0) AS shift_id, applications.worker_id, jobs.title, COALESCE(job_shifts.date, jobs.date) AS date, COALESCE(job_shifts.start_hour, jobs.start_hour) AS start_hour, COALESCE(job_shifts.end_hour, jobs.end_hour) AS end_hour, jobs.duration_in_hours`
+	engagementSource                     = `applications INNER JOIN jobs ON applications.job_id = jobs.id LEFT JOIN job_shifts ON job_shifts.job_id = jobs.id`
+	engagementShiftCondition             = `(job_shifts.id IS NULL OR ` + applicationCoversShiftCondition + `)`
+	createAvailabilityQuery              = `INSERT INTO worker_availability (worker_id, day_of_week, start_hour, end_hour) VALUES (:worker_id, :day_of_week, :start_hour, :end_hour) RETURNING ` + availabilityColumns + `;`
+	deleteAvailabilityQuery              = `DELETE FROM worker_availability WHERE id=$1 AND worker_id=$2 RETURNING id;`
+	fetchAvailabilityByWorkerIdQuery     = `SELECT ` + availabilityColumns + ` FROM worker_availability WHERE worker_id=$1 ORDER BY day_of_week, start_hour;`
+	createBlackoutDateQuery              = `INSERT INTO worker_blackout_dates (worker_id, date, reason) VALUES (:worker_id, :date, :reason) RETURNING ` + blackoutDateColumns + `;`
+	deleteBlackoutDateQuery              = `DELETE FROM worker_blackout_dates WHERE id=$1 AND worker_id=$2 RETURNING id;`
+	fetchBlackoutDatesByWorkerIdQuery    = `SELECT ` + blackoutDateColumns + ` FROM worker_blackout_dates WHERE worker_id=$1 ORDER BY date;`
+	fetchEngagementsByApplicationIdQuery = `SELECT ` + engagementColumns + ` FROM ` + engagementSource + ` WHERE applications.id = $1 AND ` + engagementShiftCondition + ` ORDER BY date, start_hour;`
+	fetchEngagementsByWorkerIdQuery      = `SELECT ` + engagementColumns + ` FROM ` + engagementSource + ` WHERE applications.worker_id = $1 AND applications.status = 'confirmed' AND ` + engagementShiftCondition + ` ORDER BY date, start_hour;`
+)
+
+func (schS *scheduleStore) CreateAvailability(ctx context.Context, window AvailabilityWindow) (AvailabilityWindow, error) {
+	var createdWindow AvailabilityWindow
+
+	rows, err := schS.DB.NamedQuery(createAvailabilityQuery, window)
+	if err != nil {
+		return AvailabilityWindow{}, err
+	}
+
+	defer rows.Close()
+
+	if rows.Next() {
+		err = rows.StructScan(&createdWindow)
+		if err != nil {
+			return AvailabilityWindow{}, err
+		}
+	}
+	return createdWindow, nil
+}
+
+func (schS *scheduleStore) DeleteAvailability(ctx context.Context, workerId int, availabilityId int) (int, error) {
+	var id int
+
+	err := schS.DB.Get(&id, deleteAvailabilityQuery, availabilityId, workerId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return -1, apperrors.ErrNoAvailabilityExists
+		}
+		return -1, err
+	}
+	return id, nil
+}
+
+func (schS *scheduleStore) FetchAvailabilityByWorkerId(ctx context.Context, workerId int) ([]AvailabilityWindow, error) {
+	windows := make([]AvailabilityWindow, 0)
+
+	err := schS.DB.Select(&windows, fetchAvailabilityByWorkerIdQuery, workerId)
+	if err != nil {
+		return []AvailabilityWindow{}, err
+	}
+	return windows, nil
+}
+
+func (schS *scheduleStore) CreateBlackoutDate(ctx context.Context, blackout BlackoutDate) (BlackoutDate, error) {
+	var createdBlackout BlackoutDate
+
+	rows, err := schS.DB.NamedQuery(createBlackoutDateQuery, blackout)
+	if err != nil {
+		return BlackoutDate{}, err
+	}
+
+	defer rows.Close()
+
+	if rows.Next() {
+		err = rows.StructScan(&createdBlackout)
+		if err != nil {
+			return BlackoutDate{}, err
+		}
+	}
+	return createdBlackout, nil
+}
+
+func (schS *scheduleStore) DeleteBlackoutDate(ctx context.Context, workerId int, blackoutId int) (int, error) {
+	var id int
+
+	err := schS.DB.Get(&id, deleteBlackoutDateQuery, blackoutId, workerId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return -1, apperrors.ErrNoBlackoutDateExists
+		}
+		return -1, err
+	}
+	return id, nil
+}
+
+func (schS *scheduleStore) FetchBlackoutDatesByWorkerId(ctx context.Context, workerId int) ([]BlackoutDate, error) {
+	blackouts := make([]BlackoutDate, 0)
+
+	err := schS.DB.Select(&blackouts, fetchBlackoutDatesByWorkerIdQuery, workerId)
+	if err != nil {
+		return []BlackoutDate{}, err
+	}
+	return blackouts, nil
+}
+
+// Fetch the job slots (one per covered shift) of an application, irrespective of its status
+func (schS *scheduleStore) FetchEngagementsByApplicationId(ctx context.Context, applicationId int) ([]Engagement, error) {
+	engagements := make([]Engagement, 0)
+
+	err := schS.DB.Select(&engagements, fetchEngagementsByApplicationIdQuery, applicationId)
+	if err != nil {
+		return []Engagement{}, err
+	}
+
+	if len(engagements) == 0 {
+		return []Engagement{}, apperrors.ErrNoApplicationExists
+	}
+	return engagements, nil
+}
+
+// Fetch the job slots of all confirmed applications of a worker
+func (schS *scheduleStore) FetchEngagementsByWorkerId(ctx context.Context, workerId int) ([]Engagement, error) {
+	engagements := make([]Engagement, 0)
+
+	err := schS.DB.Select(&engagements, fetchEngagementsByWorkerIdQuery, workerId)
+	if err != nil {
+		return []Engagement{}, err
+	}
+	return engagements, nil
+}
@@ -19,6 +19,8 @@ type SectoreStorer interface {
 	UpdateSectorById(ctx context.Context, sectorData Sector) (Sector, error)
 	DeleteSectorById(ctx context.Context, sectorId int) (int, error)
 	FetchAllSectors(ctx context.Context) ([]Sector, error)
+	FetchSectorStats(ctx context.Context, sector Sector) (SectorStats, error)
+	MergeSector(ctx context.Context, source Sector, target Sector) error
 }
 
 func NewSectorRepo(db *sqlx.DB) SectoreStorer {
@@ -34,8 +36,31 @@ const (
 	updateSectorByIdQuery = `UPDATE sectors SET name=:name, description=:description where id=:id RETURNING *;`
 	deleteSectorByIdQuery = `DELETE FROM sectors WHERE id=$1 RETURNING id;`
 	fetchAllSectorQuery   = `SELECT * FROM sectors ORDER BY id;`
+
+	// sectors are stored by name in the comma separated sectors column of workers, employers and jobs
+	fetchSectorStatsQuery = `SELECT
+		(SELECT COUNT(*) FROM workers WHERE EXISTS (SELECT 1 FROM unnest(string_to_array(workers.sectors, ',')) AS s WHERE LOWER(TRIM(s)) = LOWER($1))) AS workers,
+		(SELECT COUNT(*) FROM employers WHERE EXISTS (SELECT 1 FROM unnest(string_to_array(employers.sectors, ',')) AS s WHERE LOWER(TRIM(s)) = LOWER($1))) AS employers,
+		(SELECT COUNT(*) FROM jobs WHERE EXISTS (SELECT 1 FROM unnest(string_to_array(jobs.sectors, ',')) AS s WHERE LOWER(TRIM(s)) = LOWER($1))) AS jobs,
+		(SELECT COUNT(*) FROM jobs WHERE EXISTS (SELECT 1 FROM unnest(string_to_array(jobs.sectors, ',')) AS s WHERE LOWER(TRIM(s)) = LOWER($1))
+			AND jobs.vacancy > (SELECT COUNT(*) FROM applications WHERE applications.job_id = jobs.id AND applications.status = 'confirmed')) AS open_jobs,
+		(SELECT COUNT(*) FROM skill_sectors WHERE sector_id = $2) AS skills,
+		(SELECT COALESCE(AVG(wage), 0) FROM jobs WHERE EXISTS (SELECT 1 FROM unnest(string_to_array(jobs.sectors, ',')) AS s WHERE LOWER(TRIM(s)) = LOWER($1))) AS average_wage;`
+	fetchWorkerSectorsQuery         = `SELECT id, sectors FROM workers WHERE EXISTS (SELECT 1 FROM unnest(string_to_array(workers.sectors, ',')) AS s WHERE LOWER(TRIM(s)) = LOWER($1));`
+	fetchEmployerSectorsQuery       = `SELECT id, sectors FROM employers WHERE EXISTS (SELECT 1 FROM unnest(string_to_array(employers.sectors, ',')) AS s WHERE LOWER(TRIM(s)) = LOWER($1));`
+	fetchJobSectorsQuery            = `SELECT id, sectors FROM jobs WHERE EXISTS (SELECT 1 FROM unnest(string_to_array(jobs.sectors, ',')) AS s WHERE LOWER(TRIM(s)) = LOWER($1));`
+	updateWorkerSectorsQuery        = `UPDATE workers SET sectors=$1, updated_at=NOW() WHERE id=$2;`
+	updateEmployerSectorsQuery      = `UPDATE employers SET sectors=$1, updated_at=NOW() WHERE id=$2;`
+	updateJobSectorsQuery           = `UPDATE jobs SET sectors=$1, updated_at=NOW() WHERE id=$2;`
+	mergeSkillSectorsQuery          = `INSERT INTO skill_sectors (skill_id, sector_id) SELECT skill_id, $2 FROM skill_sectors WHERE sector_id=$1 ON CONFLICT DO NOTHING;`
+	deleteSkillSectorsBySectorQuery = `DELETE FROM skill_sectors WHERE sector_id=$1;`
 )
 
+type sectorReference struct {
+	ID      int    `db:"id"`
+	Sectors string `db:"sectors"`
+}
+
 func (sectorS *sectorStore) CreateNewSector(ctx context.Context, sectorData Sector) (Sector, error) {
 	var createdSector Sector
 
@@ -114,3 +139,64 @@ func (sectorS *sectorStore) FetchAllSectors(ctx context.Context) ([]Sector, erro
 	}
 	return sectors, nil
 }
+
+func (sectorS *sectorStore) FetchSectorStats(ctx context.Context, sector Sector) (SectorStats, error) {
+	var stats SectorStats
+
+	err := sectorS.DB.Get(&stats, fetchSectorStatsQuery, sector.Name, sector.ID)
+	if err != nil {
+		return SectorStats{}, err
+	}
+	return stats, nil
+}
+
+// Rewrite all references of the source sector to the target sector and delete the source, in a single transaction
+func (sectorS *sectorStore) MergeSector(ctx context.Context, source Sector, target Sector) error {
+	tx, err := sectorS.DB.Beginx()
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback()
+
+	references := []struct {
+		fetchQuery  string
+		updateQuery string
+	}{
+		{fetchWorkerSectorsQuery, updateWorkerSectorsQuery},
+		{fetchEmployerSectorsQuery, updateEmployerSectorsQuery},
+		{fetchJobSectorsQuery, updateJobSectorsQuery},
+	}
+
+	for _, reference := range references {
+		var rows []sectorReference
+		err = tx.Select(&rows, reference.fetchQuery, source.Name)
+		if err != nil {
+			return err
+		}
+
+		for _, row := range rows {
+			_, err = tx.Exec(reference.updateQuery, ReplaceSector(row.Sectors, source.Name, target.Name), row.ID)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	_, err = tx.Exec(mergeSkillSectorsQuery, source.ID, target.ID)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(deleteSkillSectorsBySectorQuery, source.ID)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(deleteSectorByIdQuery, source.ID)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
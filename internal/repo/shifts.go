@@ -0,0 +1,82 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+type shiftStore struct {
+	BaseRepository
+}
+
+type ShiftStorer interface {
+	FetchShiftsByJobId(ctx context.Context, jobId int) ([]JobShift, error)
+	FetchShiftsByApplicationId(ctx context.Context, applicationId int) ([]JobShift, error)
+}
+
+func NewShiftRepo(db *sqlx.DB) ShiftStorer {
+	return &shiftStore{
+		BaseRepository: BaseRepository{DB: db},
+	}
+}
+
+// PostgreSQL Queries
+const (
+	shiftColumns                    = `job_shifts.id, job_shifts.job_id, job_shifts.date, job_shifts.start_hour, job_shifts.end_hour, job_shifts.wage`
+	applicationCoversShiftCondition = `applications.whole_series OR job_shifts.id IN (SELECT shift_id FROM application_shifts WHERE application_shifts.application_id = applications.id)`
+	deleteStaleJobShiftsQuery       = `DELETE FROM job_shifts WHERE job_id=$1 AND NOT (date = ANY(CAST($2 AS DATE[])));`
+	upsertJobShiftQuery             = `INSERT INTO job_shifts (job_id, date, start_hour, end_hour, wage) VALUES ($1, $2, $3, $4, $5) ON CONFLICT (job_id, date) DO UPDATE SET start_hour=EXCLUDED.start_hour, end_hour=EXCLUDED.end_hour, wage=EXCLUDED.wage RETURNING ` + shiftColumns + `;`
+	fetchShiftsByJobIdQuery         = `SELECT ` + shiftColumns + ` FROM job_shifts WHERE job_id=$1 ORDER BY date;`
+	createApplicationShiftQuery     = `INSERT INTO application_shifts (application_id, shift_id) VALUES ($1, $2);`
+	fetchShiftsByApplicationIdQuery = `SELECT ` + shiftColumns + ` FROM job_shifts INNER JOIN applications ON applications.job_id = job_shifts.job_id WHERE applications.id=$1 AND (` + applicationCoversShiftCondition + `) ORDER BY job_shifts.date;`
+)
+
+// syncJobShifts replaces the shifts of a job with the given ones in the transaction of the job, shifts
+// are matched by date so that the ids of unchanged days (and the applications targeting them) are
+// kept, dropped days are deleted
+func syncJobShifts(tx *sqlx.Tx, jobId int, shifts []JobShift) ([]JobShift, error) {
+	dates := make([]string, 0)
+	for _, shift := range shifts {
+		dates = append(dates, string(shift.Date))
+	}
+
+	_, err := tx.Exec(deleteStaleJobShiftsQuery, jobId, pq.Array(dates))
+	if err != nil {
+		return []JobShift{}, err
+	}
+
+	syncedShifts := make([]JobShift, 0)
+	for _, shift := range shifts {
+		var syncedShift JobShift
+		err = tx.Get(&syncedShift, upsertJobShiftQuery, jobId, shift.Date, shift.StartHour, shift.EndHour, shift.Wage)
+		if err != nil {
+			return []JobShift{}, err
+		}
+		syncedShifts = append(syncedShifts, syncedShift)
+	}
+	return syncedShifts, nil
+}
+
+func (shiftS *shiftStore) FetchShiftsByJobId(ctx context.Context, jobId int) ([]JobShift, error) {
+	shifts := make([]JobShift, 0)
+
+	err := shiftS.DB.Select(&shifts, fetchShiftsByJobIdQuery, jobId)
+	if err != nil {
+		return []JobShift{}, err
+	}
+	return shifts, nil
+}
+
+// Fetch the shifts covered by an application, all shifts of the job when it targets the whole series
+// and only the picked ones still on the job otherwise
+func (shiftS *shiftStore) FetchShiftsByApplicationId(ctx context.Context, applicationId int) ([]JobShift, error) {
+	shifts := make([]JobShift, 0)
+
+	err := shiftS.DB.Select(&shifts, fetchShiftsByApplicationIdQuery, applicationId)
+	if err != nil {
+		return []JobShift{}, err
+	}
+	return shifts, nil
+}
@@ -0,0 +1,268 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+type skillStore struct {
+	BaseRepository
+}
+
+type SkillStorer interface {
+	CreateSkill(ctx context.Context, skillData Skill, sectorIds []int) (Skill, error)
+	FetchSkillById(ctx context.Context, skillId int) (Skill, error)
+	UpdateSkillById(ctx context.Context, skillData Skill, sectorIds []int) (Skill, error)
+	DeleteSkillById(ctx context.Context, skillId int) (int, error)
+	FetchAllSkills(ctx context.Context) ([]Skill, error)
+	FetchChildSkills(ctx context.Context, parentId int) ([]Skill, error)
+	FindSkillByTerm(ctx context.Context, term string) (Skill, error)
+	AddSkillSynonym(ctx context.Context, synonymData SkillSynonym) (SkillSynonym, error)
+	DeleteSkillSynonym(ctx context.Context, skillId int, synonymId int) (int, error)
+	FetchSynonymsBySkillId(ctx context.Context, skillId int) ([]SkillSynonym, error)
+	FetchSectorIdsBySkillId(ctx context.Context, skillId int) ([]int, error)
+}
+
+func NewSkillRepo(db *sqlx.DB) SkillStorer {
+	return &skillStore{
+		BaseRepository: BaseRepository{DB: db},
+	}
+}
+
+// PostgreSQL Queries
+const (
+	skillColumns                 = `skills.id, skills.name, skills.description, COALESCE(skills.parent_id, 0) AS parent_id`
+	createSkillQuery             = `INSERT INTO skills (name, description, parent_id) VALUES (:name, :description, NULLIF(:parent_id, 0)) RETURNING ` + skillColumns + `;`
+	fetchSkillByIdQuery          = `SELECT ` + skillColumns + ` FROM skills WHERE id=$1;`
+	updateSkillByIdQuery         = `UPDATE skills SET name=:name, description=:description, parent_id=NULLIF(:parent_id, 0) WHERE id=:id RETURNING ` + skillColumns + `;`
+	deleteSkillByIdQuery         = `DELETE FROM skills WHERE id=$1 RETURNING id;`
+	fetchAllSkillsQuery          = `SELECT ` + skillColumns + ` FROM skills ORDER BY id;`
+	fetchChildSkillsQuery        = `SELECT ` + skillColumns + ` FROM skills WHERE parent_id=$1 ORDER BY id;`
+	findSkillByTermQuery         = `SELECT DISTINCT ` + skillColumns + ` FROM skills LEFT JOIN skill_synonyms ON skill_synonyms.skill_id = skills.id WHERE LOWER(skills.name) = $1 OR skill_synonyms.synonym = $1 LIMIT 1;`
+	addSkillSynonymQuery         = `INSERT INTO skill_synonyms (skill_id, synonym) VALUES (:skill_id, :synonym) RETURNING *;`
+	deleteSkillSynonymQuery      = `DELETE FROM skill_synonyms WHERE id=$1 AND skill_id=$2 RETURNING id;`
+	fetchSynonymsBySkillIdQuery  = `SELECT * FROM skill_synonyms WHERE skill_id=$1 ORDER BY id;`
+	deleteSkillSectorsQuery      = `DELETE FROM skill_sectors WHERE skill_id=$1;`
+	createSkillSectorQuery       = `INSERT INTO skill_sectors (skill_id, sector_id) VALUES ($1, $2);`
+	fetchSectorIdsBySkillIdQuery = `SELECT sector_id FROM skill_sectors WHERE skill_id=$1 ORDER BY sector_id;`
+)
+
+// Create a skill together with the sectors it is linked to, either both are saved or neither
+func (skillS *skillStore) CreateSkill(ctx context.Context, skillData Skill, sectorIds []int) (Skill, error) {
+	var createdSkill Skill
+
+	tx, err := skillS.DB.Beginx()
+	if err != nil {
+		return Skill{}, err
+	}
+
+	defer tx.Rollback()
+
+	rows, err := tx.NamedQuery(createSkillQuery, skillData)
+	if err != nil {
+		return Skill{}, err
+	}
+
+	defer rows.Close()
+
+	if rows.Next() {
+		err = rows.StructScan(&createdSkill)
+		if err != nil {
+			return Skill{}, err
+		}
+	}
+	rows.Close()
+
+	err = replaceSkillSectors(tx, createdSkill.ID, sectorIds)
+	if err != nil {
+		return Skill{}, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return Skill{}, err
+	}
+
+	return createdSkill, nil
+}
+
+func (skillS *skillStore) FetchSkillById(ctx context.Context, skillId int) (Skill, error) {
+	var skill Skill
+
+	err := skillS.DB.Get(&skill, fetchSkillByIdQuery, skillId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return Skill{}, apperrors.ErrNoSkillExists
+		}
+		return Skill{}, err
+	}
+
+	return skill, nil
+}
+
+// Update a skill and replace the sectors it is linked to in the same transaction
+func (skillS *skillStore) UpdateSkillById(ctx context.Context, skillData Skill, sectorIds []int) (Skill, error) {
+	var skill Skill
+
+	tx, err := skillS.DB.Beginx()
+	if err != nil {
+		return Skill{}, err
+	}
+
+	defer tx.Rollback()
+
+	rows, err := tx.NamedQuery(updateSkillByIdQuery, skillData)
+	if err != nil {
+		return Skill{}, err
+	}
+
+	defer rows.Close()
+
+	if rows.Next() {
+		err = rows.StructScan(&skill)
+		if err != nil {
+			return Skill{}, err
+		}
+	}
+	rows.Close()
+
+	err = replaceSkillSectors(tx, skillData.ID, sectorIds)
+	if err != nil {
+		return Skill{}, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return Skill{}, err
+	}
+
+	return skill, nil
+}
+
+func (skillS *skillStore) DeleteSkillById(ctx context.Context, skillId int) (int, error) {
+	var id int
+
+	err := skillS.DB.Get(&id, deleteSkillByIdQuery, skillId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return -1, apperrors.ErrNoSkillExists
+		}
+		return -1, err
+	}
+
+	return id, nil
+}
+
+func (skillS *skillStore) FetchAllSkills(ctx context.Context) ([]Skill, error) {
+	skills := make([]Skill, 0)
+
+	err := skillS.DB.Select(&skills, fetchAllSkillsQuery)
+	if err != nil {
+		return []Skill{}, err
+	}
+	return skills, nil
+}
+
+func (skillS *skillStore) FetchChildSkills(ctx context.Context, parentId int) ([]Skill, error) {
+	skills := make([]Skill, 0)
+
+	err := skillS.DB.Select(&skills, fetchChildSkillsQuery, parentId)
+	if err != nil {
+		return []Skill{}, err
+	}
+	return skills, nil
+}
+
+// Find a skill whose name or one of its synonyms matches the normalized term
+func (skillS *skillStore) FindSkillByTerm(ctx context.Context, term string) (Skill, error) {
+	var skill Skill
+
+	err := skillS.DB.Get(&skill, findSkillByTermQuery, term)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return Skill{}, apperrors.ErrNoSkillExists
+		}
+		return Skill{}, err
+	}
+
+	return skill, nil
+}
+
+func (skillS *skillStore) AddSkillSynonym(ctx context.Context, synonymData SkillSynonym) (SkillSynonym, error) {
+	var synonym SkillSynonym
+
+	rows, err := skillS.DB.NamedQuery(addSkillSynonymQuery, synonymData)
+	if err != nil {
+		return SkillSynonym{}, err
+	}
+
+	defer rows.Close()
+
+	if rows.Next() {
+		err = rows.StructScan(&synonym)
+		if err != nil {
+			return SkillSynonym{}, err
+		}
+	}
+	return synonym, nil
+}
+
+func (skillS *skillStore) DeleteSkillSynonym(ctx context.Context, skillId int, synonymId int) (int, error) {
+	var id int
+
+	err := skillS.DB.Get(&id, deleteSkillSynonymQuery, synonymId, skillId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return -1, apperrors.ErrNoSkillSynonymExists
+		}
+		return -1, err
+	}
+
+	return id, nil
+}
+
+func (skillS *skillStore) FetchSynonymsBySkillId(ctx context.Context, skillId int) ([]SkillSynonym, error) {
+	synonyms := make([]SkillSynonym, 0)
+
+	err := skillS.DB.Select(&synonyms, fetchSynonymsBySkillIdQuery, skillId)
+	if err != nil {
+		return []SkillSynonym{}, err
+	}
+	return synonyms, nil
+}
+
+// Replace the set of sectors a skill is linked to, an unknown sector id is reported as ErrNoSectorExists
+func replaceSkillSectors(tx *sqlx.Tx, skillId int, sectorIds []int) error {
+	_, err := tx.Exec(deleteSkillSectorsQuery, skillId)
+	if err != nil {
+		return err
+	}
+
+	for _, sectorId := range sectorIds {
+		_, err = tx.Exec(createSkillSectorQuery, skillId, sectorId)
+		if err != nil {
+			var pqErr *pq.Error
+			if errors.As(err, &pqErr) && pqErr.Code == foreignKeyViolation {
+				return apperrors.ErrNoSectorExists
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (skillS *skillStore) FetchSectorIdsBySkillId(ctx context.Context, skillId int) ([]int, error) {
+	sectorIds := make([]int, 0)
+
+	err := skillS.DB.Select(&sectorIds, fetchSectorIdsBySkillIdQuery, skillId)
+	if err != nil {
+		return []int{}, err
+	}
+	return sectorIds, nil
+}
@@ -0,0 +1,54 @@
+package repo
+
+import (
+	"context"
+	"strings"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/jmoiron/sqlx"
+)
+
+type streamStore struct {
+	BaseRepository
+}
+
+// StreamStorer reads the outbox events a reconnecting client missed, the outbox event ids are the
+// ids of the streamed events
+type StreamStorer interface {
+	FetchStreamEvents(ctx context.Context, role string, recipientId int, eventTypes []string, afterId int, limit int) ([]OutboxEvent, error)
+}
+
+func NewStreamRepo(db *sqlx.DB) StreamStorer {
+	return &streamStore{
+		BaseRepository: BaseRepository{DB: db},
+	}
+}
+
+// PostgreSQL Queries
+const (
+	streamEventColumns = `outbox_events.id, outbox_events.type, outbox_events.payload, outbox_events.attempts, outbox_events.created_at`
+	// application events only carry the job, their employer is the employer of the job
+	fetchWorkerStreamEventsQuery   = `SELECT ` + streamEventColumns + ` FROM outbox_events WHERE outbox_events.id > $1 AND outbox_events.type = ANY(string_to_array($2, ',')) AND (outbox_events.payload->>'worker_id')::int = $3 ORDER BY outbox_events.id LIMIT $4;`
+	fetchEmployerStreamEventsQuery = `SELECT ` + streamEventColumns + ` FROM outbox_events LEFT JOIN jobs ON jobs.id = (outbox_events.payload->>'job_id')::int WHERE outbox_events.id > $1 AND outbox_events.type = ANY(string_to_array($2, ',')) AND COALESCE((outbox_events.payload->>'employer_id')::int, jobs.employer_id) = $3 ORDER BY outbox_events.id LIMIT $4;`
+)
+
+// Fetch the events of the given types addressed to the worker or employer after an event id,
+// oldest first
+func (strS *streamStore) FetchStreamEvents(ctx context.Context, role string, recipientId int, eventTypes []string, afterId int, limit int) ([]OutboxEvent, error) {
+	events := make([]OutboxEvent, 0)
+
+	query := fetchWorkerStreamEventsQuery
+	switch role {
+	case "worker":
+	case "employer":
+		query = fetchEmployerStreamEventsQuery
+	default:
+		return []OutboxEvent{}, apperrors.ErrInvalidRecipient
+	}
+
+	err := strS.DB.Select(&events, query, afterId, strings.Join(eventTypes, ","), recipientId, limit)
+	if err != nil {
+		return []OutboxEvent{}, err
+	}
+	return events, nil
+}
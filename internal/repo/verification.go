@@ -0,0 +1,187 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/jmoiron/sqlx"
+)
+
+type verificationStore struct {
+	BaseRepository
+}
+
+type VerificationStorer interface {
+	CreateVerification(ctx context.Context, verification EmployerVerification, documents []VerificationDocument) (EmployerVerification, error)
+	FetchLatestVerification(ctx context.Context, employerId int) (EmployerVerification, error)
+	FetchVerificationById(ctx context.Context, verificationId int) (EmployerVerification, error)
+	FetchVerificationDocuments(ctx context.Context, verificationId int) ([]VerificationDocument, error)
+	FetchVerificationsByStatus(ctx context.Context, status VerificationStatus) ([]EmployerVerification, error)
+	ReviewVerification(ctx context.Context, verificationId int, status VerificationStatus, reason string, reviewedBy int) (EmployerVerification, error)
+}
+
+func NewVerificationRepo(db *sqlx.DB) VerificationStorer {
+	return &verificationStore{
+		BaseRepository: BaseRepository{DB: db},
+	}
+}
+
+// PostgreSQL Queries
+const (
+	verificationColumns             = `employer_verifications.id, employer_verifications.employer_id, employers.name AS employer_name, COALESCE(employer_verifications.gstin, '') AS gstin, COALESCE(employer_verifications.pan, '') AS pan, COALESCE(employer_verifications.udyam_number, '') AS udyam_number, employer_verifications.status, COALESCE(employer_verifications.reason, '') AS reason, COALESCE(employer_verifications.reviewed_by, 0) AS reviewed_by, employer_verifications.submitted_at, employer_verifications.reviewed_at`
+	verificationSource              = `employer_verifications INNER JOIN employers ON employer_verifications.employer_id = employers.id`
+	createVerificationQuery         = `INSERT INTO employer_verifications (employer_id, gstin, pan, udyam_number, status, submitted_at) VALUES (:employer_id, NULLIF(:gstin, ''), NULLIF(:pan, ''), NULLIF(:udyam_number, ''), 'pending', NOW()) RETURNING id;`
+	createVerificationDocumentQuery = `INSERT INTO employer_verification_documents (verification_id, document_type, file_name, content_type, size, url) VALUES (:verification_id, :document_type, :file_name, :content_type, :size, :url);`
+	fetchVerificationByIdQuery      = `SELECT ` + verificationColumns + ` FROM ` + verificationSource + ` WHERE employer_verifications.id = $1;`
+	fetchLatestVerificationQuery    = `SELECT ` + verificationColumns + ` FROM ` + verificationSource + ` WHERE employer_verifications.employer_id = $1 ORDER BY employer_verifications.submitted_at DESC, employer_verifications.id DESC LIMIT 1;`
+	fetchVerificationDocumentsQuery = `SELECT id, verification_id, document_type, file_name, content_type, size, url FROM employer_verification_documents WHERE verification_id = $1 ORDER BY id;`
+	fetchVerificationsByStatusQuery = `SELECT ` + verificationColumns + ` FROM ` + verificationSource + ` WHERE employer_verifications.status = $1 ORDER BY employer_verifications.submitted_at, employer_verifications.id;`
+	reviewVerificationQuery         = `UPDATE employer_verifications SET status = $2, reason = NULLIF($3, ''), reviewed_by = NULLIF($4, 0), reviewed_at = NOW() WHERE id = $1;`
+	markEmployerVerifiedQuery       = `UPDATE employers SET is_verified = TRUE, updated_at = NOW() WHERE id = (SELECT employer_id FROM employer_verifications WHERE id = $1);`
+	fetchVerificationForUpdateQuery = `SELECT status FROM employer_verifications WHERE id = $1 FOR UPDATE;`
+)
+
+// Create a pending verification request with its documents in a single transaction
+func (verS *verificationStore) CreateVerification(ctx context.Context, verification EmployerVerification, documents []VerificationDocument) (EmployerVerification, error) {
+	tx, err := verS.DB.Beginx()
+	if err != nil {
+		return EmployerVerification{}, err
+	}
+
+	defer tx.Rollback()
+
+	rows, err := tx.NamedQuery(createVerificationQuery, verification)
+	if err != nil {
+		return EmployerVerification{}, err
+	}
+
+	defer rows.Close()
+
+	var verificationId int
+	if rows.Next() {
+		err = rows.Scan(&verificationId)
+		if err != nil {
+			return EmployerVerification{}, err
+		}
+	}
+	rows.Close()
+
+	for _, document := range documents {
+		document.VerificationID = verificationId
+		_, err = tx.NamedExec(createVerificationDocumentQuery, document)
+		if err != nil {
+			return EmployerVerification{}, err
+		}
+	}
+
+	var createdVerification EmployerVerification
+	err = tx.Get(&createdVerification, fetchVerificationByIdQuery, verificationId)
+	if err != nil {
+		return EmployerVerification{}, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return EmployerVerification{}, err
+	}
+
+	return createdVerification, nil
+}
+
+func (verS *verificationStore) FetchLatestVerification(ctx context.Context, employerId int) (EmployerVerification, error) {
+	var verification EmployerVerification
+
+	err := verS.DB.Get(&verification, fetchLatestVerificationQuery, employerId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return EmployerVerification{}, apperrors.ErrNoVerificationExists
+		}
+		return EmployerVerification{}, err
+	}
+	return verification, nil
+}
+
+func (verS *verificationStore) FetchVerificationById(ctx context.Context, verificationId int) (EmployerVerification, error) {
+	var verification EmployerVerification
+
+	err := verS.DB.Get(&verification, fetchVerificationByIdQuery, verificationId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return EmployerVerification{}, apperrors.ErrNoVerificationExists
+		}
+		return EmployerVerification{}, err
+	}
+	return verification, nil
+}
+
+func (verS *verificationStore) FetchVerificationDocuments(ctx context.Context, verificationId int) ([]VerificationDocument, error) {
+	documents := make([]VerificationDocument, 0)
+
+	err := verS.DB.Select(&documents, fetchVerificationDocumentsQuery, verificationId)
+	if err != nil {
+		return []VerificationDocument{}, err
+	}
+	return documents, nil
+}
+
+// Fetch the verification requests with a status, oldest first so that the review queue is
+// worked in order of submission
+func (verS *verificationStore) FetchVerificationsByStatus(ctx context.Context, status VerificationStatus) ([]EmployerVerification, error) {
+	verifications := make([]EmployerVerification, 0)
+
+	err := verS.DB.Select(&verifications, fetchVerificationsByStatusQuery, status)
+	if err != nil {
+		return []EmployerVerification{}, err
+	}
+	return verifications, nil
+}
+
+// Approve or reject a pending verification request, approving also marks the employer verified in
+// the same transaction. Requests that were already reviewed are left as they are.
+func (verS *verificationStore) ReviewVerification(ctx context.Context, verificationId int, status VerificationStatus, reason string, reviewedBy int) (EmployerVerification, error) {
+	tx, err := verS.DB.Beginx()
+	if err != nil {
+		return EmployerVerification{}, err
+	}
+
+	defer tx.Rollback()
+
+	var currentStatus VerificationStatus
+	err = tx.Get(&currentStatus, fetchVerificationForUpdateQuery, verificationId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return EmployerVerification{}, apperrors.ErrNoVerificationExists
+		}
+		return EmployerVerification{}, err
+	}
+	if currentStatus != VerificationPending {
+		return EmployerVerification{}, apperrors.ErrVerificationAlreadyReviewed
+	}
+
+	_, err = tx.Exec(reviewVerificationQuery, verificationId, status, reason, reviewedBy)
+	if err != nil {
+		return EmployerVerification{}, err
+	}
+
+	if status == VerificationApproved {
+		_, err = tx.Exec(markEmployerVerifiedQuery, verificationId)
+		if err != nil {
+			return EmployerVerification{}, err
+		}
+	}
+
+	var reviewedVerification EmployerVerification
+	err = tx.Get(&reviewedVerification, fetchVerificationByIdQuery, verificationId)
+	if err != nil {
+		return EmployerVerification{}, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return EmployerVerification{}, err
+	}
+
+	return reviewedVerification, nil
+}
@@ -0,0 +1,98 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type wageAnalyticsStore struct {
+	BaseRepository
+}
+
+type WageAnalyticsStorer interface {
+	FetchWageDistributions(ctx context.Context, filter WageFilter, groupBy string) ([]WageDistribution, error)
+}
+
+func NewWageAnalyticsRepo(db *sqlx.DB) WageAnalyticsStorer {
+	return &wageAnalyticsStore{
+		BaseRepository: BaseRepository{DB: db},
+	}
+}
+
+// Wage groups, the distributions of every job matching a filter are not grouped
+const (
+	WageGroupNone   = ""
+	WageGroupSector = "sector"
+	WageGroupSkill  = "skill"
+	WageGroupCity   = "city"
+	WageGroupSeason = "season"
+)
+
+// PostgreSQL Queries
+const (
+	// a job is one wage sample and every worker confirmed for it is one more, so the wages workers
+	// were hired at count more than the ones nobody took. Wages are compared as the wage of an 8 hour day
+	wageSamplesQuery = `WITH wage_samples AS (SELECT jobs.sectors, jobs.skills_required, address.city, ` + seasonColumn + ` AS season, jobs.wage * 8.0 / jobs.duration_in_hours AS daily_wage
+		FROM jobs INNER JOIN address ON jobs.location = address.id
+		CROSS JOIN LATERAL generate_series(0, (SELECT COUNT(*) FROM applications WHERE applications.job_id = jobs.id AND applications.status = 'confirmed')) AS hire
+		WHERE jobs.duration_in_hours > 0 AND jobs.date >= $1
+		AND NOT EXISTS (SELECT 1 FROM hidden_content WHERE hidden_content.target_type = 'job' AND hidden_content.target_id = jobs.id)`
+	seasonColumn            = `CASE WHEN EXTRACT(MONTH FROM jobs.date) BETWEEN 3 AND 5 THEN 'summer' WHEN EXTRACT(MONTH FROM jobs.date) BETWEEN 6 AND 9 THEN 'monsoon' ELSE 'winter' END`
+	wageDistributionColumns = `COUNT(*) AS samples, COALESCE(percentile_cont(0.25) WITHIN GROUP (ORDER BY daily_wage), 0) AS p25, COALESCE(percentile_cont(0.5) WITHIN GROUP (ORDER BY daily_wage), 0) AS median, COALESCE(percentile_cont(0.75) WITHIN GROUP (ORDER BY daily_wage), 0) AS p75`
+	// only the 20 groups with the most samples are listed
+	wageDistributionGroupOrder = ` ORDER BY samples DESC, value LIMIT 20;`
+)
+
+// wageGroupQueries select the distribution of each group from the wage_samples of a filter, a job
+// with several sectors or skills is counted in each of them
+var wageGroupQueries = map[string]string{
+	WageGroupNone:   `SELECT '' AS value, ` + wageDistributionColumns + ` FROM wage_samples;`,
+	WageGroupSector: `SELECT LOWER(TRIM(sector)) AS value, ` + wageDistributionColumns + ` FROM wage_samples CROSS JOIN LATERAL unnest(string_to_array(wage_samples.sectors, ',')) AS sector WHERE TRIM(sector) <> '' GROUP BY 1` + wageDistributionGroupOrder,
+	WageGroupSkill:  `SELECT LOWER(TRIM(skill)) AS value, ` + wageDistributionColumns + ` FROM wage_samples CROSS JOIN LATERAL unnest(string_to_array(wage_samples.skills_required, ',')) AS skill WHERE TRIM(skill) <> '' GROUP BY 1` + wageDistributionGroupOrder,
+	WageGroupCity:   `SELECT LOWER(TRIM(city)) AS value, ` + wageDistributionColumns + ` FROM wage_samples GROUP BY 1` + wageDistributionGroupOrder,
+	WageGroupSeason: `SELECT season AS value, ` + wageDistributionColumns + ` FROM wage_samples GROUP BY 1` + wageDistributionGroupOrder,
+}
+
+// Fetch the wage distribution of the jobs matching a filter, one for each sector, skill, city or
+// season when grouped by it
+func (wageAnalyticsS *wageAnalyticsStore) FetchWageDistributions(ctx context.Context, filter WageFilter, groupBy string) ([]WageDistribution, error) {
+	groupQuery, ok := wageGroupQueries[groupBy]
+	if !ok {
+		return []WageDistribution{}, fmt.Errorf("unknown wage group %q", groupBy)
+	}
+
+	distributions := make([]WageDistribution, 0)
+	query := wageSamplesQuery
+	args := []interface{}{filter.Since}
+	argIndex := 2
+
+	if len(filter.Sector) > 0 {
+		query += fmt.Sprintf(" AND EXISTS (SELECT 1 FROM unnest(string_to_array(jobs.sectors, ',')) AS s WHERE LOWER(TRIM(s)) = LOWER($%d))", argIndex)
+		args = append(args, filter.Sector)
+		argIndex++
+	}
+	if len(filter.Skill) > 0 {
+		query += fmt.Sprintf(" AND EXISTS (SELECT 1 FROM unnest(string_to_array(jobs.skills_required, ',')) AS s WHERE LOWER(TRIM(s)) = LOWER($%d))", argIndex)
+		args = append(args, filter.Skill)
+		argIndex++
+	}
+	if len(filter.City) > 0 {
+		query += fmt.Sprintf(" AND LOWER(TRIM(address.city)) = LOWER($%d)", argIndex)
+		args = append(args, filter.City)
+		argIndex++
+	}
+	if len(filter.Season) > 0 {
+		query += fmt.Sprintf(" AND "+seasonColumn+" = $%d", argIndex)
+		args = append(args, filter.Season)
+	}
+
+	query += ") " + groupQuery
+
+	err := wageAnalyticsS.DB.Select(&distributions, query, args...)
+	if err != nil {
+		return []WageDistribution{}, err
+	}
+	return distributions, nil
+}
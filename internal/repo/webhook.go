@@ -0,0 +1,218 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/jmoiron/sqlx"
+)
+
+type webhookStore struct {
+	BaseRepository
+}
+
+type WebhookStorer interface {
+	CreateWebhook(ctx context.Context, webhook Webhook) (Webhook, error)
+	FetchWebhooksByEmployerId(ctx context.Context, employerId int) ([]Webhook, error)
+	FetchWebhookById(ctx context.Context, webhookId int) (Webhook, error)
+	UpdateWebhook(ctx context.Context, webhook Webhook) (Webhook, error)
+	DeleteWebhook(ctx context.Context, webhookId int) (int, error)
+	FetchSubscribedWebhooks(ctx context.Context, employerId int, eventType string) ([]Webhook, error)
+	RecordWebhookOutcome(ctx context.Context, webhookId int, succeeded bool, disableAfter int) (Webhook, error)
+	EnqueueDelivery(ctx context.Context, delivery WebhookDelivery) (WebhookDelivery, error)
+	ClaimDueDeliveries(ctx context.Context, limit int, lease time.Duration) ([]WebhookDelivery, error)
+	FetchDeliveriesByWebhookId(ctx context.Context, webhookId int) ([]WebhookDelivery, error)
+	FetchDeliveryById(ctx context.Context, deliveryId int) (WebhookDelivery, error)
+	SaveDeliveryAttempt(ctx context.Context, delivery WebhookDelivery) (WebhookDelivery, error)
+}
+
+func NewWebhookRepo(db *sqlx.DB) WebhookStorer {
+	return &webhookStore{
+		BaseRepository: BaseRepository{DB: db},
+	}
+}
+
+// PostgreSQL Queries
+const (
+	webhookColumns                 = `id, employer_id, url, secret, events, enabled, consecutive_failures, disabled_at, created_at, updated_at`
+	deliveryColumns                = `id, webhook_id, event_id, event_type, payload, status, attempts, next_attempt_at, response_status, response_body, error, redelivery_of, created_at, delivered_at`
+	createWebhookQuery             = `INSERT INTO webhooks (employer_id, url, secret, events, enabled, consecutive_failures, created_at, updated_at) VALUES (:employer_id, :url, :secret, :events, TRUE, 0, NOW(), NOW()) RETURNING ` + webhookColumns + `;`
+	fetchWebhooksByEmployerIdQuery = `SELECT ` + webhookColumns + ` FROM webhooks WHERE employer_id = $1 ORDER BY id;`
+	fetchWebhookByIdQuery          = `SELECT ` + webhookColumns + ` FROM webhooks WHERE id = $1;`
+	updateWebhookQuery             = `UPDATE webhooks SET url=:url, events=:events, enabled=:enabled, consecutive_failures=:consecutive_failures, disabled_at=:disabled_at, updated_at=NOW() WHERE id=:id RETURNING ` + webhookColumns + `;`
+	deleteWebhookQuery             = `DELETE FROM webhooks WHERE id = $1 RETURNING id;`
+	fetchSubscribedWebhooksQuery   = `SELECT ` + webhookColumns + ` FROM webhooks WHERE employer_id = $1 AND enabled AND $2 = ANY(string_to_array(events, ',')) ORDER BY id;`
+	// consecutive failures are counted atomically, the webhook is disabled by the failure that reaches disableAfter
+	recordWebhookOutcomeQuery = `UPDATE webhooks SET consecutive_failures = CASE WHEN $2 THEN 0 ELSE consecutive_failures + 1 END, enabled = CASE WHEN NOT $2 AND consecutive_failures + 1 >= $3 THEN FALSE ELSE enabled END, disabled_at = CASE WHEN NOT $2 AND consecutive_failures + 1 >= $3 AND enabled THEN NOW() ELSE disabled_at END, updated_at = NOW() WHERE id = $1 RETURNING ` + webhookColumns + `;`
+	// an event is queued once per webhook however often the outbox delivers it, redeliveries are separate rows
+	enqueueDeliveryQuery            = `INSERT INTO webhook_deliveries (webhook_id, event_id, event_type, payload, status, attempts, next_attempt_at, response_status, response_body, error, redelivery_of, created_at) VALUES (:webhook_id, :event_id, :event_type, :payload, 'pending', 0, NOW(), 0, '', '', :redelivery_of, NOW()) ON CONFLICT (webhook_id, event_id) WHERE redelivery_of IS NULL DO NOTHING RETURNING ` + deliveryColumns + `;`
+	claimDueDeliveriesQuery         = `WITH claimed AS (UPDATE webhook_deliveries SET next_attempt_at = NOW() + make_interval(secs => $2) WHERE id IN (SELECT id FROM webhook_deliveries WHERE status = 'pending' AND next_attempt_at <= NOW() ORDER BY id LIMIT $1 FOR UPDATE SKIP LOCKED) RETURNING ` + deliveryColumns + `) SELECT * FROM claimed ORDER BY id;`
+	fetchDeliveriesByWebhookIdQuery = `SELECT ` + deliveryColumns + ` FROM webhook_deliveries WHERE webhook_id = $1 ORDER BY id DESC LIMIT 100;`
+	fetchDeliveryByIdQuery          = `SELECT ` + deliveryColumns + ` FROM webhook_deliveries WHERE id = $1;`
+	saveDeliveryAttemptQuery        = `UPDATE webhook_deliveries SET status=:status, attempts=:attempts, next_attempt_at=:next_attempt_at, response_status=:response_status, response_body=:response_body, error=:error, delivered_at=:delivered_at WHERE id=:id RETURNING ` + deliveryColumns + `;`
+)
+
+func (whS *webhookStore) CreateWebhook(ctx context.Context, webhook Webhook) (Webhook, error) {
+	var createdWebhook Webhook
+	rows, err := whS.DB.NamedQuery(createWebhookQuery, webhook)
+	if err != nil {
+		return Webhook{}, err
+	}
+
+	defer rows.Close()
+
+	if rows.Next() {
+		err = rows.StructScan(&createdWebhook)
+		if err != nil {
+			return Webhook{}, err
+		}
+	}
+	return createdWebhook, nil
+}
+
+func (whS *webhookStore) FetchWebhooksByEmployerId(ctx context.Context, employerId int) ([]Webhook, error) {
+	webhooks := make([]Webhook, 0)
+	err := whS.DB.Select(&webhooks, fetchWebhooksByEmployerIdQuery, employerId)
+	if err != nil {
+		return []Webhook{}, err
+	}
+	return webhooks, nil
+}
+
+func (whS *webhookStore) FetchWebhookById(ctx context.Context, webhookId int) (Webhook, error) {
+	var webhook Webhook
+	err := whS.DB.Get(&webhook, fetchWebhookByIdQuery, webhookId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return Webhook{}, apperrors.ErrNoWebhookExists
+		}
+		return Webhook{}, err
+	}
+	return webhook, nil
+}
+
+func (whS *webhookStore) UpdateWebhook(ctx context.Context, webhook Webhook) (Webhook, error) {
+	var updatedWebhook Webhook
+	rows, err := whS.DB.NamedQuery(updateWebhookQuery, webhook)
+	if err != nil {
+		return Webhook{}, err
+	}
+
+	defer rows.Close()
+
+	if !rows.Next() {
+		return Webhook{}, apperrors.ErrNoWebhookExists
+	}
+
+	err = rows.StructScan(&updatedWebhook)
+	if err != nil {
+		return Webhook{}, err
+	}
+	return updatedWebhook, nil
+}
+
+func (whS *webhookStore) DeleteWebhook(ctx context.Context, webhookId int) (int, error) {
+	var deletedId int
+	err := whS.DB.Get(&deletedId, deleteWebhookQuery, webhookId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return -1, apperrors.ErrNoWebhookExists
+		}
+		return -1, err
+	}
+	return deletedId, nil
+}
+
+func (whS *webhookStore) FetchSubscribedWebhooks(ctx context.Context, employerId int, eventType string) ([]Webhook, error) {
+	webhooks := make([]Webhook, 0)
+	err := whS.DB.Select(&webhooks, fetchSubscribedWebhooksQuery, employerId, eventType)
+	if err != nil {
+		return []Webhook{}, err
+	}
+	return webhooks, nil
+}
+
+func (whS *webhookStore) RecordWebhookOutcome(ctx context.Context, webhookId int, succeeded bool, disableAfter int) (Webhook, error) {
+	var webhook Webhook
+	err := whS.DB.Get(&webhook, recordWebhookOutcomeQuery, webhookId, succeeded, disableAfter)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return Webhook{}, apperrors.ErrNoWebhookExists
+		}
+		return Webhook{}, err
+	}
+	return webhook, nil
+}
+
+// EnqueueDelivery queues an event for a webhook, an empty delivery is returned when the event
+// was already queued for it
+func (whS *webhookStore) EnqueueDelivery(ctx context.Context, delivery WebhookDelivery) (WebhookDelivery, error) {
+	var queuedDelivery WebhookDelivery
+	rows, err := whS.DB.NamedQuery(enqueueDeliveryQuery, delivery)
+	if err != nil {
+		return WebhookDelivery{}, err
+	}
+
+	defer rows.Close()
+
+	if rows.Next() {
+		err = rows.StructScan(&queuedDelivery)
+		if err != nil {
+			return WebhookDelivery{}, err
+		}
+	}
+	return queuedDelivery, nil
+}
+
+func (whS *webhookStore) ClaimDueDeliveries(ctx context.Context, limit int, lease time.Duration) ([]WebhookDelivery, error) {
+	deliveries := make([]WebhookDelivery, 0)
+	err := whS.DB.Select(&deliveries, claimDueDeliveriesQuery, limit, lease.Seconds())
+	if err != nil {
+		return []WebhookDelivery{}, err
+	}
+	return deliveries, nil
+}
+
+func (whS *webhookStore) FetchDeliveriesByWebhookId(ctx context.Context, webhookId int) ([]WebhookDelivery, error) {
+	deliveries := make([]WebhookDelivery, 0)
+	err := whS.DB.Select(&deliveries, fetchDeliveriesByWebhookIdQuery, webhookId)
+	if err != nil {
+		return []WebhookDelivery{}, err
+	}
+	return deliveries, nil
+}
+
+func (whS *webhookStore) FetchDeliveryById(ctx context.Context, deliveryId int) (WebhookDelivery, error) {
+	var delivery WebhookDelivery
+	err := whS.DB.Get(&delivery, fetchDeliveryByIdQuery, deliveryId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return WebhookDelivery{}, apperrors.ErrNoWebhookDeliveryExists
+		}
+		return WebhookDelivery{}, err
+	}
+	return delivery, nil
+}
+
+func (whS *webhookStore) SaveDeliveryAttempt(ctx context.Context, delivery WebhookDelivery) (WebhookDelivery, error) {
+	var savedDelivery WebhookDelivery
+	rows, err := whS.DB.NamedQuery(saveDeliveryAttemptQuery, delivery)
+	if err != nil {
+		return WebhookDelivery{}, err
+	}
+
+	defer rows.Close()
+
+	if !rows.Next() {
+		return WebhookDelivery{}, apperrors.ErrNoWebhookDeliveryExists
+	}
+
+	err = rows.StructScan(&savedDelivery)
+	if err != nil {
+		return WebhookDelivery{}, err
+	}
+	return savedDelivery, nil
+}
@@ -0,0 +1,61 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/datetime"
+	"github.com/jmoiron/sqlx"
+)
+
+type workHistoryStore struct {
+	BaseRepository
+}
+
+type WorkHistoryStorer interface {
+	FetchWorkHistory(ctx context.Context, workerId int, from datetime.Date, to datetime.Date) ([]WorkHistoryEntry, error)
+}
+
+func NewWorkHistoryRepo(db *sqlx.DB) WorkHistoryStorer {
+	return &workHistoryStore{
+		BaseRepository: BaseRepository{DB: db},
+	}
+}
+
+// PostgreSQL Queries
+const (
+	// a day of work is completed when the worker checked out, days from $2 to $3 are counted. Only the
+	// wages of those days are owed and only the payments recorded from $4 until $5 are taken off
+	fetchWorkHistoryQuery = `SELECT applications.id AS application_id, applications.job_id, jobs.title, applications.worker_id, jobs.employer_id, applications.status,
+		CASE WHEN EXISTS (SELECT 1 FROM job_shifts WHERE job_shifts.job_id = jobs.id) THEN COALESCE(SUM(job_shifts.wage), 0) ELSE jobs.wage END AS owed,
+		COALESCE((SELECT SUM(CASE WHEN payments.type = 'refund' THEN -amount ELSE amount END) FROM payments WHERE payments.application_id = applications.id AND payments.type IN ('advance', 'final', 'refund') AND payments.recorded_at >= $4 AND payments.recorded_at < $5), 0) AS paid,
+		COALESCE((SELECT SUM(amount) FROM payments WHERE payments.application_id = applications.id AND payments.type = 'deduction' AND payments.recorded_at >= $4 AND payments.recorded_at < $5), 0) AS deducted,
+		employers.name AS employer_name, employers.rating AS employer_rating,
+		MIN(attendance.date) AS first_day, MAX(attendance.date) AS last_day, COUNT(*) AS days_worked, COALESCE(SUM(attendance.minutes_worked), 0) AS minutes_worked
+		FROM ` + duesSource + ` INNER JOIN employers ON jobs.employer_id = employers.id INNER JOIN attendance ON attendance.application_id = applications.id
+		LEFT JOIN job_shifts ON job_shifts.id = attendance.shift_id AND job_shifts.job_id = jobs.id
+		WHERE applications.worker_id = $1 AND applications.status = 'confirmed' AND attendance.status = 'checked_out' AND attendance.date BETWEEN $2 AND $3
+		GROUP BY applications.id, jobs.id, employers.id ORDER BY first_day, applications.id;`
+)
+
+// Fetch the confirmed applications a worker completed work for in a period, the first one worked first,
+// with the dues of the days worked and the payments recorded in the period
+func (workHistoryS *workHistoryStore) FetchWorkHistory(ctx context.Context, workerId int, from datetime.Date, to datetime.Date) ([]WorkHistoryEntry, error) {
+	entries := make([]WorkHistoryEntry, 0)
+
+	// payments are recorded from the start of the first day until the midnight after the last one
+	paidFrom, err := from.Time()
+	if err != nil {
+		return []WorkHistoryEntry{}, err
+	}
+	paidTo, err := to.Time()
+	if err != nil {
+		return []WorkHistoryEntry{}, err
+	}
+	paidTo = paidTo.AddDate(0, 0, 1)
+
+	err = workHistoryS.DB.Select(&entries, fetchWorkHistoryQuery, workerId, from, to, paidFrom, paidTo)
+	if err != nil {
+		return []WorkHistoryEntry{}, err
+	}
+	return entries, nil
+}
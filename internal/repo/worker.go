@@ -32,14 +32,16 @@ func NewWorkerRepo(db *sqlx.DB) WorkerStorer {
 
 // PostgreSQL Queries
 const (
-	fetchWorkerByIDQuery             = `SELECT workers.id, name, contact_number, email, gender, sectors, skills, location, is_available, rating, total_jobs_worked, created_at, updated_at, language from workers inner join address on workers.location = address.id where workers.id = $1;`
+	fetchWorkerByIDQuery             = `SELECT workers.id, name, contact_number, email, gender, sectors, skills, location, is_available, rating, total_jobs_worked, created_at, updated_at, language, ` + verifiedDocumentsColumn + ` from workers inner join address on workers.location = address.id where workers.id = $1;`
 	createWorkerQuery                = `INSERT INTO Workers (name, contact_number, email, gender, password, sectors, skills, location, is_available, rating, total_jobs_worked, created_at, updated_at, language) VALUES (:name, :contact_number, :email, :gender, :password, :sectors, :skills, :location, :is_available, :rating, :total_jobs_worked, NOW(), NOW(), :language) RETURNING *;`
 	updateWorkerByIDQuery            = `UPDATE Workers SET name=:name, contact_number=:contact_number, email=:email, gender=:gender, sectors=:sectors, skills=:skills, is_available=:is_available, rating=:rating, total_jobs_worked=:total_jobs_worked, updated_at=NOW(), language=:language WHERE id=:id RETURNING *;`
 	deleteWorkerByIdQuery            = `DELETE FROM workers WHERE id=$1 RETURNING location;`
 	findEmailExistsQuery             = "SELECT id FROM workers WHERE email = $1;"
 	findIdExistsQuery                = "SELECT id FROM workers WHERE id = $1;"
 	fetchApplicationsByWorkerIdQuery = `select applications.*, address.details, address.street, address.state, address.city, address.pincode, jobs.title, jobs.description, jobs.skills_required, jobs.sectors, jobs.wage, jobs.vacancy, jobs.date, employers.name, employers.contact_number, employers.email, employers.type from applications inner join address on applications.pick_up_location = address.id inner join jobs on applications.job_id = jobs.id inner join employers on jobs.employer_id = employers.id WHERE applications.worker_id = $1`
-	fetchAllWorkersQuery             = `SELECT workers.*, address.details, address.street, address.city, address.state, address.pincode FROM workers inner join address on workers.location = address.id;`
+	fetchAllWorkersQuery             = `SELECT workers.*, address.details, address.street, address.city, address.state, address.pincode, ` + verifiedDocumentsColumn + ` FROM workers inner join address on workers.location = address.id WHERE NOT EXISTS (SELECT 1 FROM hidden_content WHERE hidden_content.target_type = 'worker' AND hidden_content.target_id = workers.id);`
+	// the types of the approved KYC documents of the worker, comma separated
+	verifiedDocumentsColumn = `COALESCE((SELECT string_agg(DISTINCT worker_documents.document_type, ',') FROM worker_documents WHERE worker_documents.worker_id = workers.id AND worker_documents.status = 'approved'), '') AS verified_documents`
 )
 
 // Create a New Worker
@@ -54,14 +56,21 @@ func (ws *workerStore) CreateWorker(ctx context.Context, workerData Worker) (Wor
 		Pincode: worker.Pincode,
 	}
 
-	address, err := CreateAddress(ctx, ws.DB, addressData)
+	tx, err := ws.DB.Beginx()
+	if err != nil {
+		return Worker{}, err
+	}
+
+	defer tx.Rollback()
+
+	address, err := CreateAddress(ctx, tx, addressData)
 	if err != nil {
 		return Worker{}, err
 	}
 
 	workerData.Location = address.ID
 
-	rows, err := ws.DB.NamedQuery(createWorkerQuery, workerData)
+	rows, err := tx.NamedQuery(createWorkerQuery, workerData)
 	if err != nil {
 		return Worker{}, err
 	}
@@ -74,6 +83,17 @@ func (ws *workerStore) CreateWorker(ctx context.Context, workerData Worker) (Wor
 			return Worker{}, err
 		}
 	}
+	rows.Close()
+
+	err = writeOutboxEvent(ctx, tx, WorkerRegisteredEvent, EventPayload{WorkerID: worker.ID})
+	if err != nil {
+		return Worker{}, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return Worker{}, err
+	}
 	worker = MapAddressToWorker(worker, address)
 	return worker, nil
 }
@@ -148,16 +168,30 @@ func (ws *workerStore) UpdateWorkerByID(ctx context.Context, workerData Worker)
 
 // Delete Worker data and address
 func (ws *workerStore) DeleteWorkerByID(ctx context.Context, workerId int) (int, error) {
+	tx, err := ws.DB.Beginx()
+	if err != nil {
+		return -1, err
+	}
+
+	defer tx.Rollback()
 
 	var addressId int
+	err = tx.Get(&addressId, deleteWorkerByIdQuery, workerId)
+	if err != nil {
+		return -1, err
+	}
 
-	err := ws.DB.Get(&addressId, deleteWorkerByIdQuery, workerId)
+	err = DeleteAddress(ctx, tx, addressId)
+	if err != nil {
+		return -1, err
+	}
 
+	err = writeOutboxEvent(ctx, tx, WorkerDeletedEvent, EventPayload{WorkerID: workerId})
 	if err != nil {
 		return -1, err
 	}
 
-	err = DeleteAddress(ctx, ws.DB, addressId)
+	err = tx.Commit()
 	if err != nil {
 		return -1, err
 	}
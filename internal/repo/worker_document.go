@@ -0,0 +1,135 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/harsh-jagtap-josh/RozgarLink/internal/pkg/apperrors"
+	"github.com/jmoiron/sqlx"
+)
+
+type workerDocumentStore struct {
+	BaseRepository
+}
+
+type WorkerDocumentStorer interface {
+	CreateWorkerDocument(ctx context.Context, document WorkerDocument) (WorkerDocument, error)
+	FetchWorkerDocuments(ctx context.Context, workerId int) ([]WorkerDocument, error)
+	FetchWorkerDocumentById(ctx context.Context, documentId int) (WorkerDocument, error)
+	FetchWorkerDocumentsByStatus(ctx context.Context, status VerificationStatus) ([]WorkerDocument, error)
+	ReviewWorkerDocument(ctx context.Context, documentId int, status VerificationStatus, reason string, reviewedBy int) (WorkerDocument, error)
+}
+
+func NewWorkerDocumentRepo(db *sqlx.DB) WorkerDocumentStorer {
+	return &workerDocumentStore{
+		BaseRepository: BaseRepository{DB: db},
+	}
+}
+
+// PostgreSQL Queries
+const (
+	workerDocumentColumns             = `worker_documents.id, worker_documents.worker_id, workers.name AS worker_name, worker_documents.document_type, worker_documents.masked_number, worker_documents.blob_key, worker_documents.file_name, worker_documents.content_type, worker_documents.size, worker_documents.status, COALESCE(worker_documents.reason, '') AS reason, COALESCE(worker_documents.reviewed_by, 0) AS reviewed_by, worker_documents.uploaded_at, worker_documents.reviewed_at`
+	workerDocumentSource              = `worker_documents INNER JOIN workers ON worker_documents.worker_id = workers.id`
+	createWorkerDocumentQuery         = `INSERT INTO worker_documents (worker_id, document_type, masked_number, blob_key, file_name, content_type, size, status, uploaded_at) VALUES (:worker_id, :document_type, :masked_number, :blob_key, :file_name, :content_type, :size, 'pending', NOW()) RETURNING id;`
+	fetchWorkerDocumentsQuery         = `SELECT ` + workerDocumentColumns + ` FROM ` + workerDocumentSource + ` WHERE worker_documents.worker_id = $1 ORDER BY worker_documents.uploaded_at DESC, worker_documents.id DESC;`
+	fetchWorkerDocumentByIdQuery      = `SELECT ` + workerDocumentColumns + ` FROM ` + workerDocumentSource + ` WHERE worker_documents.id = $1;`
+	fetchWorkerDocumentsByStatusQuery = `SELECT ` + workerDocumentColumns + ` FROM ` + workerDocumentSource + ` WHERE worker_documents.status = $1 ORDER BY worker_documents.uploaded_at, worker_documents.id;`
+	lockWorkerDocumentStatusQuery     = `SELECT status FROM worker_documents WHERE id = $1 FOR UPDATE;`
+	reviewWorkerDocumentQuery         = `UPDATE worker_documents SET status = $2, reason = NULLIF($3, ''), reviewed_by = NULLIF($4, 0), reviewed_at = NOW() WHERE id = $1;`
+)
+
+func (wdS *workerDocumentStore) CreateWorkerDocument(ctx context.Context, document WorkerDocument) (WorkerDocument, error) {
+	rows, err := wdS.DB.NamedQuery(createWorkerDocumentQuery, document)
+	if err != nil {
+		return WorkerDocument{}, err
+	}
+
+	defer rows.Close()
+
+	var documentId int
+	if rows.Next() {
+		err = rows.Scan(&documentId)
+		if err != nil {
+			return WorkerDocument{}, err
+		}
+	}
+	rows.Close()
+
+	return wdS.FetchWorkerDocumentById(ctx, documentId)
+}
+
+func (wdS *workerDocumentStore) FetchWorkerDocuments(ctx context.Context, workerId int) ([]WorkerDocument, error) {
+	documents := make([]WorkerDocument, 0)
+
+	err := wdS.DB.Select(&documents, fetchWorkerDocumentsQuery, workerId)
+	if err != nil {
+		return []WorkerDocument{}, err
+	}
+	return documents, nil
+}
+
+func (wdS *workerDocumentStore) FetchWorkerDocumentById(ctx context.Context, documentId int) (WorkerDocument, error) {
+	var document WorkerDocument
+
+	err := wdS.DB.Get(&document, fetchWorkerDocumentByIdQuery, documentId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return WorkerDocument{}, apperrors.ErrNoWorkerDocumentExists
+		}
+		return WorkerDocument{}, err
+	}
+	return document, nil
+}
+
+// Fetch the documents with a status, oldest first so that the review queue is worked in order
+// of upload
+func (wdS *workerDocumentStore) FetchWorkerDocumentsByStatus(ctx context.Context, status VerificationStatus) ([]WorkerDocument, error) {
+	documents := make([]WorkerDocument, 0)
+
+	err := wdS.DB.Select(&documents, fetchWorkerDocumentsByStatusQuery, status)
+	if err != nil {
+		return []WorkerDocument{}, err
+	}
+	return documents, nil
+}
+
+// Approve or reject a pending document, documents that were already reviewed are left as they are
+func (wdS *workerDocumentStore) ReviewWorkerDocument(ctx context.Context, documentId int, status VerificationStatus, reason string, reviewedBy int) (WorkerDocument, error) {
+	tx, err := wdS.DB.Beginx()
+	if err != nil {
+		return WorkerDocument{}, err
+	}
+
+	defer tx.Rollback()
+
+	var currentStatus VerificationStatus
+	err = tx.Get(&currentStatus, lockWorkerDocumentStatusQuery, documentId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return WorkerDocument{}, apperrors.ErrNoWorkerDocumentExists
+		}
+		return WorkerDocument{}, err
+	}
+	if currentStatus != VerificationPending {
+		return WorkerDocument{}, apperrors.ErrDocumentAlreadyReviewed
+	}
+
+	_, err = tx.Exec(reviewWorkerDocumentQuery, documentId, status, reason, reviewedBy)
+	if err != nil {
+		return WorkerDocument{}, err
+	}
+
+	var reviewedDocument WorkerDocument
+	err = tx.Get(&reviewedDocument, fetchWorkerDocumentByIdQuery, documentId)
+	if err != nil {
+		return WorkerDocument{}, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return WorkerDocument{}, err
+	}
+
+	return reviewedDocument, nil
+}